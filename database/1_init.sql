@@ -9,18 +9,46 @@ BEGIN
 END;
 $function$;
 
+CREATE OR REPLACE FUNCTION prevent_modification()
+	RETURNS trigger LANGUAGE plpgsql AS $function$
+BEGIN
+	RAISE EXCEPTION '% rows cannot be modified', TG_TABLE_NAME;
+END;
+$function$;
+
+CREATE TABLE tiers (
+	id UUID PRIMARY KEY,
+	name TEXT UNIQUE NOT NULL,
+	min_points DECIMAL UNIQUE NOT NULL CHECK (min_points >= 0),
+	benefits TEXT[] NOT NULL DEFAULT '{}',
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER tiers_modtime BEFORE UPDATE
+	ON tiers
+	FOR EACH ROW EXECUTE PROCEDURE update_modified_column();
+
 CREATE TABLE users (
 	id UUID PRIMARY KEY,
 	name TEXT NOT NULL,
 	email CITEXT UNIQUE NOT NULL,
 	password TEXT NOT NULL,
 	balance DECIMAL DEFAULT 0,
+	bonus_balance DECIMAL NOT NULL DEFAULT 0,
+	loyalty_points DECIMAL NOT NULL DEFAULT 0 CHECK (loyalty_points >= 0),
+	tier_id UUID REFERENCES tiers(id) ON DELETE SET NULL,
+	tier_grace_until TIMESTAMPTZ,
+	currency CHAR(3) NOT NULL DEFAULT 'EUR',
+	country CHAR(2),
+	referral_code TEXT UNIQUE NOT NULL DEFAULT upper(substr(md5(gen_random_uuid()::text), 1, 8)),
 	role INTEGER DEFAULT 0,
 	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
 CREATE INDEX users_email_idx ON users (email);
+CREATE INDEX users_mailbox_idx ON users (lower(regexp_replace(email::text, '\+[^@]*@', '@')));
 
 CREATE TRIGGER users_modtime BEFORE UPDATE
 	ON users 
@@ -31,23 +59,447 @@ CREATE TABLE promotions (
 	title TEXT NOT NULL,
 	description TEXT,
 	amount DECIMAL NOT NULL,
+	currency CHAR(3) NOT NULL DEFAULT 'EUR',
 	is_active BOOLEAN,
 	type TEXT NOT NULL DEFAULT 'regular',
+	wagering_multiplier DECIMAL NOT NULL DEFAULT 0 CHECK (wagering_multiplier >= 0),
+	cashback JSONB,
+	match_bonus JSONB,
+	free_spins JSONB,
+	eligibility JSONB,
+	available_from TIMESTAMPTZ,
+	available_until TIMESTAMPTZ,
+	-- switched by hand, the scheduler leaves it until the next edge of the window
+	manual_state_at TIMESTAMPTZ,
+	budget DECIMAL CHECK (budget > 0),
+	max_claims INTEGER CHECK (max_claims > 0),
+	max_claims_per_user INTEGER CHECK (max_claims_per_user > 0),
+	spent DECIMAL NOT NULL DEFAULT 0,
+	claims INTEGER NOT NULL DEFAULT 0,
+	archived TIMESTAMPTZ,
 	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	updated TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK ((type = 'cashback') = (cashback IS NOT NULL)),
+	CHECK ((type = 'match_bonus') = (match_bonus IS NOT NULL)),
+	CHECK ((type = 'free_spins') = (free_spins IS NOT NULL)),
+	CHECK (available_from < available_until),
+	CHECK (spent <= budget),
+	CHECK (claims <= max_claims),
+	-- archived promotions cannot be assigned
+	CHECK (archived IS NULL OR NOT COALESCE(is_active, FALSE))
 );
 
+CREATE INDEX promotions_scheduled_idx ON promotions (available_from, available_until)
+	WHERE available_from IS NOT NULL OR available_until IS NOT NULL;
+
 CREATE TRIGGER promotions_modtime BEFORE UPDATE
 	ON promotions 
 	FOR EACH ROW EXECUTE PROCEDURE update_modified_column();
 
+CREATE TABLE promotion_state_changes (
+	id UUID PRIMARY KEY,
+	promotion_id UUID NOT NULL REFERENCES promotions(id) ON DELETE CASCADE,
+	is_active BOOLEAN NOT NULL,
+	reason TEXT NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX promotion_state_changes_promotion_id_idx ON promotion_state_changes (promotion_id, created DESC);
+
+CREATE TRIGGER promotion_state_changes_immutable BEFORE UPDATE
+	ON promotion_state_changes
+	FOR EACH ROW EXECUTE PROCEDURE prevent_modification();
+
 CREATE TABLE users_promotions (
 	id UUID PRIMARY KEY,
 	user_id UUID REFERENCES users(id) ON DELETE CASCADE,
-	promotion_id UUID REFERENCES promotions(id) ON DELETE CASCADE,
+	promotion_id UUID REFERENCES promotions(id),
+	status TEXT NOT NULL DEFAULT 'assigned'
+		CHECK (status IN ('assigned', 'claimed', 'expired', 'revoked', 'forfeited')),
 	claimed TIMESTAMPTZ,
+	bonus_amount DECIMAL NOT NULL DEFAULT 0,
+	wagering_required DECIMAL NOT NULL DEFAULT 0,
+	wagered DECIMAL NOT NULL DEFAULT 0,
+	converted TIMESTAMPTZ,
+	forfeited TIMESTAMPTZ,
+	deposit_id UUID UNIQUE,
 	start_date TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 	end_date TIMESTAMPTZ NOT NULL,
 	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
 	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
-); 
\ No newline at end of file
+);
+
+CREATE INDEX users_promotions_active_bonus_idx ON users_promotions (user_id)
+	WHERE status = 'claimed' AND converted IS NULL;
+
+CREATE INDEX users_promotions_assigned_idx ON users_promotions (end_date)
+	WHERE status = 'assigned';
+
+-- assigned promotions are claimed, expire or are revoked, claimed bonuses
+-- are forfeited or revoked
+CREATE OR REPLACE FUNCTION check_user_promotion_status()
+	RETURNS trigger LANGUAGE plpgsql AS $function$
+BEGIN
+	IF NEW.status <> OLD.status AND NOT (
+		(OLD.status = 'assigned' AND NEW.status IN ('claimed', 'expired', 'revoked')) OR
+		(OLD.status = 'claimed' AND NEW.status IN ('forfeited', 'revoked'))
+	) THEN
+		RAISE EXCEPTION 'user promotion cannot change from % to %', OLD.status, NEW.status
+			USING ERRCODE = 'check_violation';
+	END IF;
+	RETURN NEW;
+END;
+$function$;
+
+CREATE TRIGGER users_promotions_status BEFORE UPDATE OF status
+	ON users_promotions
+	FOR EACH ROW EXECUTE PROCEDURE check_user_promotion_status();
+
+-- reminders sent for assigned promotions, once per lead time before their
+-- end date
+CREATE TABLE user_promotion_reminders (
+	user_promotion_id UUID NOT NULL REFERENCES users_promotions(id) ON DELETE CASCADE,
+	lead_seconds BIGINT NOT NULL CHECK (lead_seconds > 0),
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_promotion_id, lead_seconds)
+);
+
+-- kept for compliance when the user promotion is deleted
+CREATE TABLE user_promotion_revocations (
+	id UUID PRIMARY KEY,
+	user_promotion_id UUID NOT NULL,
+	user_id UUID NOT NULL REFERENCES users(id),
+	promotion_id UUID NOT NULL,
+	revoked_by UUID NOT NULL REFERENCES users(id),
+	reason TEXT NOT NULL,
+	note TEXT NOT NULL DEFAULT '',
+	previous_status TEXT NOT NULL,
+	credited DECIMAL NOT NULL,
+	clawed_back DECIMAL NOT NULL,
+	currency CHAR(3) NOT NULL,
+	account TEXT,
+	policy TEXT NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX user_promotion_revocations_user_id_idx ON user_promotion_revocations (user_id, created DESC);
+
+CREATE TRIGGER user_promotion_revocations_immutable BEFORE UPDATE OR DELETE
+	ON user_promotion_revocations
+	FOR EACH ROW EXECUTE PROCEDURE prevent_modification();
+
+-- versions cannot change, players keep the version they registered under
+CREATE TABLE welcome_packages (
+	id UUID PRIMARY KEY,
+	version INTEGER UNIQUE NOT NULL CHECK (version > 0),
+	steps JSONB NOT NULL,
+	created_by UUID NOT NULL REFERENCES users(id),
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER welcome_packages_immutable BEFORE UPDATE OR DELETE
+	ON welcome_packages
+	FOR EACH ROW EXECUTE PROCEDURE prevent_modification();
+
+CREATE TABLE user_welcome_packages (
+	user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	package_id UUID NOT NULL REFERENCES welcome_packages(id),
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE user_welcome_steps (
+	user_id UUID NOT NULL REFERENCES user_welcome_packages(user_id) ON DELETE CASCADE,
+	step INTEGER NOT NULL,
+	user_promotion_id UUID REFERENCES users_promotions(id) ON DELETE SET NULL,
+	unlocked TIMESTAMPTZ NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, step)
+);
+
+CREATE TABLE promotion_codes (
+	id UUID PRIMARY KEY,
+	promotion_id UUID NOT NULL REFERENCES promotions(id) ON DELETE CASCADE,
+	code TEXT UNIQUE NOT NULL,
+	kind TEXT NOT NULL,
+	max_redemptions INTEGER CHECK (max_redemptions > 0),
+	redemptions INTEGER NOT NULL DEFAULT 0,
+	validity_days INTEGER NOT NULL DEFAULT 7 CHECK (validity_days > 0),
+	expires TIMESTAMPTZ,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (redemptions <= max_redemptions)
+);
+
+CREATE INDEX promotion_codes_promotion_id_idx ON promotion_codes (promotion_id, created);
+
+CREATE TABLE promotion_code_redemptions (
+	promotion_code_id UUID NOT NULL REFERENCES promotion_codes(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	user_promotion_id UUID NOT NULL REFERENCES users_promotions(id) ON DELETE CASCADE,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (promotion_code_id, user_id)
+);
+
+CREATE TABLE free_spins (
+	id UUID PRIMARY KEY,
+	user_promotion_id UUID UNIQUE NOT NULL REFERENCES users_promotions(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	game_id TEXT NOT NULL,
+	spins INTEGER NOT NULL CHECK (spins > 0),
+	remaining INTEGER NOT NULL CHECK (remaining >= 0),
+	stake DECIMAL NOT NULL CHECK (stake > 0),
+	winnings DECIMAL NOT NULL DEFAULT 0 CHECK (winnings >= 0),
+	currency CHAR(3) NOT NULL,
+	expires TIMESTAMPTZ NOT NULL,
+	settled TIMESTAMPTZ,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX free_spins_user_id_idx ON free_spins (user_id) WHERE settled IS NULL;
+CREATE INDEX free_spins_expires_idx ON free_spins (expires) WHERE settled IS NULL;
+
+CREATE TRIGGER free_spins_modtime BEFORE UPDATE
+	ON free_spins
+	FOR EACH ROW EXECUTE PROCEDURE update_modified_column();
+
+CREATE TABLE free_spin_rounds (
+	free_spins_id UUID NOT NULL REFERENCES free_spins(id) ON DELETE CASCADE,
+	round_id TEXT NOT NULL,
+	win DECIMAL NOT NULL CHECK (win >= 0),
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (free_spins_id, round_id)
+);
+
+CREATE TABLE cashback_calculations (
+	id UUID PRIMARY KEY,
+	promotion_id UUID NOT NULL REFERENCES promotions(id),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	-- kept as the audit record when the user promotion is deleted
+	user_promotion_id UUID REFERENCES users_promotions(id) ON DELETE SET NULL,
+	period_start TIMESTAMPTZ NOT NULL,
+	period_end TIMESTAMPTZ NOT NULL,
+	wagered DECIMAL NOT NULL,
+	returned DECIMAL NOT NULL,
+	net_loss DECIMAL NOT NULL,
+	percentage DECIMAL NOT NULL,
+	max_amount DECIMAL NOT NULL,
+	amount DECIMAL NOT NULL,
+	currency CHAR(3) NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (promotion_id, user_id, period_start)
+);
+
+CREATE INDEX cashback_calculations_user_id_idx ON cashback_calculations (user_id, period_start DESC);
+
+CREATE TABLE ledger_entries (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id),
+	debit_account TEXT NOT NULL,
+	credit_account TEXT NOT NULL,
+	amount DECIMAL NOT NULL CHECK (amount > 0),
+	currency CHAR(3) NOT NULL,
+	source TEXT NOT NULL,
+	reference_id UUID,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (debit_account <> credit_account)
+);
+
+CREATE INDEX ledger_entries_user_id_idx ON ledger_entries (user_id, created DESC, id DESC);
+
+CREATE TRIGGER ledger_entries_immutable BEFORE UPDATE OR DELETE
+	ON ledger_entries
+	FOR EACH ROW EXECUTE PROCEDURE prevent_modification();
+
+CREATE OR REPLACE FUNCTION ledger_balance(p_user_id UUID, p_account TEXT)
+	RETURNS DECIMAL LANGUAGE sql STABLE AS $function$
+	SELECT COALESCE(SUM(CASE WHEN credit_account = p_account THEN amount ELSE -amount END), 0)
+	FROM ledger_entries
+	WHERE user_id = p_user_id
+		AND (credit_account = p_account OR debit_account = p_account);
+$function$;
+
+CREATE TABLE idempotency_keys (
+	user_id UUID NOT NULL,
+	key TEXT NOT NULL,
+	request_hash TEXT NOT NULL,
+	status_code INT,
+	response BYTEA,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, key)
+);
+
+CREATE TABLE game_events (
+	id UUID PRIMARY KEY,
+	event_id TEXT NOT NULL,
+	user_id UUID NOT NULL REFERENCES users(id),
+	game_id TEXT NOT NULL,
+	game_category TEXT NOT NULL DEFAULT '',
+	round_id TEXT NOT NULL,
+	type TEXT NOT NULL,
+	amount DECIMAL NOT NULL CHECK (amount >= 0),
+	currency CHAR(3) NOT NULL,
+	cash_amount DECIMAL NOT NULL DEFAULT 0,
+	bonus_amount DECIMAL NOT NULL DEFAULT 0,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (game_id, event_id)
+);
+
+CREATE INDEX game_events_user_id_idx ON game_events (user_id, created);
+CREATE INDEX game_events_round_idx ON game_events (game_id, round_id, type);
+
+-- a round has one bet and at most one rollback, wins can repeat
+CREATE UNIQUE INDEX game_events_round_bet_idx ON game_events (game_id, round_id, type)
+	WHERE type <> 'win';
+
+-- what each bet added to the wagered total of a bonus, so the wager of a
+-- rolled back bet can be taken off again
+CREATE TABLE user_promotion_wagers (
+	user_promotion_id UUID NOT NULL REFERENCES users_promotions(id) ON DELETE CASCADE,
+	game_event_id UUID NOT NULL REFERENCES game_events(id),
+	amount DECIMAL NOT NULL CHECK (amount > 0),
+	reversed TIMESTAMPTZ,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_promotion_id, game_event_id)
+);
+
+CREATE INDEX user_promotion_wagers_game_event_id_idx ON user_promotion_wagers (game_event_id);
+
+CREATE TABLE points_rates (
+	category TEXT PRIMARY KEY,
+	rate DECIMAL NOT NULL CHECK (rate >= 0),
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER points_rates_modtime BEFORE UPDATE
+	ON points_rates
+	FOR EACH ROW EXECUTE PROCEDURE update_modified_column();
+
+CREATE TABLE points_entries (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id),
+	points DECIMAL NOT NULL,
+	source TEXT NOT NULL,
+	reference_id UUID,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE (source, reference_id)
+);
+
+CREATE INDEX points_entries_user_id_idx ON points_entries (user_id, created);
+
+CREATE TRIGGER points_entries_immutable BEFORE UPDATE OR DELETE
+	ON points_entries
+	FOR EACH ROW EXECUTE PROCEDURE prevent_modification();
+
+CREATE TABLE points_lots (
+	entry_id UUID PRIMARY KEY REFERENCES points_entries(id),
+	user_id UUID NOT NULL REFERENCES users(id),
+	remaining DECIMAL NOT NULL CHECK (remaining >= 0),
+	expires TIMESTAMPTZ,
+	warned TIMESTAMPTZ,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX points_lots_user_id_idx ON points_lots (user_id, expires) WHERE remaining > 0;
+CREATE INDEX points_lots_expires_idx ON points_lots (expires) WHERE remaining > 0;
+
+CREATE TABLE tier_history (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id),
+	previous_tier_id UUID,
+	previous_tier_name TEXT,
+	tier_id UUID,
+	tier_name TEXT,
+	reason TEXT NOT NULL,
+	qualifying_points DECIMAL NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX tier_history_user_id_idx ON tier_history (user_id, created DESC);
+
+CREATE TRIGGER tier_history_immutable BEFORE UPDATE OR DELETE
+	ON tier_history
+	FOR EACH ROW EXECUTE PROCEDURE prevent_modification();
+
+CREATE TABLE catalog_items (
+	id UUID PRIMARY KEY,
+	title TEXT NOT NULL,
+	description TEXT,
+	type TEXT NOT NULL,
+	points_price DECIMAL NOT NULL CHECK (points_price > 0),
+	stock INTEGER CHECK (stock >= 0),
+	bonus_amount DECIMAL NOT NULL DEFAULT 0,
+	currency CHAR(3) NOT NULL DEFAULT 'EUR',
+	promotion_id UUID REFERENCES promotions(id),
+	validity_days INTEGER NOT NULL DEFAULT 7,
+	is_active BOOLEAN NOT NULL DEFAULT TRUE,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER catalog_items_modtime BEFORE UPDATE
+	ON catalog_items
+	FOR EACH ROW EXECUTE PROCEDURE update_modified_column();
+
+CREATE TABLE redemptions (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id),
+	catalog_item_id UUID NOT NULL REFERENCES catalog_items(id),
+	points DECIMAL NOT NULL,
+	status TEXT NOT NULL,
+	user_promotion_id UUID,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	fulfilled TIMESTAMPTZ
+);
+
+CREATE INDEX redemptions_user_id_idx ON redemptions (user_id, created DESC);
+CREATE INDEX redemptions_pending_idx ON redemptions (created) WHERE status = 'pending';
+
+CREATE TABLE tournaments (
+	id UUID PRIMARY KEY,
+	name TEXT NOT NULL,
+	description TEXT,
+	game_category TEXT NOT NULL DEFAULT '',
+	scoring TEXT NOT NULL,
+	start_date TIMESTAMPTZ NOT NULL,
+	end_date TIMESTAMPTZ NOT NULL,
+	prizes JSONB NOT NULL DEFAULT '[]',
+	finished TIMESTAMPTZ,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (start_date < end_date)
+);
+
+CREATE INDEX tournaments_running_idx ON tournaments (end_date, start_date) WHERE finished IS NULL;
+
+CREATE TRIGGER tournaments_modtime BEFORE UPDATE
+	ON tournaments
+	FOR EACH ROW EXECUTE PROCEDURE update_modified_column();
+
+CREATE TABLE tournament_results (
+	tournament_id UUID NOT NULL REFERENCES tournaments(id),
+	user_id UUID NOT NULL REFERENCES users(id),
+	rank INTEGER NOT NULL,
+	score DOUBLE PRECISION NOT NULL,
+	user_promotion_id UUID,
+	-- why the prize can never be granted
+	prize_error TEXT,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (tournament_id, user_id)
+);
+
+CREATE INDEX tournament_results_unpaid_idx ON tournament_results (created)
+	WHERE user_promotion_id IS NULL AND prize_error IS NULL;
+
+CREATE TABLE referrals (
+	id UUID PRIMARY KEY,
+	referrer_id UUID NOT NULL REFERENCES users(id),
+	referee_id UUID UNIQUE NOT NULL REFERENCES users(id),
+	status TEXT NOT NULL DEFAULT 'pending',
+	rewarded TIMESTAMPTZ,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (referrer_id <> referee_id)
+);
+
+CREATE INDEX referrals_referrer_id_idx ON referrals (referrer_id);
+CREATE INDEX referrals_pending_idx ON referrals (created) WHERE status = 'pending';
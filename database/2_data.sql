@@ -54,4 +54,49 @@ VALUES (
 		20,
 		true,
 		'regular'
-	);
\ No newline at end of file
+	);
+INSERT INTO ledger_entries (
+		id,
+		user_id,
+		debit_account,
+		credit_account,
+		amount,
+		currency,
+		source
+	)
+VALUES (
+		'0b7e7d4c-6f0e-4d57-9a3a-3f1f4f0c1a01',
+		'460aec7e-7d58-42fd-93b8-bca05a77bbf5',
+		'adjustments',
+		'player_cash',
+		10,
+		'EUR',
+		'adjustment'
+	),
+	(
+		'0b7e7d4c-6f0e-4d57-9a3a-3f1f4f0c1a02',
+		'8c3524e5-a297-42aa-85d3-faca261cbfb8',
+		'adjustments',
+		'player_cash',
+		10,
+		'EUR',
+		'adjustment'
+	),
+	(
+		'0b7e7d4c-6f0e-4d57-9a3a-3f1f4f0c1a03',
+		'3b4fef91-2523-46ab-b06d-17e3e2d4b209',
+		'adjustments',
+		'player_cash',
+		10,
+		'EUR',
+		'adjustment'
+	),
+	(
+		'0b7e7d4c-6f0e-4d57-9a3a-3f1f4f0c1a04',
+		'80ddee0a-b1cc-4c03-8a78-b994486850e7',
+		'adjustments',
+		'player_cash',
+		10,
+		'EUR',
+		'adjustment'
+	);
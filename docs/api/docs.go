@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/login": {
-            "post": {
-                "description": "Authenticates a user and returns their details along with a token.",
+        "/api/v1/cashback/{user_id}": {
+            "get": {
+                "description": "Retrieve the net losses, rules and amounts each cashback granted to the user was calculated from, latest period first",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,35 +25,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Cashback"
                 ],
-                "summary": "Login a user",
+                "summary": "Get the cashback calculations of a user",
                 "parameters": [
                     {
-                        "description": "User login details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_http_users_handlers.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User logged in successfully",
+                        "description": "Cashback calculations",
                         "schema": {
-                            "$ref": "#/definitions/internal_http_users_handlers.LoginResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CashbackCalculation"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid ID format",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -67,41 +62,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/notifications": {
-            "get": {
-                "description": "Establishes a WebSocket connection to receive real-time notifications for the authenticated user.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Notifications"
-                ],
-                "summary": "Listen to notifications",
-                "responses": {
-                    "101": {
-                        "description": "Switching Protocols - WebSocket connection established",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized - User not authenticated",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/promotions": {
+        "/api/v1/catalog": {
             "get": {
-                "description": "Retrieve a list of all promotions",
+                "description": "Retrieve the rewards players can buy with loyalty points. Players only see active items",
                 "consumes": [
                     "application/json"
                 ],
@@ -109,19 +72,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Promotions"
+                    "Catalog"
                 ],
-                "summary": "Get all promotions",
+                "summary": "Get catalog items",
                 "responses": {
                     "200": {
-                        "description": "List of promotions",
+                        "description": "List of catalog items",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Missing requestor account",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -131,7 +100,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new promotion with the provided details",
+                "description": "Create a reward with its points price, stock and the bonus amount or promotion it grants. Bonus items are wagered under the multiplier of their promotion within the validity days",
                 "consumes": [
                     "application/json"
                 ],
@@ -139,25 +108,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Promotions"
+                    "Catalog"
                 ],
-                "summary": "Create a new promotion",
+                "summary": "Create a catalog item",
                 "parameters": [
                     {
-                        "description": "Promotion details",
-                        "name": "promotion",
+                        "description": "Catalog item details",
+                        "name": "item",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Created promotion",
+                        "description": "Created catalog item",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem"
                         }
                     },
                     "400": {
@@ -175,9 +144,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/promotions/{id}": {
+        "/api/v1/catalog/redemptions": {
             "get": {
-                "description": "Retrieve a promotion using its unique ID",
+                "description": "Retrieve redemptions newest first. Players only see their own, staff can filter by user and status",
                 "consumes": [
                     "application/json"
                 ],
@@ -185,33 +154,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Promotions"
+                    "Catalog"
                 ],
-                "summary": "Get a promotion by ID",
+                "summary": "Get redemptions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Promotion ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "fulfilled"
+                        ],
+                        "type": "string",
+                        "description": "Redemption status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Retrieved promotion",
+                        "description": "List of redemptions",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Redemption"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid ID format",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Promotion not found",
+                        "description": "Invalid filter",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -223,9 +198,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/catalog/redemptions/{id}/fulfil": {
             "put": {
-                "description": "Update an existing promotion with the provided details",
+                "description": "Mark a pending physical item redemption as handed out to the player",
                 "consumes": [
                     "application/json"
                 ],
@@ -233,29 +210,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Promotions"
+                    "Catalog"
                 ],
-                "summary": "Update a promotion",
+                "summary": "Fulfil a redemption",
                 "parameters": [
                     {
-                        "description": "Updated promotion details",
-                        "name": "promotion",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
-                        }
+                        "type": "string",
+                        "description": "Redemption ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated promotion",
+                        "description": "Fulfilled redemption",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Redemption"
                         }
                     },
                     "400": {
-                        "description": "Invalid input",
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pending redemption not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -267,9 +248,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a promotion using its unique ID",
+            }
+        },
+        "/api/v1/catalog/{id}": {
+            "get": {
+                "description": "Retrieve a catalog item using its unique ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -277,13 +260,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Promotions"
+                    "Catalog"
                 ],
-                "summary": "Delete a promotion",
+                "summary": "Get a catalog item by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Promotion ID",
+                        "description": "Catalog item ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -291,9 +274,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Retrieved catalog item",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem"
                         }
                     },
                     "400": {
@@ -303,7 +286,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Promotion not found",
+                        "description": "Catalog item not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -315,11 +298,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/register": {
-            "post": {
-                "description": "Creates a new user account and returns the user details along with a token.",
+            },
+            "put": {
+                "description": "Update the price, stock, reward or availability of a catalog item",
                 "consumes": [
                     "application/json"
                 ],
@@ -327,35 +308,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Catalog"
                 ],
-                "summary": "Register a new user",
+                "summary": "Update a catalog item",
                 "parameters": [
                     {
-                        "description": "User registration details",
-                        "name": "request",
+                        "type": "string",
+                        "description": "Catalog item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated catalog item details",
+                        "name": "item",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_http_users_handlers.RegisterRequest"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User registered successfully",
+                        "description": "Updated catalog item",
                         "schema": {
-                            "$ref": "#/definitions/internal_http_users_handlers.RegisterResponse"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "User already exists",
+                    "404": {
+                        "description": "Catalog item not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -367,11 +355,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/user-promotions/{user_id}": {
-            "get": {
-                "description": "Retrieve a list of all promotions assigned to a specific user",
+            },
+            "delete": {
+                "description": "Delete a catalog item that was never redeemed. Redeemed items can only be deactivated",
                 "consumes": [
                     "application/json"
                 ],
@@ -379,26 +365,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Promotions"
+                    "Catalog"
                 ],
-                "summary": "Get all promotions for a user",
+                "summary": "Delete a catalog item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Catalog item ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of user promotions",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -407,6 +390,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Catalog item not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Catalog item was already redeemed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -414,9 +409,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/catalog/{id}/redeem": {
             "post": {
-                "description": "Assign a promotion to a user with the provided details",
+                "description": "Debit the points price from the requestor and hand out the reward. Physical items stay pending until staff fulfil them",
                 "consumes": [
                     "application/json"
                 ],
@@ -424,25 +421,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Promotions"
+                    "Catalog"
                 ],
-                "summary": "Add a promotion to a user",
+                "summary": "Redeem a catalog item",
                 "parameters": [
                     {
-                        "description": "User Promotion details",
-                        "name": "userPromotion",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
-                        }
+                        "type": "string",
+                        "description": "Catalog item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the original response when the request is sent again with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Added user promotion",
+                        "description": "Redemption",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Redemption"
                         }
                     },
                     "400": {
@@ -451,6 +452,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Catalog item not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Out of stock or request with the same idempotency key is in progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency key was used for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -460,9 +479,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user-promotions/{user_id}/promotion/{user_prom_id}": {
+        "/api/v1/free_spins/{user_id}": {
             "get": {
-                "description": "Retrieve a user promotion using its unique ID",
+                "description": "Retrieve the free spins the player claimed that are not used up, expired or settled, soonest expiry first. Game servers can narrow them down to a game.",
                 "consumes": [
                     "application/json"
                 ],
@@ -470,23 +489,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Promotions"
+                    "Free spins"
                 ],
-                "summary": "Get a user promotion by ID",
+                "summary": "Get the free spins of a player",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User Promotion ID",
-                        "name": "user_prom_id",
+                        "description": "Game server API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Game ID",
+                        "name": "game_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Retrieved user promotion",
+                        "description": "Free spins",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpinsEntitlement"
+                            }
                         }
                     },
                     "400": {
@@ -495,8 +530,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User promotion not found",
+                    "401": {
+                        "description": "Invalid API key",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -510,9 +545,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user-promotions/{user_id}/promotions/{user_prom_id}": {
-            "delete": {
-                "description": "Delete a user promotion using its unique ID",
+        "/api/v1/free_spins/{user_id}/{free_spins_id}/spins": {
+            "post": {
+                "description": "Uses one spin for a game round and adds its win to the winnings. With the last spin the winnings are credited to the player, as bonus under the wagering multiplier of the promotion or as cash without one. Rounds are deduplicated, a repeated round returns the free spins and uses nothing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -520,27 +555,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Promotions"
+                    "Free spins"
                 ],
-                "summary": "Delete a user promotion",
+                "summary": "Play a free spin",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User Promotion ID",
-                        "name": "user_prom_id",
+                        "description": "Game server API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Free spins ID",
+                        "name": "free_spins_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Played round",
+                        "name": "spin",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpin"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Round was already played",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpinsEntitlement"
+                        }
+                    },
+                    "201": {
+                        "description": "Spin used",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpinsEntitlement"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID format",
+                        "description": "Invalid ID format, invalid spin or currency mismatch",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Free spins not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Free spins are used up, expired or settled",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -554,9 +636,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user-promotions/{user_id}/promotions/{user_prom_id}/claim": {
+        "/api/v1/game_events": {
             "post": {
-                "description": "Allows a user to claim a promotion if eligible",
+                "description": "Applies a bet, win or rollback of a game round to the player's balances. Events are deduplicated by game ID and event ID, a repeated event returns the stored one and applies nothing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -564,40 +646,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User Promotions"
+                    "Games"
                 ],
-                "summary": "Claim a promotion",
+                "summary": "Ingest a game event",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "path",
+                        "description": "Game server API key",
+                        "name": "X-API-Key",
+                        "in": "header",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "User Promotion ID",
-                        "name": "user_prom_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Game event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.GameEvent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Event was already applied",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.GameEvent"
+                        }
+                    },
+                    "201": {
+                        "description": "Event applied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.GameEvent"
                         }
                     },
                     "400": {
-                        "description": "Invalid input or business rule violation",
+                        "description": "Invalid event, currency mismatch or insufficient balance",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - Requestor ID does not match",
+                    "401": {
+                        "description": "Invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User or bet of the round not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Round already has a win, or a bet or rollback with another event ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -611,9 +713,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users": {
-            "get": {
-                "description": "Retrieves a list of all users.",
+        "/api/v1/login": {
+            "post": {
+                "description": "Authenticates a user and returns their details along with a token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -623,15 +725,35 @@ const docTemplate = `{
                 "tags": [
                     "Users"
                 ],
-                "summary": "Get all users",
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "User login details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_http_users_handlers.LoginRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "List of users retrieved successfully",
+                        "description": "User logged in successfully",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
-                            }
+                            "$ref": "#/definitions/internal_http_users_handlers.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
                     "500": {
@@ -643,9 +765,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users/{id}": {
+        "/api/v1/notifications": {
             "get": {
-                "description": "Retrieves the details of a user by their unique ID.",
+                "description": "Establishes a WebSocket connection to receive real-time notifications for the authenticated user. Staff also receive staff notifications such as promotion budget alerts.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Listen to notifications",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols - WebSocket connection established",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - User not authenticated",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/points/liability": {
+            "get": {
+                "description": "Sum the unredeemed loyalty points per day of expiry. The period defaults to the next 30 days",
                 "consumes": [
                     "application/json"
                 ],
@@ -653,36 +807,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Loyalty"
                 ],
-                "summary": "Get a user by ID",
+                "summary": "Get expiring points liability",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Start of the period, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period, RFC 3339",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User details retrieved successfully",
+                        "description": "Expiring points per day",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PointsLiability"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/points_rates": {
+            "get": {
+                "description": "Retrieve the loyalty points earned per unit wagered for each game category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loyalty"
+                ],
+                "summary": "Get all points rates",
+                "responses": {
+                    "200": {
+                        "description": "List of points rates",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PointsRate"
+                            }
+                        }
                     },
                     "500": {
                         "description": "Internal server error",
@@ -691,9 +879,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/points_rates/{category}": {
             "put": {
-                "description": "Updates the details of an existing user.",
+                "description": "Set the loyalty points earned per unit wagered on games of the category. The \"default\" category applies to games without a rate of their own",
                 "consumes": [
                     "application/json"
                 ],
@@ -701,29 +891,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Loyalty"
                 ],
-                "summary": "Update a user",
+                "summary": "Set a points rate",
                 "parameters": [
                     {
-                        "description": "User details to update",
-                        "name": "request",
+                        "type": "string",
+                        "description": "Game category",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Points rate",
+                        "name": "rate",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PointsRate"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated successfully",
+                        "description": "Stored points rate",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PointsRate"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid input",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -737,7 +934,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a user by their unique ID.",
+                "description": "Delete the points rate of a game category, its games fall back to the default rate",
                 "consumes": [
                     "application/json"
                 ],
@@ -745,33 +942,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Loyalty"
                 ],
-                "summary": "Delete a user",
+                "summary": "Delete a points rate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Game category",
+                        "name": "category",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted successfully",
+                        "description": "OK",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Invalid user ID",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
-                        }
-                    },
                     "404": {
-                        "description": "User not found",
+                        "description": "Points rate not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -785,9 +976,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users/{id}/balance": {
-            "put": {
-                "description": "Updates the balance of a user based on the transaction type and value.",
+        "/api/v1/promotion_codes/redeem": {
+            "post": {
+                "description": "Assign the promotion of the code to the requestor, who can then claim it. Redemption attempts are rate limited per player",
                 "consumes": [
                     "application/json"
                 ],
@@ -795,29 +986,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Promotion codes"
                 ],
-                "summary": "Update user balance",
+                "summary": "Redeem a promotion code",
                 "parameters": [
                     {
-                        "description": "Balance update details",
+                        "description": "Code to redeem",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_http_users_handlers.UpdateBalanceRequest"
+                            "$ref": "#/definitions/handlers.RedeemPromotionCodeRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the original response when the request is sent again with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "User balance updated successfully",
+                    "201": {
+                        "description": "Assigned user promotion",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload or insufficient balance",
+                        "description": "Invalid, expired or used up code, promotion no longer active or player not eligible",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Code already redeemed or request with the same idempotency key is in progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency key was used for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many redemption attempts",
                         "schema": {
                             "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
                         }
@@ -830,55 +1045,3236 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion": {
+        "/api/v1/promotions": {
+            "get": {
+                "description": "Retrieve a list of all promotions, optionally only the ones that are live, upcoming or ended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Get all promotions",
+                "parameters": [
+                    {
+                        "enum": [
+                            "live",
+                            "upcoming",
+                            "ended"
+                        ],
+                        "type": "string",
+                        "description": "Availability",
+                        "name": "availability",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of promotions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new promotion with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Create a new promotion",
+                "parameters": [
+                    {
+                        "description": "Promotion details",
+                        "name": "promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created promotion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/archived": {
+            "get": {
+                "description": "Retrieve the archived promotions, latest archived first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Get archived promotions",
+                "responses": {
+                    "200": {
+                        "description": "Archived promotions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/welcome_package": {
+            "get": {
+                "description": "Retrieve the version of the welcome package players who register now get",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Get the welcome package",
+                "responses": {
+                    "200": {
+                        "description": "Current welcome package version",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomePackage"
+                        }
+                    },
+                    "404": {
+                        "description": "No welcome package was created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create the next version of the welcome package new players get. Steps are numbered in the order given and unlock at registration or with the player's nth deposit. Players keep the version that was current when they registered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Create a welcome package version",
+                "parameters": [
+                    {
+                        "description": "Steps of the welcome package",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateWelcomePackageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created welcome package version",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomePackage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid steps",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Another version was created at the same time",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/welcome_package/versions": {
+            "get": {
+                "description": "Retrieve every version of the welcome package, latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Get welcome package versions",
+                "responses": {
+                    "200": {
+                        "description": "Welcome package versions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomePackage"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/{id}": {
+            "get": {
+                "description": "Retrieve a promotion using its unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Get a promotion by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Retrieved promotion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing promotion with the provided details. Switching a scheduled promotion on or off by hand overrides its availability window until the next edge of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Update a promotion",
+                "parameters": [
+                    {
+                        "description": "Updated promotion details",
+                        "name": "promotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated promotion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Promotion is archived",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Archive a promotion using its unique ID. Archived promotions are deactivated and can no longer be assigned, players keep their promotions of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Archive a promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/{id}/codes": {
+            "get": {
+                "description": "Retrieve the codes of a promotion with how often they were redeemed, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotion codes"
+                ],
+                "summary": "Get promotion codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promotion codes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Generate a batch of single use codes, or create a shared code that every player can redeem once up to its redemption limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotion codes"
+                ],
+                "summary": "Create promotion codes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Codes to create",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCodeBatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created codes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCode"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input, promotion cannot be assigned or is archived",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Code already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/{id}/restore": {
+            "put": {
+                "description": "Restore an archived promotion. It stays inactive until it is activated or, when it is scheduled, its availability starts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Restore an archived promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restored promotion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Promotion is not archived",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promotions/{id}/state_changes": {
+            "get": {
+                "description": "Retrieve the audit trail of the promotion being switched on and off, by the scheduler or by hand, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Promotions"
+                ],
+                "summary": "Get promotion state changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "State changes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionStateChange"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/referrals/report": {
+            "get": {
+                "description": "Retrieve the number of referrals per referrer and how many of them were rewarded, most referrals first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Referrals"
+                ],
+                "summary": "Get the referral report",
+                "responses": {
+                    "200": {
+                        "description": "Referrals per referrer",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ReferralReport"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/register": {
+            "post": {
+                "description": "Creates a new user account and returns the user details along with a token. A referral code of another player can be given to be rewarded together once the new player qualifies.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_http_users_handlers.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User registered successfully",
+                        "schema": {
+                            "$ref": "#/definitions/internal_http_users_handlers.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload or referral code",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tiers": {
+            "get": {
+                "description": "Retrieve all VIP tiers with their points thresholds and benefits, ordered by threshold",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loyalty"
+                ],
+                "summary": "Get all tiers",
+                "responses": {
+                    "200": {
+                        "description": "List of tiers",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a VIP tier reached by players who earned at least its points threshold",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loyalty"
+                ],
+                "summary": "Create a new tier",
+                "parameters": [
+                    {
+                        "description": "Tier details",
+                        "name": "tier",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created tier",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tier name or threshold already used",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tiers/history/{user_id}": {
+            "get": {
+                "description": "Retrieve every tier change of a user with its reason and the points it was based on, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loyalty"
+                ],
+                "summary": "Get the tier history of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tier changes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TierHistoryEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Requestor ID does not match",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tiers/{id}": {
+            "put": {
+                "description": "Update the name, points threshold and benefits of a tier. Players are moved between tiers on the next recalculation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loyalty"
+                ],
+                "summary": "Update a tier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated tier details",
+                        "name": "tier",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated tier",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tier not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tier name or threshold already used",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tier, its players are moved to another tier on the next recalculation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loyalty"
+                ],
+                "summary": "Delete a tier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tier ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tier not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tournaments": {
+            "get": {
+                "description": "Retrieve the scheduled, running and finished tournaments, latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tournaments"
+                ],
+                "summary": "Get all tournaments",
+                "responses": {
+                    "200": {
+                        "description": "List of tournaments",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a tournament with its window, scoring rule and the promotions granted as prizes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tournaments"
+                ],
+                "summary": "Create a tournament",
+                "parameters": [
+                    {
+                        "description": "Tournament details",
+                        "name": "tournament",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created tournament",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tournaments/{id}": {
+            "get": {
+                "description": "Retrieve a tournament using its unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tournaments"
+                ],
+                "summary": "Get a tournament by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tournament ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Retrieved tournament",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tournament not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a tournament that is not finished. Once it started its scoring, game category and start date cannot change",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tournaments"
+                ],
+                "summary": "Update a tournament",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tournament ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated tournament details",
+                        "name": "tournament",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated tournament",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tournament not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tournament already started or finished",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tournament that did not start yet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tournaments"
+                ],
+                "summary": "Delete a tournament",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tournament ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tournament not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tournament already started",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tournaments/{id}/leaderboard": {
+            "get": {
+                "description": "Retrieve the leading players of a tournament and the standing of the requestor. Running tournaments are ranked live, finished ones by their final results",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tournaments"
+                ],
+                "summary": "Get a tournament leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tournament ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of leading players, 10 by default and at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tournament leaderboard",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Leaderboard"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tournament not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}": {
+            "get": {
+                "description": "Retrieve a list of all promotions assigned to a specific user, optionally only those in a status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Get all promotions for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "assigned",
+                            "claimed",
+                            "expired",
+                            "revoked",
+                            "forfeited"
+                        ],
+                        "type": "string",
+                        "description": "Status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of user promotions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format or status",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Assign a promotion to a user with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Add a promotion to a user",
+                "parameters": [
+                    {
+                        "description": "User Promotion details",
+                        "name": "userPromotion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Added user promotion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or business rule violation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}/promotion/{user_prom_id}": {
+            "get": {
+                "description": "Retrieve a user promotion using its unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Get a user promotion by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User Promotion ID",
+                        "name": "user_prom_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Retrieved user promotion",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}/promotions/{user_prom_id}": {
+            "delete": {
+                "description": "Delete a user promotion using its unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Delete a user promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User Promotion ID",
+                        "name": "user_prom_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}/promotions/{user_prom_id}/claim": {
+            "post": {
+                "description": "Allows a user to claim a promotion if eligible. A match bonus matches the latest deposit made since the promotion was assigned, free spins grant their spins to be played on the game servers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Claim a promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User Promotion ID",
+                        "name": "user_prom_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the original response when the request is sent again with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or business rule violation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Requestor ID does not match",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Promotion budget or claim limit reached, or request with the same idempotency key is in progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency key was used for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}/promotions/{user_prom_id}/revoke": {
+            "post": {
+                "description": "Revoke an assigned or claimed promotion for a reason. What a claim credited is taken back under the clawback policy, which either allows a negative balance or stops at zero. The player is notified and the revocation is recorded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Revoke a user promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User Promotion ID",
+                        "name": "user_prom_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason of the revocation",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.RevokePromotionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recorded revocation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotionRevocation"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or promotion expired or forfeited",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Promotion already revoked or changed while it was revoked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}/revocations": {
+            "get": {
+                "description": "Retrieve the recorded revocations of the promotions of a user, latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Get user promotion revocations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Revocations",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotionRevocation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-promotions/{user_id}/welcome_package": {
+            "get": {
+                "description": "Retrieve the welcome package version a user registered under with the steps unlocked so far and the promotions they were assigned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User Promotions"
+                ],
+                "summary": "Get the welcome package of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Welcome package of the user",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserWelcomePackage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Requestor ID does not match",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User has no welcome package",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users": {
+            "get": {
+                "description": "Retrieves a list of all users.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "List of users retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}": {
+            "get": {
+                "description": "Retrieves the details of a user by their unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get a user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User details retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates the details of an existing user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "description": "User details to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a user by their unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deleted successfully",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User has balance history",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/balance": {
+            "put": {
+                "description": "Updates the balance of a user based on the transaction type and value. Every update is recorded as a ledger entry, adjustments and references are restricted to staff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update user balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Balance update details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_http_users_handlers.UpdateBalanceRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the original response when the request is sent again with the same key",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User balance updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload, currency mismatch or insufficient balance",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Requestor ID does not match or adjustment or reference without staff role",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Request with the same idempotency key is in progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Idempotency key was used for a different request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/balance/rebuild": {
+            "post": {
+                "description": "Overwrites the stored balance of a user with the balance computed from their ledger entries.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Rebuild user balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User balance rebuilt successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/balance/reconciliation": {
+            "get": {
+                "description": "Compares the stored balance of a user with the balance computed from their ledger entries.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Reconcile user balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balance reconciliation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.BalanceReconciliation"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}/transactions": {
+            "get": {
+                "description": "Retrieves the ledger entries of a user, newest first, with cursor pagination. Entries caused by a promotion claim include the claimed user promotion.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries created at or after this time (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries created before this time (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "manual",
+                                "promotion_claim",
+                                "adjustment",
+                                "bonus_conversion",
+                                "bonus_forfeit",
+                                "game_bet",
+                                "game_win",
+                                "game_rollback",
+                                "points_redemption",
+                                "referral_reward",
+                                "free_spins_win",
+                                "promotion_clawback"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Transaction types",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, defaults to 50, maximum 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page of transactions",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Requestor ID does not match",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.BalanceReconciliation": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "bonus_balance": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "bonus_difference": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "difference": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "ledger_balance": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "ledger_bonus_balance": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CashbackCalculation": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_amount": {
+                    "type": "string"
+                },
+                "net_loss": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "percentage": {
+                    "type": "string"
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "returned": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_promotion_id": {
+                    "type": "string"
+                },
+                "wagered": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CashbackPeriod": {
+            "type": "string",
+            "enum": [
+                "daily",
+                "weekly"
+            ],
+            "x-enum-varnames": [
+                "CashbackDaily",
+                "CashbackWeekly"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CashbackRule": {
+            "type": "object",
+            "required": [
+                "period"
+            ],
+            "properties": {
+                "max_amount": {
+                    "type": "string",
+                    "example": "100"
+                },
+                "percentage": {
+                    "type": "string",
+                    "example": "10"
+                },
+                "period": {
+                    "enum": [
+                        "daily",
+                        "weekly"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CashbackPeriod"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItem": {
+            "type": "object",
+            "required": [
+                "title",
+                "type"
+            ],
+            "properties": {
+                "bonus_amount": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "points_price": {
+                    "type": "string"
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "bonus",
+                        "promotion",
+                        "physical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItemType"
+                        }
+                    ]
+                },
+                "updated": {
+                    "type": "string"
+                },
+                "validity_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CatalogItemType": {
+            "type": "string",
+            "enum": [
+                "bonus",
+                "promotion",
+                "physical"
+            ],
+            "x-enum-varnames": [
+                "CatalogItemBonus",
+                "CatalogItemPromotion",
+                "CatalogItemPhysical"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ClawbackPolicy": {
+            "type": "string",
+            "enum": [
+                "allow_negative",
+                "cap_at_zero"
+            ],
+            "x-enum-varnames": [
+                "ClawbackAllowNegative",
+                "ClawbackCapAtZero"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.EligibilityRules": {
+            "type": "object",
+            "properties": {
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "DE",
+                        "AT"
+                    ]
+                },
+                "excluded_countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "US"
+                    ]
+                },
+                "excluded_promotion_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "min_account_age_days": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 30
+                },
+                "min_total_deposits": {
+                    "type": "string",
+                    "example": "100"
+                },
+                "required_promotion_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tier_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpin": {
+            "type": "object",
+            "required": [
+                "round_id"
+            ],
+            "properties": {
+                "round_id": {
+                    "type": "string"
+                },
+                "win": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpinsEntitlement": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "string"
+                },
+                "expires": {
+                    "type": "string"
+                },
+                "game_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "settled": {
+                    "type": "string"
+                },
+                "spins": {
+                    "type": "integer"
+                },
+                "stake": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "updated": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_promotion_id": {
+                    "type": "string"
+                },
+                "winnings": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpinsRule": {
+            "type": "object",
+            "properties": {
+                "game_id": {
+                    "type": "string",
+                    "example": "starburst"
+                },
+                "spins": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "stake": {
+                    "type": "string",
+                    "example": "0.2"
+                },
+                "validity_days": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.GameEvent": {
+            "type": "object",
+            "required": [
+                "event_id",
+                "game_id",
+                "round_id",
+                "type",
+                "user_id"
+            ],
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "bonus_amount": {
+                    "type": "string"
+                },
+                "cash_amount": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "game_category": {
+                    "type": "string"
+                },
+                "game_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "round_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "bet",
+                        "win",
+                        "rollback"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.GameEventType"
+                        }
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.GameEventType": {
+            "type": "string",
+            "enum": [
+                "bet",
+                "win",
+                "rollback"
+            ],
+            "x-enum-varnames": [
+                "GameEventBet",
+                "GameEventWin",
+                "GameEventRollback"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Leaderboard": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LeaderboardEntry"
+                    }
+                },
+                "player": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LeaderboardEntry"
+                },
+                "tournament": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LeaderboardEntry": {
+            "type": "object",
+            "properties": {
+                "rank": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerAccount": {
+            "type": "string",
+            "enum": [
+                "player_cash",
+                "player_bonus",
+                "cashier",
+                "promotions",
+                "adjustments",
+                "games",
+                "loyalty"
+            ],
+            "x-enum-varnames": [
+                "LedgerAccountPlayerCash",
+                "LedgerAccountPlayerBonus",
+                "LedgerAccountCashier",
+                "LedgerAccountPromotions",
+                "LedgerAccountAdjustments",
+                "LedgerAccountGames",
+                "LedgerAccountLoyalty"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerEntry": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "credit_account": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerAccount"
+                },
+                "debit_account": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerAccount"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reference_id": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerSource"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_promotion": {
+                    "description": "UserPromotion is set when the entry was caused by a user promotion.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerPage": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerEntry"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerSource": {
+            "type": "string",
+            "enum": [
+                "manual",
+                "promotion_claim",
+                "adjustment",
+                "bonus_conversion",
+                "bonus_forfeit",
+                "game_bet",
+                "game_win",
+                "game_rollback",
+                "points_redemption",
+                "referral_reward",
+                "free_spins_win",
+                "promotion_clawback"
+            ],
+            "x-enum-varnames": [
+                "LedgerSourceManual",
+                "LedgerSourcePromotionClaim",
+                "LedgerSourceAdjustment",
+                "LedgerSourceBonusConvert",
+                "LedgerSourceBonusForfeit",
+                "LedgerSourceGameBet",
+                "LedgerSourceGameWin",
+                "LedgerSourceGameRollback",
+                "LedgerSourceRedemption",
+                "LedgerSourceReferral",
+                "LedgerSourceFreeSpins",
+                "LedgerSourceClawback"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.MatchBonusRule": {
+            "type": "object",
+            "properties": {
+                "max_amount": {
+                    "type": "string",
+                    "example": "200"
+                },
+                "min_deposit": {
+                    "type": "string",
+                    "example": "20"
+                },
+                "percentage": {
+                    "type": "string",
+                    "example": "100"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "10.50"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "EUR"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PointsLiability": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PointsRate": {
+            "type": "object",
+            "required": [
+                "category"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "created": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "updated": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Promotion": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "archived": {
+                    "type": "string"
+                },
+                "available_from": {
+                    "type": "string"
+                },
+                "available_until": {
+                    "type": "string"
+                },
+                "budget": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionBudget"
+                },
+                "cashback": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.CashbackRule"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "eligibility": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.EligibilityRules"
+                },
+                "free_spins": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.FreeSpinsRule"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "manual_state_at": {
+                    "type": "string"
+                },
+                "match_bonus": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.MatchBonusRule"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "enum": [
+                        "regular",
+                        "welcome_bonus",
+                        "cashback",
+                        "match_bonus",
+                        "free_spins",
+                        "referral"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionType"
+                        }
+                    ]
+                },
+                "updated": {
+                    "type": "string"
+                },
+                "wagering_multiplier": {
+                    "type": "string",
+                    "example": "30"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionBudget": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "claims": {
+                    "type": "integer"
+                },
+                "max_claims": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 500
+                },
+                "max_claims_per_user": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "spent": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCode": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "expires": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCodeKind"
+                },
+                "max_redemptions": {
+                    "type": "integer"
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "redemptions": {
+                    "type": "integer"
+                },
+                "validity_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCodeBatch": {
+            "type": "object",
+            "required": [
+                "kind"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 4,
+                    "example": "SPRING25"
+                },
+                "count": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1,
+                    "example": 100
+                },
+                "expires": {
+                    "type": "string"
+                },
+                "kind": {
+                    "enum": [
+                        "single_use",
+                        "shared"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCodeKind"
+                        }
+                    ]
+                },
+                "max_redemptions": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 500
+                },
+                "validity_days": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 7
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionCodeKind": {
+            "type": "string",
+            "enum": [
+                "single_use",
+                "shared"
+            ],
+            "x-enum-varnames": [
+                "PromotionCodeSingleUse",
+                "PromotionCodeShared"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionStateChange": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionStateChangeReason"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionStateChangeReason": {
+            "type": "string",
+            "enum": [
+                "scheduled_start",
+                "scheduled_end",
+                "manual_start",
+                "manual_end"
+            ],
+            "x-enum-varnames": [
+                "PromotionScheduledStart",
+                "PromotionScheduledEnd",
+                "PromotionManualStart",
+                "PromotionManualEnd"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionType": {
+            "type": "string",
+            "enum": [
+                "regular",
+                "welcome_bonus",
+                "cashback",
+                "match_bonus",
+                "free_spins",
+                "referral"
+            ],
+            "x-enum-varnames": [
+                "Regular",
+                "WelcomeBonus",
+                "Cashback",
+                "MatchBonus",
+                "FreeSpins",
+                "ReferralBonus"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Redemption": {
+            "type": "object",
+            "properties": {
+                "catalog_item_id": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "fulfilled": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.RedemptionStatus"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_promotion_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.RedemptionStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "fulfilled"
+            ],
+            "x-enum-varnames": [
+                "RedemptionPending",
+                "RedemptionFulfilled"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ReferralReport": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "last_referral": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "referrals": {
+                    "type": "integer"
+                },
+                "referrer_id": {
+                    "type": "string"
+                },
+                "rewarded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.RevocationReason": {
+            "type": "string",
+            "enum": [
+                "bonus_abuse",
+                "fraud",
+                "duplicate_account",
+                "not_eligible",
+                "operator_error",
+                "player_request"
+            ],
+            "x-enum-varnames": [
+                "RevocationBonusAbuse",
+                "RevocationFraud",
+                "RevocationDuplicateAccount",
+                "RevocationNotEligible",
+                "RevocationOperatorError",
+                "RevocationPlayerRequest"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "min_points": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "updated": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TierChangeReason": {
+            "type": "string",
+            "enum": [
+                "qualified",
+                "demoted"
+            ],
+            "x-enum-varnames": [
+                "TierChangeQualified",
+                "TierChangeDemoted"
+            ]
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TierHistoryEntry": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
+                "created": {
+                    "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
+                "previous_tier_id": {
+                    "type": "string"
+                },
+                "previous_tier_name": {
+                    "type": "string"
+                },
+                "qualifying_points": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TierChangeReason"
+                },
+                "tier_id": {
+                    "type": "string"
+                },
+                "tier_name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tournament": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "name",
+                "scoring",
+                "start_date"
+            ],
+            "properties": {
                 "created": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
-                "id": {
+                "end_date": {
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "finished": {
+                    "type": "string"
                 },
-                "title": {
+                "game_category": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "id": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionType"
+                "name": {
+                    "type": "string"
+                },
+                "prizes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TournamentPrize"
+                    }
+                },
+                "scoring": {
+                    "enum": [
+                        "total_wagered",
+                        "biggest_multiplier",
+                        "points_earned"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TournamentScoring"
+                        }
+                    ]
+                },
+                "start_date": {
+                    "type": "string"
                 },
                 "updated": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.PromotionType": {
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TournamentPrize": {
+            "type": "object",
+            "required": [
+                "promotion_id"
+            ],
+            "properties": {
+                "from_rank": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "to_rank": {
+                    "type": "integer"
+                },
+                "validity_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TournamentScoring": {
             "type": "string",
             "enum": [
-                "regular",
-                "welcome_bonus"
+                "total_wagered",
+                "biggest_multiplier",
+                "points_earned"
             ],
             "x-enum-varnames": [
-                "Regular",
-                "WelcomeBonus"
+                "TournamentScoringWagered",
+                "TournamentScoringMultiplier",
+                "TournamentScoringPoints"
             ]
         },
         "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TransactionType": {
@@ -896,7 +4292,13 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "balance": {
-                    "type": "number"
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "bonus_balance": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "country": {
+                    "type": "string"
                 },
                 "created": {
                     "type": "string"
@@ -907,6 +4309,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "loyalty_points": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -919,9 +4324,15 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion"
                     }
                 },
+                "referral_code": {
+                    "type": "string"
+                },
                 "role": {
                     "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserType"
                 },
+                "tier": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Tier"
+                },
                 "updated": {
                     "type": "string"
                 }
@@ -930,15 +4341,27 @@ const docTemplate = `{
         "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotion": {
             "type": "object",
             "properties": {
+                "bonus_amount": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
                 "claimed": {
                     "type": "string"
                 },
+                "converted": {
+                    "type": "string"
+                },
                 "created": {
                     "type": "string"
                 },
+                "deposit_id": {
+                    "type": "string"
+                },
                 "end_date": {
                     "type": "string"
                 },
+                "forfeited": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -951,6 +4374,9 @@ const docTemplate = `{
                 "start_date": {
                     "type": "string"
                 },
+                "status": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotionStatus"
+                },
                 "updated": {
                     "type": "string"
                 },
@@ -959,9 +4385,76 @@ const docTemplate = `{
                 },
                 "user_id": {
                     "type": "string"
+                },
+                "wagered": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "wagering_required": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotionRevocation": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerAccount"
+                },
+                "clawed_back": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "created": {
+                    "type": "string"
+                },
+                "credited": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "policy": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.ClawbackPolicy"
+                },
+                "previous_status": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotionStatus"
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.RevocationReason"
+                },
+                "revoked_by": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_promotion_id": {
+                    "type": "string"
                 }
             }
         },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserPromotionStatus": {
+            "type": "string",
+            "enum": [
+                "assigned",
+                "claimed",
+                "expired",
+                "revoked",
+                "forfeited"
+            ],
+            "x-enum-varnames": [
+                "UserPromotionAssigned",
+                "UserPromotionClaimed",
+                "UserPromotionExpired",
+                "UserPromotionRevoked",
+                "UserPromotionForfeited"
+            ]
+        },
         "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserType": {
             "type": "integer",
             "enum": [
@@ -973,6 +4466,198 @@ const docTemplate = `{
                 "Staff"
             ]
         },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserWelcomePackage": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "string"
+                },
+                "package_id": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserWelcomeStep"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.UserWelcomeStep": {
+            "type": "object",
+            "required": [
+                "promotion_id",
+                "unlock"
+            ],
+            "properties": {
+                "deposits": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "step": {
+                    "type": "integer"
+                },
+                "unlock": {
+                    "enum": [
+                        "registration",
+                        "deposit"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomeUnlockCondition"
+                        }
+                    ]
+                },
+                "unlocked": {
+                    "type": "string"
+                },
+                "user_promotion_id": {
+                    "type": "string"
+                },
+                "validity_hours": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 24
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomePackage": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomeStep"
+                    }
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomeStep": {
+            "type": "object",
+            "required": [
+                "promotion_id",
+                "unlock"
+            ],
+            "properties": {
+                "deposits": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "promotion_id": {
+                    "type": "string"
+                },
+                "step": {
+                    "type": "integer"
+                },
+                "unlock": {
+                    "enum": [
+                        "registration",
+                        "deposit"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomeUnlockCondition"
+                        }
+                    ]
+                },
+                "validity_hours": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 24
+                }
+            }
+        },
+        "github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomeUnlockCondition": {
+            "type": "string",
+            "enum": [
+                "registration",
+                "deposit"
+            ],
+            "x-enum-varnames": [
+                "WelcomeUnlockRegistration",
+                "WelcomeUnlockDeposit"
+            ]
+        },
+        "handlers.CreateWelcomePackageRequest": {
+            "type": "object",
+            "required": [
+                "steps"
+            ],
+            "properties": {
+                "steps": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.WelcomeStep"
+                    }
+                }
+            }
+        },
+        "handlers.RedeemPromotionCodeRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "SPRING25"
+                }
+            }
+        },
+        "handlers.RevokePromotionRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Same device as an existing account"
+                },
+                "reason": {
+                    "enum": [
+                        "bonus_abuse",
+                        "fraud",
+                        "duplicate_account",
+                        "not_eligible",
+                        "operator_error",
+                        "player_request"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.RevocationReason"
+                        }
+                    ],
+                    "example": "bonus_abuse"
+                }
+            }
+        },
         "internal_http_users_handlers.LoginRequest": {
             "type": "object",
             "required": [
@@ -1014,6 +4699,10 @@ const docTemplate = `{
                 "password"
             ],
             "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "DE"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -1024,6 +4713,10 @@ const docTemplate = `{
                 "password": {
                     "type": "string",
                     "minLength": 6
+                },
+                "referral_code": {
+                    "type": "string",
+                    "maxLength": 32
                 }
             }
         },
@@ -1039,6 +4732,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "referral_code": {
+                    "type": "string"
+                },
                 "token": {
                     "type": "string"
                 }
@@ -1047,15 +4743,28 @@ const docTemplate = `{
         "internal_http_users_handlers.UpdateBalanceRequest": {
             "type": "object",
             "required": [
-                "transaction_type",
-                "value"
+                "transaction_type"
             ],
             "properties": {
+                "reference_id": {
+                    "type": "string"
+                },
+                "source": {
+                    "enum": [
+                        "manual",
+                        "adjustment"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.LedgerSource"
+                        }
+                    ]
+                },
                 "transaction_type": {
                     "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.TransactionType"
                 },
                 "value": {
-                    "type": "number"
+                    "$ref": "#/definitions/github_com_Jozzo6_casino_loyalty_reward_system_internal_types.Money"
                 }
             }
         }
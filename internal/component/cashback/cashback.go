@@ -0,0 +1,143 @@
+package cashback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+)
+
+type CashbackProvider interface {
+	CalculateCashback(ctx context.Context) (int, error)
+	GetCashbackCalculations(ctx context.Context, userID uuid.UUID) ([]types.CashbackCalculation, error)
+}
+
+type component struct {
+	persistent store.Persistent
+	pubsub     store.PubSub
+	validity   time.Duration
+}
+
+var _ CashbackProvider = (*component)(nil)
+
+// New returns the cashback component. Granted cashback can be claimed for
+// validity.
+func New(persistent store.Persistent, pubsub store.PubSub, validity time.Duration) *component {
+	return &component{
+		persistent: persistent,
+		pubsub:     pubsub,
+		validity:   validity,
+	}
+}
+
+// CalculateCashback grants the cashback of the last completed period of each
+// active cashback promotion as a claimable user promotion. A promotion pays
+// out for periods that started after it was created, and each player gets it
+// once per period, whichever replica calculates it first. It returns the
+// number of user promotions granted.
+func (c *component) CalculateCashback(ctx context.Context) (int, error) {
+	promotionType, active := types.Cashback, true
+
+	promotions, err := c.persistent.GetPromotions(ctx, types.PromotionFilter{
+		ByType:   &promotionType,
+		IsActive: &active,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	granted := 0
+	for _, promotion := range promotions {
+		from, to := promotion.Cashback.Period.Last(now)
+		if from.Before(promotion.Created) {
+			continue
+		}
+
+		losses, err := c.persistent.GetNetLosses(ctx, promotion.Amount.Currency, from, to)
+		if err != nil {
+			return granted, err
+		}
+
+		for _, loss := range losses {
+			ok, err := c.grant(ctx, promotion, loss, now)
+			if err != nil {
+				return granted, err
+			}
+			if ok {
+				granted++
+			}
+		}
+	}
+
+	return granted, nil
+}
+
+// grant stores the calculation and assigns the cashback in one transaction.
+// It reports false when the player already got the cashback of the period or
+// is not eligible for the promotion.
+func (c *component) grant(ctx context.Context, promotion types.Promotion, calculation types.CashbackCalculation, now time.Time) (bool, error) {
+	calculation.ID = uuid.New()
+	calculation.PromotionID = promotion.ID
+	calculation.Percentage = promotion.Cashback.Percentage
+	calculation.MaxAmount = promotion.Cashback.MaxAmount
+	calculation.Amount = types.NewMoney(promotion.Cashback.Amount(calculation.NetLoss.Amount), promotion.Amount.Currency)
+	calculation.Created = now
+
+	if !calculation.Amount.IsPositive() {
+		return false, nil
+	}
+
+	err := promotions.CheckEligibility(ctx, c.persistent, promotion, calculation.UserID)
+	if errors.Is(err, types.ErrNotEligible) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer db.RollbackTx(ctx)
+
+	userPromotion, err := db.AddPromotion(ctx, types.UserPromotion{
+		ID:          uuid.New(),
+		UserID:      calculation.UserID,
+		PromotionID: promotion.ID,
+		BonusAmount: calculation.Amount,
+		StartDate:   now,
+		EndDate:     now.Add(c.validity),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	calculation.UserPromotionID = uuid.NullUUID{UUID: userPromotion.ID, Valid: true}
+
+	created, err := db.CashbackCalculationCreate(ctx, calculation)
+	if err != nil || !created {
+		return false, err
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userPromotion.UserID.String()), userPromotion)
+
+	return true, nil
+}
+
+func (c *component) GetCashbackCalculations(ctx context.Context, userID uuid.UUID) ([]types.CashbackCalculation, error) {
+	return c.persistent.GetCashbackCalculations(ctx, userID)
+}
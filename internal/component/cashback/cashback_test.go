@@ -0,0 +1,188 @@
+package cashback_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/cashback"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	pubsub          *fakes.FakePubSub
+}
+
+func TestCalculateCashback(t *testing.T) {
+	userID := uuid.New()
+
+	promotion := types.Promotion{
+		ID:       uuid.New(),
+		Title:    "Weekly cashback",
+		Amount:   eur(0),
+		IsActive: true,
+		Type:     types.Cashback,
+		Cashback: &types.CashbackRule{
+			Percentage: decimal.NewFromInt(10),
+			MaxAmount:  decimal.NewFromInt(100),
+			Period:     types.CashbackWeekly,
+		},
+		Created: time.Now().AddDate(0, -1, 0),
+	}
+
+	cashbackPromotions := func(promotions ...types.Promotion) func(context.Context, types.PromotionFilter) ([]types.Promotion, error) {
+		return func(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
+			require.Equal(t, types.Cashback, *filter.ByType)
+			require.True(t, *filter.IsActive)
+			return promotions, nil
+		}
+	}
+
+	netLosses := func(losses ...types.CashbackCalculation) func(context.Context, types.Currency, time.Time, time.Time) ([]types.CashbackCalculation, error) {
+		return func(ctx context.Context, currency types.Currency, from time.Time, to time.Time) ([]types.CashbackCalculation, error) {
+			require.Equal(t, types.EUR, currency)
+			require.Equal(t, time.Monday, to.Weekday())
+			require.Equal(t, 7*24*time.Hour, to.Sub(from))
+			for i := range losses {
+				losses[i].PeriodStart, losses[i].PeriodEnd = from, to
+			}
+			return losses, nil
+		}
+	}
+
+	loss := func(wagered, returned int64) types.CashbackCalculation {
+		return types.CashbackCalculation{
+			UserID:   userID,
+			Wagered:  eur(wagered),
+			Returned: eur(returned),
+			NetLoss:  eur(wagered - returned),
+		}
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	assigned := func(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error) {
+		return userPromotion, nil
+	}
+
+	created := func(ok bool) func(context.Context, types.CashbackCalculation) (bool, error) {
+		return func(ctx context.Context, calculation types.CashbackCalculation) (bool, error) {
+			return ok, nil
+		}
+	}
+
+	tests := []struct {
+		name            string
+		fields          fields
+		expectedGranted int
+		expectedBonus   []types.Money
+		expectedError   error
+	}{
+		{
+			name: "it should grant a percentage of the net loss",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub: cashbackPromotions(promotion),
+					GetNetLossesStub:  netLosses(loss(500, 250)),
+					WithTxStub: tx(&fakes.FakePersistent{
+						AddPromotionStub:              assigned,
+						CashbackCalculationCreateStub: created(true),
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedGranted: 1,
+			expectedBonus:   []types.Money{eur(25)},
+		},
+		{
+			name: "it should cap the cashback",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub: cashbackPromotions(promotion),
+					GetNetLossesStub:  netLosses(loss(3000, 500)),
+					WithTxStub: tx(&fakes.FakePersistent{
+						AddPromotionStub:              assigned,
+						CashbackCalculationCreateStub: created(true),
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedGranted: 1,
+			expectedBonus:   []types.Money{eur(100)},
+		},
+		{
+			name: "it should skip cashback granted by another replica",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub: cashbackPromotions(promotion),
+					GetNetLossesStub:  netLosses(loss(500, 250)),
+					WithTxStub: tx(&fakes.FakePersistent{
+						AddPromotionStub:              assigned,
+						CashbackCalculationCreateStub: created(false),
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedBonus: []types.Money{eur(25)},
+		},
+		{
+			name: "it should skip periods that started before the promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub: cashbackPromotions(types.Promotion{
+						ID:       promotion.ID,
+						Amount:   promotion.Amount,
+						IsActive: true,
+						Type:     types.Cashback,
+						Cashback: promotion.Cashback,
+						Created:  time.Now(),
+					}),
+					WithTxStub: tx(&fakes.FakePersistent{}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := cashback.New(tt.fields.persistentStore, tt.fields.pubsub, 7*24*time.Hour)
+			granted, err := c.CalculateCashback(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedGranted, granted)
+			require.Equal(t, tt.expectedGranted, tt.fields.pubsub.PublishCallCount())
+
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			db, _ := persistent.WithTx(context.Background())
+			fake := db.(*fakes.FakePersistent)
+			require.Equal(t, len(tt.expectedBonus), fake.AddPromotionCallCount())
+			for i, bonus := range tt.expectedBonus {
+				_, userPromotion := fake.AddPromotionArgsForCall(i)
+				require.Equal(t, userID, userPromotion.UserID)
+				require.Equal(t, promotion.ID, userPromotion.PromotionID)
+				require.True(t, bonus.Amount.Equal(userPromotion.BonusAmount.Amount))
+
+				_, calculation := fake.CashbackCalculationCreateArgsForCall(i)
+				require.Equal(t, uuid.NullUUID{UUID: userPromotion.ID, Valid: true}, calculation.UserPromotionID)
+				require.True(t, bonus.Amount.Equal(calculation.Amount.Amount))
+				require.Equal(t, promotion.Cashback.Percentage, calculation.Percentage)
+				require.Equal(t, time.Monday, calculation.PeriodStart.Weekday())
+			}
+		})
+	}
+}
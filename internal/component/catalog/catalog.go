@@ -0,0 +1,332 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CatalogProvider interface {
+	CreateItem(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error)
+	GetItems(ctx context.Context, activeOnly bool) ([]types.CatalogItem, error)
+	GetItemByID(ctx context.Context, id uuid.UUID) (types.CatalogItem, error)
+	UpdateItem(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error)
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+	Redeem(ctx context.Context, userID uuid.UUID, itemID uuid.UUID) (types.Redemption, error)
+	GetRedemptions(ctx context.Context, filter types.RedemptionFilter) ([]types.Redemption, error)
+	FulfilRedemption(ctx context.Context, id uuid.UUID) (types.Redemption, error)
+}
+
+type component struct {
+	persistent store.Persistent
+	pubsub     store.PubSub
+}
+
+var _ CatalogProvider = (*component)(nil)
+
+func New(persistent store.Persistent, pubsub store.PubSub) *component {
+	return &component{
+		persistent: persistent,
+		pubsub:     pubsub,
+	}
+}
+
+func (c *component) CreateItem(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error) {
+	item.ID = uuid.New()
+
+	err := validateItem(&item)
+	if err != nil {
+		return types.CatalogItem{}, err
+	}
+
+	return c.persistent.CatalogItemCreate(ctx, item)
+}
+
+func (c *component) GetItems(ctx context.Context, activeOnly bool) ([]types.CatalogItem, error) {
+	return c.persistent.GetCatalogItems(ctx, activeOnly)
+}
+
+func (c *component) GetItemByID(ctx context.Context, id uuid.UUID) (types.CatalogItem, error) {
+	return c.persistent.CatalogItemGetByID(ctx, id)
+}
+
+func (c *component) UpdateItem(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error) {
+	err := validateItem(&item)
+	if err != nil {
+		return types.CatalogItem{}, err
+	}
+
+	return c.persistent.CatalogItemUpdate(ctx, item)
+}
+
+func (c *component) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	err := c.persistent.CatalogItemDelete(ctx, id)
+	if store.IsErrForeignKeyViolation(err) {
+		return types.ErrCatalogItemRedeemed
+	}
+
+	return err
+}
+
+// validateItem checks the item has the reward of its type and clears the
+// rewards of other types.
+func validateItem(item *types.CatalogItem) error {
+	if !item.PointsPrice.IsPositive() || (item.Stock != nil && *item.Stock < 0) {
+		return types.ErrInvalidCatalogItem
+	}
+
+	if item.BonusAmount.Currency == "" {
+		item.BonusAmount.Currency = types.DefaultCurrency
+	}
+
+	switch item.Type {
+	case types.CatalogItemBonus:
+		if !item.BonusAmount.IsPositive() {
+			return types.ErrInvalidCatalogItem
+		}
+	case types.CatalogItemPromotion:
+		item.BonusAmount.Amount = decimal.Zero
+	case types.CatalogItemPhysical:
+		item.BonusAmount.Amount = decimal.Zero
+		item.PromotionID = uuid.NullUUID{}
+		return nil
+	default:
+		return types.ErrInvalidCatalogItem
+	}
+
+	// bonus items take the wagering terms from the promotion
+	if !item.PromotionID.Valid {
+		return types.ErrInvalidCatalogItem
+	}
+
+	if item.ValidityDays == 0 {
+		item.ValidityDays = types.DefaultCatalogValidityDays
+	}
+	if item.ValidityDays < 0 {
+		return types.ErrInvalidCatalogItem
+	}
+
+	return nil
+}
+
+// Redeem buys the item for the user. Stock, points and the reward are
+// booked in one transaction, so a failed fulfilment costs no points.
+func (c *component) Redeem(ctx context.Context, userID uuid.UUID, itemID uuid.UUID) (types.Redemption, error) {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+	defer db.RollbackTx(ctx)
+
+	item, err := db.CatalogItemGetByID(ctx, itemID)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	if !item.IsActive {
+		return types.Redemption{}, types.ErrCatalogItemUnavailable
+	}
+
+	err = db.CatalogItemReserve(ctx, item.ID)
+	if store.IsErrNotFound(err) {
+		return types.Redemption{}, types.ErrCatalogItemOutOfStock
+	}
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	now := time.Now()
+	redemption := types.Redemption{
+		ID:            uuid.New(),
+		UserID:        userID,
+		CatalogItemID: item.ID,
+		Points:        item.PointsPrice,
+		Status:        types.RedemptionPending,
+		Created:       now,
+	}
+
+	// the lots are locked before the balance, in the order the expiry job
+	// takes them, so the two never wait on each other
+	err = db.PointsLotsConsume(ctx, userID, item.PointsPrice)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	_, err = db.PointsEntryCreate(ctx, types.PointsEntry{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Points:      item.PointsPrice.Neg(),
+		Source:      types.PointsSourceRedemption,
+		ReferenceID: uuid.NullUUID{UUID: redemption.ID, Valid: true},
+		Created:     now,
+	})
+	if store.IsErrCheckViolation(err) {
+		return types.Redemption{}, types.ErrInsufficientPoints
+	}
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	err = c.fulfil(ctx, db, item, &redemption)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	redemption, err = db.RedemptionCreate(ctx, redemption)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userID.String()), redemption)
+
+	return redemption, nil
+}
+
+// fulfil hands out bonus and promotion rewards. Physical items stay pending
+// until staff fulfil them.
+func (c *component) fulfil(ctx context.Context, db store.Persistent, item types.CatalogItem, redemption *types.Redemption) error {
+	now := time.Now()
+
+	switch item.Type {
+	case types.CatalogItemBonus:
+		userPromotion, err := c.grantBonus(ctx, db, item, *redemption, now)
+		if err != nil {
+			return err
+		}
+
+		redemption.UserPromotionID = uuid.NullUUID{UUID: userPromotion.ID, Valid: true}
+	case types.CatalogItemPromotion:
+		promotion, err := db.PromotionGetByID(ctx, item.PromotionID.UUID)
+		if err != nil {
+			return err
+		}
+
+		err = promotions.CheckAssignable(promotion)
+		if err != nil {
+			return err
+		}
+
+		err = promotions.CheckEligibility(ctx, db, promotion, redemption.UserID)
+		if err != nil {
+			return err
+		}
+
+		userPromotion, err := db.AddPromotion(ctx, types.UserPromotion{
+			ID:          uuid.New(),
+			UserID:      redemption.UserID,
+			PromotionID: promotion.ID,
+			StartDate:   now,
+			EndDate:     now.AddDate(0, 0, item.ValidityDays),
+		})
+		if err != nil {
+			return err
+		}
+
+		redemption.UserPromotionID = uuid.NullUUID{UUID: userPromotion.ID, Valid: true}
+	case types.CatalogItemPhysical:
+		return nil
+	}
+
+	redemption.Status = types.RedemptionFulfilled
+	redemption.Fulfilled = &now
+
+	return nil
+}
+
+// grantBonus credits the bonus of a bonus item as a claimed user promotion
+// of the item's promotion, so it is wagered under the promotion's multiplier
+// and forfeited when it is not wagered within the item's validity. Without a
+// wagering requirement the bonus is paid out as cash. The promotion has to
+// be assignable to the player like any other grant of it.
+func (c *component) grantBonus(ctx context.Context, db store.Persistent, item types.CatalogItem, redemption types.Redemption, now time.Time) (types.UserPromotion, error) {
+	promotion, err := db.PromotionGetByID(ctx, item.PromotionID.UUID)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	err = promotions.CheckAssignable(promotion)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	err = promotions.CheckEligibility(ctx, db, promotion, redemption.UserID)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: redemption.UserID, Valid: true}})
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	if user.Balance.Currency != item.BonusAmount.Currency || promotion.Amount.Currency != item.BonusAmount.Currency {
+		return types.UserPromotion{}, types.ErrCurrencyMismatch
+	}
+
+	userPromotion, err := db.AddPromotion(ctx, types.UserPromotion{
+		ID:          uuid.New(),
+		UserID:      redemption.UserID,
+		PromotionID: promotion.ID,
+		BonusAmount: item.BonusAmount,
+		StartDate:   now,
+		EndDate:     now.AddDate(0, 0, item.ValidityDays),
+	})
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	userPromotion.WageringRequired = promotion.WageringRequirement(item.BonusAmount)
+
+	newEntry := types.NewPlayerBonusEntry
+	if userPromotion.WageringRequired.IsZero() {
+		userPromotion.Converted = &now
+		newEntry = types.NewPlayerCashEntry
+	}
+
+	err = db.ClaimPromotion(ctx, userPromotion)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	_, err = db.UserBalanceUpdate(ctx, newEntry(
+		redemption.UserID,
+		types.LedgerSourceRedemption,
+		uuid.NullUUID{UUID: userPromotion.ID, Valid: true},
+		item.BonusAmount,
+	))
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	return userPromotion, nil
+}
+
+func (c *component) GetRedemptions(ctx context.Context, filter types.RedemptionFilter) ([]types.Redemption, error) {
+	return c.persistent.GetRedemptions(ctx, filter)
+}
+
+// FulfilRedemption marks a pending physical item as handed out. It returns
+// pgx.ErrNoRows when there is no pending redemption with the id.
+func (c *component) FulfilRedemption(ctx context.Context, id uuid.UUID) (types.Redemption, error) {
+	redemption, err := c.persistent.RedemptionFulfil(ctx, id)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, redemption.UserID.String()), redemption)
+
+	return redemption, nil
+}
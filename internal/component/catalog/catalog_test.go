@@ -0,0 +1,342 @@
+package catalog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/catalog"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	pubsub          *fakes.FakePubSub
+}
+
+func TestRedeem(t *testing.T) {
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	user := types.User{ID: userID, Balance: eur(0), BonusBalance: eur(0), LoyaltyPoints: decimal.NewFromInt(500)}
+
+	bonusItem := types.CatalogItem{
+		ID:           uuid.New(),
+		Title:        "10 EUR bonus",
+		Type:         types.CatalogItemBonus,
+		PointsPrice:  decimal.NewFromInt(100),
+		BonusAmount:  eur(10),
+		PromotionID:  uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		ValidityDays: 7,
+		IsActive:     true,
+	}
+
+	promotionItem := types.CatalogItem{
+		ID:           uuid.New(),
+		Title:        "Weekend reload",
+		Type:         types.CatalogItemPromotion,
+		PointsPrice:  decimal.NewFromInt(200),
+		PromotionID:  uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		ValidityDays: 7,
+		IsActive:     true,
+	}
+
+	physicalItem := types.CatalogItem{
+		ID:          uuid.New(),
+		Title:       "Dinner voucher",
+		Type:        types.CatalogItemPhysical,
+		PointsPrice: decimal.NewFromInt(300),
+		IsActive:    true,
+	}
+
+	inactiveItem := bonusItem
+	inactiveItem.IsActive = false
+
+	tx := func(item types.CatalogItem, tx *fakes.FakePersistent) *fakes.FakePersistent {
+		tx.CatalogItemGetByIDStub = func(ctx context.Context, id uuid.UUID) (types.CatalogItem, error) {
+			return item, nil
+		}
+		tx.PointsLotsConsumeStub = func(ctx context.Context, userID uuid.UUID, points decimal.Decimal) error {
+			require.Equal(t, item.PointsPrice, points)
+			return nil
+		}
+		if tx.PointsEntryCreateStub == nil {
+			tx.PointsEntryCreateStub = func(ctx context.Context, e types.PointsEntry) (bool, error) {
+				require.Equal(t, item.PointsPrice.Neg(), e.Points)
+				require.Equal(t, types.PointsSourceRedemption, e.Source)
+				return true, nil
+			}
+		}
+		tx.RedemptionCreateStub = func(ctx context.Context, r types.Redemption) (types.Redemption, error) {
+			return r, nil
+		}
+		tx.UserGetByStub = func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+			return user, nil
+		}
+		return tx
+	}
+
+	var bonusPromotion uuid.UUID
+	archived := time.Now()
+
+	tests := []struct {
+		name                  string
+		fields                fields
+		itemID                uuid.UUID
+		expectedStatus        types.RedemptionStatus
+		expectedUserPromotion bool
+		expectedNotified      int
+		expectedError         error
+	}{
+		{
+			name: "it should credit a bonus item as a bonus of its promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(bonusItem, &fakes.FakePersistent{
+							PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+								require.Equal(t, bonusItem.PromotionID.UUID, id)
+								return types.Promotion{ID: id, Amount: eur(0), IsActive: true, WageringMultiplier: decimal.NewFromInt(30)}, nil
+							},
+							AddPromotionStub: func(ctx context.Context, up types.UserPromotion) (types.UserPromotion, error) {
+								bonusPromotion = up.ID
+								require.Equal(t, eur(10), up.BonusAmount)
+								require.Equal(t, 7*24.0, up.EndDate.Sub(up.StartDate).Hours())
+								return up, nil
+							},
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
+								require.Equal(t, eur(300), up.WageringRequired)
+								require.Nil(t, up.Converted)
+								return nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.CreditAccount)
+								require.Equal(t, types.LedgerAccountLoyalty, e.DebitAccount)
+								require.Equal(t, eur(10), e.Amount)
+								// the entry is listed with its user promotion in the history
+								require.Equal(t, uuid.NullUUID{UUID: bonusPromotion, Valid: true}, e.ReferenceID)
+								return user, nil
+							},
+						}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:                bonusItem.ID,
+			expectedStatus:        types.RedemptionFulfilled,
+			expectedUserPromotion: true,
+			expectedNotified:      1,
+		},
+		{
+			name: "it should fail a bonus item of an archived promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(bonusItem, &fakes.FakePersistent{
+							PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+								return types.Promotion{ID: id, Amount: eur(0), Archived: &archived}, nil
+							},
+						}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:        bonusItem.ID,
+			expectedError: types.ErrPromotionArchived,
+		},
+		{
+			name: "it should fail a bonus item the player is not eligible for",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(bonusItem, &fakes.FakePersistent{
+							PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+								return types.Promotion{
+									ID:          id,
+									Amount:      eur(0),
+									IsActive:    true,
+									Eligibility: &types.EligibilityRules{Countries: []string{"DE"}},
+								}, nil
+							},
+							GetEligibilityProfileStub: func(ctx context.Context, id uuid.UUID) (types.EligibilityProfile, error) {
+								return types.EligibilityProfile{UserID: id, Country: "AT"}, nil
+							},
+						}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:        bonusItem.ID,
+			expectedError: types.ErrNotEligible,
+		},
+		{
+			name: "it should assign the promotion of a promotion item",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(promotionItem, &fakes.FakePersistent{
+							PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+								return types.Promotion{ID: id, IsActive: true}, nil
+							},
+							AddPromotionStub: func(ctx context.Context, up types.UserPromotion) (types.UserPromotion, error) {
+								require.Equal(t, promotionItem.PromotionID.UUID, up.PromotionID)
+								require.Equal(t, 7*24.0, up.EndDate.Sub(up.StartDate).Hours())
+								return up, nil
+							},
+						}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:                promotionItem.ID,
+			expectedStatus:        types.RedemptionFulfilled,
+			expectedUserPromotion: true,
+			expectedNotified:      1,
+		},
+		{
+			name: "it should keep a physical item pending",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(physicalItem, &fakes.FakePersistent{}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:           physicalItem.ID,
+			expectedStatus:   types.RedemptionPending,
+			expectedNotified: 1,
+		},
+		{
+			name: "it should fail insufficient points",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(bonusItem, &fakes.FakePersistent{
+							PointsEntryCreateStub: func(ctx context.Context, e types.PointsEntry) (bool, error) {
+								return false, &pgconn.PgError{Code: "23514"}
+							},
+						}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:        bonusItem.ID,
+			expectedError: types.ErrInsufficientPoints,
+		},
+		{
+			name: "it should fail out of stock item",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(bonusItem, &fakes.FakePersistent{
+							CatalogItemReserveStub: func(ctx context.Context, id uuid.UUID) error {
+								return pgx.ErrNoRows
+							},
+						}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:        bonusItem.ID,
+			expectedError: types.ErrCatalogItemOutOfStock,
+		},
+		{
+			name: "it should fail inactive item",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return tx(inactiveItem, &fakes.FakePersistent{}), nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			itemID:        inactiveItem.ID,
+			expectedError: types.ErrCatalogItemUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := catalog.New(tt.fields.persistentStore, tt.fields.pubsub)
+			redemption, err := c.Redeem(context.Background(), userID, tt.itemID)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedStatus, redemption.Status)
+			require.Equal(t, tt.expectedUserPromotion, redemption.UserPromotionID.Valid)
+			require.Equal(t, tt.expectedNotified, tt.fields.pubsub.PublishCallCount())
+		})
+	}
+}
+
+func TestCreateItem(t *testing.T) {
+	negative := -1
+
+	tests := []struct {
+		name          string
+		item          types.CatalogItem
+		expectedError error
+	}{
+		{
+			name: "it should create a bonus item",
+			item: types.CatalogItem{Type: types.CatalogItemBonus, PointsPrice: decimal.NewFromInt(100), BonusAmount: eur(10), PromotionID: uuid.NullUUID{UUID: uuid.New(), Valid: true}},
+		},
+		{
+			name: "it should create a promotion item with default validity",
+			item: types.CatalogItem{Type: types.CatalogItemPromotion, PointsPrice: decimal.NewFromInt(100), PromotionID: uuid.NullUUID{UUID: uuid.New(), Valid: true}},
+		},
+		{
+			name:          "it should fail bonus item without amount",
+			item:          types.CatalogItem{Type: types.CatalogItemBonus, PointsPrice: decimal.NewFromInt(100)},
+			expectedError: types.ErrInvalidCatalogItem,
+		},
+		{
+			name:          "it should fail bonus item without promotion",
+			item:          types.CatalogItem{Type: types.CatalogItemBonus, PointsPrice: decimal.NewFromInt(100), BonusAmount: eur(10)},
+			expectedError: types.ErrInvalidCatalogItem,
+		},
+		{
+			name:          "it should fail promotion item without promotion",
+			item:          types.CatalogItem{Type: types.CatalogItemPromotion, PointsPrice: decimal.NewFromInt(100)},
+			expectedError: types.ErrInvalidCatalogItem,
+		},
+		{
+			name:          "it should fail item without price",
+			item:          types.CatalogItem{Type: types.CatalogItemPhysical},
+			expectedError: types.ErrInvalidCatalogItem,
+		},
+		{
+			name:          "it should fail negative stock",
+			item:          types.CatalogItem{Type: types.CatalogItemPhysical, PointsPrice: decimal.NewFromInt(100), Stock: &negative},
+			expectedError: types.ErrInvalidCatalogItem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			persistent := &fakes.FakePersistent{
+				CatalogItemCreateStub: func(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error) {
+					if item.Type != types.CatalogItemPhysical {
+						require.Equal(t, types.DefaultCatalogValidityDays, item.ValidityDays)
+					}
+					return item, nil
+				},
+			}
+			c := catalog.New(persistent, &fakes.FakePubSub{})
+			_, err := c.CreateItem(context.Background(), tt.item)
+
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package freespins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type FreeSpinsProvider interface {
+	GetFreeSpins(ctx context.Context, userID uuid.UUID, gameID string) ([]types.FreeSpinsEntitlement, error)
+	PlayFreeSpin(ctx context.Context, userID uuid.UUID, id uuid.UUID, spin types.FreeSpin) (types.FreeSpinsEntitlement, bool, error)
+	SettleExpiredFreeSpins(ctx context.Context) (int, error)
+}
+
+const settleBatchSize = 100
+
+type component struct {
+	persistent store.Persistent
+	pubsub     store.PubSub
+}
+
+var _ FreeSpinsProvider = (*component)(nil)
+
+func New(persistent store.Persistent, pubsub store.PubSub) *component {
+	return &component{
+		persistent: persistent,
+		pubsub:     pubsub,
+	}
+}
+
+// GetFreeSpins returns the free spins the user can still play, of gameID
+// when it is set.
+func (c *component) GetFreeSpins(ctx context.Context, userID uuid.UUID, gameID string) ([]types.FreeSpinsEntitlement, error) {
+	return c.persistent.GetActiveFreeSpins(ctx, userID, gameID)
+}
+
+// PlayFreeSpin uses one of the user's free spins for a round and adds its win
+// to the winnings. The winnings are settled with the last spin. A round that
+// was already played returns the free spins unchanged and false.
+func (c *component) PlayFreeSpin(ctx context.Context, userID uuid.UUID, id uuid.UUID, spin types.FreeSpin) (types.FreeSpinsEntitlement, bool, error) {
+	if spin.Win.IsNegative() {
+		return types.FreeSpinsEntitlement{}, false, types.ErrInvalidAmount
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, false, err
+	}
+	defer db.RollbackTx(ctx)
+
+	freeSpins, err := db.FreeSpinsGetByID(ctx, id)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, false, err
+	}
+
+	if freeSpins.UserID != userID {
+		return types.FreeSpinsEntitlement{}, false, pgx.ErrNoRows
+	}
+
+	if spin.Win.Currency != freeSpins.Stake.Currency {
+		return types.FreeSpinsEntitlement{}, false, types.ErrCurrencyMismatch
+	}
+
+	created, err := db.FreeSpinRoundCreate(ctx, id, spin)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, false, err
+	}
+	if !created {
+		return freeSpins, false, nil
+	}
+
+	freeSpins, err = db.FreeSpinsConsume(ctx, id, spin.Win)
+	if store.IsErrNotFound(err) {
+		return types.FreeSpinsEntitlement{}, false, types.ErrFreeSpinsUnavailable
+	}
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, false, err
+	}
+
+	var settled types.UserPromotion
+	if freeSpins.Remaining == 0 {
+		freeSpins, settled, err = settle(ctx, db, id)
+		if err != nil {
+			return types.FreeSpinsEntitlement{}, false, err
+		}
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, false, err
+	}
+
+	if freeSpins.Settled != nil {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, settled.UserID.String()), settled)
+	}
+
+	return freeSpins, true, nil
+}
+
+// SettleExpiredFreeSpins pays out the winnings of free spins that expired
+// before all spins were played. It returns the number of free spins settled.
+func (c *component) SettleExpiredFreeSpins(ctx context.Context) (int, error) {
+	expired, err := c.persistent.GetExpiredFreeSpins(ctx, time.Now(), settleBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	settled := 0
+	for _, freeSpins := range expired {
+		err = c.settleExpired(ctx, freeSpins.ID)
+		if store.IsErrNotFound(err) {
+			// already settled by another replica
+			continue
+		}
+		if err != nil {
+			return settled, err
+		}
+		settled++
+	}
+
+	return settled, nil
+}
+
+func (c *component) settleExpired(ctx context.Context, id uuid.UUID) error {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	_, userPromotion, err := settle(ctx, db, id)
+	if err != nil {
+		return err
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userPromotion.UserID.String()), userPromotion)
+
+	return nil
+}
+
+// settle closes the free spins and credits their winnings as the bonus of
+// the user promotion they were claimed with. The bonus is wagered like any
+// other, for as long as the spins were valid, and without a wagering
+// requirement it is paid out as cash.
+func settle(ctx context.Context, db store.Persistent, id uuid.UUID) (types.FreeSpinsEntitlement, types.UserPromotion, error) {
+	freeSpins, err := db.FreeSpinsSettle(ctx, id)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, types.UserPromotion{}, err
+	}
+
+	userPromotion, err := db.GetUserPromotionByID(ctx, freeSpins.UserPromotionID)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, types.UserPromotion{}, err
+	}
+
+	now := time.Now()
+	userPromotion.BonusAmount = freeSpins.Winnings
+	userPromotion.WageringRequired = userPromotion.Promotion.WageringRequirement(freeSpins.Winnings)
+	userPromotion.EndDate = now.Add(freeSpins.Expires.Sub(freeSpins.Created))
+
+	newEntry := types.NewPlayerBonusEntry
+	if userPromotion.WageringRequired.IsZero() {
+		userPromotion.Converted = &now
+		newEntry = types.NewPlayerCashEntry
+	}
+
+	err = db.UserPromotionSettle(ctx, userPromotion)
+	if err != nil {
+		return types.FreeSpinsEntitlement{}, types.UserPromotion{}, err
+	}
+
+	if freeSpins.Winnings.IsPositive() {
+		_, err = db.UserBalanceUpdate(ctx, newEntry(
+			freeSpins.UserID,
+			types.LedgerSourceFreeSpins,
+			uuid.NullUUID{UUID: userPromotion.ID, Valid: true},
+			freeSpins.Winnings,
+		))
+		if err != nil {
+			return types.FreeSpinsEntitlement{}, types.UserPromotion{}, err
+		}
+	}
+
+	return freeSpins, userPromotion, nil
+}
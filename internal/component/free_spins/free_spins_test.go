@@ -0,0 +1,335 @@
+package freespins_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	freespins "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/free_spins"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	pubsub          *fakes.FakePubSub
+}
+
+func TestPlayFreeSpin(t *testing.T) {
+	userID := uuid.New()
+
+	freeSpins := types.FreeSpinsEntitlement{
+		ID:              uuid.New(),
+		UserPromotionID: uuid.New(),
+		UserID:          userID,
+		GameID:          "starburst",
+		Spins:           20,
+		Remaining:       2,
+		Stake:           eur(1),
+		Winnings:        eur(5),
+		Expires:         time.Now().AddDate(0, 0, 7),
+		Created:         time.Now(),
+	}
+
+	userPromotion := types.UserPromotion{
+		ID:     freeSpins.UserPromotionID,
+		UserID: userID,
+		Promotion: &types.Promotion{
+			Amount:             eur(0),
+			Type:               types.FreeSpins,
+			WageringMultiplier: decimal.NewFromInt(10),
+		},
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	found := func(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error) {
+		return freeSpins, nil
+	}
+
+	recorded := func(ok bool) func(context.Context, uuid.UUID, types.FreeSpin) (bool, error) {
+		return func(ctx context.Context, id uuid.UUID, spin types.FreeSpin) (bool, error) {
+			return ok, nil
+		}
+	}
+
+	consumed := func(remaining int) func(context.Context, uuid.UUID, types.Money) (types.FreeSpinsEntitlement, error) {
+		return func(ctx context.Context, id uuid.UUID, win types.Money) (types.FreeSpinsEntitlement, error) {
+			fs := freeSpins
+			fs.Remaining = remaining
+			fs.Winnings, _ = fs.Winnings.Add(win)
+			return fs, nil
+		}
+	}
+
+	settled := func(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error) {
+		now := time.Now()
+		fs := freeSpins
+		fs.Remaining = 0
+		fs.Winnings = eur(8)
+		fs.Settled = &now
+		return fs, nil
+	}
+
+	spin := types.FreeSpin{RoundID: "round-1", Win: eur(3)}
+
+	tests := []struct {
+		name              string
+		fields            fields
+		userID            uuid.UUID
+		spin              types.FreeSpin
+		expectedCreated   bool
+		expectedRemaining int
+		expectedSettled   bool
+		expectedError     error
+	}{
+		{
+			name: "it should use a spin and add its win",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsGetByIDStub:    found,
+						FreeSpinRoundCreateStub: recorded(true),
+						FreeSpinsConsumeStub:    consumed(1),
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			userID:            userID,
+			spin:              spin,
+			expectedCreated:   true,
+			expectedRemaining: 1,
+		},
+		{
+			name: "it should settle the winnings with the last spin",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsGetByIDStub:    found,
+						FreeSpinRoundCreateStub: recorded(true),
+						FreeSpinsConsumeStub:    consumed(0),
+						FreeSpinsSettleStub:     settled,
+						GetUserPromotionByIDStub: func(ctx context.Context, id uuid.UUID) (types.UserPromotion, error) {
+							return userPromotion, nil
+						},
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			userID:          userID,
+			spin:            spin,
+			expectedCreated: true,
+			expectedSettled: true,
+		},
+		{
+			name: "it should not use a spin for a repeated round",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsGetByIDStub:    found,
+						FreeSpinRoundCreateStub: recorded(false),
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			userID:            userID,
+			spin:              spin,
+			expectedRemaining: 2,
+		},
+		{
+			name: "it should fail when the spins are used up",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsGetByIDStub:    found,
+						FreeSpinRoundCreateStub: recorded(true),
+						FreeSpinsConsumeStub: func(ctx context.Context, id uuid.UUID, win types.Money) (types.FreeSpinsEntitlement, error) {
+							return types.FreeSpinsEntitlement{}, pgx.ErrNoRows
+						},
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			userID:        userID,
+			spin:          spin,
+			expectedError: types.ErrFreeSpinsUnavailable,
+		},
+		{
+			name: "it should not find free spins of another player",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsGetByIDStub: found,
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			userID:        uuid.New(),
+			spin:          spin,
+			expectedError: pgx.ErrNoRows,
+		},
+		{
+			name: "it should reject a win in another currency",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsGetByIDStub: found,
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			userID:        userID,
+			spin:          types.FreeSpin{RoundID: "round-1", Win: types.NewMoney(decimal.NewFromInt(3), "USD")},
+			expectedError: types.ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := freespins.New(tt.fields.persistentStore, tt.fields.pubsub)
+			res, created, err := c.PlayFreeSpin(context.Background(), tt.userID, freeSpins.ID, tt.spin)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedCreated, created)
+
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			db, _ := persistent.WithTx(context.Background())
+			fake := db.(*fakes.FakePersistent)
+
+			if !tt.expectedSettled {
+				require.Equal(t, 0, fake.FreeSpinsSettleCallCount())
+				require.Equal(t, 0, tt.fields.pubsub.PublishCallCount())
+				if tt.expectedError == nil {
+					require.Equal(t, tt.expectedRemaining, res.Remaining)
+				}
+				return
+			}
+
+			require.NotNil(t, res.Settled)
+			require.Equal(t, 1, tt.fields.pubsub.PublishCallCount())
+
+			_, up := fake.UserPromotionSettleArgsForCall(0)
+			require.Equal(t, eur(8), up.BonusAmount)
+			require.Equal(t, "80", up.WageringRequired.Amount.String())
+			require.Nil(t, up.Converted)
+
+			_, entry := fake.UserBalanceUpdateArgsForCall(0)
+			require.Equal(t, types.LedgerSourceFreeSpins, entry.Source)
+			require.Equal(t, types.LedgerAccountPlayerBonus, entry.CreditAccount)
+			require.Equal(t, uuid.NullUUID{UUID: userPromotion.ID, Valid: true}, entry.ReferenceID)
+			require.Equal(t, eur(8), entry.Amount)
+		})
+	}
+}
+
+func TestSettleExpiredFreeSpins(t *testing.T) {
+	expires := time.Now().Add(-time.Minute)
+
+	freeSpins := types.FreeSpinsEntitlement{
+		ID:              uuid.New(),
+		UserPromotionID: uuid.New(),
+		UserID:          uuid.New(),
+		Remaining:       5,
+		Stake:           eur(1),
+		Winnings:        eur(4),
+		Expires:         expires,
+		Created:         expires.AddDate(0, 0, -7),
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	expired := func(ctx context.Context, before time.Time, limit int) ([]types.FreeSpinsEntitlement, error) {
+		return []types.FreeSpinsEntitlement{freeSpins}, nil
+	}
+
+	tests := []struct {
+		name            string
+		fields          fields
+		expectedSettled int
+		expectedEntries []types.LedgerEntry
+		expectedError   error
+	}{
+		{
+			name: "it should pay out the winnings as cash without wagering",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetExpiredFreeSpinsStub: expired,
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsSettleStub: func(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error) {
+							return freeSpins, nil
+						},
+						GetUserPromotionByIDStub: func(ctx context.Context, id uuid.UUID) (types.UserPromotion, error) {
+							return types.UserPromotion{
+								ID:        freeSpins.UserPromotionID,
+								UserID:    freeSpins.UserID,
+								Promotion: &types.Promotion{Amount: eur(0), Type: types.FreeSpins},
+							}, nil
+						},
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedSettled: 1,
+			expectedEntries: []types.LedgerEntry{
+				{CreditAccount: types.LedgerAccountPlayerCash, Amount: eur(4)},
+			},
+		},
+		{
+			name: "it should skip free spins settled by another replica",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetExpiredFreeSpinsStub: expired,
+					WithTxStub: tx(&fakes.FakePersistent{
+						FreeSpinsSettleStub: func(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error) {
+							return types.FreeSpinsEntitlement{}, pgx.ErrNoRows
+						},
+					}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := freespins.New(tt.fields.persistentStore, tt.fields.pubsub)
+			settled, err := c.SettleExpiredFreeSpins(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedSettled, settled)
+			require.Equal(t, tt.expectedSettled, tt.fields.pubsub.PublishCallCount())
+
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			db, _ := persistent.WithTx(context.Background())
+			fake := db.(*fakes.FakePersistent)
+			require.Equal(t, len(tt.expectedEntries), fake.UserBalanceUpdateCallCount())
+			for i, expected := range tt.expectedEntries {
+				_, entry := fake.UserBalanceUpdateArgsForCall(i)
+				require.Equal(t, freeSpins.UserID, entry.UserID)
+				require.Equal(t, expected.CreditAccount, entry.CreditAccount)
+				require.Equal(t, expected.Amount, entry.Amount)
+
+				_, up := fake.UserPromotionSettleArgsForCall(i)
+				require.NotNil(t, up.Converted)
+				require.WithinDuration(t, time.Now().AddDate(0, 0, 7), up.EndDate, time.Minute)
+			}
+		})
+	}
+}
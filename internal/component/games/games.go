@@ -0,0 +1,288 @@
+package games
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/loyalty"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/tournaments"
+	userpromotion "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/user_promotion"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type GameProvider interface {
+	IngestEvent(ctx context.Context, event types.GameEvent) (types.GameEvent, bool, error)
+	ListenToGameEvents(ctx context.Context) error
+}
+
+type component struct {
+	persistent     store.Persistent
+	pubsub         store.PubSub
+	userPromotions userpromotion.UserPromotionProvider
+	loyalty        loyalty.LoyaltyProvider
+	tournaments    tournaments.TournamentProvider
+}
+
+var _ GameProvider = (*component)(nil)
+
+func New(persistent store.Persistent, pubsub store.PubSub, userPromotions userpromotion.UserPromotionProvider, loyalty loyalty.LoyaltyProvider, tournaments tournaments.TournamentProvider) *component {
+	return &component{
+		persistent:     persistent,
+		pubsub:         pubsub,
+		userPromotions: userPromotions,
+		loyalty:        loyalty,
+		tournaments:    tournaments,
+	}
+}
+
+// IngestEvent applies a game event to the player's balances. It reports
+// false together with the stored event when the game server already
+// reported an event with the same event ID for the game, in which case
+// nothing is applied.
+func (c *component) IngestEvent(ctx context.Context, event types.GameEvent) (types.GameEvent, bool, error) {
+	if event.EventID == "" || event.GameID == "" || event.RoundID == "" {
+		return types.GameEvent{}, false, types.ErrInvalidGameEvent
+	}
+
+	if event.Amount.IsNegative() {
+		return types.GameEvent{}, false, types.ErrInvalidAmount
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.GameEvent{}, false, err
+	}
+	defer db.RollbackTx(ctx)
+
+	// the user stays locked until the event is booked, so concurrent bets
+	// are taken from the balances one after another
+	user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: event.UserID, Valid: true}, ForUpdate: true})
+	if err != nil {
+		return types.GameEvent{}, false, err
+	}
+
+	// a resent event is answered with the stored one before the balances
+	// and the round are checked again, as they changed since it was applied
+	existing, err := db.GameEventGetByEventID(ctx, event.GameID, event.EventID)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !store.IsErrNotFound(err) {
+		return types.GameEvent{}, false, err
+	}
+
+	if event.Amount.Currency == "" {
+		event.Amount.Currency = user.Balance.Currency
+	}
+
+	if event.Amount.Currency != user.Balance.Currency {
+		return types.GameEvent{}, false, types.ErrCurrencyMismatch
+	}
+
+	event.ID = uuid.New()
+	event.Created = time.Now()
+
+	var entries []types.LedgerEntry
+	switch event.Type {
+	case types.GameEventBet:
+		entries, err = c.bet(user, &event)
+	case types.GameEventWin:
+		entries, err = c.win(ctx, db, &event)
+	case types.GameEventRollback:
+		entries, err = c.rollback(ctx, db, &event)
+	default:
+		err = types.ErrInvalidGameEvent
+	}
+	if err != nil {
+		return types.GameEvent{}, false, err
+	}
+
+	created, err := db.GameEventCreate(ctx, event)
+	if store.IsErrConflict(err) {
+		return types.GameEvent{}, false, types.ErrGameRoundDuplicate
+	}
+	if err != nil {
+		return types.GameEvent{}, false, err
+	}
+
+	if !created {
+		// stored concurrently by a request that did not lock the user
+		db.RollbackTx(ctx)
+
+		existing, err := c.persistent.GameEventGetByEventID(ctx, event.GameID, event.EventID)
+		return existing, false, err
+	}
+
+	for _, entry := range entries {
+		_, err = db.UserBalanceUpdate(ctx, entry)
+		if err != nil {
+			return types.GameEvent{}, false, err
+		}
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.GameEvent{}, false, err
+	}
+
+	c.afterEvent(ctx, event)
+
+	return event, true, nil
+}
+
+// bet takes the stake from the cash balance first and from the bonus balance
+// for the rest.
+func (c *component) bet(user types.User, event *types.GameEvent) ([]types.LedgerEntry, error) {
+	total := user.Balance.Amount.Add(user.BonusBalance.Amount)
+	if event.Amount.Amount.GreaterThan(total) {
+		return nil, types.ErrInsufficientBalance
+	}
+
+	event.CashAmount = decimal.Min(event.Amount.Amount, decimal.Max(user.Balance.Amount, decimal.Zero))
+	event.BonusAmount = event.Amount.Amount.Sub(event.CashAmount)
+
+	return c.entries(event, types.LedgerSourceGameBet, event.CashAmount.Neg(), event.BonusAmount.Neg()), nil
+}
+
+// win pays to the bonus balance when the bet of the round was placed with
+// bonus funds, so bonus money cannot be turned into cash by playing.
+func (c *component) win(ctx context.Context, db store.Persistent, event *types.GameEvent) ([]types.LedgerEntry, error) {
+	bet, err := roundBet(ctx, db, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if bet.BonusAmount.IsPositive() {
+		event.BonusAmount = event.Amount.Amount
+	} else {
+		event.CashAmount = event.Amount.Amount
+	}
+
+	return c.entries(event, types.LedgerSourceGameWin, event.CashAmount, event.BonusAmount), nil
+}
+
+// rollback refunds the bet of the round to the balances it was taken from.
+func (c *component) rollback(ctx context.Context, db store.Persistent, event *types.GameEvent) ([]types.LedgerEntry, error) {
+	bet, err := roundBet(ctx, db, event)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.GameEventGet(ctx, event.GameID, event.RoundID, types.GameEventWin)
+	if err == nil {
+		return nil, types.ErrGameRoundSettled
+	}
+	if !store.IsErrNotFound(err) {
+		return nil, err
+	}
+
+	event.Amount = bet.Amount
+	event.CashAmount = bet.CashAmount
+	event.BonusAmount = bet.BonusAmount
+
+	return c.entries(event, types.LedgerSourceGameRollback, event.CashAmount, event.BonusAmount), nil
+}
+
+func roundBet(ctx context.Context, db store.Persistent, event *types.GameEvent) (types.GameEvent, error) {
+	bet, err := db.GameEventGet(ctx, event.GameID, event.RoundID, types.GameEventBet)
+	if err != nil {
+		return types.GameEvent{}, err
+	}
+
+	if bet.UserID != event.UserID {
+		return types.GameEvent{}, types.ErrInvalidGameEvent
+	}
+
+	return bet, nil
+}
+
+func (c *component) entries(event *types.GameEvent, source types.LedgerSource, cash, bonus decimal.Decimal) []types.LedgerEntry {
+	var (
+		entries     []types.LedgerEntry
+		referenceID = uuid.NullUUID{UUID: event.ID, Valid: true}
+	)
+
+	if !cash.IsZero() {
+		entries = append(entries, types.NewPlayerCashEntry(event.UserID, source, referenceID, types.NewMoney(cash, event.Amount.Currency)))
+	}
+
+	if !bonus.IsZero() {
+		entries = append(entries, types.NewPlayerBonusEntry(event.UserID, source, referenceID, types.NewMoney(bonus, event.Amount.Currency)))
+	}
+
+	return entries
+}
+
+// afterEvent feeds a newly applied event to the loyalty features. A rolled
+// back bet stops counting towards bonus wagering and gives back its points.
+// Failures are logged, the event itself is already booked.
+func (c *component) afterEvent(ctx context.Context, event types.GameEvent) {
+	log := types.GetLoggerFromContext(ctx)
+
+	var points types.PointsEntry
+	switch {
+	case event.Type == types.GameEventBet && event.Amount.IsPositive():
+		err := c.userPromotions.RecordWager(ctx, event.UserID, event.ID, event.Amount)
+		if err != nil {
+			log.Errorf("failed to record wager of game event %s: %s", event.ID, err)
+		}
+
+		points, err = c.loyalty.AccruePoints(ctx, event)
+		if err != nil {
+			log.Errorf("failed to accrue points for game event %s: %s", event.ID, err)
+		}
+	case event.Type == types.GameEventRollback:
+		bet, err := c.persistent.GameEventGet(ctx, event.GameID, event.RoundID, types.GameEventBet)
+		if err != nil {
+			log.Errorf("failed to get bet rolled back by game event %s: %s", event.ID, err)
+			break
+		}
+
+		err = c.userPromotions.RevertWager(ctx, event.UserID, bet.ID)
+		if err != nil {
+			log.Errorf("failed to revert wager of game event %s: %s", bet.ID, err)
+		}
+
+		_, err = c.loyalty.ReversePoints(ctx, event.UserID, bet.ID)
+		if err != nil {
+			log.Errorf("failed to reverse points of game event %s: %s", bet.ID, err)
+		}
+	}
+
+	err := c.tournaments.RecordEvent(ctx, event, points.Points)
+	if err != nil {
+		log.Errorf("failed to score game event %s in tournaments: %s", event.ID, err)
+	}
+}
+
+func (c *component) ListenToGameEvents(ctx context.Context) error {
+	sub := c.pubsub.Subscribe(ctx, redis_pub_sub.GameEventsChannel)
+	defer sub.Close()
+
+	log := types.GetLoggerFromContext(ctx)
+
+	ch := sub.Channel()
+
+	for msg := range ch {
+		var event types.GameEvent
+		err := json.Unmarshal([]byte(msg.Payload), &event)
+		if err != nil {
+			log.Errorf("failed to unmarshal game event: %s", err)
+			continue
+		}
+
+		_, _, err = c.IngestEvent(ctx, event)
+		if err != nil {
+			log.Errorf("failed to ingest game event for round %s: %s", event.RoundID, err)
+			continue
+		}
+	}
+
+	return nil
+}
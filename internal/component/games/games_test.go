@@ -0,0 +1,386 @@
+package games_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/games"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	pubsub          store.PubSub
+	userPromotions  *fakes.FakeUserPromotionProvider
+	loyalty         *fakes.FakeLoyaltyProvider
+}
+
+func TestIngestEvent(t *testing.T) {
+	type args struct {
+		event types.GameEvent
+	}
+
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	user := types.User{ID: userID, Balance: eur(10), BonusBalance: eur(20)}
+
+	event := func(eventType types.GameEventType, amount types.Money) types.GameEvent {
+		return types.GameEvent{
+			EventID: "event-1",
+			UserID:  userID,
+			GameID:  "slot-1",
+			RoundID: "round-1",
+			Type:    eventType,
+			Amount:  amount,
+		}
+	}
+
+	bonusBet := event(types.GameEventBet, eur(15))
+	bonusBet.ID = uuid.MustParse("1f0a3c52-6a4e-4f7b-9a51-3a8d5c1e2b40")
+	bonusBet.CashAmount = decimal.NewFromInt(10)
+	bonusBet.BonusAmount = decimal.NewFromInt(5)
+
+	notStored := func(ctx context.Context, g string, e string) (types.GameEvent, error) {
+		return types.GameEvent{}, pgx.ErrNoRows
+	}
+
+	stored := func(stored types.GameEvent) func(context.Context, string, string) (types.GameEvent, error) {
+		return func(ctx context.Context, g string, e string) (types.GameEvent, error) {
+			require.Equal(t, "slot-1", g)
+			require.Equal(t, "event-1", e)
+			return stored, nil
+		}
+	}
+
+	tests := []struct {
+		name            string
+		fields          fields
+		args            args
+		expectedCreated bool
+		expectedWagers  int
+		expectedReverts int
+		expectedError   error
+	}{
+		{
+			name: "it should take a bet from cash and bonus balance",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								require.True(t, uf.ForUpdate)
+								return user, nil
+							},
+							GameEventCreateStub: func(ctx context.Context, e types.GameEvent) (bool, error) {
+								require.Equal(t, "10", e.CashAmount.String())
+								require.Equal(t, "5", e.BonusAmount.String())
+								return true, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerSourceGameBet, e.Source)
+								require.Equal(t, types.LedgerAccountGames, e.CreditAccount)
+								return user, nil
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventBet, eur(15)),
+			},
+			expectedCreated: true,
+			expectedWagers:  1,
+		},
+		{
+			name: "it should pay a win of a bonus bet to the bonus balance",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventGetStub: func(ctx context.Context, g string, r string, et types.GameEventType) (types.GameEvent, error) {
+								return bonusBet, nil
+							},
+							GameEventCreateStub: func(ctx context.Context, e types.GameEvent) (bool, error) {
+								return true, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerAccountGames, e.DebitAccount)
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.CreditAccount)
+								require.Equal(t, eur(30), e.Amount)
+								return user, nil
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventWin, eur(30)),
+			},
+			expectedCreated: true,
+		},
+		{
+			name: "it should refund a rolled back bet and revert its wager",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventGetStub: func(ctx context.Context, g string, r string, et types.GameEventType) (types.GameEvent, error) {
+								if et == types.GameEventWin {
+									return types.GameEvent{}, pgx.ErrNoRows
+								}
+								return bonusBet, nil
+							},
+							GameEventCreateStub: func(ctx context.Context, e types.GameEvent) (bool, error) {
+								require.Equal(t, eur(15), e.Amount)
+								return true, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerSourceGameRollback, e.Source)
+								return user, nil
+							},
+						}, nil
+					},
+					GameEventGetStub: func(ctx context.Context, g string, r string, et types.GameEventType) (types.GameEvent, error) {
+						require.Equal(t, "slot-1", g)
+						require.Equal(t, types.GameEventBet, et)
+						return bonusBet, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{
+					RevertWagerStub: func(ctx context.Context, u uuid.UUID, b uuid.UUID) error {
+						require.Equal(t, bonusBet.ID, b)
+						return nil
+					},
+				},
+				loyalty: &fakes.FakeLoyaltyProvider{
+					ReversePointsStub: func(ctx context.Context, u uuid.UUID, b uuid.UUID) (types.PointsEntry, error) {
+						require.Equal(t, bonusBet.ID, b)
+						return types.PointsEntry{}, nil
+					},
+				},
+			},
+			args: args{
+				event: event(types.GameEventRollback, eur(0)),
+			},
+			expectedCreated: true,
+			expectedReverts: 1,
+		},
+		{
+			name: "it should fail rollback of a round with a win",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventGetStub: func(ctx context.Context, g string, r string, et types.GameEventType) (types.GameEvent, error) {
+								return bonusBet, nil
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventRollback, eur(0)),
+			},
+			expectedError: types.ErrGameRoundSettled,
+		},
+		{
+			name: "it should return a resent bet after its balance was spent",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(0), BonusBalance: eur(0)}, nil
+							},
+							GameEventGetByEventIDStub: stored(bonusBet),
+							GameEventCreateStub: func(ctx context.Context, e types.GameEvent) (bool, error) {
+								t.Fatal("resent event should not be stored")
+								return false, nil
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventBet, eur(15)),
+			},
+		},
+		{
+			name: "it should return a resent rollback after the round was won",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventGetByEventIDStub: stored(event(types.GameEventRollback, eur(15))),
+							GameEventGetStub: func(ctx context.Context, g string, r string, et types.GameEventType) (types.GameEvent, error) {
+								return bonusBet, nil
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventRollback, eur(0)),
+			},
+		},
+		{
+			name: "it should not apply an event stored concurrently",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventCreateStub: func(ctx context.Context, e types.GameEvent) (bool, error) {
+								return false, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								t.Fatal("duplicate event should not update the balance")
+								return types.User{}, nil
+							},
+						}, nil
+					},
+					GameEventGetByEventIDStub: func(ctx context.Context, g string, e string) (types.GameEvent, error) {
+						require.Equal(t, "slot-1", g)
+						require.Equal(t, "event-1", e)
+						return bonusBet, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventBet, eur(15)),
+			},
+		},
+		{
+			name: "it should fail a second bet of a round",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventCreateStub: func(ctx context.Context, e types.GameEvent) (bool, error) {
+								return false, &pgconn.PgError{Code: "23505"}
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventBet, eur(15)),
+			},
+			expectedError: types.ErrGameRoundDuplicate,
+		},
+		{
+			name: "it should fail insufficient balance",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventBet, eur(31)),
+			},
+			expectedError: types.ErrInsufficientBalance,
+		},
+		{
+			name: "it should fail win without bet",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GameEventGetByEventIDStub: notStored,
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return user, nil
+							},
+							GameEventGetStub: func(ctx context.Context, g string, r string, et types.GameEventType) (types.GameEvent, error) {
+								return types.GameEvent{}, pgx.ErrNoRows
+							},
+						}, nil
+					},
+				},
+				userPromotions: &fakes.FakeUserPromotionProvider{},
+				loyalty:        &fakes.FakeLoyaltyProvider{},
+			},
+			args: args{
+				event: event(types.GameEventWin, eur(5)),
+			},
+			expectedError: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tournaments := &fakes.FakeTournamentProvider{}
+			c := games.New(tt.fields.persistentStore, tt.fields.pubsub, tt.fields.userPromotions, tt.fields.loyalty, tournaments)
+			_, created, err := c.IngestEvent(context.Background(), tt.args.event)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedCreated, created)
+			require.Equal(t, tt.expectedWagers, tt.fields.userPromotions.RecordWagerCallCount())
+			require.Equal(t, tt.expectedWagers, tt.fields.loyalty.AccruePointsCallCount())
+			require.Equal(t, tt.expectedReverts, tt.fields.userPromotions.RevertWagerCallCount())
+			require.Equal(t, tt.expectedReverts, tt.fields.loyalty.ReversePointsCallCount())
+			if created {
+				require.Equal(t, 1, tournaments.RecordEventCallCount())
+			} else {
+				require.Zero(t, tournaments.RecordEventCallCount())
+			}
+		})
+	}
+}
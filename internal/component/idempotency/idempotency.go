@@ -0,0 +1,70 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+)
+
+const maxKeyLength = 255
+
+type IdempotencyProvider interface {
+	Begin(ctx context.Context, key types.IdempotencyKey) (types.IdempotencyKey, error)
+	Complete(ctx context.Context, key types.IdempotencyKey) error
+	Release(ctx context.Context, userID uuid.UUID, key string) error
+}
+
+type component struct {
+	persistent store.Persistent
+}
+
+var _ IdempotencyProvider = (*component)(nil)
+
+func New(persistent store.Persistent) *component {
+	return &component{persistent: persistent}
+}
+
+// Begin reserves the key for a new request. If the key was already used the
+// stored key is returned instead, callers replay it when it is completed.
+func (c *component) Begin(ctx context.Context, key types.IdempotencyKey) (types.IdempotencyKey, error) {
+	if key.Key == "" || len(key.Key) > maxKeyLength {
+		return types.IdempotencyKey{}, types.ErrIdempotencyKeyInvalid
+	}
+
+	key.Created = time.Now()
+
+	created, err := c.persistent.IdempotencyKeyCreate(ctx, key)
+	if err != nil {
+		return types.IdempotencyKey{}, err
+	}
+	if created {
+		return key, nil
+	}
+
+	existing, err := c.persistent.IdempotencyKeyGet(ctx, key.UserID, key.Key)
+	if err != nil {
+		return types.IdempotencyKey{}, err
+	}
+
+	if existing.RequestHash != key.RequestHash {
+		return types.IdempotencyKey{}, types.ErrIdempotencyKeyReused
+	}
+
+	if !existing.IsCompleted() {
+		return types.IdempotencyKey{}, types.ErrIdempotencyKeyInUse
+	}
+
+	return existing, nil
+}
+
+func (c *component) Complete(ctx context.Context, key types.IdempotencyKey) error {
+	return c.persistent.IdempotencyKeyComplete(ctx, key)
+}
+
+func (c *component) Release(ctx context.Context, userID uuid.UUID, key string) error {
+	return c.persistent.IdempotencyKeyDelete(ctx, userID, key)
+}
@@ -0,0 +1,135 @@
+package idempotency_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/idempotency"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fields struct {
+	persistentStore store.Persistent
+}
+
+func TestBegin(t *testing.T) {
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	type args struct {
+		key types.IdempotencyKey
+	}
+
+	key := types.IdempotencyKey{
+		UserID:      userID,
+		Key:         "retry-1",
+		RequestHash: "hash",
+	}
+
+	completed := key
+	completed.StatusCode = 200
+	completed.Response = []byte(`"OK"`)
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		expectedError  error
+		expectedOutput types.IdempotencyKey
+	}{
+		{
+			name: "it should reserve a new key",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					IdempotencyKeyCreateStub: func(ctx context.Context, k types.IdempotencyKey) (bool, error) {
+						require.False(t, k.Created.IsZero())
+						return true, nil
+					},
+				},
+			},
+			args: args{
+				key: key,
+			},
+			expectedOutput: key,
+		},
+		{
+			name: "it should return the completed key",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					IdempotencyKeyCreateStub: func(ctx context.Context, k types.IdempotencyKey) (bool, error) {
+						return false, nil
+					},
+					IdempotencyKeyGetStub: func(ctx context.Context, u uuid.UUID, k string) (types.IdempotencyKey, error) {
+						return completed, nil
+					},
+				},
+			},
+			args: args{
+				key: key,
+			},
+			expectedOutput: completed,
+		},
+		{
+			name: "it should fail key in use",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					IdempotencyKeyCreateStub: func(ctx context.Context, k types.IdempotencyKey) (bool, error) {
+						return false, nil
+					},
+					IdempotencyKeyGetStub: func(ctx context.Context, u uuid.UUID, k string) (types.IdempotencyKey, error) {
+						return key, nil
+					},
+				},
+			},
+			args: args{
+				key: key,
+			},
+			expectedError: types.ErrIdempotencyKeyInUse,
+		},
+		{
+			name: "it should fail key reused for a different request",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					IdempotencyKeyCreateStub: func(ctx context.Context, k types.IdempotencyKey) (bool, error) {
+						return false, nil
+					},
+					IdempotencyKeyGetStub: func(ctx context.Context, u uuid.UUID, k string) (types.IdempotencyKey, error) {
+						other := completed
+						other.RequestHash = "other"
+						return other, nil
+					},
+				},
+			},
+			args: args{
+				key: key,
+			},
+			expectedError: types.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "it should fail empty key",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+			},
+			args: args{
+				key: types.IdempotencyKey{UserID: userID},
+			},
+			expectedError: types.ErrIdempotencyKeyInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := idempotency.New(tt.fields.persistentStore)
+			key, err := c.Begin(context.Background(), tt.args.key)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedOutput.StatusCode, key.StatusCode)
+			require.Equal(t, tt.expectedOutput.Response, key.Response)
+			require.Equal(t, tt.expectedOutput.Key, key.Key)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type LoyaltyProvider interface {
+	AccruePoints(ctx context.Context, event types.GameEvent) (types.PointsEntry, error)
+	ReversePoints(ctx context.Context, userID uuid.UUID, betID uuid.UUID) (types.PointsEntry, error)
+	GetPointsRates(ctx context.Context) ([]types.PointsRate, error)
+	SetPointsRate(ctx context.Context, rate types.PointsRate) (types.PointsRate, error)
+	DeletePointsRate(ctx context.Context, category string) error
+	GetTiers(ctx context.Context) ([]types.Tier, error)
+	CreateTier(ctx context.Context, tier types.Tier) (types.Tier, error)
+	UpdateTier(ctx context.Context, tier types.Tier) (types.Tier, error)
+	DeleteTier(ctx context.Context, id uuid.UUID) error
+	UpdateUserTiers(ctx context.Context, userID uuid.NullUUID) error
+	EvaluateTiers(ctx context.Context) error
+	GetTierHistory(ctx context.Context, userID uuid.UUID) ([]types.TierHistoryEntry, error)
+	ExpirePoints(ctx context.Context) error
+	GetPointsLiability(ctx context.Context, from time.Time, to time.Time) ([]types.PointsLiability, error)
+}
+
+const expireBatchSize = 100
+
+type component struct {
+	persistent    store.Persistent
+	pubsub        store.PubSub
+	qualification types.TierQualification
+	expiry        types.PointsExpiry
+}
+
+var _ LoyaltyProvider = (*component)(nil)
+
+func New(persistent store.Persistent, pubsub store.PubSub, qualification types.TierQualification, expiry types.PointsExpiry) *component {
+	return &component{
+		persistent:    persistent,
+		pubsub:        pubsub,
+		qualification: qualification,
+		expiry:        expiry,
+	}
+}
+
+// AccruePoints books the points earned by a bet at the rate of its game
+// category, falling back to the default rate. Bets without a rate earn
+// nothing and an empty entry is returned. Accruing a bet twice books it once.
+func (c *component) AccruePoints(ctx context.Context, event types.GameEvent) (types.PointsEntry, error) {
+	if event.Type != types.GameEventBet || !event.Amount.IsPositive() {
+		return types.PointsEntry{}, nil
+	}
+
+	rate, err := c.pointsRate(ctx, event.GameCategory)
+	if store.IsErrNotFound(err) {
+		return types.PointsEntry{}, nil
+	}
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+
+	points := rate.PointsFor(event.Amount)
+	if !points.IsPositive() {
+		return types.PointsEntry{}, nil
+	}
+
+	entry := types.PointsEntry{
+		ID:          uuid.New(),
+		UserID:      event.UserID,
+		Points:      points,
+		Source:      types.PointsSourceWager,
+		ReferenceID: uuid.NullUUID{UUID: event.ID, Valid: true},
+		Created:     time.Now(),
+	}
+	if c.expiry.Validity > 0 {
+		expires := entry.Created.Add(c.expiry.Validity)
+		entry.Expires = &expires
+	}
+
+	created, err := c.persistent.PointsEntryCreate(ctx, entry)
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+
+	if !created {
+		return types.PointsEntry{}, nil
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, entry.UserID.String()), entry)
+
+	err = c.UpdateUserTiers(ctx, uuid.NullUUID{UUID: entry.UserID, Valid: true})
+	if err != nil {
+		return entry, err
+	}
+
+	return entry, nil
+}
+
+// ReversePoints books back the points earned by the bet with the event ID
+// betID after the bet was rolled back, and evaluates the player's tier
+// again. Points already redeemed cannot be taken back, so at most the
+// player's current points are reversed, but the bet stops counting towards
+// their tier either way. Bets that earned nothing return an empty entry and
+// reversing a bet twice books it once.
+func (c *component) ReversePoints(ctx context.Context, userID uuid.UUID, betID uuid.UUID) (types.PointsEntry, error) {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+	defer db.RollbackTx(ctx)
+
+	earned, err := db.PointsEntryGet(ctx, types.PointsSourceWager, betID)
+	if store.IsErrNotFound(err) {
+		return types.PointsEntry{}, nil
+	}
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+
+	if earned.UserID != userID {
+		return types.PointsEntry{}, types.ErrInvalidGameEvent
+	}
+
+	user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: userID, Valid: true}, ForUpdate: true})
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+
+	points := decimal.Min(earned.Points, decimal.Max(user.LoyaltyPoints, decimal.Zero))
+
+	entry := types.PointsEntry{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Points:      points.Neg(),
+		Source:      types.PointsSourceRollback,
+		ReferenceID: uuid.NullUUID{UUID: betID, Valid: true},
+		Created:     time.Now(),
+	}
+
+	created, err := db.PointsEntryCreate(ctx, entry)
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+
+	if !created {
+		return types.PointsEntry{}, nil
+	}
+
+	if points.IsPositive() {
+		err = db.PointsLotsConsume(ctx, userID, points)
+		if err != nil {
+			return types.PointsEntry{}, err
+		}
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.PointsEntry{}, err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userID.String()), entry)
+
+	err = c.evaluateTiers(ctx, uuid.NullUUID{UUID: userID, Valid: true})
+	if err != nil {
+		return entry, err
+	}
+
+	return entry, nil
+}
+
+func (c *component) pointsRate(ctx context.Context, category string) (types.PointsRate, error) {
+	if category != "" {
+		rate, err := c.persistent.PointsRateGet(ctx, category)
+		if !store.IsErrNotFound(err) {
+			return rate, err
+		}
+	}
+
+	return c.persistent.PointsRateGet(ctx, types.DefaultGameCategory)
+}
+
+func (c *component) GetPointsRates(ctx context.Context) ([]types.PointsRate, error) {
+	return c.persistent.GetPointsRates(ctx)
+}
+
+func (c *component) SetPointsRate(ctx context.Context, rate types.PointsRate) (types.PointsRate, error) {
+	if rate.Rate.IsNegative() {
+		return types.PointsRate{}, types.ErrInvalidPointsRate
+	}
+
+	return c.persistent.PointsRateUpsert(ctx, rate)
+}
+
+func (c *component) DeletePointsRate(ctx context.Context, category string) error {
+	return c.persistent.PointsRateDelete(ctx, category)
+}
+
+func (c *component) GetTiers(ctx context.Context) ([]types.Tier, error) {
+	return c.persistent.GetTiers(ctx)
+}
+
+func (c *component) CreateTier(ctx context.Context, tier types.Tier) (types.Tier, error) {
+	tier.ID = uuid.New()
+
+	if tier.MinPoints.IsNegative() {
+		return types.Tier{}, types.ErrInvalidTierThreshold
+	}
+
+	if tier.Benefits == nil {
+		tier.Benefits = []string{}
+	}
+
+	tier, err := c.persistent.TierCreate(ctx, tier)
+	if store.IsErrConflict(err) {
+		return types.Tier{}, types.ErrTierExists
+	}
+
+	return tier, err
+}
+
+func (c *component) UpdateTier(ctx context.Context, tier types.Tier) (types.Tier, error) {
+	if tier.MinPoints.IsNegative() {
+		return types.Tier{}, types.ErrInvalidTierThreshold
+	}
+
+	if tier.Benefits == nil {
+		tier.Benefits = []string{}
+	}
+
+	tier, err := c.persistent.TierUpdate(ctx, tier)
+	if store.IsErrConflict(err) {
+		return types.Tier{}, types.ErrTierExists
+	}
+
+	return tier, err
+}
+
+func (c *component) DeleteTier(ctx context.Context, id uuid.UUID) error {
+	return c.persistent.TierDelete(ctx, id)
+}
+
+// UpdateUserTiers moves the given user, or all users when userID is not set,
+// up to the tier the points earned in the qualification window qualify for
+// and notifies every player whose tier changed. Players are never moved
+// down here, see EvaluateTiers.
+func (c *component) UpdateUserTiers(ctx context.Context, userID uuid.NullUUID) error {
+	changes, err := c.persistent.UserTiersQualify(ctx, userID, c.qualification.Since(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	c.notifyTierChanges(ctx, changes)
+
+	return nil
+}
+
+// EvaluateTiers requalifies all players. Players who no longer earn enough
+// points for their tier are warned and keep it for the grace period, after
+// which they are demoted to the tier they qualify for.
+func (c *component) EvaluateTiers(ctx context.Context) error {
+	return c.evaluateTiers(ctx, uuid.NullUUID{})
+}
+
+// evaluateTiers requalifies the given user, or all users when userID is not
+// set.
+func (c *component) evaluateTiers(ctx context.Context, userID uuid.NullUUID) error {
+	now := time.Now()
+	since := c.qualification.Since(now)
+
+	err := c.UpdateUserTiers(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	demotions, err := c.persistent.UserTiersDemote(ctx, userID, since, now)
+	if err != nil {
+		return err
+	}
+
+	c.notifyTierChanges(ctx, demotions)
+
+	warnings, err := c.persistent.UserTiersWarn(ctx, userID, since, now.Add(c.qualification.GracePeriod))
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, warning.UserID.String()), warning)
+	}
+
+	return nil
+}
+
+func (c *component) notifyTierChanges(ctx context.Context, changes []types.TierChange) {
+	for _, change := range changes {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, change.UserID.String()), change)
+	}
+}
+
+func (c *component) GetTierHistory(ctx context.Context, userID uuid.UUID) ([]types.TierHistoryEntry, error) {
+	return c.persistent.GetTierHistory(ctx, userID)
+}
+
+// ExpirePoints warns players about points expiring within the warning period
+// and books out the points that expired. Each lot is warned and expired
+// once, so replicas running the job concurrently notify a player once.
+func (c *component) ExpirePoints(ctx context.Context) error {
+	now := time.Now()
+
+	if c.expiry.Warning > 0 {
+		notices, err := c.persistent.PointsLotsWarn(ctx, now, now.Add(c.expiry.Warning))
+		if err != nil {
+			return err
+		}
+		c.notifyPointsExpiry(ctx, notices)
+	}
+
+	for {
+		notices, err := c.persistent.PointsLotsExpire(ctx, now, expireBatchSize)
+		if err != nil {
+			return err
+		}
+		c.notifyPointsExpiry(ctx, notices)
+
+		if len(notices) == 0 {
+			return nil
+		}
+	}
+}
+
+func (c *component) notifyPointsExpiry(ctx context.Context, notices []types.PointsExpiryNotice) {
+	for _, notice := range notices {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, notice.UserID.String()), notice)
+	}
+}
+
+func (c *component) GetPointsLiability(ctx context.Context, from time.Time, to time.Time) ([]types.PointsLiability, error) {
+	if !from.Before(to) {
+		return nil, types.ErrStartAfterEndDate
+	}
+
+	return c.persistent.GetPointsLiability(ctx, from, to)
+}
@@ -0,0 +1,523 @@
+package loyalty_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/loyalty"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+var qualification = types.TierQualification{
+	Period:      types.TierQualificationRolling,
+	Days:        90,
+	GracePeriod: 14 * 24 * time.Hour,
+}
+
+var expiry = types.PointsExpiry{
+	Validity: 365 * 24 * time.Hour,
+	Warning:  7 * 24 * time.Hour,
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	pubsub          *fakes.FakePubSub
+}
+
+func TestAccruePoints(t *testing.T) {
+	type args struct {
+		event types.GameEvent
+	}
+
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	bet := types.GameEvent{
+		ID:           uuid.New(),
+		UserID:       userID,
+		GameID:       "slot-1",
+		GameCategory: "slots",
+		RoundID:      "round-1",
+		Type:         types.GameEventBet,
+		Amount:       eur(15),
+	}
+
+	win := bet
+	win.Type = types.GameEventWin
+
+	rates := func(rates map[string]string) func(context.Context, string) (types.PointsRate, error) {
+		return func(ctx context.Context, category string) (types.PointsRate, error) {
+			rate, ok := rates[category]
+			if !ok {
+				return types.PointsRate{}, pgx.ErrNoRows
+			}
+			return types.PointsRate{Category: category, Rate: decimal.RequireFromString(rate)}, nil
+		}
+	}
+
+	tests := []struct {
+		name             string
+		fields           fields
+		args             args
+		expectedPoints   string
+		expectedNotified int
+		expectedError    error
+	}{
+		{
+			name: "it should accrue points at the category rate",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsRateGetStub: rates(map[string]string{"slots": "0.5", types.DefaultGameCategory: "0.1"}),
+					PointsEntryCreateStub: func(ctx context.Context, e types.PointsEntry) (bool, error) {
+						require.Equal(t, types.PointsSourceWager, e.Source)
+						require.Equal(t, bet.ID, e.ReferenceID.UUID)
+						require.NotNil(t, e.Expires)
+						require.Equal(t, e.Created.Add(expiry.Validity), *e.Expires)
+						return true, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				event: bet,
+			},
+			expectedPoints:   "7.5",
+			expectedNotified: 1,
+		},
+		{
+			name: "it should fall back to the default rate",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsRateGetStub: rates(map[string]string{types.DefaultGameCategory: "0.1"}),
+					PointsEntryCreateStub: func(ctx context.Context, e types.PointsEntry) (bool, error) {
+						return true, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				event: bet,
+			},
+			expectedPoints:   "1.5",
+			expectedNotified: 1,
+		},
+		{
+			name: "it should not accrue points without a rate",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsRateGetStub: rates(map[string]string{}),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				event: bet,
+			},
+			expectedPoints: "0",
+		},
+		{
+			name: "it should not accrue points for wins",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				event: win,
+			},
+			expectedPoints: "0",
+		},
+		{
+			name: "it should not notify about an already accrued bet",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsRateGetStub: rates(map[string]string{"slots": "0.5"}),
+					PointsEntryCreateStub: func(ctx context.Context, e types.PointsEntry) (bool, error) {
+						return false, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				event: bet,
+			},
+			expectedPoints: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := loyalty.New(tt.fields.persistentStore, tt.fields.pubsub, qualification, expiry)
+			entry, err := c.AccruePoints(context.Background(), tt.args.event)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedPoints, entry.Points.String())
+			require.Equal(t, tt.expectedNotified, tt.fields.pubsub.PublishCallCount())
+			require.Equal(t, tt.expectedNotified, tt.fields.persistentStore.(*fakes.FakePersistent).UserTiersQualifyCallCount())
+		})
+	}
+}
+
+func TestReversePoints(t *testing.T) {
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+	betID, err := uuid.Parse("8c3524e5-a297-42aa-85d3-faca261cbfb8")
+	require.NoError(t, err)
+
+	earned := func(ctx context.Context, source types.PointsSource, referenceID uuid.UUID) (types.PointsEntry, error) {
+		require.Equal(t, types.PointsSourceWager, source)
+		require.Equal(t, betID, referenceID)
+		return types.PointsEntry{UserID: userID, Points: decimal.NewFromInt(30), Source: source}, nil
+	}
+
+	tests := []struct {
+		name             string
+		loyaltyPoints    int64
+		earned           func(context.Context, types.PointsSource, uuid.UUID) (types.PointsEntry, error)
+		created          bool
+		expectedPoints   string
+		expectedConsumed string
+		expectedNotified int
+		expectedError    error
+	}{
+		{
+			name:             "it should reverse the points of the bet",
+			loyaltyPoints:    100,
+			earned:           earned,
+			created:          true,
+			expectedPoints:   "-30",
+			expectedConsumed: "30",
+			expectedNotified: 1,
+		},
+		{
+			name:             "it should reverse at most the points left",
+			loyaltyPoints:    10,
+			earned:           earned,
+			created:          true,
+			expectedPoints:   "-10",
+			expectedConsumed: "10",
+			expectedNotified: 1,
+		},
+		{
+			name:             "it should record the reversal of redeemed points",
+			loyaltyPoints:    0,
+			earned:           earned,
+			created:          true,
+			expectedPoints:   "0",
+			expectedNotified: 1,
+		},
+		{
+			name:           "it should not reverse a bet twice",
+			loyaltyPoints:  100,
+			earned:         earned,
+			created:        false,
+			expectedPoints: "0",
+		},
+		{
+			name: "it should skip bets that earned nothing",
+			earned: func(ctx context.Context, source types.PointsSource, referenceID uuid.UUID) (types.PointsEntry, error) {
+				return types.PointsEntry{}, pgx.ErrNoRows
+			},
+			expectedPoints: "0",
+		},
+		{
+			name: "it should fail for the bet of another player",
+			earned: func(ctx context.Context, source types.PointsSource, referenceID uuid.UUID) (types.PointsEntry, error) {
+				return types.PointsEntry{UserID: uuid.New(), Points: decimal.NewFromInt(30)}, nil
+			},
+			expectedPoints: "0",
+			expectedError:  types.ErrInvalidGameEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var consumed decimal.Decimal
+			tx := &fakes.FakePersistent{
+				PointsEntryGetStub: tt.earned,
+				UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+					require.True(t, uf.ForUpdate)
+					return types.User{ID: userID, LoyaltyPoints: decimal.NewFromInt(tt.loyaltyPoints)}, nil
+				},
+				PointsEntryCreateStub: func(ctx context.Context, e types.PointsEntry) (bool, error) {
+					require.Equal(t, types.PointsSourceRollback, e.Source)
+					require.Equal(t, uuid.NullUUID{UUID: betID, Valid: true}, e.ReferenceID)
+					return tt.created, nil
+				},
+				PointsLotsConsumeStub: func(ctx context.Context, u uuid.UUID, points decimal.Decimal) error {
+					consumed = points
+					return nil
+				},
+			}
+			persistent := &fakes.FakePersistent{
+				WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+					return tx, nil
+				},
+			}
+			pubsub := &fakes.FakePubSub{}
+
+			c := loyalty.New(persistent, pubsub, qualification, expiry)
+			entry, err := c.ReversePoints(context.Background(), userID, betID)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedPoints, entry.Points.String())
+			require.Equal(t, tt.expectedNotified, pubsub.PublishCallCount())
+			if tt.expectedConsumed != "" {
+				require.Equal(t, tt.expectedConsumed, consumed.String())
+			} else {
+				require.Zero(t, tx.PointsLotsConsumeCallCount())
+			}
+			// the player's tier is evaluated again after a reversal
+			require.Equal(t, tt.expectedNotified, persistent.UserTiersQualifyCallCount())
+			require.Equal(t, tt.expectedNotified, persistent.UserTiersWarnCallCount())
+			if tt.expectedNotified > 0 {
+				_, id, _, _ := persistent.UserTiersWarnArgsForCall(0)
+				require.Equal(t, uuid.NullUUID{UUID: userID, Valid: true}, id)
+			}
+		})
+	}
+}
+
+func TestSetPointsRate(t *testing.T) {
+	tests := []struct {
+		name          string
+		rate          types.PointsRate
+		expectedCalls int
+		expectedError error
+	}{
+		{
+			name:          "it should store the rate",
+			rate:          types.PointsRate{Category: "slots", Rate: decimal.RequireFromString("0.5")},
+			expectedCalls: 1,
+		},
+		{
+			name:          "it should fail negative rate",
+			rate:          types.PointsRate{Category: "slots", Rate: decimal.NewFromInt(-1)},
+			expectedError: types.ErrInvalidPointsRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			persistent := &fakes.FakePersistent{}
+			c := loyalty.New(persistent, &fakes.FakePubSub{}, qualification, expiry)
+			_, err := c.SetPointsRate(context.Background(), tt.rate)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedCalls, persistent.PointsRateUpsertCallCount())
+		})
+	}
+}
+
+func TestEvaluateTiers(t *testing.T) {
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	gold := &types.Tier{ID: uuid.New(), Name: "Gold", MinPoints: decimal.NewFromInt(1000)}
+	silver := &types.Tier{ID: uuid.New(), Name: "Silver", MinPoints: decimal.NewFromInt(100)}
+
+	tests := []struct {
+		name             string
+		fields           fields
+		expectedNotified int
+		expectedError    error
+	}{
+		{
+			name: "it should notify upgraded, demoted and warned players",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserTiersQualifyStub: func(ctx context.Context, id uuid.NullUUID, since time.Time) ([]types.TierChange, error) {
+						require.False(t, id.Valid)
+						require.WithinDuration(t, time.Now().AddDate(0, 0, -90), since, time.Minute)
+						return []types.TierChange{{UserID: userID, Tier: gold, Reason: types.TierChangeQualified}}, nil
+					},
+					UserTiersDemoteStub: func(ctx context.Context, id uuid.NullUUID, since time.Time, now time.Time) ([]types.TierChange, error) {
+						return []types.TierChange{{UserID: uuid.New(), Reason: types.TierChangeDemoted}}, nil
+					},
+					UserTiersWarnStub: func(ctx context.Context, id uuid.NullUUID, since time.Time, demotionDate time.Time) ([]types.TierWarning, error) {
+						require.WithinDuration(t, time.Now().Add(qualification.GracePeriod), demotionDate, time.Minute)
+						return []types.TierWarning{{UserID: uuid.New(), Tier: gold, QualifiedTier: silver, DemotionDate: demotionDate}}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedNotified: 3,
+		},
+		{
+			name: "it should not notify without changes",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+		},
+		{
+			name: "it should stop when requalification fails",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserTiersQualifyStub: func(ctx context.Context, id uuid.NullUUID, since time.Time) ([]types.TierChange, error) {
+						return nil, pgx.ErrTxClosed
+					},
+					UserTiersDemoteStub: func(ctx context.Context, id uuid.NullUUID, since time.Time, now time.Time) ([]types.TierChange, error) {
+						t.Fatal("players should not be demoted after a failed requalification")
+						return nil, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedError: pgx.ErrTxClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := loyalty.New(tt.fields.persistentStore, tt.fields.pubsub, qualification, expiry)
+			err := c.EvaluateTiers(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedNotified, tt.fields.pubsub.PublishCallCount())
+		})
+	}
+}
+
+func TestCreateTier(t *testing.T) {
+	tests := []struct {
+		name          string
+		tier          types.Tier
+		createError   error
+		expectedError error
+	}{
+		{
+			name: "it should create the tier",
+			tier: types.Tier{Name: "Silver", MinPoints: decimal.NewFromInt(100)},
+		},
+		{
+			name:          "it should fail negative threshold",
+			tier:          types.Tier{Name: "Silver", MinPoints: decimal.NewFromInt(-1)},
+			expectedError: types.ErrInvalidTierThreshold,
+		},
+		{
+			name:          "it should fail existing tier",
+			tier:          types.Tier{Name: "Silver", MinPoints: decimal.NewFromInt(100)},
+			createError:   &pgconn.PgError{Code: "23505"},
+			expectedError: types.ErrTierExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			persistent := &fakes.FakePersistent{
+				TierCreateStub: func(ctx context.Context, tier types.Tier) (types.Tier, error) {
+					require.NotNil(t, tier.Benefits)
+					return tier, tt.createError
+				},
+			}
+			c := loyalty.New(persistent, &fakes.FakePubSub{}, qualification, expiry)
+			_, err := c.CreateTier(context.Background(), tt.tier)
+
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestExpirePoints(t *testing.T) {
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	notice := types.PointsExpiryNotice{
+		UserID:  userID,
+		Points:  decimal.NewFromInt(40),
+		Expires: time.Now(),
+	}
+
+	// expired returns the notices on the first call and nothing after, as
+	// the store does once the expired lots are booked out
+	expired := func(notices ...types.PointsExpiryNotice) func(context.Context, time.Time, int) ([]types.PointsExpiryNotice, error) {
+		calls := 0
+		return func(ctx context.Context, now time.Time, limit int) ([]types.PointsExpiryNotice, error) {
+			calls++
+			if calls > 1 {
+				return nil, nil
+			}
+			return notices, nil
+		}
+	}
+
+	tests := []struct {
+		name             string
+		fields           fields
+		expiry           types.PointsExpiry
+		expectedWarned   int
+		expectedExpired  int
+		expectedNotified int
+		expectedError    error
+	}{
+		{
+			name: "it should warn about expiring points and expire stale points",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsLotsWarnStub: func(ctx context.Context, now time.Time, before time.Time) ([]types.PointsExpiryNotice, error) {
+						require.Equal(t, now.Add(expiry.Warning), before)
+						return []types.PointsExpiryNotice{notice}, nil
+					},
+					PointsLotsExpireStub: expired(notice, notice),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expiry:           expiry,
+			expectedWarned:   1,
+			expectedExpired:  2,
+			expectedNotified: 3,
+		},
+		{
+			name: "it should not warn without a warning period",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsLotsExpireStub: expired(notice),
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expiry:           types.PointsExpiry{Validity: expiry.Validity},
+			expectedWarned:   0,
+			expectedExpired:  2,
+			expectedNotified: 1,
+		},
+		{
+			name: "it should return the error of expiring points",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PointsLotsExpireStub: func(ctx context.Context, now time.Time, limit int) ([]types.PointsExpiryNotice, error) {
+						return nil, pgx.ErrTxClosed
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expiry:          expiry,
+			expectedWarned:  1,
+			expectedExpired: 1,
+			expectedError:   pgx.ErrTxClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := loyalty.New(tt.fields.persistentStore, tt.fields.pubsub, qualification, tt.expiry)
+			err := c.ExpirePoints(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			require.Equal(t, tt.expectedWarned, persistent.PointsLotsWarnCallCount())
+			require.Equal(t, tt.expectedExpired, persistent.PointsLotsExpireCallCount())
+			require.Equal(t, tt.expectedNotified, tt.fields.pubsub.PublishCallCount())
+		})
+	}
+}
@@ -6,13 +6,14 @@ import (
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 
 	"github.com/coder/websocket"
 	"github.com/google/uuid"
 )
 
 type NotificationProvider interface {
-	ListenToNotifications(ctx context.Context, conn *websocket.Conn, userID uuid.UUID) error
+	ListenToNotifications(ctx context.Context, conn *websocket.Conn, userID uuid.UUID, role types.UserType) error
 }
 
 type component struct {
@@ -29,10 +30,19 @@ func New(persistent store.Persistent, pubsub store.PubSub) *component {
 	}
 }
 
-func (c *component) ListenToNotifications(ctx context.Context, conn *websocket.Conn, userID uuid.UUID) error {
+// ListenToNotifications writes the notifications of the user to conn. Staff
+// also receive the notifications meant for all staff, like budget alerts.
+func (c *component) ListenToNotifications(ctx context.Context, conn *websocket.Conn, userID uuid.UUID, role types.UserType) error {
 	sub := c.pubsub.Subscribe(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userID))
 	defer sub.Close()
 
+	if role >= types.Staff {
+		err := sub.Subscribe(ctx, redis_pub_sub.StaffNotificationsChannel)
+		if err != nil {
+			return err
+		}
+	}
+
 	ch := sub.Channel()
 
 	for msg := range ch {
@@ -0,0 +1,233 @@
+package promotioncodes
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+)
+
+type PromotionCodeProvider interface {
+	CreatePromotionCodes(ctx context.Context, promotionID uuid.UUID, batch types.PromotionCodeBatch) ([]types.PromotionCode, error)
+	GetPromotionCodes(ctx context.Context, promotionID uuid.UUID) ([]types.PromotionCode, error)
+	RedeemPromotionCode(ctx context.Context, userID uuid.UUID, code string) (types.UserPromotion, error)
+}
+
+const (
+	// codeAlphabet leaves out characters that are easily mistaken for each
+	// other. Its 32 characters map evenly onto random bytes.
+	codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	codeLength   = 12
+)
+
+type component struct {
+	persistent store.Persistent
+	pubsub     store.PubSub
+	limiter    store.RateLimiter
+	rateLimit  types.RateLimit
+}
+
+var _ PromotionCodeProvider = (*component)(nil)
+
+// New returns the promotion codes component. Players can try rateLimit
+// redemptions per window, whether the codes are valid or not.
+func New(persistent store.Persistent, pubsub store.PubSub, limiter store.RateLimiter, rateLimit types.RateLimit) *component {
+	return &component{
+		persistent: persistent,
+		pubsub:     pubsub,
+		limiter:    limiter,
+		rateLimit:  rateLimit,
+	}
+}
+
+// CreatePromotionCodes creates the codes of the batch for the promotion.
+// Single use codes are generated, shared codes are stored as given in upper
+// case.
+func (c *component) CreatePromotionCodes(ctx context.Context, promotionID uuid.UUID, batch types.PromotionCodeBatch) ([]types.PromotionCode, error) {
+	promotion, err := c.persistent.PromotionGetByID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if promotion.Archived != nil {
+		return nil, types.ErrPromotionArchived
+	}
+
+	if !promotion.IsAssignable() {
+		return nil, types.ErrPromotionNotAssignable
+	}
+
+	now := time.Now()
+	if batch.Expires != nil && batch.Expires.Before(now) {
+		return nil, types.ErrInvalidPromotionCodes
+	}
+
+	if batch.ValidityDays == 0 {
+		batch.ValidityDays = types.DefaultPromotionCodeValidityDays
+	}
+
+	var values []string
+	switch batch.Kind {
+	case types.PromotionCodeSingleUse:
+		if batch.Count < 1 || batch.Code != "" {
+			return nil, types.ErrInvalidPromotionCodes
+		}
+		one := 1
+		batch.MaxRedemptions = &one
+
+		for i := 0; i < batch.Count; i++ {
+			value, err := newCode()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+	case types.PromotionCodeShared:
+		if batch.Code == "" || batch.Count > 1 {
+			return nil, types.ErrInvalidPromotionCodes
+		}
+		values = append(values, normalizeCode(batch.Code))
+	default:
+		return nil, types.ErrInvalidPromotionCodes
+	}
+
+	codes := make([]types.PromotionCode, 0, len(values))
+	for _, value := range values {
+		codes = append(codes, types.PromotionCode{
+			ID:             uuid.New(),
+			PromotionID:    promotionID,
+			Code:           value,
+			Kind:           batch.Kind,
+			MaxRedemptions: batch.MaxRedemptions,
+			ValidityDays:   batch.ValidityDays,
+			Expires:        batch.Expires,
+			Created:        now,
+		})
+	}
+
+	err = c.persistent.PromotionCodesCreate(ctx, codes)
+	if store.IsErrConflict(err) {
+		return nil, types.ErrPromotionCodeExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (c *component) GetPromotionCodes(ctx context.Context, promotionID uuid.UUID) ([]types.PromotionCode, error) {
+	return c.persistent.GetPromotionCodes(ctx, promotionID)
+}
+
+// RedeemPromotionCode assigns the promotion of the code to the user. Every
+// attempt counts towards the user's rate limit, so codes cannot be guessed.
+func (c *component) RedeemPromotionCode(ctx context.Context, userID uuid.UUID, code string) (types.UserPromotion, error) {
+	attempts, err := c.limiter.RateLimitHit(ctx, fmt.Sprintf("promotion_codes:attempts:%s", userID.String()), c.rateLimit.Window)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	if attempts > int64(c.rateLimit.Attempts) {
+		return types.UserPromotion{}, types.ErrTooManyAttempts
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+	defer db.RollbackTx(ctx)
+
+	promotionCode, err := db.PromotionCodeGetByCode(ctx, normalizeCode(code))
+	if store.IsErrNotFound(err) {
+		return types.UserPromotion{}, types.ErrInvalidPromotionCode
+	}
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	now := time.Now()
+	if promotionCode.Expires != nil && now.After(*promotionCode.Expires) {
+		return types.UserPromotion{}, types.ErrInvalidPromotionCode
+	}
+
+	promotion, err := db.PromotionGetByID(ctx, promotionCode.PromotionID)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	if !promotion.IsActive {
+		return types.UserPromotion{}, types.ErrPromotionNoLongerActive
+	}
+
+	if !promotion.IsAssignable() {
+		return types.UserPromotion{}, types.ErrPromotionNotAssignable
+	}
+
+	err = promotions.CheckEligibility(ctx, db, promotion, userID)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	err = db.PromotionCodeRedeem(ctx, promotionCode.ID)
+	if store.IsErrNotFound(err) {
+		return types.UserPromotion{}, types.ErrPromotionCodeUsedUp
+	}
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	userPromotion, err := db.AddPromotion(ctx, types.UserPromotion{
+		ID:          uuid.New(),
+		UserID:      userID,
+		PromotionID: promotion.ID,
+		StartDate:   now,
+		EndDate:     now.AddDate(0, 0, promotionCode.ValidityDays),
+	})
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	created, err := db.PromotionCodeRedemptionCreate(ctx, promotionCode.ID, userID, userPromotion.ID)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+	if !created {
+		return types.UserPromotion{}, types.ErrPromotionCodeRedeemed
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userID.String()), userPromotion)
+
+	return userPromotion, nil
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func newCode() (string, error) {
+	code := make([]byte, codeLength)
+	_, err := rand.Read(code)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range code {
+		code[i] = codeAlphabet[int(code[i])%len(codeAlphabet)]
+	}
+
+	return string(code), nil
+}
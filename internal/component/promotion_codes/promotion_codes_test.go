@@ -0,0 +1,311 @@
+package promotioncodes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	promotioncodes "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotion_codes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+type fields struct {
+	persistentStore store.Persistent
+	limiter         *fakes.FakeRateLimiter
+}
+
+var rateLimit = types.RateLimit{Attempts: 5, Window: time.Hour}
+
+func TestCreatePromotionCodes(t *testing.T) {
+	promotion := types.Promotion{ID: uuid.New(), IsActive: true, Type: types.Regular}
+	archived := time.Now()
+
+	found := func(promotion types.Promotion) func(context.Context, uuid.UUID) (types.Promotion, error) {
+		return func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+			return promotion, nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		fields        fields
+		batch         types.PromotionCodeBatch
+		expectedCodes int
+		expectedError error
+	}{
+		{
+			name: "it should generate single use codes",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{PromotionGetByIDStub: found(promotion)},
+			},
+			batch:         types.PromotionCodeBatch{Kind: types.PromotionCodeSingleUse, Count: 50},
+			expectedCodes: 50,
+		},
+		{
+			name: "it should create a shared code in upper case",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{PromotionGetByIDStub: found(promotion)},
+			},
+			batch:         types.PromotionCodeBatch{Kind: types.PromotionCodeShared, Code: "spring25"},
+			expectedCodes: 1,
+		},
+		{
+			name: "it should reject a single use batch with a code",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{PromotionGetByIDStub: found(promotion)},
+			},
+			batch:         types.PromotionCodeBatch{Kind: types.PromotionCodeSingleUse, Count: 5, Code: "SPRING25"},
+			expectedError: types.ErrInvalidPromotionCodes,
+		},
+		{
+			name: "it should reject codes of a cashback promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{PromotionGetByIDStub: found(types.Promotion{ID: promotion.ID, Type: types.Cashback})},
+			},
+			batch:         types.PromotionCodeBatch{Kind: types.PromotionCodeShared, Code: "SPRING25"},
+			expectedError: types.ErrPromotionNotAssignable,
+		},
+		{
+			name: "it should reject codes of an archived promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{PromotionGetByIDStub: found(types.Promotion{ID: promotion.ID, Type: types.Regular, Archived: &archived})},
+			},
+			batch:         types.PromotionCodeBatch{Kind: types.PromotionCodeShared, Code: "SPRING25"},
+			expectedError: types.ErrPromotionArchived,
+		},
+		{
+			name: "it should fail when the shared code exists",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PromotionGetByIDStub: found(promotion),
+					PromotionCodesCreateStub: func(ctx context.Context, codes []types.PromotionCode) error {
+						return &pgconn.PgError{Code: "23505"}
+					},
+				},
+			},
+			batch:         types.PromotionCodeBatch{Kind: types.PromotionCodeShared, Code: "SPRING25"},
+			expectedError: types.ErrPromotionCodeExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := promotioncodes.New(tt.fields.persistentStore, &fakes.FakePubSub{}, &fakes.FakeRateLimiter{}, rateLimit)
+			codes, err := c.CreatePromotionCodes(context.Background(), promotion.ID, tt.batch)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Len(t, codes, tt.expectedCodes)
+
+			unique := map[string]bool{}
+			for _, code := range codes {
+				require.Equal(t, promotion.ID, code.PromotionID)
+				require.Equal(t, types.DefaultPromotionCodeValidityDays, code.ValidityDays)
+				unique[code.Code] = true
+
+				if tt.batch.Kind == types.PromotionCodeSingleUse {
+					require.Len(t, code.Code, 12)
+					require.Equal(t, 1, *code.MaxRedemptions)
+				} else {
+					require.Equal(t, "SPRING25", code.Code)
+				}
+			}
+			require.Len(t, unique, tt.expectedCodes)
+		})
+	}
+}
+
+func TestRedeemPromotionCode(t *testing.T) {
+	userID := uuid.New()
+	expired := time.Now().Add(-time.Minute)
+
+	promotionCode := types.PromotionCode{
+		ID:           uuid.New(),
+		PromotionID:  uuid.New(),
+		Code:         "SPRING25",
+		Kind:         types.PromotionCodeShared,
+		ValidityDays: 3,
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	attempts := func(n int64) *fakes.FakeRateLimiter {
+		return &fakes.FakeRateLimiter{
+			RateLimitHitStub: func(ctx context.Context, key string, window time.Duration) (int64, error) {
+				require.Equal(t, rateLimit.Window, window)
+				return n, nil
+			},
+		}
+	}
+
+	code := func(code types.PromotionCode) func(context.Context, string) (types.PromotionCode, error) {
+		return func(ctx context.Context, value string) (types.PromotionCode, error) {
+			require.Equal(t, "SPRING25", value)
+			return code, nil
+		}
+	}
+
+	active := func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+		return types.Promotion{ID: id, IsActive: true, Type: types.Regular}, nil
+	}
+
+	assigned := func(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error) {
+		return userPromotion, nil
+	}
+
+	recorded := func(ok bool) func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (bool, error) {
+		return func(ctx context.Context, codeID uuid.UUID, userID uuid.UUID, userPromotionID uuid.UUID) (bool, error) {
+			return ok, nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		fields        fields
+		expectedError error
+	}{
+		{
+			name: "it should assign the promotion of the code",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionCodeGetByCodeStub:        code(promotionCode),
+						PromotionGetByIDStub:              active,
+						AddPromotionStub:                  assigned,
+						PromotionCodeRedemptionCreateStub: recorded(true),
+					}),
+				},
+				limiter: attempts(1),
+			},
+		},
+		{
+			name: "it should limit redemption attempts",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{}),
+				},
+				limiter: attempts(6),
+			},
+			expectedError: types.ErrTooManyAttempts,
+		},
+		{
+			name: "it should reject an unknown code",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionCodeGetByCodeStub: func(ctx context.Context, value string) (types.PromotionCode, error) {
+							return types.PromotionCode{}, pgx.ErrNoRows
+						},
+					}),
+				},
+				limiter: attempts(1),
+			},
+			expectedError: types.ErrInvalidPromotionCode,
+		},
+		{
+			name: "it should reject an expired code",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionCodeGetByCodeStub: code(types.PromotionCode{
+							ID:          promotionCode.ID,
+							PromotionID: promotionCode.PromotionID,
+							Code:        promotionCode.Code,
+							Expires:     &expired,
+						}),
+					}),
+				},
+				limiter: attempts(1),
+			},
+			expectedError: types.ErrInvalidPromotionCode,
+		},
+		{
+			name: "it should reject a player who is not eligible",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionCodeGetByCodeStub: code(promotionCode),
+						PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+							return types.Promotion{
+								ID:          id,
+								IsActive:    true,
+								Eligibility: &types.EligibilityRules{Countries: []string{"DE"}},
+							}, nil
+						},
+						GetEligibilityProfileStub: func(ctx context.Context, id uuid.UUID) (types.EligibilityProfile, error) {
+							return types.EligibilityProfile{UserID: id, Country: "AT"}, nil
+						},
+					}),
+				},
+				limiter: attempts(1),
+			},
+			expectedError: types.ErrNotEligible,
+		},
+		{
+			name: "it should reject a code that reached its limit",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionCodeGetByCodeStub: code(promotionCode),
+						PromotionGetByIDStub:       active,
+						PromotionCodeRedeemStub: func(ctx context.Context, id uuid.UUID) error {
+							return pgx.ErrNoRows
+						},
+					}),
+				},
+				limiter: attempts(1),
+			},
+			expectedError: types.ErrPromotionCodeUsedUp,
+		},
+		{
+			name: "it should reject a code the player already redeemed",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionCodeGetByCodeStub:        code(promotionCode),
+						PromotionGetByIDStub:              active,
+						AddPromotionStub:                  assigned,
+						PromotionCodeRedemptionCreateStub: recorded(false),
+					}),
+				},
+				limiter: attempts(1),
+			},
+			expectedError: types.ErrPromotionCodeRedeemed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubsub := &fakes.FakePubSub{}
+			c := promotioncodes.New(tt.fields.persistentStore, pubsub, tt.fields.limiter, rateLimit)
+			userPromotion, err := c.RedeemPromotionCode(context.Background(), userID, " spring25 ")
+
+			require.ErrorIs(t, err, tt.expectedError)
+
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			db, _ := persistent.WithTx(context.Background())
+			fake := db.(*fakes.FakePersistent)
+
+			if tt.expectedError != nil {
+				require.Equal(t, 0, fake.CommitTxCallCount())
+				require.Equal(t, 0, pubsub.PublishCallCount())
+				return
+			}
+
+			require.Equal(t, userID, userPromotion.UserID)
+			require.Equal(t, promotionCode.PromotionID, userPromotion.PromotionID)
+			require.Equal(t, userPromotion.StartDate.AddDate(0, 0, 3), userPromotion.EndDate)
+			require.Equal(t, 1, fake.CommitTxCallCount())
+			require.Equal(t, 1, pubsub.PublishCallCount())
+		})
+	}
+}
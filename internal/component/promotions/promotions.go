@@ -2,19 +2,28 @@ package promotions
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type PromotionProvider interface {
 	CreatePromotions(ctx context.Context, promotion types.Promotion) (types.Promotion, error)
-	GetPromotions(ctx context.Context) ([]types.Promotion, error)
+	GetPromotions(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error)
 	GetPromotionByID(ctx context.Context, ID uuid.UUID) (types.Promotion, error)
 	UpdatePromotion(ctx context.Context, promotion types.Promotion) (types.Promotion, error)
 	DeletePromotion(ctx context.Context, ID uuid.UUID) error
+	RestorePromotion(ctx context.Context, ID uuid.UUID) (types.Promotion, error)
+	ApplySchedule(ctx context.Context) (int, error)
+	GetPromotionStateChanges(ctx context.Context, ID uuid.UUID) ([]types.PromotionStateChange, error)
+	CreateWelcomePackage(ctx context.Context, welcomePackage types.WelcomePackage) (types.WelcomePackage, error)
+	GetWelcomePackage(ctx context.Context) (types.WelcomePackage, error)
+	GetWelcomePackages(ctx context.Context) ([]types.WelcomePackage, error)
 }
 
 type component struct {
@@ -30,6 +39,16 @@ func New(persistent store.Persistent) *component {
 func (c *component) CreatePromotions(ctx context.Context, promotion types.Promotion) (types.Promotion, error) {
 	promotion.ID = uuid.New()
 
+	err := validatePromotion(&promotion)
+	if err != nil {
+		return types.Promotion{}, err
+	}
+
+	// the window decides from now on, the scheduler switches at its edges
+	if promotion.IsScheduled() {
+		promotion.IsActive = promotion.IsAvailableAt(time.Now())
+	}
+
 	createdPromotion, err := c.persistent.PromotionCreate(ctx, promotion)
 	if err != nil {
 		return types.Promotion{}, err
@@ -42,14 +61,335 @@ func (c *component) GetPromotionByID(ctx context.Context, ID uuid.UUID) (types.P
 	return c.persistent.PromotionGetByID(ctx, ID)
 }
 
-func (c *component) GetPromotions(ctx context.Context) ([]types.Promotion, error) {
-	return c.persistent.GetPromotions(ctx)
+func (c *component) GetPromotions(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
+	filter.At = time.Now()
+
+	return c.persistent.GetPromotions(ctx, filter)
 }
 
+// UpdatePromotion updates the promotion. Switching it on or off by hand is
+// recorded and overrides its availability window until the scheduler reaches
+// the next edge of it.
 func (c *component) UpdatePromotion(ctx context.Context, promotion types.Promotion) (types.Promotion, error) {
-	return c.persistent.PromotionUpdate(ctx, promotion)
+	err := validatePromotion(&promotion)
+	if err != nil {
+		return types.Promotion{}, err
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.Promotion{}, err
+	}
+	defer db.RollbackTx(ctx)
+
+	existing, err := db.PromotionGetByID(ctx, promotion.ID)
+	if err != nil {
+		return types.Promotion{}, err
+	}
+
+	// archived promotions are not updated, they have to be restored first
+	if existing.Archived != nil {
+		return types.Promotion{}, types.ErrPromotionArchived
+	}
+
+	now := time.Now()
+	applyState(&promotion, existing, now)
+
+	updatedPromotion, err := db.PromotionUpdate(ctx, promotion)
+	if store.IsErrCheckViolation(err) {
+		// the budget or claim limit is below what was already spent or claimed
+		return types.Promotion{}, types.ErrInvalidBudget
+	}
+	if store.IsErrNotFound(err) {
+		return types.Promotion{}, types.ErrPromotionArchived
+	}
+	if err != nil {
+		return types.Promotion{}, err
+	}
+
+	if promotion.IsActive != existing.IsActive {
+		reason := types.PromotionManualEnd
+		if promotion.IsActive {
+			reason = types.PromotionManualStart
+		}
+
+		err = db.PromotionStateChangeCreate(ctx, types.PromotionStateChange{
+			ID:          uuid.New(),
+			PromotionID: promotion.ID,
+			IsActive:    promotion.IsActive,
+			Reason:      reason,
+			Created:     now,
+		})
+		if err != nil {
+			return types.Promotion{}, err
+		}
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.Promotion{}, err
+	}
+
+	return updatedPromotion, nil
 }
 
+// applyState decides whether the updated promotion is active. A changed
+// window decides from now on. Otherwise the requested state is kept and
+// switching by hand overrides the window until its next edge.
+func applyState(promotion *types.Promotion, existing types.Promotion, now time.Time) {
+	promotion.ManualStateAt = existing.ManualStateAt
+
+	switch {
+	case !promotion.IsScheduled():
+		promotion.ManualStateAt = nil
+	case !sameTime(promotion.AvailableFrom, existing.AvailableFrom) ||
+		!sameTime(promotion.AvailableUntil, existing.AvailableUntil):
+		promotion.IsActive = promotion.IsAvailableAt(now)
+		promotion.ManualStateAt = nil
+	case promotion.IsActive != existing.IsActive:
+		promotion.ManualStateAt = &now
+	}
+}
+
+// sameTime reports whether a and b are both unset or the same instant.
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// validatePromotion checks the promotion and the rule of its type. Rules of
+// other types are dropped.
+func validatePromotion(promotion *types.Promotion) error {
+	if promotion.Amount.Currency == "" {
+		promotion.Amount.Currency = types.DefaultCurrency
+	}
+
+	if promotion.Type == "" {
+		promotion.Type = types.Regular
+	}
+
+	if promotion.WageringMultiplier.IsNegative() {
+		return types.ErrInvalidWagering
+	}
+
+	switch promotion.Type {
+	case types.Cashback:
+		rule := promotion.Cashback
+		if rule == nil ||
+			!rule.Percentage.IsPositive() ||
+			rule.Percentage.GreaterThan(decimal.NewFromInt(100)) ||
+			!rule.MaxAmount.IsPositive() ||
+			(rule.Period != types.CashbackDaily && rule.Period != types.CashbackWeekly) {
+			return types.ErrInvalidCashback
+		}
+		promotion.Amount.Amount = decimal.Zero
+		promotion.MatchBonus = nil
+		promotion.FreeSpins = nil
+	case types.MatchBonus:
+		rule := promotion.MatchBonus
+		if rule == nil ||
+			!rule.Percentage.IsPositive() ||
+			!rule.MaxAmount.IsPositive() ||
+			rule.MinDeposit.IsNegative() {
+			return types.ErrInvalidMatchBonus
+		}
+		promotion.Amount.Amount = decimal.Zero
+		promotion.Cashback = nil
+		promotion.FreeSpins = nil
+	case types.FreeSpins:
+		rule := promotion.FreeSpins
+		if rule == nil ||
+			rule.GameID == "" ||
+			rule.Spins <= 0 ||
+			!rule.Stake.IsPositive() ||
+			rule.ValidityDays <= 0 {
+			return types.ErrInvalidFreeSpins
+		}
+		promotion.Amount.Amount = decimal.Zero
+		promotion.Cashback = nil
+		promotion.MatchBonus = nil
+	case types.ReferralBonus:
+		promotion.Amount.Amount = decimal.Zero
+		promotion.Cashback = nil
+		promotion.MatchBonus = nil
+		promotion.FreeSpins = nil
+	default:
+		promotion.Cashback = nil
+		promotion.MatchBonus = nil
+		promotion.FreeSpins = nil
+	}
+
+	if promotion.AvailableFrom != nil && promotion.AvailableUntil != nil &&
+		!promotion.AvailableFrom.Before(*promotion.AvailableUntil) {
+		return types.ErrInvalidAvailability
+	}
+
+	if budget := promotion.Budget; budget != nil {
+		if budget.Amount != nil && !budget.Amount.IsPositive() ||
+			budget.MaxClaims != nil && *budget.MaxClaims < 1 ||
+			budget.MaxClaimsPerUser != nil && *budget.MaxClaimsPerUser < 1 {
+			return types.ErrInvalidBudget
+		}
+	}
+
+	if promotion.Eligibility != nil {
+		return validateEligibility(*promotion.Eligibility)
+	}
+
+	return nil
+}
+
+// validateEligibility rejects rules no player can pass.
+func validateEligibility(rules types.EligibilityRules) error {
+	if rules.MinAccountAgeDays < 0 || rules.MinTotalDeposits.IsNegative() {
+		return types.ErrInvalidEligibility
+	}
+
+	for _, country := range rules.Countries {
+		if slices.Contains(rules.ExcludedCountries, country) {
+			return types.ErrInvalidEligibility
+		}
+	}
+
+	for _, id := range rules.RequiredPromotionIDs {
+		if slices.Contains(rules.ExcludedPromotionIDs, id) {
+			return types.ErrInvalidEligibility
+		}
+	}
+
+	return nil
+}
+
+// ApplySchedule switches promotions on and off at the edges of their
+// availability windows and returns how many were switched.
+func (c *component) ApplySchedule(ctx context.Context) (int, error) {
+	changes, err := c.persistent.PromotionsApplySchedule(ctx, time.Now())
+
+	return len(changes), err
+}
+
+func (c *component) GetPromotionStateChanges(ctx context.Context, ID uuid.UUID) ([]types.PromotionStateChange, error) {
+	return c.persistent.GetPromotionStateChanges(ctx, ID)
+}
+
+// CreateWelcomePackage creates the next version of the welcome package,
+// which players who register from now on get. Steps are numbered in the
+// order they are given.
+func (c *component) CreateWelcomePackage(ctx context.Context, welcomePackage types.WelcomePackage) (types.WelcomePackage, error) {
+	welcomePackage.ID = uuid.New()
+
+	if len(welcomePackage.Steps) == 0 {
+		return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+	}
+
+	for i := range welcomePackage.Steps {
+		step := &welcomePackage.Steps[i]
+		step.Step = i + 1
+
+		if step.ValidityHours == 0 {
+			step.ValidityHours = types.DefaultWelcomeStepValidityHours
+		}
+
+		switch step.Unlock {
+		case types.WelcomeUnlockRegistration:
+			if step.Deposits != 0 {
+				return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+			}
+		case types.WelcomeUnlockDeposit:
+			if step.Deposits < 1 {
+				return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+			}
+		default:
+			return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+		}
+
+		if step.ValidityHours < 0 {
+			return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+		}
+
+		promotion, err := c.persistent.PromotionGetByID(ctx, step.PromotionID)
+		if store.IsErrNotFound(err) {
+			return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+		}
+		if err != nil {
+			return types.WelcomePackage{}, err
+		}
+
+		if promotion.Archived != nil || !promotion.IsAssignable() {
+			return types.WelcomePackage{}, types.ErrInvalidWelcomePackage
+		}
+	}
+
+	created, err := c.persistent.WelcomePackageCreate(ctx, welcomePackage)
+	if store.IsErrConflict(err) {
+		return types.WelcomePackage{}, types.ErrWelcomePackageConflict
+	}
+
+	return created, err
+}
+
+// GetWelcomePackage returns the current version of the welcome package.
+func (c *component) GetWelcomePackage(ctx context.Context) (types.WelcomePackage, error) {
+	return c.persistent.WelcomePackageGetCurrent(ctx)
+}
+
+func (c *component) GetWelcomePackages(ctx context.Context) ([]types.WelcomePackage, error) {
+	return c.persistent.GetWelcomePackages(ctx)
+}
+
+// CheckAssignable rejects promotions that cannot be assigned to players
+// anymore.
+func CheckAssignable(promotion types.Promotion) error {
+	if promotion.Archived != nil {
+		return types.ErrPromotionArchived
+	}
+
+	if !promotion.IsActive {
+		return types.ErrPromotionNoLongerActive
+	}
+
+	if !promotion.IsAssignable() {
+		return types.ErrPromotionNotAssignable
+	}
+
+	return nil
+}
+
+// CheckEligibility returns an EligibilityError naming the first eligibility
+// rule of the promotion the user fails. Promotions without rules are open to
+// every player.
+func CheckEligibility(ctx context.Context, db store.Persistent, promotion types.Promotion, userID uuid.UUID) error {
+	if promotion.Eligibility == nil {
+		return nil
+	}
+
+	profile, err := db.GetEligibilityProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return promotion.Eligibility.Check(profile, promotion.Amount.Currency, time.Now())
+}
+
+// DeletePromotion archives the promotion. Archived promotions are no longer
+// listed or assigned, but the players' promotions of it are kept.
 func (c *component) DeletePromotion(ctx context.Context, ID uuid.UUID) error {
-	return c.persistent.PromotionDelete(ctx, ID)
+	return c.persistent.PromotionArchive(ctx, ID)
+}
+
+// RestorePromotion restores the archived promotion. It stays inactive until
+// staff activate it or, when it is scheduled, its availability starts.
+func (c *component) RestorePromotion(ctx context.Context, ID uuid.UUID) (types.Promotion, error) {
+	promotion, err := c.persistent.PromotionRestore(ctx, ID)
+	if store.IsErrNotFound(err) {
+		_, err = c.persistent.PromotionGetByID(ctx, ID)
+		if err == nil {
+			return types.Promotion{}, types.ErrPromotionNotArchived
+		}
+	}
+
+	return promotion, err
 }
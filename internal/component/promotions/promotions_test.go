@@ -11,9 +11,15 @@ import (
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
 type fields struct {
 	persistentStore store.Persistent
 	pubsub          store.PubSub
@@ -33,10 +39,14 @@ func TestCreatePromotions(t *testing.T) {
 		Title:       "Welcome",
 		Description: "Description",
 		IsActive:    true,
-		Amount:      10,
+		Amount:      eur(10),
 		Type:        types.WelcomeBonus,
 	}
 
+	yesterday := time.Now().AddDate(0, 0, -1)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	zero := decimal.Zero
+
 	tests := []struct {
 		name           string
 		fields         fields
@@ -65,6 +75,145 @@ func TestCreatePromotions(t *testing.T) {
 			},
 			expectedOutput: promotion,
 		},
+		{
+			name: "it should create a cashback promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PromotionCreateStub: func(ctx context.Context, p types.Promotion) (types.Promotion, error) {
+						require.Equal(t, types.Cashback, p.Type)
+						require.True(t, p.Amount.Amount.IsZero())
+						require.Equal(t, types.EUR, p.Amount.Currency)
+						return promotion, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Type:   types.Cashback,
+					Amount: eur(10),
+					Cashback: &types.CashbackRule{
+						Percentage: decimal.NewFromInt(10),
+						MaxAmount:  decimal.NewFromInt(100),
+						Period:     types.CashbackWeekly,
+					},
+				},
+			},
+			expectedOutput: promotion,
+		},
+		{
+			name: "it should reject a cashback promotion without a cap",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Type: types.Cashback,
+					Cashback: &types.CashbackRule{
+						Percentage: decimal.NewFromInt(10),
+						Period:     types.CashbackDaily,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidCashback,
+		},
+		{
+			name: "it should reject a cashback promotion above 100 percent",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Type: types.Cashback,
+					Cashback: &types.CashbackRule{
+						Percentage: decimal.NewFromInt(150),
+						MaxAmount:  decimal.NewFromInt(100),
+						Period:     types.CashbackDaily,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidCashback,
+		},
+		{
+			name: "it should reject a match bonus without a maximum bonus",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Type: types.MatchBonus,
+					MatchBonus: &types.MatchBonusRule{
+						Percentage: decimal.NewFromInt(100),
+						MinDeposit: decimal.NewFromInt(20),
+					},
+				},
+			},
+			expectedError: types.ErrInvalidMatchBonus,
+		},
+		{
+			name: "it should reject free spins without a game",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Type: types.FreeSpins,
+					FreeSpins: &types.FreeSpinsRule{
+						Spins:        20,
+						Stake:        decimal.RequireFromString("0.2"),
+						ValidityDays: 7,
+					},
+				},
+			},
+			expectedError: types.ErrInvalidFreeSpins,
+		},
+		{
+			name: "it should reject eligibility excluding a required country",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Eligibility: &types.EligibilityRules{
+						Countries:         []string{"DE", "AT"},
+						ExcludedCountries: []string{"AT"},
+					},
+				},
+			},
+			expectedError: types.ErrInvalidEligibility,
+		},
+		{
+			name: "it should reject an availability window ending before it starts",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					AvailableFrom:  &tomorrow,
+					AvailableUntil: &yesterday,
+				},
+			},
+			expectedError: types.ErrInvalidAvailability,
+		},
+		{
+			name: "it should reject a budget that is not positive",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				promotion: types.Promotion{
+					Budget: &types.PromotionBudget{Amount: &zero},
+				},
+			},
+			expectedError: types.ErrInvalidBudget,
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,7 +242,7 @@ func TestGetPromotions(t *testing.T) {
 		Title:       "Welcome",
 		Description: "Description",
 		IsActive:    true,
-		Amount:      10,
+		Amount:      eur(10),
 		Type:        types.WelcomeBonus,
 	}
 
@@ -109,12 +258,12 @@ func TestGetPromotions(t *testing.T) {
 			name: "it should get register",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					GetPromotionsStub: func(ctx context.Context) ([]types.Promotion, error) {
+					GetPromotionsStub: func(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
 						return []types.Promotion{promotion}, err
 					},
 				},
 				tester: &fakes.FakePromotionProvider{
-					GetPromotionsStub: func(ctx context.Context) ([]types.Promotion, error) {
+					GetPromotionsStub: func(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
 						return []types.Promotion{promotion}, err
 					},
 				},
@@ -128,7 +277,7 @@ func TestGetPromotions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := promotions.New(tt.fields.persistentStore)
-			res, err := c.GetPromotions(context.Background())
+			res, err := c.GetPromotions(context.Background(), types.PromotionFilter{})
 
 			require.ErrorIs(t, err, tt.expectedError)
 			if tt.expectedError == nil {
@@ -151,7 +300,7 @@ func TestGetPromotionByID(t *testing.T) {
 		Title:       "Welcome",
 		Description: "Description",
 		IsActive:    true,
-		Amount:      10,
+		Amount:      eur(10),
 		Type:        types.WelcomeBonus,
 	}
 
@@ -232,72 +381,154 @@ func TestUpdatePromotion(t *testing.T) {
 		Title:       "Welcome",
 		Description: "Description",
 		IsActive:    true,
-		Amount:      10,
+		Amount:      eur(10),
 		Type:        types.WelcomeBonus,
 		Created:     time.Time{},
 		Updated:     time.Time{},
 	}
 
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	scheduled := promotion
+	scheduled.Type = types.Regular
+	scheduled.AvailableFrom = &tomorrow
+
+	yesterday := time.Now().AddDate(0, 0, -1)
+	live := promotion
+	live.Type = types.Regular
+	live.AvailableFrom = &yesterday
+
+	switchedOff := live
+	switchedOff.IsActive = false
+
+	overridden := switchedOff
+	overridden.ManualStateAt = &yesterday
+
+	existing := func(p types.Promotion) func(context.Context, uuid.UUID) (types.Promotion, error) {
+		return func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+			return p, nil
+		}
+	}
+
+	updated := func(ctx context.Context, p types.Promotion) (types.Promotion, error) {
+		return p, nil
+	}
+
 	tests := []struct {
-		name           string
-		fields         fields
-		vars           map[string]string
-		args           args
-		expectedError  error
-		expectedOutput types.Promotion
+		name             string
+		persistent       *fakes.FakePersistent
+		args             args
+		expectedError    error
+		expectedOutput   types.Promotion
+		expectedActive   bool
+		expectedOverride bool
+		expectedChange   types.PromotionStateChangeReason
 	}{
 		{
 			name: "it should update promotion",
-			fields: fields{
-				persistentStore: &fakes.FakePersistent{
-					PromotionUpdateStub: func(ctx context.Context, p types.Promotion) (types.Promotion, error) {
-						return promotion, nil
-					},
-				},
-				tester: &fakes.FakePromotionProvider{
-					UpdatePromotionStub: func(ctx context.Context, p types.Promotion) (types.Promotion, error) {
-						return promotion, nil
-					},
-				},
-				pubsub: &fakes.FakePubSub{},
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: existing(promotion),
+				PromotionUpdateStub:  updated,
 			},
 			args: args{
 				promotion: promotion,
 			},
 			expectedOutput: promotion,
+			expectedActive: true,
 		},
 		{
 			name: "it should fail update promotion not found",
-			fields: fields{
-				persistentStore: &fakes.FakePersistent{
-					PromotionUpdateStub: func(ctx context.Context, p types.Promotion) (types.Promotion, error) {
-						return types.Promotion{}, pgx.ErrNoRows
-					},
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					return types.Promotion{}, pgx.ErrNoRows
 				},
-				tester: &fakes.FakePromotionProvider{
-					UpdatePromotionStub: func(ctx context.Context, p types.Promotion) (types.Promotion, error) {
-						return types.Promotion{}, pgx.ErrNoRows
-					},
+			},
+			args: args{
+				promotion: promotion,
+			},
+			expectedError: pgx.ErrNoRows,
+		},
+		{
+			name: "it should fail to update an archived promotion",
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					archived := time.Now()
+					return types.Promotion{ID: id, Archived: &archived}, nil
 				},
-				pubsub: &fakes.FakePubSub{},
 			},
 			args: args{
 				promotion: promotion,
 			},
-			expectedOutput: types.Promotion{},
-			expectedError:  pgx.ErrNoRows,
+			expectedError: types.ErrPromotionArchived,
+		},
+		{
+			name: "it should deactivate a promotion whose new window did not start",
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: existing(promotion),
+				PromotionUpdateStub:  updated,
+			},
+			args: args{
+				promotion: scheduled,
+			},
+			expectedActive: false,
+			expectedChange: types.PromotionManualEnd,
+		},
+		{
+			name: "it should override the window of a promotion switched off by hand",
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: existing(live),
+				PromotionUpdateStub:  updated,
+			},
+			args: args{
+				promotion: switchedOff,
+			},
+			expectedActive:   false,
+			expectedOverride: true,
+			expectedChange:   types.PromotionManualEnd,
+		},
+		{
+			name: "it should keep the override when the state is not switched",
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: existing(overridden),
+				PromotionUpdateStub:  updated,
+			},
+			args: args{
+				promotion: switchedOff,
+			},
+			expectedActive:   false,
+			expectedOverride: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := promotions.New(tt.fields.persistentStore)
+			c := promotions.New(&fakes.FakePersistent{
+				WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+					return tt.persistent, nil
+				},
+			})
 			res, err := c.UpdatePromotion(context.Background(), tt.args.promotion)
 
 			require.ErrorIs(t, err, tt.expectedError)
-			if tt.expectedError == nil {
+			if tt.expectedError != nil {
+				require.Zero(t, tt.persistent.CommitTxCallCount())
+				return
+			}
+
+			require.Equal(t, tt.expectedActive, res.IsActive)
+			require.Equal(t, tt.expectedOverride, res.ManualStateAt != nil)
+			if tt.expectedOutput.ID != uuid.Nil {
 				require.Equal(t, tt.expectedOutput, res)
 			}
+
+			if tt.expectedChange == "" {
+				require.Zero(t, tt.persistent.PromotionStateChangeCreateCallCount())
+			} else {
+				require.Equal(t, 1, tt.persistent.PromotionStateChangeCreateCallCount())
+				_, change := tt.persistent.PromotionStateChangeCreateArgsForCall(0)
+				require.Equal(t, tt.expectedChange, change.Reason)
+				require.Equal(t, tt.expectedActive, change.IsActive)
+			}
+			require.Equal(t, 1, tt.persistent.CommitTxCallCount())
 		})
 	}
 }
@@ -321,7 +552,7 @@ func TestDeletePromotion(t *testing.T) {
 			name: "it should get delete promotion",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					PromotionDeleteStub: func(ctx context.Context, u uuid.UUID) error {
+					PromotionArchiveStub: func(ctx context.Context, u uuid.UUID) error {
 						return nil
 					},
 				},
@@ -338,7 +569,7 @@ func TestDeletePromotion(t *testing.T) {
 			name: "it should fail to delete not found",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					PromotionDeleteStub: func(ctx context.Context, u uuid.UUID) error {
+					PromotionArchiveStub: func(ctx context.Context, u uuid.UUID) error {
 						return pgx.ErrNoRows
 					},
 				},
@@ -363,3 +594,181 @@ func TestDeletePromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestRestorePromotion(t *testing.T) {
+	ID := uuid.New()
+
+	tests := []struct {
+		name          string
+		persistent    *fakes.FakePersistent
+		expectedError error
+	}{
+		{
+			name: "it should restore an archived promotion",
+			persistent: &fakes.FakePersistent{
+				PromotionRestoreStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					return types.Promotion{ID: id}, nil
+				},
+			},
+		},
+		{
+			name: "it should fail to restore a promotion that is not archived",
+			persistent: &fakes.FakePersistent{
+				PromotionRestoreStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					return types.Promotion{}, pgx.ErrNoRows
+				},
+				PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					return types.Promotion{ID: id, IsActive: true}, nil
+				},
+			},
+			expectedError: types.ErrPromotionNotArchived,
+		},
+		{
+			name: "it should fail to restore a promotion that does not exist",
+			persistent: &fakes.FakePersistent{
+				PromotionRestoreStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					return types.Promotion{}, pgx.ErrNoRows
+				},
+				PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+					return types.Promotion{}, pgx.ErrNoRows
+				},
+			},
+			expectedError: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := promotions.New(tt.persistent)
+			promotion, err := c.RestorePromotion(context.Background(), ID)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, ID, promotion.ID)
+			}
+		})
+	}
+}
+
+func TestApplySchedule(t *testing.T) {
+	persistent := &fakes.FakePersistent{
+		PromotionsApplyScheduleStub: func(ctx context.Context, now time.Time) ([]types.PromotionStateChange, error) {
+			require.WithinDuration(t, time.Now(), now, time.Second)
+			return []types.PromotionStateChange{
+				{PromotionID: uuid.New(), IsActive: true, Reason: types.PromotionScheduledStart},
+				{PromotionID: uuid.New(), IsActive: false, Reason: types.PromotionScheduledEnd},
+			}, nil
+		},
+	}
+
+	c := promotions.New(persistent)
+	switched, err := c.ApplySchedule(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 2, switched)
+	require.Equal(t, 1, persistent.PromotionsApplyScheduleCallCount())
+}
+
+func TestCreateWelcomePackage(t *testing.T) {
+	welcomeBonus := uuid.New()
+	matchBonus := uuid.New()
+	cashback := uuid.New()
+
+	found := func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+		switch id {
+		case welcomeBonus:
+			return types.Promotion{ID: id, Type: types.WelcomeBonus}, nil
+		case matchBonus:
+			return types.Promotion{ID: id, Type: types.MatchBonus}, nil
+		case cashback:
+			return types.Promotion{ID: id, Type: types.Cashback}, nil
+		}
+		return types.Promotion{}, pgx.ErrNoRows
+	}
+
+	created := func(ctx context.Context, welcomePackage types.WelcomePackage) (types.WelcomePackage, error) {
+		welcomePackage.Version = 2
+		return welcomePackage, nil
+	}
+
+	tests := []struct {
+		name          string
+		persistent    *fakes.FakePersistent
+		steps         []types.WelcomeStep
+		expectedError error
+	}{
+		{
+			name:       "it should number the steps and default their validity",
+			persistent: &fakes.FakePersistent{PromotionGetByIDStub: found, WelcomePackageCreateStub: created},
+			steps: []types.WelcomeStep{
+				{PromotionID: welcomeBonus, Unlock: types.WelcomeUnlockRegistration},
+				{PromotionID: matchBonus, Unlock: types.WelcomeUnlockDeposit, Deposits: 1, ValidityHours: 72},
+				{PromotionID: matchBonus, Unlock: types.WelcomeUnlockDeposit, Deposits: 2, ValidityHours: 72},
+			},
+		},
+		{
+			name:       "it should reject a registration step with a deposit",
+			persistent: &fakes.FakePersistent{PromotionGetByIDStub: found},
+			steps: []types.WelcomeStep{
+				{PromotionID: welcomeBonus, Unlock: types.WelcomeUnlockRegistration, Deposits: 1},
+			},
+			expectedError: types.ErrInvalidWelcomePackage,
+		},
+		{
+			name:       "it should reject a deposit step without a deposit",
+			persistent: &fakes.FakePersistent{PromotionGetByIDStub: found},
+			steps: []types.WelcomeStep{
+				{PromotionID: matchBonus, Unlock: types.WelcomeUnlockDeposit},
+			},
+			expectedError: types.ErrInvalidWelcomePackage,
+		},
+		{
+			name:       "it should reject an unknown promotion",
+			persistent: &fakes.FakePersistent{PromotionGetByIDStub: found},
+			steps: []types.WelcomeStep{
+				{PromotionID: uuid.New(), Unlock: types.WelcomeUnlockRegistration},
+			},
+			expectedError: types.ErrInvalidWelcomePackage,
+		},
+		{
+			name:       "it should reject a cashback promotion",
+			persistent: &fakes.FakePersistent{PromotionGetByIDStub: found},
+			steps: []types.WelcomeStep{
+				{PromotionID: cashback, Unlock: types.WelcomeUnlockRegistration},
+			},
+			expectedError: types.ErrInvalidWelcomePackage,
+		},
+		{
+			name: "it should fail when another version was created at the same time",
+			persistent: &fakes.FakePersistent{
+				PromotionGetByIDStub: found,
+				WelcomePackageCreateStub: func(ctx context.Context, welcomePackage types.WelcomePackage) (types.WelcomePackage, error) {
+					return types.WelcomePackage{}, &pgconn.PgError{Code: "23505"}
+				},
+			},
+			steps: []types.WelcomeStep{
+				{PromotionID: welcomeBonus, Unlock: types.WelcomeUnlockRegistration},
+			},
+			expectedError: types.ErrWelcomePackageConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := promotions.New(tt.persistent)
+			welcomePackage, err := c.CreateWelcomePackage(context.Background(), types.WelcomePackage{Steps: tt.steps})
+
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError != nil {
+				return
+			}
+
+			require.Equal(t, 2, welcomePackage.Version)
+			for i, step := range welcomePackage.Steps {
+				require.Equal(t, i+1, step.Step)
+			}
+			require.Equal(t, types.DefaultWelcomeStepValidityHours, welcomePackage.Steps[0].ValidityHours)
+			require.Equal(t, 72, welcomePackage.Steps[1].ValidityHours)
+		})
+	}
+}
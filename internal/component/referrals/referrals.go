@@ -0,0 +1,167 @@
+package referrals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ReferralProvider interface {
+	RewardReferrals(ctx context.Context) (int, error)
+	GetReferralReport(ctx context.Context) ([]types.ReferralReport, error)
+}
+
+const rewardBatchSize = 100
+
+type component struct {
+	persistent store.Persistent
+	pubsub     store.PubSub
+	program    types.ReferralProgram
+}
+
+var _ ReferralProvider = (*component)(nil)
+
+func New(persistent store.Persistent, pubsub store.PubSub, program types.ReferralProgram) *component {
+	return &component{
+		persistent: persistent,
+		pubsub:     pubsub,
+		program:    program,
+	}
+}
+
+// RewardReferrals grants the referral bonus to both players of each pending
+// referral whose referee met the program condition, as claimable user
+// promotions of the active referral promotion, so the bonuses are wagered
+// under its multiplier. Referrals stay pending while no referral promotion
+// in the currency of the program is active. A referral is rewarded by one
+// replica only. It returns the number of referrals rewarded.
+func (c *component) RewardReferrals(ctx context.Context) (int, error) {
+	promotion, err := c.promotion(ctx)
+	if store.IsErrNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	rewarded := 0
+	for {
+		referrals, err := c.persistent.GetQualifiedReferrals(ctx, c.program.Condition, c.program.Threshold.Amount, rewardBatchSize)
+		if err != nil {
+			return rewarded, err
+		}
+
+		for _, referral := range referrals {
+			err = c.reward(ctx, promotion, referral)
+			if store.IsErrNotFound(err) {
+				// already rewarded by another replica
+				continue
+			}
+			if err != nil {
+				return rewarded, err
+			}
+			rewarded++
+		}
+
+		if len(referrals) < rewardBatchSize {
+			return rewarded, nil
+		}
+	}
+}
+
+// promotion returns the first active referral promotion in the currency of
+// the program, or pgx.ErrNoRows without one.
+func (c *component) promotion(ctx context.Context) (types.Promotion, error) {
+	promotionType, active := types.ReferralBonus, true
+
+	referralPromotions, err := c.persistent.GetPromotions(ctx, types.PromotionFilter{
+		ByType:   &promotionType,
+		IsActive: &active,
+	})
+	if err != nil {
+		return types.Promotion{}, err
+	}
+
+	for _, promotion := range referralPromotions {
+		if promotion.Amount.Currency == c.program.ReferrerReward.Currency {
+			return promotion, nil
+		}
+	}
+
+	return types.Promotion{}, pgx.ErrNoRows
+}
+
+// reward grants the bonus of each side of the referral that has one and is
+// eligible for the promotion.
+func (c *component) reward(ctx context.Context, promotion types.Promotion, referral types.Referral) error {
+	now := time.Now()
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	err = db.ReferralReward(ctx, referral.ID, now)
+	if err != nil {
+		return err
+	}
+
+	var userPromotions []types.UserPromotion
+	for _, grant := range []struct {
+		userID uuid.UUID
+		bonus  types.Money
+	}{
+		{referral.ReferrerID, c.program.ReferrerReward},
+		{referral.RefereeID, c.program.RefereeReward},
+	} {
+		if !grant.bonus.IsPositive() {
+			continue
+		}
+
+		err = promotions.CheckEligibility(ctx, db, promotion, grant.userID)
+		if errors.Is(err, types.ErrNotEligible) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		userPromotion, err := db.AddPromotion(ctx, types.UserPromotion{
+			ID:          uuid.New(),
+			UserID:      grant.userID,
+			PromotionID: promotion.ID,
+			BonusAmount: grant.bonus,
+			StartDate:   now,
+			EndDate:     now.Add(c.program.Validity),
+		})
+		if err != nil {
+			return err
+		}
+		userPromotions = append(userPromotions, userPromotion)
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, userPromotion := range userPromotions {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userPromotion.UserID.String()), userPromotion)
+	}
+
+	return nil
+}
+
+func (c *component) GetReferralReport(ctx context.Context) ([]types.ReferralReport, error) {
+	return c.persistent.GetReferralReport(ctx)
+}
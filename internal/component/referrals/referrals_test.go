@@ -0,0 +1,196 @@
+package referrals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/referrals"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	pubsub          *fakes.FakePubSub
+	program         types.ReferralProgram
+}
+
+func TestRewardReferrals(t *testing.T) {
+	referral := types.Referral{
+		ID:         uuid.New(),
+		ReferrerID: uuid.New(),
+		RefereeID:  uuid.New(),
+		Status:     types.ReferralPending,
+		Created:    time.Now(),
+	}
+
+	program := types.ReferralProgram{
+		Condition:      types.ReferralConditionDeposit,
+		Threshold:      eur(20),
+		ReferrerReward: eur(10),
+		RefereeReward:  eur(5),
+		Validity:       7 * 24 * time.Hour,
+	}
+
+	promotion := types.Promotion{
+		ID:       uuid.New(),
+		Type:     types.ReferralBonus,
+		Amount:   eur(0),
+		IsActive: true,
+	}
+
+	referralPromotions := func(promotions ...types.Promotion) func(context.Context, types.PromotionFilter) ([]types.Promotion, error) {
+		return func(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
+			require.Equal(t, types.ReferralBonus, *filter.ByType)
+			require.True(t, *filter.IsActive)
+			return promotions, nil
+		}
+	}
+
+	qualified := func(referrals ...types.Referral) func(context.Context, types.ReferralCondition, decimal.Decimal, int) ([]types.Referral, error) {
+		return func(ctx context.Context, condition types.ReferralCondition, threshold decimal.Decimal, limit int) ([]types.Referral, error) {
+			require.Equal(t, types.ReferralConditionDeposit, condition)
+			require.True(t, threshold.Equal(decimal.NewFromInt(20)))
+			return referrals, nil
+		}
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	tests := []struct {
+		name                   string
+		fields                 fields
+		expectedRewarded       int
+		expectedUserPromotions []types.UserPromotion
+		expectedPublishes      int
+		expectedError          error
+	}{
+		{
+			name: "it should grant both players of a qualified referral a bonus",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub:         referralPromotions(promotion),
+					GetQualifiedReferralsStub: qualified(referral),
+					WithTxStub:                tx(&fakes.FakePersistent{}),
+				},
+				pubsub:  &fakes.FakePubSub{},
+				program: program,
+			},
+			expectedRewarded: 1,
+			expectedUserPromotions: []types.UserPromotion{
+				{UserID: referral.ReferrerID, BonusAmount: eur(10)},
+				{UserID: referral.RefereeID, BonusAmount: eur(5)},
+			},
+			expectedPublishes: 2,
+		},
+		{
+			name: "it should skip a side without a reward",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub:         referralPromotions(promotion),
+					GetQualifiedReferralsStub: qualified(referral),
+					WithTxStub:                tx(&fakes.FakePersistent{}),
+				},
+				pubsub: &fakes.FakePubSub{},
+				program: types.ReferralProgram{
+					Condition:      program.Condition,
+					Threshold:      program.Threshold,
+					ReferrerReward: eur(10),
+					RefereeReward:  eur(0),
+					Validity:       program.Validity,
+				},
+			},
+			expectedRewarded: 1,
+			expectedUserPromotions: []types.UserPromotion{
+				{UserID: referral.ReferrerID, BonusAmount: eur(10)},
+			},
+			expectedPublishes: 1,
+		},
+		{
+			name: "it should keep referrals pending without a referral promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub:         referralPromotions(),
+					GetQualifiedReferralsStub: qualified(referral),
+					WithTxStub:                tx(&fakes.FakePersistent{}),
+				},
+				pubsub:  &fakes.FakePubSub{},
+				program: program,
+			},
+		},
+		{
+			name: "it should skip referrals rewarded by another replica",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub:         referralPromotions(promotion),
+					GetQualifiedReferralsStub: qualified(referral),
+					WithTxStub: tx(&fakes.FakePersistent{
+						ReferralRewardStub: func(ctx context.Context, id uuid.UUID, rewarded time.Time) error {
+							return pgx.ErrNoRows
+						},
+					}),
+				},
+				pubsub:  &fakes.FakePubSub{},
+				program: program,
+			},
+		},
+		{
+			name: "it should not notify when the reward fails",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetPromotionsStub:         referralPromotions(promotion),
+					GetQualifiedReferralsStub: qualified(referral),
+					WithTxStub: tx(&fakes.FakePersistent{
+						AddPromotionStub: func(ctx context.Context, up types.UserPromotion) (types.UserPromotion, error) {
+							return types.UserPromotion{}, types.ErrCurrencyMismatch
+						},
+					}),
+				},
+				pubsub:  &fakes.FakePubSub{},
+				program: program,
+			},
+			expectedUserPromotions: []types.UserPromotion{
+				{UserID: referral.ReferrerID, BonusAmount: eur(10)},
+			},
+			expectedError: types.ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := referrals.New(tt.fields.persistentStore, tt.fields.pubsub, tt.fields.program)
+			rewarded, err := c.RewardReferrals(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedRewarded, rewarded)
+			require.Equal(t, tt.expectedPublishes, tt.fields.pubsub.PublishCallCount())
+
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			db, _ := persistent.WithTx(context.Background())
+			fake := db.(*fakes.FakePersistent)
+			require.Zero(t, fake.UserBalanceUpdateCallCount())
+			require.Equal(t, len(tt.expectedUserPromotions), fake.AddPromotionCallCount())
+			for i, expected := range tt.expectedUserPromotions {
+				_, userPromotion := fake.AddPromotionArgsForCall(i)
+				require.Equal(t, expected.UserID, userPromotion.UserID)
+				require.Equal(t, promotion.ID, userPromotion.PromotionID)
+				require.Equal(t, expected.BonusAmount, userPromotion.BonusAmount)
+				require.Equal(t, program.Validity, userPromotion.EndDate.Sub(userPromotion.StartDate))
+			}
+		})
+	}
+}
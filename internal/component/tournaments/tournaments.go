@@ -0,0 +1,490 @@
+package tournaments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type TournamentProvider interface {
+	GetTournaments(ctx context.Context) ([]types.Tournament, error)
+	GetTournamentByID(ctx context.Context, id uuid.UUID) (types.Tournament, error)
+	CreateTournament(ctx context.Context, tournament types.Tournament) (types.Tournament, error)
+	UpdateTournament(ctx context.Context, tournament types.Tournament) (types.Tournament, error)
+	DeleteTournament(ctx context.Context, id uuid.UUID) error
+	RecordEvent(ctx context.Context, event types.GameEvent, points decimal.Decimal) error
+	GetLeaderboard(ctx context.Context, id uuid.UUID, userID uuid.UUID, limit int) (types.Leaderboard, error)
+	DistributePrizes(ctx context.Context) (int, error)
+}
+
+const (
+	// resultsSize is the number of standings kept when a tournament
+	// finishes, unless more players win a prize.
+	resultsSize = 100
+	// leaderboardRetention is how long the live leaderboard of a finished
+	// tournament is kept next to the stored results.
+	leaderboardRetention = 7 * 24 * time.Hour
+)
+
+type component struct {
+	persistent  store.Persistent
+	leaderboard store.Leaderboard
+	pubsub      store.PubSub
+}
+
+var _ TournamentProvider = (*component)(nil)
+
+func New(persistent store.Persistent, leaderboard store.Leaderboard, pubsub store.PubSub) *component {
+	return &component{
+		persistent:  persistent,
+		leaderboard: leaderboard,
+		pubsub:      pubsub,
+	}
+}
+
+func leaderboardKey(id uuid.UUID) string {
+	return fmt.Sprintf("tournament:%s:leaderboard", id.String())
+}
+
+func (c *component) GetTournaments(ctx context.Context) ([]types.Tournament, error) {
+	return c.persistent.GetTournaments(ctx)
+}
+
+func (c *component) GetTournamentByID(ctx context.Context, id uuid.UUID) (types.Tournament, error) {
+	return c.persistent.TournamentGetByID(ctx, id)
+}
+
+func (c *component) CreateTournament(ctx context.Context, tournament types.Tournament) (types.Tournament, error) {
+	tournament.ID = uuid.New()
+
+	err := c.validateTournament(ctx, &tournament)
+	if err != nil {
+		return types.Tournament{}, err
+	}
+
+	return c.persistent.TournamentCreate(ctx, tournament)
+}
+
+// UpdateTournament updates a tournament until it is finished. Once it
+// started, what is scored and since when can no longer change.
+func (c *component) UpdateTournament(ctx context.Context, tournament types.Tournament) (types.Tournament, error) {
+	existing, err := c.persistent.TournamentGetByID(ctx, tournament.ID)
+	if err != nil {
+		return types.Tournament{}, err
+	}
+
+	if existing.Finished != nil {
+		return types.Tournament{}, types.ErrTournamentFinished
+	}
+
+	if !time.Now().Before(existing.StartDate) &&
+		(tournament.Scoring != existing.Scoring ||
+			tournament.GameCategory != existing.GameCategory ||
+			!tournament.StartDate.Equal(existing.StartDate)) {
+		return types.Tournament{}, types.ErrTournamentStarted
+	}
+
+	err = c.validateTournament(ctx, &tournament)
+	if err != nil {
+		return types.Tournament{}, err
+	}
+
+	return c.persistent.TournamentUpdate(ctx, tournament)
+}
+
+// DeleteTournament deletes a tournament that did not start yet.
+func (c *component) DeleteTournament(ctx context.Context, id uuid.UUID) error {
+	tournament, err := c.persistent.TournamentGetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !time.Now().Before(tournament.StartDate) {
+		return types.ErrTournamentStarted
+	}
+
+	return c.persistent.TournamentDelete(ctx, id)
+}
+
+// validateTournament checks the window and the prizes of the tournament. Prize
+// ranks may not overlap and each prize must assign an existing promotion.
+func (c *component) validateTournament(ctx context.Context, tournament *types.Tournament) error {
+	if !tournament.StartDate.Before(tournament.EndDate) {
+		return types.ErrStartAfterEndDate
+	}
+
+	if tournament.Prizes == nil {
+		tournament.Prizes = []types.TournamentPrize{}
+	}
+
+	slices.SortFunc(tournament.Prizes, func(a, b types.TournamentPrize) int {
+		return a.FromRank - b.FromRank
+	})
+
+	for i := range tournament.Prizes {
+		prize := &tournament.Prizes[i]
+
+		if i > 0 && prize.FromRank <= tournament.Prizes[i-1].ToRank {
+			return types.ErrInvalidTournamentPrizes
+		}
+
+		if prize.ValidityDays == 0 {
+			prize.ValidityDays = types.DefaultPrizeValidityDays
+		}
+		if prize.ValidityDays < 0 {
+			return types.ErrInvalidTournamentPrizes
+		}
+
+		_, err := c.persistent.PromotionGetByID(ctx, prize.PromotionID)
+		if store.IsErrNotFound(err) {
+			return types.ErrInvalidTournamentPrizes
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecordEvent scores a game event in the running tournaments of its game
+// category. points are the loyalty points the event earned.
+func (c *component) RecordEvent(ctx context.Context, event types.GameEvent, points decimal.Decimal) error {
+	tournaments, err := c.persistent.GetRunningTournaments(ctx, event.Created)
+	if err != nil {
+		return err
+	}
+
+	for _, tournament := range tournaments {
+		if !tournament.Counts(event.GameCategory) {
+			continue
+		}
+
+		err = c.score(ctx, tournament, event, points)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *component) score(ctx context.Context, tournament types.Tournament, event types.GameEvent, points decimal.Decimal) error {
+	key := leaderboardKey(tournament.ID)
+
+	switch tournament.Scoring {
+	case types.TournamentScoringWagered:
+		switch event.Type {
+		case types.GameEventBet:
+			return c.leaderboard.LeaderboardIncrement(ctx, key, event.UserID, event.Amount.Amount.InexactFloat64())
+		case types.GameEventRollback:
+			// only bets placed during the tournament were scored
+			bet, err := c.persistent.GameEventGet(ctx, event.GameID, event.RoundID, types.GameEventBet)
+			if err != nil {
+				return err
+			}
+			if !tournament.IsRunning(bet.Created) {
+				return nil
+			}
+			return c.leaderboard.LeaderboardIncrement(ctx, key, event.UserID, event.Amount.Amount.Neg().InexactFloat64())
+		}
+	case types.TournamentScoringPoints:
+		if event.Type == types.GameEventRollback {
+			return c.unscorePoints(ctx, tournament, event)
+		}
+		if points.IsPositive() {
+			return c.leaderboard.LeaderboardIncrement(ctx, key, event.UserID, points.InexactFloat64())
+		}
+	case types.TournamentScoringMultiplier:
+		if event.Type != types.GameEventWin || !event.Amount.IsPositive() {
+			return nil
+		}
+		bet, err := c.persistent.GameEventGet(ctx, event.GameID, event.RoundID, types.GameEventBet)
+		if err != nil {
+			return err
+		}
+		if !bet.Amount.IsPositive() {
+			return nil
+		}
+		multiplier := event.Amount.Amount.Div(bet.Amount.Amount)
+		return c.leaderboard.LeaderboardMax(ctx, key, event.UserID, multiplier.InexactFloat64())
+	}
+
+	return nil
+}
+
+// unscorePoints takes the points the rolled back bet earned off the player's
+// score, when the bet was scored in the tournament.
+func (c *component) unscorePoints(ctx context.Context, tournament types.Tournament, event types.GameEvent) error {
+	bet, err := c.persistent.GameEventGet(ctx, event.GameID, event.RoundID, types.GameEventBet)
+	if err != nil {
+		return err
+	}
+	if !tournament.IsRunning(bet.Created) {
+		return nil
+	}
+
+	earned, err := c.persistent.PointsEntryGet(ctx, types.PointsSourceWager, bet.ID)
+	if store.IsErrNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !earned.Points.IsPositive() {
+		return nil
+	}
+
+	return c.leaderboard.LeaderboardIncrement(ctx, leaderboardKey(tournament.ID), event.UserID, earned.Points.Neg().InexactFloat64())
+}
+
+// GetLeaderboard returns the limit leading players of the tournament and
+// the standing of userID. Running tournaments are ranked live, finished ones
+// by their stored results.
+func (c *component) GetLeaderboard(ctx context.Context, id uuid.UUID, userID uuid.UUID, limit int) (types.Leaderboard, error) {
+	tournament, err := c.persistent.TournamentGetByID(ctx, id)
+	if err != nil {
+		return types.Leaderboard{}, err
+	}
+
+	leaderboard := types.Leaderboard{
+		Tournament: tournament,
+		Entries:    []types.LeaderboardEntry{},
+	}
+
+	if tournament.Finished != nil {
+		results, err := c.persistent.GetTournamentResults(ctx, id)
+		if err != nil {
+			return types.Leaderboard{}, err
+		}
+
+		for _, result := range results {
+			if len(leaderboard.Entries) < limit {
+				leaderboard.Entries = append(leaderboard.Entries, result.LeaderboardEntry)
+			}
+			if result.UserID == userID {
+				leaderboard.Player = &result.LeaderboardEntry
+			}
+		}
+
+		return leaderboard, nil
+	}
+
+	leaderboard.Entries, err = c.leaderboard.LeaderboardTop(ctx, leaderboardKey(id), limit)
+	if err != nil {
+		return types.Leaderboard{}, err
+	}
+
+	player, err := c.leaderboard.LeaderboardRank(ctx, leaderboardKey(id), userID)
+	if store.IsErrNotFound(err) {
+		return leaderboard, nil
+	}
+	if err != nil {
+		return types.Leaderboard{}, err
+	}
+	leaderboard.Player = &player
+
+	return leaderboard, nil
+}
+
+// DistributePrizes finishes the tournaments that ended and grants the
+// prizes of finished tournaments that were not granted yet, so a prize that
+// failed is granted again on the next run. A tournament is finished, and a
+// prize granted, by one replica only. It returns the number of prizes
+// granted.
+func (c *component) DistributePrizes(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	tournaments, err := c.persistent.GetEndedTournaments(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, tournament := range tournaments {
+		err = c.finish(ctx, tournament, now)
+		if store.IsErrNotFound(err) {
+			// already finished by another replica
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return c.grantPrizes(ctx, now)
+}
+
+// finish stores the final standings of the tournament.
+func (c *component) finish(ctx context.Context, tournament types.Tournament, now time.Time) error {
+	log := types.GetLoggerFromContext(ctx)
+	key := leaderboardKey(tournament.ID)
+
+	standings, err := c.leaderboard.LeaderboardTop(ctx, key, max(tournament.PrizeRanks(), resultsSize))
+	if err != nil {
+		return err
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	err = db.TournamentFinish(ctx, tournament.ID, now)
+	if err != nil {
+		return err
+	}
+
+	for _, standing := range standings {
+		err = db.TournamentResultCreate(ctx, types.TournamentResult{
+			LeaderboardEntry: standing,
+			TournamentID:     tournament.ID,
+			Created:          now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = c.leaderboard.LeaderboardExpire(ctx, key, leaderboardRetention)
+	if err != nil {
+		log.Errorf("failed to expire leaderboard of tournament %s: %s", tournament.ID, err)
+	}
+
+	return nil
+}
+
+// grantPrizes grants the prizes of the final standings that have no user
+// promotion yet. A prize that cannot be granted is logged and tried again on
+// the next run, unless the player can never get it.
+func (c *component) grantPrizes(ctx context.Context, now time.Time) (int, error) {
+	log := types.GetLoggerFromContext(ctx)
+
+	results, err := c.persistent.GetUnpaidTournamentResults(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tournaments := map[uuid.UUID]types.Tournament{}
+	granted := 0
+	for _, result := range results {
+		tournament, ok := tournaments[result.TournamentID]
+		if !ok {
+			tournament, err = c.persistent.TournamentGetByID(ctx, result.TournamentID)
+			if err != nil {
+				return granted, err
+			}
+			tournaments[tournament.ID] = tournament
+		}
+
+		prize, ok := tournament.Prize(result.Rank)
+		if !ok {
+			continue
+		}
+
+		err = c.grantPrize(ctx, result, prize, now)
+		if store.IsErrNotFound(err) {
+			// already granted by another replica
+			continue
+		}
+		if err != nil {
+			log.Errorf("failed to grant prize of tournament %s to user %s: %s", tournament.ID, result.UserID, err)
+			continue
+		}
+		granted++
+	}
+
+	return granted, nil
+}
+
+// grantPrize assigns the prize promotion to the player. The result stays
+// locked until the prize is recorded, so replicas do not grant it twice. A
+// prize whose promotion can no longer be assigned, or the player is not
+// eligible for, is recorded as failed and its error returned.
+func (c *component) grantPrize(ctx context.Context, result types.TournamentResult, prize types.TournamentPrize, now time.Time) error {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	_, err = db.TournamentResultLock(ctx, result.TournamentID, result.UserID)
+	if err != nil {
+		return err
+	}
+
+	promotion, err := db.PromotionGetByID(ctx, prize.PromotionID)
+	if err != nil {
+		return err
+	}
+
+	prizeErr := promotions.CheckAssignable(promotion)
+	if prizeErr == nil {
+		prizeErr = promotions.CheckEligibility(ctx, db, promotion, result.UserID)
+	}
+	if isPermanent(prizeErr) {
+		err = db.TournamentResultPrizeFail(ctx, result.TournamentID, result.UserID, prizeErr.Error())
+		if err != nil {
+			return err
+		}
+
+		err = db.CommitTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		return prizeErr
+	}
+	if prizeErr != nil {
+		return prizeErr
+	}
+
+	userPromotion, err := db.AddPromotion(ctx, types.UserPromotion{
+		ID:          uuid.New(),
+		UserID:      result.UserID,
+		PromotionID: promotion.ID,
+		StartDate:   now,
+		EndDate:     now.AddDate(0, 0, prize.ValidityDays),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = db.TournamentResultPrize(ctx, result.TournamentID, result.UserID, userPromotion.ID)
+	if err != nil {
+		return err
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userPromotion.UserID.String()), userPromotion)
+
+	return nil
+}
+
+// isPermanent reports whether err keeps a prize from ever being granted. An
+// inactive promotion may be activated again, so its prize is retried.
+func isPermanent(err error) bool {
+	return errors.Is(err, types.ErrPromotionArchived) ||
+		errors.Is(err, types.ErrPromotionNotAssignable) ||
+		errors.Is(err, types.ErrNotEligible)
+}
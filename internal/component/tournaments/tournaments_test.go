@@ -0,0 +1,546 @@
+package tournaments_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/tournaments"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
+type fields struct {
+	persistentStore store.Persistent
+	leaderboard     *fakes.FakeLeaderboard
+	pubsub          *fakes.FakePubSub
+}
+
+func TestRecordEvent(t *testing.T) {
+	type args struct {
+		event  types.GameEvent
+		points decimal.Decimal
+	}
+
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	now := time.Now()
+	tournament := func(scoring types.TournamentScoring) types.Tournament {
+		return types.Tournament{
+			ID:           uuid.New(),
+			Name:         "Weekly slots",
+			GameCategory: "slots",
+			Scoring:      scoring,
+			StartDate:    now.Add(-time.Hour),
+			EndDate:      now.Add(time.Hour),
+		}
+	}
+
+	event := func(eventType types.GameEventType, category string, amount types.Money) types.GameEvent {
+		return types.GameEvent{
+			ID:           uuid.New(),
+			UserID:       userID,
+			GameID:       "slot-1",
+			GameCategory: category,
+			RoundID:      "round-1",
+			Type:         eventType,
+			Amount:       amount,
+			Created:      now,
+		}
+	}
+
+	running := func(tournaments ...types.Tournament) func(context.Context, time.Time) ([]types.Tournament, error) {
+		return func(ctx context.Context, at time.Time) ([]types.Tournament, error) {
+			return tournaments, nil
+		}
+	}
+
+	roundBet := func(ctx context.Context, gameID string, roundID string, eventType types.GameEventType) (types.GameEvent, error) {
+		require.Equal(t, types.GameEventBet, eventType)
+		return event(types.GameEventBet, "slots", eur(2)), nil
+	}
+
+	tests := []struct {
+		name                string
+		fields              fields
+		args                args
+		expectedIncrements  []float64
+		expectedMultipliers []float64
+		expectedError       error
+	}{
+		{
+			name: "it should add bets to the total wagered",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringWagered)),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event: event(types.GameEventBet, "slots", eur(15)),
+			},
+			expectedIncrements: []float64{15},
+		},
+		{
+			name: "it should take rolled back bets off the total wagered",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringWagered)),
+					GameEventGetStub:          roundBet,
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event: event(types.GameEventRollback, "slots", eur(2)),
+			},
+			expectedIncrements: []float64{-2},
+		},
+		{
+			name: "it should keep the biggest win multiplier",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringMultiplier)),
+					GameEventGetStub:          roundBet,
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event: event(types.GameEventWin, "slots", eur(50)),
+			},
+			expectedMultipliers: []float64{25},
+		},
+		{
+			name: "it should add the points earned",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringPoints), tournament(types.TournamentScoringWagered)),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event:  event(types.GameEventBet, "slots", eur(15)),
+				points: decimal.RequireFromString("7.5"),
+			},
+			expectedIncrements: []float64{7.5, 15},
+		},
+		{
+			name: "it should take the points of rolled back bets off the score",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringPoints)),
+					GameEventGetStub:          roundBet,
+					PointsEntryGetStub: func(ctx context.Context, source types.PointsSource, referenceID uuid.UUID) (types.PointsEntry, error) {
+						require.Equal(t, types.PointsSourceWager, source)
+						return types.PointsEntry{Points: decimal.RequireFromString("1.5")}, nil
+					},
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event: event(types.GameEventRollback, "slots", eur(2)),
+			},
+			expectedIncrements: []float64{-1.5},
+		},
+		{
+			name: "it should not score games of other categories",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringWagered)),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event: event(types.GameEventBet, "table", eur(15)),
+			},
+		},
+		{
+			name: "it should return the error of finding the round bet",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetRunningTournamentsStub: running(tournament(types.TournamentScoringMultiplier)),
+					GameEventGetStub: func(ctx context.Context, gameID string, roundID string, eventType types.GameEventType) (types.GameEvent, error) {
+						return types.GameEvent{}, pgx.ErrNoRows
+					},
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+			},
+			args: args{
+				event: event(types.GameEventWin, "slots", eur(50)),
+			},
+			expectedError: pgx.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tournaments.New(tt.fields.persistentStore, tt.fields.leaderboard, &fakes.FakePubSub{})
+			err := c.RecordEvent(context.Background(), tt.args.event, tt.args.points)
+
+			require.ErrorIs(t, err, tt.expectedError)
+
+			require.Equal(t, len(tt.expectedIncrements), tt.fields.leaderboard.LeaderboardIncrementCallCount())
+			for i, expected := range tt.expectedIncrements {
+				_, _, user, score := tt.fields.leaderboard.LeaderboardIncrementArgsForCall(i)
+				require.Equal(t, userID, user)
+				require.Equal(t, expected, score)
+			}
+
+			require.Equal(t, len(tt.expectedMultipliers), tt.fields.leaderboard.LeaderboardMaxCallCount())
+			for i, expected := range tt.expectedMultipliers {
+				_, _, _, score := tt.fields.leaderboard.LeaderboardMaxArgsForCall(i)
+				require.Equal(t, expected, score)
+			}
+		})
+	}
+}
+
+func TestDistributePrizes(t *testing.T) {
+	now := time.Now()
+
+	tournament := types.Tournament{
+		ID:        uuid.New(),
+		Name:      "Weekly slots",
+		Scoring:   types.TournamentScoringWagered,
+		StartDate: now.Add(-7 * 24 * time.Hour),
+		EndDate:   now.Add(-time.Minute),
+		Prizes: []types.TournamentPrize{
+			{FromRank: 1, ToRank: 1, PromotionID: uuid.New(), ValidityDays: 7},
+			{FromRank: 2, ToRank: 3, PromotionID: uuid.New(), ValidityDays: 7},
+		},
+	}
+
+	standings := []types.LeaderboardEntry{
+		{Rank: 1, UserID: uuid.New(), Score: 900},
+		{Rank: 2, UserID: uuid.New(), Score: 500},
+		{Rank: 3, UserID: uuid.New(), Score: 300},
+		{Rank: 4, UserID: uuid.New(), Score: 100},
+	}
+
+	var unpaid []types.TournamentResult
+	for _, standing := range standings[:3] {
+		unpaid = append(unpaid, types.TournamentResult{LeaderboardEntry: standing, TournamentID: tournament.ID})
+	}
+
+	ended := func(tournaments ...types.Tournament) func(context.Context, time.Time) ([]types.Tournament, error) {
+		return func(ctx context.Context, at time.Time) ([]types.Tournament, error) {
+			return tournaments, nil
+		}
+	}
+
+	unpaidResults := func(results ...types.TournamentResult) func(context.Context) ([]types.TournamentResult, error) {
+		return func(ctx context.Context) ([]types.TournamentResult, error) {
+			return results, nil
+		}
+	}
+
+	getTournament := func(ctx context.Context, id uuid.UUID) (types.Tournament, error) {
+		require.Equal(t, tournament.ID, id)
+		return tournament, nil
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	top := func(ctx context.Context, key string, limit int) ([]types.LeaderboardEntry, error) {
+		return standings, nil
+	}
+
+	promotion := func(promotion types.Promotion) func(context.Context, uuid.UUID) (types.Promotion, error) {
+		return func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+			promotion.ID = id
+			return promotion, nil
+		}
+	}
+
+	assigned := func(ctx context.Context, up types.UserPromotion) (types.UserPromotion, error) {
+		require.Equal(t, up.StartDate.AddDate(0, 0, 7), up.EndDate)
+		return up, nil
+	}
+
+	archived := now.Add(-time.Hour)
+
+	tests := []struct {
+		name            string
+		fields          fields
+		expectedGranted int
+		expectedFailed  int
+		expectedResults int
+		expectedError   error
+	}{
+		{
+			name: "it should store the standings and grant the prizes",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(tournament),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid...),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionGetByIDStub: promotion(types.Promotion{IsActive: true}),
+						AddPromotionStub:     assigned,
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{
+					LeaderboardTopStub: top,
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedGranted: 3,
+			expectedResults: 4,
+		},
+		{
+			name: "it should skip tournaments finished by another replica",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub: ended(tournament),
+					WithTxStub: tx(&fakes.FakePersistent{
+						TournamentFinishStub: func(ctx context.Context, id uuid.UUID, finished time.Time) error {
+							return pgx.ErrNoRows
+						},
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{
+					LeaderboardTopStub: top,
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+		},
+		{
+			name: "it should keep granting when a prize fails",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(tournament),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid...),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionGetByIDStub: promotion(types.Promotion{IsActive: true}),
+						AddPromotionStub: func(ctx context.Context, up types.UserPromotion) (types.UserPromotion, error) {
+							if up.UserID == standings[0].UserID {
+								return types.UserPromotion{}, errors.New("connection reset")
+							}
+							return up, nil
+						},
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{
+					LeaderboardTopStub: top,
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedGranted: 2,
+			expectedResults: 4,
+		},
+		{
+			name: "it should record prizes of archived promotions as failed",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid...),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+							if id == tournament.Prizes[0].PromotionID {
+								return types.Promotion{ID: id, Archived: &archived}, nil
+							}
+							return types.Promotion{ID: id, IsActive: true}, nil
+						},
+						AddPromotionStub: assigned,
+						TournamentResultPrizeFailStub: func(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID, reason string) error {
+							require.Equal(t, standings[0].UserID, userID)
+							require.Equal(t, types.ErrPromotionArchived.Error(), reason)
+							return nil
+						},
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+				pubsub:      &fakes.FakePubSub{},
+			},
+			expectedGranted: 2,
+			expectedFailed:  1,
+		},
+		{
+			name: "it should record prizes the player is not eligible for as failed",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid[0]),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionGetByIDStub: promotion(types.Promotion{
+							IsActive:    true,
+							Eligibility: &types.EligibilityRules{MinAccountAgeDays: 30},
+						}),
+						GetEligibilityProfileStub: func(ctx context.Context, userID uuid.UUID) (types.EligibilityProfile, error) {
+							return types.EligibilityProfile{UserID: userID, Created: now}, nil
+						},
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+				pubsub:      &fakes.FakePubSub{},
+			},
+			expectedFailed: 1,
+		},
+		{
+			name: "it should retry prizes of inactive promotions",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid[0]),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionGetByIDStub: promotion(types.Promotion{IsActive: false}),
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+				pubsub:      &fakes.FakePubSub{},
+			},
+		},
+		{
+			name: "it should retry prizes of finished tournaments",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid[0]),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						PromotionGetByIDStub: promotion(types.Promotion{IsActive: true}),
+						AddPromotionStub: func(ctx context.Context, up types.UserPromotion) (types.UserPromotion, error) {
+							require.Equal(t, tournament.Prizes[0].PromotionID, up.PromotionID)
+							return up, nil
+						},
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+				pubsub:      &fakes.FakePubSub{},
+			},
+			expectedGranted: 1,
+		},
+		{
+			name: "it should skip prizes granted by another replica",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetEndedTournamentsStub:        ended(),
+					GetUnpaidTournamentResultsStub: unpaidResults(unpaid[0]),
+					TournamentGetByIDStub:          getTournament,
+					WithTxStub: tx(&fakes.FakePersistent{
+						TournamentResultLockStub: func(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID) (types.TournamentResult, error) {
+							return types.TournamentResult{}, pgx.ErrNoRows
+						},
+					}),
+				},
+				leaderboard: &fakes.FakeLeaderboard{},
+				pubsub:      &fakes.FakePubSub{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tournaments.New(tt.fields.persistentStore, tt.fields.leaderboard, tt.fields.pubsub)
+			granted, err := c.DistributePrizes(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedGranted, granted)
+
+			persistent := tt.fields.persistentStore.(*fakes.FakePersistent)
+			db, _ := persistent.WithTx(context.Background())
+			fake := db.(*fakes.FakePersistent)
+			require.Equal(t, tt.expectedResults, fake.TournamentResultCreateCallCount())
+			require.Equal(t, tt.expectedGranted, fake.TournamentResultPrizeCallCount())
+			require.Equal(t, tt.expectedFailed, fake.TournamentResultPrizeFailCallCount())
+			// players are notified of the prizes that were committed
+			require.Equal(t, tt.expectedGranted, tt.fields.pubsub.PublishCallCount())
+		})
+	}
+}
+
+func TestCreateTournament(t *testing.T) {
+	now := time.Now()
+	promotionID := uuid.New()
+
+	persistent := &fakes.FakePersistent{
+		PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+			if id != promotionID {
+				return types.Promotion{}, pgx.ErrNoRows
+			}
+			return types.Promotion{ID: id, IsActive: true}, nil
+		},
+		TournamentCreateStub: func(ctx context.Context, tournament types.Tournament) (types.Tournament, error) {
+			return tournament, nil
+		},
+	}
+
+	tests := []struct {
+		name          string
+		prizes        []types.TournamentPrize
+		endDate       time.Time
+		expectedError error
+	}{
+		{
+			name: "it should create a tournament",
+			prizes: []types.TournamentPrize{
+				{FromRank: 2, ToRank: 10, PromotionID: promotionID},
+				{FromRank: 1, ToRank: 1, PromotionID: promotionID},
+			},
+			endDate: now.Add(7 * 24 * time.Hour),
+		},
+		{
+			name: "it should reject overlapping prize ranks",
+			prizes: []types.TournamentPrize{
+				{FromRank: 1, ToRank: 3, PromotionID: promotionID},
+				{FromRank: 3, ToRank: 10, PromotionID: promotionID},
+			},
+			endDate:       now.Add(7 * 24 * time.Hour),
+			expectedError: types.ErrInvalidTournamentPrizes,
+		},
+		{
+			name: "it should reject prizes of unknown promotions",
+			prizes: []types.TournamentPrize{
+				{FromRank: 1, ToRank: 1, PromotionID: uuid.New()},
+			},
+			endDate:       now.Add(7 * 24 * time.Hour),
+			expectedError: types.ErrInvalidTournamentPrizes,
+		},
+		{
+			name:          "it should reject a tournament ending before it starts",
+			endDate:       now.Add(-time.Hour),
+			expectedError: types.ErrStartAfterEndDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tournaments.New(persistent, &fakes.FakeLeaderboard{}, &fakes.FakePubSub{})
+			tournament, err := c.CreateTournament(context.Background(), types.Tournament{
+				Name:      "Weekly slots",
+				Scoring:   types.TournamentScoringWagered,
+				StartDate: now,
+				EndDate:   tt.endDate,
+				Prizes:    tt.prizes,
+			})
+
+			require.ErrorIs(t, err, tt.expectedError)
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, 1, tournament.Prizes[0].FromRank)
+			for _, prize := range tournament.Prizes {
+				require.Equal(t, types.DefaultPrizeValidityDays, prize.ValidityDays)
+			}
+		})
+	}
+}
@@ -2,36 +2,65 @@ package userpromotion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 )
 
 type UserPromotionProvider interface {
 	AddPromotion(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error)
-	AddWelcomePromotion(ctx context.Context, userID uuid.UUID) (types.UserPromotion, error)
-	GetUserPromotions(ctx context.Context, userID uuid.UUID) ([]types.UserPromotion, error)
+	StartWelcomePackage(ctx context.Context, userID uuid.UUID) ([]types.UserPromotion, error)
+	UnlockWelcomeSteps(ctx context.Context) (int, error)
+	GetUserWelcomePackage(ctx context.Context, userID uuid.UUID) (types.UserWelcomePackage, error)
+	GetUserPromotions(ctx context.Context, userID uuid.UUID, filter types.UserPromotionFilter) ([]types.UserPromotion, error)
 	GetUserPromotionByID(ctx context.Context, userPromotionID uuid.UUID) (types.UserPromotion, error)
 	ClaimPromotion(ctx context.Context, userPromotionID uuid.UUID) error
 	DeleteUserPromotion(ctx context.Context, userPromotionID uuid.UUID) error
+	RevokePromotion(ctx context.Context, revocation types.UserPromotionRevocation) (types.UserPromotionRevocation, error)
+	GetUserPromotionRevocations(ctx context.Context, userID uuid.UUID) ([]types.UserPromotionRevocation, error)
+	RecordWager(ctx context.Context, userID uuid.UUID, betID uuid.UUID, amount types.Money) error
+	RevertWager(ctx context.Context, userID uuid.UUID, betID uuid.UUID) error
+	ForfeitExpiredBonuses(ctx context.Context) (int, error)
+	ExpireUserPromotions(ctx context.Context) (int, error)
+	RemindExpiringPromotions(ctx context.Context) (int, error)
 	ListenToRegisterEvent(ctx context.Context) error
 }
 
+const (
+	forfeitBatchSize = 100
+	unlockBatchSize  = 100
+)
+
 type component struct {
-	persistent store.Persistent
-	pubsub     store.PubSub
+	persistent           store.Persistent
+	pubsub               store.PubSub
+	budgetAlertThreshold decimal.Decimal
+	clawbackPolicy       types.ClawbackPolicy
+	reminderLeadTimes    []time.Duration
 }
 
 var _ UserPromotionProvider = (*component)(nil)
 
-func New(persistent store.Persistent, pubsub store.PubSub) *component {
+// New returns the user promotion component. Staff are alerted when claims
+// spend budgetAlertThreshold of a promotion's budget. Revoked claims are
+// taken back under clawbackPolicy. Players are reminded of unclaimed
+// promotions each of reminderLeadTimes before they end.
+func New(persistent store.Persistent, pubsub store.PubSub, budgetAlertThreshold decimal.Decimal, clawbackPolicy types.ClawbackPolicy, reminderLeadTimes []time.Duration) *component {
 	comp := &component{
-		persistent: persistent,
-		pubsub:     pubsub,
+		persistent:           persistent,
+		pubsub:               pubsub,
+		budgetAlertThreshold: budgetAlertThreshold,
+		clawbackPolicy:       clawbackPolicy,
+		reminderLeadTimes:    slices.Sorted(slices.Values(reminderLeadTimes)),
 	}
 
 	go func() {
@@ -56,8 +85,14 @@ func (c *component) AddPromotion(ctx context.Context, userPromotion types.UserPr
 		return types.UserPromotion{}, err
 	}
 
-	if !promotion.IsActive {
-		return types.UserPromotion{}, types.ErrPromotionNoLongerActive
+	err = promotions.CheckAssignable(promotion)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	err = promotions.CheckEligibility(ctx, c.persistent, promotion, userPromotion.UserID)
+	if err != nil {
+		return types.UserPromotion{}, err
 	}
 
 	up, err := c.persistent.AddPromotion(ctx, userPromotion)
@@ -70,14 +105,79 @@ func (c *component) AddPromotion(ctx context.Context, userPromotion types.UserPr
 	return up, err
 }
 
-func (c *component) AddWelcomePromotion(ctx context.Context, userID uuid.UUID) (types.UserPromotion, error) {
+// StartWelcomePackage starts the user on the current version of the welcome
+// package and assigns the promotions of the steps that unlock at
+// registration. Without a welcome package the welcome bonus promotion is
+// assigned for a day.
+func (c *component) StartWelcomePackage(ctx context.Context, userID uuid.UUID) ([]types.UserPromotion, error) {
+	welcomePackage, err := c.persistent.WelcomePackageGetCurrent(ctx)
+	if store.IsErrNotFound(err) {
+		userPromotion, err := c.addWelcomeBonus(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return []types.UserPromotion{userPromotion}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.RollbackTx(ctx)
+
+	started, err := db.UserWelcomePackageCreate(ctx, userID, welcomePackage.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return nil, types.ErrWelcomePackageStarted
+	}
+
+	now := time.Now()
+	var userPromotions []types.UserPromotion
+	for _, step := range welcomePackage.Steps {
+		if step.Unlock != types.WelcomeUnlockRegistration {
+			continue
+		}
+
+		userPromotion, err := unlockWelcomeStep(ctx, db, userID, step, now)
+		if err != nil {
+			return nil, err
+		}
+		if userPromotion != nil {
+			userPromotions = append(userPromotions, *userPromotion)
+		}
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, userPromotion := range userPromotions {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, userID.String()), userPromotion)
+	}
+
+	return userPromotions, nil
+}
+
+func (c *component) addWelcomeBonus(ctx context.Context, userID uuid.UUID) (types.UserPromotion, error) {
 	promotion, err := c.persistent.PromotionGetByType(ctx, types.WelcomeBonus)
 	if err != nil {
 		return types.UserPromotion{}, err
 	}
 
-	if !promotion.IsActive {
-		return types.UserPromotion{}, types.ErrPromotionNoLongerActive
+	err = promotions.CheckAssignable(promotion)
+	if err != nil {
+		return types.UserPromotion{}, err
+	}
+
+	err = promotions.CheckEligibility(ctx, c.persistent, promotion, userID)
+	if err != nil {
+		return types.UserPromotion{}, err
 	}
 
 	uP := types.UserPromotion{
@@ -100,8 +200,128 @@ func (c *component) AddWelcomePromotion(ctx context.Context, userID uuid.UUID) (
 	return userPromotion, err
 }
 
+// UnlockWelcomeSteps unlocks the steps of the players' welcome packages
+// whose deposit was made and assigns their promotions. A step is unlocked by
+// one replica only. It returns the number of steps unlocked.
+func (c *component) UnlockWelcomeSteps(ctx context.Context) (int, error) {
+	unlocked := 0
+	for {
+		steps, err := c.persistent.GetUnlockedWelcomeSteps(ctx, unlockBatchSize)
+		if err != nil {
+			return unlocked, err
+		}
+
+		for _, step := range steps {
+			err = c.unlockDepositStep(ctx, step)
+			if errors.Is(err, types.ErrWelcomeStepUnlocked) {
+				// already unlocked by another replica
+				continue
+			}
+			if err != nil {
+				return unlocked, err
+			}
+			unlocked++
+		}
+
+		if len(steps) < unlockBatchSize {
+			return unlocked, nil
+		}
+	}
+}
+
+func (c *component) unlockDepositStep(ctx context.Context, step types.UnlockedWelcomeStep) error {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	userPromotion, err := unlockWelcomeStep(ctx, db, step.UserID, step.WelcomeStep, step.Unlocked)
+	if err != nil {
+		return err
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if userPromotion != nil {
+		c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, step.UserID.String()), *userPromotion)
+	}
+
+	return nil
+}
+
+// unlockWelcomeStep records the step of the user's welcome package as
+// unlocked at unlocked and assigns its promotion for the validity of the
+// step from then, so a match bonus matches the deposit that unlocked it and
+// a step unlocked by a late run is not valid for longer. A promotion that no
+// longer exists or can be assigned, or the user is not eligible for, is
+// skipped and nil is returned. It returns ErrWelcomeStepUnlocked when the step was
+// already unlocked.
+func unlockWelcomeStep(ctx context.Context, db store.Persistent, userID uuid.UUID, step types.WelcomeStep, unlocked time.Time) (*types.UserPromotion, error) {
+	var skipped error
+
+	promotion, err := db.PromotionGetByID(ctx, step.PromotionID)
+	switch {
+	case store.IsErrNotFound(err):
+		skipped = err
+	case err != nil:
+		return nil, err
+	default:
+		skipped = promotions.CheckAssignable(promotion)
+	}
+
+	if skipped == nil {
+		skipped = promotions.CheckEligibility(ctx, db, promotion, userID)
+		if skipped != nil && !errors.Is(skipped, types.ErrNotEligible) {
+			return nil, skipped
+		}
+	}
+
+	var (
+		userPromotion   *types.UserPromotion
+		userPromotionID uuid.NullUUID
+	)
+	if skipped != nil {
+		types.GetLoggerFromContext(ctx).Infof("skipped step %d of the welcome package of user %s: %s", step.Step, userID, skipped)
+	} else {
+		assigned, err := db.AddPromotion(ctx, types.UserPromotion{
+			ID:          uuid.New(),
+			UserID:      userID,
+			PromotionID: promotion.ID,
+			StartDate:   unlocked,
+			EndDate:     unlocked.Add(time.Duration(step.ValidityHours) * time.Hour),
+		})
+		if err != nil {
+			return nil, err
+		}
+		userPromotion = &assigned
+		userPromotionID = uuid.NullUUID{UUID: assigned.ID, Valid: true}
+	}
+
+	created, err := db.UserWelcomeStepCreate(ctx, userID, step.Step, userPromotionID, unlocked)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return nil, types.ErrWelcomeStepUnlocked
+	}
+
+	return userPromotion, nil
+}
+
+func (c *component) GetUserWelcomePackage(ctx context.Context, userID uuid.UUID) (types.UserWelcomePackage, error) {
+	return c.persistent.UserWelcomePackageGet(ctx, userID)
+}
+
 func (c *component) ClaimPromotion(ctx context.Context, userPromotionID uuid.UUID) error {
 	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
 
 	userPromotion, err := db.GetUserPromotionByID(ctx, userPromotionID)
 	if err != nil {
@@ -112,6 +332,13 @@ func (c *component) ClaimPromotion(ctx context.Context, userPromotionID uuid.UUI
 		return types.ErrPromotionClaimed
 	}
 
+	switch userPromotion.Status {
+	case types.UserPromotionExpired:
+		return types.ErrPromotionExpired
+	case types.UserPromotionRevoked:
+		return types.ErrPromotionRevoked
+	}
+
 	if !userPromotion.Promotion.IsActive {
 		return types.ErrPromotionNoLongerActive
 	}
@@ -124,22 +351,346 @@ func (c *component) ClaimPromotion(ctx context.Context, userPromotionID uuid.UUI
 		return types.ErrPromotionExpired
 	}
 
-	err = db.ClaimPromotion(ctx, userPromotion.ID)
+	user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: userPromotion.UserID, Valid: true}})
 	if err != nil {
 		return err
 	}
 
-	user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: userPromotion.UserID, Valid: true}})
+	if user.Balance.Currency != userPromotion.Promotion.Amount.Currency {
+		return types.ErrCurrencyMismatch
+	}
+
+	err = bonusAmount(ctx, db, &userPromotion)
 	if err != nil {
 		return err
 	}
+	userPromotion.WageringRequired = userPromotion.Promotion.WageringRequirement(userPromotion.BonusAmount)
 
-	_, err = db.UserBalanceUpdate(ctx, user.ID, userPromotion.Promotion.Amount)
+	freeSpins := userPromotion.Promotion.Type == types.FreeSpins
+
+	// without a wagering requirement the bonus is paid out as cash right away,
+	// free spins pay out their winnings once they are settled
+	now := time.Now()
+	newEntry := types.NewPlayerBonusEntry
+	if userPromotion.WageringRequired.IsZero() && !freeSpins {
+		userPromotion.Converted = &now
+		newEntry = types.NewPlayerCashEntry
+	}
+
+	spend := userPromotion.BonusAmount.Amount
+	if freeSpins {
+		rule := userPromotion.Promotion.FreeSpins
+		spend = rule.Stake.Mul(decimal.NewFromInt(int64(rule.Spins)))
+	}
+
+	alert, err := c.spend(ctx, db, userPromotion, spend)
+	if err != nil {
+		return err
+	}
+
+	err = db.ClaimPromotion(ctx, userPromotion)
+	if store.IsErrConflict(err) {
+		// the deposit was matched by a concurrent claim
+		return types.ErrNoQualifyingDeposit
+	}
+	if err != nil {
+		return err
+	}
+
+	if freeSpins {
+		rule := userPromotion.Promotion.FreeSpins
+		err = db.FreeSpinsCreate(ctx, types.FreeSpinsEntitlement{
+			ID:              uuid.New(),
+			UserPromotionID: userPromotion.ID,
+			UserID:          userPromotion.UserID,
+			GameID:          rule.GameID,
+			Spins:           rule.Spins,
+			Remaining:       rule.Spins,
+			Stake:           types.NewMoney(rule.Stake, userPromotion.Promotion.Amount.Currency),
+			Expires:         now.AddDate(0, 0, rule.ValidityDays),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = db.CommitTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.publishAlert(ctx, alert)
+
+		return nil
+	}
+
+	_, err = db.UserBalanceUpdate(ctx, newEntry(
+		userPromotion.UserID,
+		types.LedgerSourcePromotionClaim,
+		uuid.NullUUID{UUID: userPromotion.ID, Valid: true},
+		userPromotion.BonusAmount,
+	))
 	if err != nil {
 		return err
 	}
 
 	err = db.CommitTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.publishAlert(ctx, alert)
+
+	return nil
+}
+
+// spend counts the claim of amount against the limits of the promotion. It
+// returns an alert for staff when the claim makes the spend reach the alert
+// threshold of the budget.
+func (c *component) spend(ctx context.Context, db store.Persistent, userPromotion types.UserPromotion, amount decimal.Decimal) (*types.PromotionBudgetAlert, error) {
+	promotion, err := db.PromotionSpend(ctx, userPromotion.PromotionID, amount)
+	if store.IsErrNotFound(err) {
+		promotion, err = db.PromotionGetByID(ctx, userPromotion.PromotionID)
+		if err != nil {
+			return nil, err
+		}
+
+		budget := promotion.Budget
+		if budget != nil && budget.MaxClaims != nil && budget.Claims >= *budget.MaxClaims {
+			return nil, types.ErrClaimLimitReached
+		}
+
+		return nil, types.ErrBudgetExhausted
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	budget := promotion.Budget
+	if budget == nil {
+		return nil, nil
+	}
+
+	if budget.MaxClaimsPerUser != nil {
+		// the spend locked the promotion until the claim commits, so claims
+		// of the same user cannot be counted concurrently
+		claims, err := db.UserPromotionClaimCount(ctx, userPromotion.UserID, promotion.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if claims >= *budget.MaxClaimsPerUser {
+			return nil, types.ErrUserClaimLimitReached
+		}
+	}
+
+	if budget.Amount == nil {
+		return nil, nil
+	}
+
+	threshold := budget.Amount.Mul(c.budgetAlertThreshold)
+	if budget.Spent.LessThan(threshold) || budget.Spent.Sub(amount).GreaterThanOrEqual(threshold) {
+		return nil, nil
+	}
+
+	return &types.PromotionBudgetAlert{
+		PromotionID: promotion.ID,
+		Title:       promotion.Title,
+		Budget:      types.NewMoney(*budget.Amount, promotion.Amount.Currency),
+		Spent:       types.NewMoney(budget.Spent, promotion.Amount.Currency),
+		Threshold:   c.budgetAlertThreshold,
+	}, nil
+}
+
+func (c *component) publishAlert(ctx context.Context, alert *types.PromotionBudgetAlert) {
+	if alert != nil {
+		c.pubsub.Publish(ctx, redis_pub_sub.StaffNotificationsChannel, alert)
+	}
+}
+
+// bonusAmount sets the bonus the user promotion pays out when claimed. A
+// match bonus matches the latest deposit made since the user promotion
+// started that was not matched yet, cashback and referral bonuses were set
+// when they were granted, free spins have no bonus until their winnings are
+// settled and other promotions pay their fixed amount.
+func bonusAmount(ctx context.Context, db store.Persistent, userPromotion *types.UserPromotion) error {
+	promotion := userPromotion.Promotion
+
+	switch promotion.Type {
+	case types.Cashback, types.ReferralBonus:
+		return nil
+	case types.FreeSpins:
+		userPromotion.BonusAmount = types.NewMoney(decimal.Zero, promotion.Amount.Currency)
+	case types.MatchBonus:
+		deposit, err := db.GetUnmatchedDeposit(ctx, userPromotion.UserID, userPromotion.StartDate,
+			types.NewMoney(promotion.MatchBonus.MinDeposit, promotion.Amount.Currency))
+		if store.IsErrNotFound(err) {
+			return types.ErrNoQualifyingDeposit
+		}
+		if err != nil {
+			return err
+		}
+
+		userPromotion.DepositID = uuid.NullUUID{UUID: deposit.ID, Valid: true}
+		userPromotion.BonusAmount = types.NewMoney(promotion.MatchBonus.Amount(deposit.Amount.Amount), promotion.Amount.Currency)
+	default:
+		userPromotion.BonusAmount = promotion.Amount
+	}
+
+	return nil
+}
+
+// RecordWager counts amount of the bet betID towards the wagering
+// requirement of the user's active bonuses and converts the ones that are
+// met to cash.
+func (c *component) RecordWager(ctx context.Context, userID uuid.UUID, betID uuid.UUID, amount types.Money) error {
+	if !amount.IsPositive() {
+		return types.ErrInvalidAmount
+	}
+
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	userPromotions, err := db.UserPromotionsWager(ctx, userID, betID, amount)
+	if err != nil {
+		return err
+	}
+
+	for _, userPromotion := range userPromotions {
+		if !userPromotion.IsWageringMet() {
+			continue
+		}
+
+		err = db.UserPromotionConvert(ctx, userPromotion.ID)
+		if err != nil {
+			return err
+		}
+
+		err = moveBonus(ctx, db, userPromotion, types.NewBonusConversionEntry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return db.CommitTx(ctx)
+}
+
+// RevertWager takes the wager of the rolled back bet betID off the bonuses
+// it counted towards. Bonuses it already converted to cash stay converted.
+func (c *component) RevertWager(ctx context.Context, userID uuid.UUID, betID uuid.UUID) error {
+	_, err := c.persistent.UserPromotionsUnwager(ctx, userID, betID)
+	return err
+}
+
+// ForfeitExpiredBonuses removes the remaining funds of bonuses whose user
+// promotion ended before the wagering requirement was met. Batches are
+// forfeited until no expired bonus is left.
+func (c *component) ForfeitExpiredBonuses(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	forfeited := 0
+	for {
+		userPromotions, err := c.persistent.GetExpiredUserPromotionBonuses(ctx, now, forfeitBatchSize)
+		if err != nil {
+			return forfeited, err
+		}
+
+		for _, userPromotion := range userPromotions {
+			err = c.forfeitBonus(ctx, userPromotion)
+			if store.IsErrNotFound(err) {
+				// already settled by another replica
+				continue
+			}
+			if err != nil {
+				return forfeited, err
+			}
+			forfeited++
+		}
+
+		if len(userPromotions) < forfeitBatchSize {
+			return forfeited, nil
+		}
+	}
+}
+
+// ExpireUserPromotions marks the promotions that were not claimed before
+// their end date as expired and returns how many expired.
+func (c *component) ExpireUserPromotions(ctx context.Context) (int, error) {
+	return c.persistent.UserPromotionsExpire(ctx, time.Now())
+}
+
+// RemindExpiringPromotions reminds players of their unclaimed promotions that
+// end within one of the lead times and returns how many reminders were sent.
+// Shorter lead times go first, so a promotion assigned close to its end date
+// gets only the most urgent reminder instead of all of them at once. Each
+// reminder is recorded once, so replicas running the job concurrently
+// notify a player once.
+func (c *component) RemindExpiringPromotions(ctx context.Context) (int, error) {
+	now := time.Now()
+	reminded := 0
+
+	for _, leadTime := range c.reminderLeadTimes {
+		reminders, err := c.persistent.UserPromotionsRemind(ctx, now, leadTime)
+		if err != nil {
+			return reminded, err
+		}
+
+		for _, reminder := range reminders {
+			c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, reminder.UserID.String()), reminder)
+		}
+		reminded += len(reminders)
+	}
+
+	return reminded, nil
+}
+
+func (c *component) forfeitBonus(ctx context.Context, userPromotion types.UserPromotion) error {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.RollbackTx(ctx)
+
+	err = db.UserPromotionForfeit(ctx, userPromotion.ID)
+	if err != nil {
+		return err
+	}
+
+	err = moveBonus(ctx, db, userPromotion, func(userID uuid.UUID, referenceID uuid.NullUUID, amount types.Money) types.LedgerEntry {
+		return types.NewPlayerBonusEntry(userID, types.LedgerSourceBonusForfeit, referenceID, amount.Neg())
+	})
+	if err != nil {
+		return err
+	}
+
+	return db.CommitTx(ctx)
+}
+
+// moveBonus books the settled bonus out of the bonus balance. Bonus funds
+// may already have been lost in play, so at most the remaining bonus balance
+// is moved.
+func moveBonus(ctx context.Context, db store.Persistent, userPromotion types.UserPromotion, newEntry func(userID uuid.UUID, referenceID uuid.NullUUID, amount types.Money) types.LedgerEntry) error {
+	user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: userPromotion.UserID, Valid: true}})
+	if err != nil {
+		return err
+	}
+
+	amount := types.NewMoney(
+		decimal.Min(userPromotion.BonusAmount.Amount, user.BonusBalance.Amount),
+		userPromotion.BonusAmount.Currency,
+	)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	_, err = db.UserBalanceUpdate(ctx, newEntry(
+		userPromotion.UserID,
+		uuid.NullUUID{UUID: userPromotion.ID, Valid: true},
+		amount,
+	))
+
 	return err
 }
 
@@ -147,12 +698,138 @@ func (c *component) DeleteUserPromotion(ctx context.Context, userPromotionID uui
 	return c.persistent.DeleteUserPromotion(ctx, userPromotionID)
 }
 
+// RevokePromotion revokes an assigned or claimed user promotion for the
+// reason of the revocation. What a claim credited is taken back from the
+// account it is in under the clawback policy, unplayed free spins are voided.
+// The player is notified and the revocation is recorded for compliance.
+func (c *component) RevokePromotion(ctx context.Context, revocation types.UserPromotionRevocation) (types.UserPromotionRevocation, error) {
+	db, err := c.persistent.WithTx(ctx)
+	if err != nil {
+		return types.UserPromotionRevocation{}, err
+	}
+	defer db.RollbackTx(ctx)
+
+	userPromotion, err := db.GetUserPromotionByID(ctx, revocation.UserPromotionID)
+	if err != nil {
+		return types.UserPromotionRevocation{}, err
+	}
+
+	if userPromotion.UserID != revocation.UserID {
+		return types.UserPromotionRevocation{}, pgx.ErrNoRows
+	}
+
+	switch userPromotion.Status {
+	case types.UserPromotionAssigned, types.UserPromotionClaimed:
+	case types.UserPromotionRevoked:
+		return types.UserPromotionRevocation{}, types.ErrPromotionRevoked
+	default:
+		return types.UserPromotionRevocation{}, types.ErrPromotionNotRevocable
+	}
+
+	// free spins are voided first, so a concurrent settlement either pays out
+	// before the revocation reads the bonus or finds them closed
+	if userPromotion.Status == types.UserPromotionClaimed && userPromotion.Promotion.Type == types.FreeSpins {
+		err = db.FreeSpinsVoid(ctx, userPromotion.ID)
+		if err != nil {
+			return types.UserPromotionRevocation{}, err
+		}
+	}
+
+	revoked, err := db.UserPromotionRevoke(ctx, userPromotion.ID, userPromotion.Status)
+	if store.IsErrNotFound(err) {
+		return types.UserPromotionRevocation{}, types.ErrUserPromotionChanged
+	}
+	if err != nil {
+		return types.UserPromotionRevocation{}, err
+	}
+
+	currency := revoked.BonusAmount.Currency
+	revocation.ID = uuid.New()
+	revocation.PromotionID = revoked.PromotionID
+	revocation.PreviousStatus = userPromotion.Status
+	revocation.Credited = types.NewMoney(decimal.Zero, currency)
+	revocation.ClawedBack = types.NewMoney(decimal.Zero, currency)
+	revocation.Policy = c.clawbackPolicy
+	revocation.Created = time.Now()
+
+	if userPromotion.Status == types.UserPromotionClaimed {
+		revocation.Credited = revoked.BonusAmount
+		revocation.ClawedBack, revocation.Account, err = c.clawBack(ctx, db, revoked)
+		if err != nil {
+			return types.UserPromotionRevocation{}, err
+		}
+	}
+
+	err = db.UserPromotionRevocationCreate(ctx, revocation)
+	if err != nil {
+		return types.UserPromotionRevocation{}, err
+	}
+
+	err = db.CommitTx(ctx)
+	if err != nil {
+		return types.UserPromotionRevocation{}, err
+	}
+
+	c.pubsub.Publish(ctx, fmt.Sprintf("%s:%s", redis_pub_sub.NotificationsChannel, revocation.UserID.String()), types.PromotionRevokedNotice{
+		UserID:          revocation.UserID,
+		UserPromotionID: revocation.UserPromotionID,
+		PromotionID:     revocation.PromotionID,
+		Reason:          revocation.Reason,
+		ClawedBack:      revocation.ClawedBack,
+	})
+
+	return revocation, nil
+}
+
+// clawBack debits the bonus of the revoked claim from the account it is in:
+// cash once converted, the bonus balance while it is wagered. Capped at zero,
+// only what is left in the account is taken back.
+func (c *component) clawBack(ctx context.Context, db store.Persistent, userPromotion types.UserPromotion) (types.Money, types.LedgerAccount, error) {
+	account := types.LedgerAccountPlayerBonus
+	newEntry := types.NewPlayerBonusEntry
+	if userPromotion.Converted != nil {
+		account = types.LedgerAccountPlayerCash
+		newEntry = types.NewPlayerCashEntry
+	}
+
+	amount := userPromotion.BonusAmount
+	if c.clawbackPolicy == types.ClawbackCapAtZero {
+		user, err := db.UserGetBy(ctx, types.UserFilter{ByID: uuid.NullUUID{UUID: userPromotion.UserID, Valid: true}})
+		if err != nil {
+			return types.Money{}, "", err
+		}
+
+		balance := user.BonusBalance.Amount
+		if account == types.LedgerAccountPlayerCash {
+			balance = user.Balance.Amount
+		}
+		amount.Amount = decimal.Max(decimal.Min(amount.Amount, balance), decimal.Zero)
+	}
+
+	if !amount.IsPositive() {
+		return amount, account, nil
+	}
+
+	_, err := db.UserBalanceUpdate(ctx, newEntry(
+		userPromotion.UserID,
+		types.LedgerSourceClawback,
+		uuid.NullUUID{UUID: userPromotion.ID, Valid: true},
+		amount.Neg(),
+	))
+
+	return amount, account, err
+}
+
+func (c *component) GetUserPromotionRevocations(ctx context.Context, userID uuid.UUID) ([]types.UserPromotionRevocation, error) {
+	return c.persistent.GetUserPromotionRevocations(ctx, userID)
+}
+
 func (c *component) GetUserPromotionByID(ctx context.Context, userPromotionID uuid.UUID) (types.UserPromotion, error) {
 	return c.persistent.GetUserPromotionByID(ctx, userPromotionID)
 }
 
-func (c *component) GetUserPromotions(ctx context.Context, userPromotionID uuid.UUID) ([]types.UserPromotion, error) {
-	return c.persistent.GetUserPromotions(ctx, userPromotionID)
+func (c *component) GetUserPromotions(ctx context.Context, userID uuid.UUID, filter types.UserPromotionFilter) ([]types.UserPromotion, error) {
+	return c.persistent.GetUserPromotions(ctx, userID, filter)
 }
 
 func (c *component) ListenToRegisterEvent(ctx context.Context) error {
@@ -169,9 +846,13 @@ func (c *component) ListenToRegisterEvent(ctx context.Context) error {
 			log.Errorf("failed to parse uuid: %s", err)
 			continue
 		}
-		_, err = c.AddWelcomePromotion(ctx, ID)
+		_, err = c.StartWelcomePackage(ctx, ID)
+		if errors.Is(err, types.ErrWelcomePackageStarted) {
+			// started by another replica
+			continue
+		}
 		if err != nil {
-			log.Errorf("failed to add welcome promotion: %s", err)
+			log.Errorf("failed to start welcome package: %s", err)
 			continue
 		}
 	}
@@ -8,13 +8,19 @@ import (
 	userpromotion "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/user_promotion"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
 type fields struct {
 	persistentStore store.Persistent
 	pubsub          store.PubSub
@@ -24,6 +30,9 @@ type fields struct {
 var (
 	fixedTime    = time.Date(2025, time.March, 19, 8, 15, 55, 706491000, time.Local)
 	fixedEndTime = fixedTime.Add(time.Hour)
+
+	budgetAlertThreshold = decimal.RequireFromString("0.8")
+	reminderLeadTimes    = []time.Duration{12 * time.Hour, time.Hour}
 )
 
 func TestAddPromotion(t *testing.T) {
@@ -100,12 +109,56 @@ func TestAddPromotion(t *testing.T) {
 				EndDate:     fixedEndTime,
 				Claimed:     nil,
 			},
+		}, {
+			name: "it should fail to assign a cashback promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PromotionGetByIDStub: func(ctx context.Context, u uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{IsActive: true, Type: types.Cashback}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				userPromotion: types.UserPromotion{
+					UserID:      userID,
+					PromotionID: promotionID,
+					StartDate:   fixedTime,
+					EndDate:     fixedEndTime,
+				},
+			},
+			expectedError: types.ErrPromotionNotAssignable,
+		}, {
+			name: "it should fail to assign a promotion to a player who is not eligible",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					PromotionGetByIDStub: func(ctx context.Context, u uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{
+							IsActive:    true,
+							Eligibility: &types.EligibilityRules{MinAccountAgeDays: 30},
+						}, nil
+					},
+					GetEligibilityProfileStub: func(ctx context.Context, u uuid.UUID) (types.EligibilityProfile, error) {
+						return types.EligibilityProfile{UserID: u, Created: time.Now().AddDate(0, 0, -5)}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				userPromotion: types.UserPromotion{
+					UserID:      userID,
+					PromotionID: promotionID,
+					StartDate:   fixedTime,
+					EndDate:     fixedEndTime,
+				},
+			},
+			expectedError: types.ErrNotEligible,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub)
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
 			res, err := c.AddPromotion(context.Background(), tt.args.userPromotion)
 
 			require.ErrorIs(t, err, tt.expectedError)
@@ -191,7 +244,7 @@ func TestAddWelcomePromotion(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub)
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
 			res, err := c.AddPromotion(context.Background(), tt.args.userPromotion)
 
 			require.ErrorIs(t, err, tt.expectedError)
@@ -205,6 +258,7 @@ func TestAddWelcomePromotion(t *testing.T) {
 func TestGetUserPromotions(t *testing.T) {
 	type args struct {
 		userID uuid.UUID
+		filter types.UserPromotionFilter
 	}
 
 	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
@@ -214,6 +268,8 @@ func TestGetUserPromotions(t *testing.T) {
 	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
 	require.NoError(t, err)
 
+	expired := types.UserPromotionExpired
+
 	tests := []struct {
 		name           string
 		fields         fields
@@ -226,7 +282,7 @@ func TestGetUserPromotions(t *testing.T) {
 			name: "it should user promotion by user id",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					GetUserPromotionsStub: func(ctx context.Context, u uuid.UUID) ([]types.UserPromotion, error) {
+					GetUserPromotionsStub: func(ctx context.Context, u uuid.UUID, f types.UserPromotionFilter) ([]types.UserPromotion, error) {
 						return []types.UserPromotion{
 							{
 								ID:          ID,
@@ -240,7 +296,7 @@ func TestGetUserPromotions(t *testing.T) {
 					},
 				},
 				tester: &fakes.FakeUserPromotionProvider{
-					GetUserPromotionsStub: func(ctx context.Context, u uuid.UUID) ([]types.UserPromotion, error) {
+					GetUserPromotionsStub: func(ctx context.Context, u uuid.UUID, f types.UserPromotionFilter) ([]types.UserPromotion, error) {
 						return []types.UserPromotion{
 							{
 								ID:          ID,
@@ -269,12 +325,43 @@ func TestGetUserPromotions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "it should filter user promotions by status",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetUserPromotionsStub: func(ctx context.Context, u uuid.UUID, f types.UserPromotionFilter) ([]types.UserPromotion, error) {
+						require.Equal(t, &expired, f.Status)
+						return []types.UserPromotion{
+							{
+								ID:          ID,
+								UserID:      userID,
+								PromotionID: promotionID,
+								Status:      types.UserPromotionExpired,
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				userID: userID,
+				filter: types.UserPromotionFilter{Status: &expired},
+			},
+			expectedOutput: []types.UserPromotion{
+				{
+					ID:          ID,
+					UserID:      userID,
+					PromotionID: promotionID,
+					Status:      types.UserPromotionExpired,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub)
-			res, err := c.GetUserPromotions(context.Background(), tt.args.userID)
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+			res, err := c.GetUserPromotions(context.Background(), tt.args.userID, tt.args.filter)
 
 			require.ErrorIs(t, err, tt.expectedError)
 			if tt.expectedError == nil {
@@ -349,7 +436,7 @@ func TestGetUserPromotionByID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub)
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
 			res, err := c.GetUserPromotionByID(context.Background(), tt.args.ID)
 
 			require.ErrorIs(t, err, tt.expectedError)
@@ -365,6 +452,8 @@ func TestClaimPromotion(t *testing.T) {
 		ID uuid.UUID
 	}
 
+	depositID := uuid.New()
+
 	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
 	require.NoError(t, err)
 	promotionID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
@@ -372,12 +461,39 @@ func TestClaimPromotion(t *testing.T) {
 	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
 	require.NoError(t, err)
 
+	claimable := func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+		return types.UserPromotion{
+			ID:          ID,
+			UserID:      userID,
+			PromotionID: promotionID,
+			StartDate:   time.Now(),
+			EndDate:     time.Now().Add(time.Hour),
+			Promotion: &types.Promotion{
+				ID:       promotionID,
+				Amount:   eur(10),
+				IsActive: true,
+			},
+		}, nil
+	}
+
+	player := func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+		return types.User{ID: userID, Balance: eur(10)}, nil
+	}
+
+	budgeted := func(budget types.PromotionBudget) types.Promotion {
+		return types.Promotion{ID: promotionID, Title: "Spring bonus", Amount: eur(10), Budget: &budget}
+	}
+
+	hundred := decimal.NewFromInt(100)
+	one, ten := 1, 10
+
 	tests := []struct {
-		name          string
-		fields        fields
-		vars          map[string]string
-		args          args
-		expectedError error
+		name           string
+		fields         fields
+		vars           map[string]string
+		args           args
+		expectedError  error
+		expectedAlerts int
 	}{
 		{
 			name: "it should claim promotion",
@@ -391,26 +507,30 @@ func TestClaimPromotion(t *testing.T) {
 									UserID:      userID,
 									PromotionID: promotionID,
 									StartDate:   time.Now(),
-									EndDate:     time.Date(2026, time.March, 19, 8, 15, 55, 706491000, time.Local),
+									EndDate:     time.Now().Add(time.Hour),
 									Claimed:     nil,
 									Promotion: &types.Promotion{
 										ID:       promotionID,
-										Amount:   10,
+										Amount:   eur(10),
 										IsActive: true,
 									},
 									User: &types.User{ID: userID},
 								}, nil
 							},
-							ClaimPromotionStub: func(ctx context.Context, u uuid.UUID) error {
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
 								return nil
 							},
 							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
-								return types.User{ID: userID, Balance: 10}, nil
+								return types.User{ID: userID, Balance: eur(10)}, nil
 							},
-							UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerSourcePromotionClaim, e.Source)
+								require.Equal(t, types.LedgerAccountPromotions, e.DebitAccount)
+								require.Equal(t, types.LedgerAccountPlayerCash, e.CreditAccount)
+								require.Equal(t, uuid.NullUUID{UUID: ID, Valid: true}, e.ReferenceID)
 								return types.User{
 									ID:      userID,
-									Balance: 20,
+									Balance: eur(20),
 								}, nil
 							},
 						}, err
@@ -427,6 +547,257 @@ func TestClaimPromotion(t *testing.T) {
 				ID: ID,
 			},
 		},
+		{
+			name: "it should claim promotion into bonus balance",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+								return types.UserPromotion{
+									ID:          ID,
+									UserID:      userID,
+									PromotionID: promotionID,
+									StartDate:   time.Now(),
+									EndDate:     time.Now().Add(time.Hour),
+									Promotion: &types.Promotion{
+										ID:                 promotionID,
+										Amount:             eur(10),
+										IsActive:           true,
+										WageringMultiplier: decimal.NewFromInt(30),
+									},
+								}, nil
+							},
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
+								require.Equal(t, eur(10), up.BonusAmount)
+								require.Equal(t, "300", up.WageringRequired.Amount.String())
+								require.Nil(t, up.Converted)
+								return nil
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(10)}, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerAccountPromotions, e.DebitAccount)
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.CreditAccount)
+								require.Equal(t, eur(10), e.Amount)
+								return types.User{
+									ID:           userID,
+									Balance:      eur(10),
+									BonusBalance: eur(10),
+								}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+		},
+		{
+			name: "it should claim the cashback granted to the player",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+								return types.UserPromotion{
+									ID:          ID,
+									UserID:      userID,
+									PromotionID: promotionID,
+									StartDate:   time.Now(),
+									EndDate:     time.Now().Add(time.Hour),
+									BonusAmount: eur(7),
+									Promotion: &types.Promotion{
+										ID:                 promotionID,
+										Amount:             eur(0),
+										IsActive:           true,
+										Type:               types.Cashback,
+										WageringMultiplier: decimal.NewFromInt(1),
+									},
+								}, nil
+							},
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
+								require.Equal(t, eur(7), up.BonusAmount)
+								require.Equal(t, "7", up.WageringRequired.Amount.String())
+								return nil
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(10)}, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.CreditAccount)
+								require.Equal(t, eur(7), e.Amount)
+								return types.User{ID: userID}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+		},
+		{
+			name: "it should match the deposit the promotion is claimed with",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+								return types.UserPromotion{
+									ID:          ID,
+									UserID:      userID,
+									PromotionID: promotionID,
+									StartDate:   fixedTime,
+									EndDate:     time.Now().Add(time.Hour),
+									Promotion: &types.Promotion{
+										ID:                 promotionID,
+										Amount:             eur(0),
+										IsActive:           true,
+										Type:               types.MatchBonus,
+										WageringMultiplier: decimal.NewFromInt(10),
+										MatchBonus: &types.MatchBonusRule{
+											Percentage: decimal.NewFromInt(100),
+											MaxAmount:  decimal.NewFromInt(200),
+											MinDeposit: decimal.NewFromInt(20),
+										},
+									},
+								}, nil
+							},
+							GetUnmatchedDepositStub: func(ctx context.Context, u uuid.UUID, since time.Time, minimum types.Money) (types.LedgerEntry, error) {
+								require.Equal(t, fixedTime, since)
+								require.Equal(t, eur(20), minimum)
+								return types.LedgerEntry{ID: depositID, Amount: eur(300)}, nil
+							},
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
+								require.Equal(t, uuid.NullUUID{UUID: depositID, Valid: true}, up.DepositID)
+								require.Equal(t, "200", up.BonusAmount.Amount.String())
+								require.Equal(t, "2000", up.WageringRequired.Amount.String())
+								return nil
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(300)}, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.CreditAccount)
+								require.Equal(t, "200", e.Amount.Amount.String())
+								return types.User{ID: userID}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+		},
+		{
+			name: "it should fail to claim a match bonus without a qualifying deposit",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+								return types.UserPromotion{
+									ID:          ID,
+									UserID:      userID,
+									PromotionID: promotionID,
+									StartDate:   fixedTime,
+									EndDate:     time.Now().Add(time.Hour),
+									Promotion: &types.Promotion{
+										ID:                 promotionID,
+										Amount:             eur(0),
+										IsActive:           true,
+										Type:               types.MatchBonus,
+										WageringMultiplier: decimal.NewFromInt(10),
+										MatchBonus: &types.MatchBonusRule{
+											Percentage: decimal.NewFromInt(100),
+											MaxAmount:  decimal.NewFromInt(200),
+											MinDeposit: decimal.NewFromInt(20),
+										},
+									},
+								}, nil
+							},
+							GetUnmatchedDepositStub: func(ctx context.Context, u uuid.UUID, since time.Time, minimum types.Money) (types.LedgerEntry, error) {
+								return types.LedgerEntry{}, pgx.ErrNoRows
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(10)}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+			expectedError: types.ErrNoQualifyingDeposit,
+		},
+		{
+			name: "it should grant the free spins the promotion is claimed with",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+								return types.UserPromotion{
+									ID:          ID,
+									UserID:      userID,
+									PromotionID: promotionID,
+									StartDate:   time.Now(),
+									EndDate:     time.Now().Add(time.Hour),
+									Promotion: &types.Promotion{
+										ID:                 promotionID,
+										Amount:             eur(0),
+										IsActive:           true,
+										Type:               types.FreeSpins,
+										WageringMultiplier: decimal.NewFromInt(10),
+										FreeSpins: &types.FreeSpinsRule{
+											GameID:       "starburst",
+											Spins:        20,
+											Stake:        decimal.RequireFromString("0.2"),
+											ValidityDays: 7,
+										},
+									},
+								}, nil
+							},
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
+								require.True(t, up.BonusAmount.IsZero())
+								require.True(t, up.WageringRequired.IsZero())
+								require.Nil(t, up.Converted)
+								return nil
+							},
+							FreeSpinsCreateStub: func(ctx context.Context, fs types.FreeSpinsEntitlement) error {
+								require.Equal(t, ID, fs.UserPromotionID)
+								require.Equal(t, userID, fs.UserID)
+								require.Equal(t, "starburst", fs.GameID)
+								require.Equal(t, 20, fs.Remaining)
+								require.Equal(t, "0.2", fs.Stake.Amount.String())
+								require.WithinDuration(t, time.Now().AddDate(0, 0, 7), fs.Expires, time.Minute)
+								return nil
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(10)}, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								t.Fatal("free spins are credited when settled")
+								return types.User{}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+		},
 		{
 			name: "it should fail to claim promotion claimed already",
 			fields: fields{
@@ -443,21 +814,21 @@ func TestClaimPromotion(t *testing.T) {
 									Claimed:     &fixedTime,
 									Promotion: &types.Promotion{
 										ID:       promotionID,
-										Amount:   10,
+										Amount:   eur(10),
 										IsActive: true,
 									},
 								}, nil
 							},
-							ClaimPromotionStub: func(ctx context.Context, u uuid.UUID) error {
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
 								return nil
 							},
 							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
-								return types.User{ID: userID, Balance: 10}, nil
+								return types.User{ID: userID, Balance: eur(10)}, nil
 							},
-							UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
 								return types.User{
 									ID:      userID,
-									Balance: 20,
+									Balance: eur(20),
 								}, nil
 							},
 						}, nil
@@ -491,21 +862,21 @@ func TestClaimPromotion(t *testing.T) {
 									Claimed:     nil,
 									Promotion: &types.Promotion{
 										ID:       promotionID,
-										Amount:   10,
+										Amount:   eur(10),
 										IsActive: false,
 									},
 								}, nil
 							},
-							ClaimPromotionStub: func(ctx context.Context, u uuid.UUID) error {
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
 								return nil
 							},
 							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
-								return types.User{ID: userID, Balance: 10}, nil
+								return types.User{ID: userID, Balance: eur(10)}, nil
 							},
-							UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
 								return types.User{
 									ID:      userID,
-									Balance: 20,
+									Balance: eur(20),
 								}, nil
 							},
 						}, nil
@@ -539,21 +910,21 @@ func TestClaimPromotion(t *testing.T) {
 									Claimed:     nil,
 									Promotion: &types.Promotion{
 										ID:       promotionID,
-										Amount:   10,
+										Amount:   eur(10),
 										IsActive: true,
 									},
 								}, nil
 							},
-							ClaimPromotionStub: func(ctx context.Context, u uuid.UUID) error {
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
 								return nil
 							},
 							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
-								return types.User{ID: userID, Balance: 10}, nil
+								return types.User{ID: userID, Balance: eur(10)}, nil
 							},
-							UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
 								return types.User{
 									ID:      userID,
-									Balance: 20,
+									Balance: eur(20),
 								}, nil
 							},
 						}, nil
@@ -587,7 +958,7 @@ func TestClaimPromotion(t *testing.T) {
 									Claimed:     nil,
 									Promotion: &types.Promotion{
 										ID:       promotionID,
-										Amount:   10,
+										Amount:   eur(10),
 										IsActive: true,
 									},
 									User: &types.User{
@@ -595,16 +966,16 @@ func TestClaimPromotion(t *testing.T) {
 									},
 								}, nil
 							},
-							ClaimPromotionStub: func(ctx context.Context, u uuid.UUID) error {
+							ClaimPromotionStub: func(ctx context.Context, up types.UserPromotion) error {
 								return nil
 							},
 							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
-								return types.User{ID: userID, Balance: 10}, nil
+								return types.User{ID: userID, Balance: eur(10)}, nil
 							},
-							UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
 								return types.User{
 									ID:      userID,
-									Balance: 20,
+									Balance: eur(20),
 								}, nil
 							},
 						}, nil
@@ -622,38 +993,163 @@ func TestClaimPromotion(t *testing.T) {
 			},
 			expectedError: types.ErrPromotionExpired,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub)
-			err := c.ClaimPromotion(context.Background(), tt.args.ID)
-
-			require.ErrorIs(t, err, tt.expectedError)
-		})
-	}
-}
-
-func TestDeleteUserPromotion(t *testing.T) {
-	type args struct {
-		ID uuid.UUID
-	}
-
-	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
-	require.NoError(t, err)
-
-	tests := []struct {
-		name          string
-		fields        fields
-		vars          map[string]string
-		args          args
-		expectedError error
-	}{
 		{
-			name: "it should delete user promotion",
+			name: "it should fail to claim a revoked promotion",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					DeleteUserPromotionStub: func(ctx context.Context, u uuid.UUID) error {
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: func(ctx context.Context, u uuid.UUID) (types.UserPromotion, error) {
+								userPromotion, err := claimable(ctx, u)
+								userPromotion.Status = types.UserPromotionRevoked
+								return userPromotion, err
+							},
+							UserGetByStub: player,
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+			expectedError: types.ErrPromotionRevoked,
+		},
+		{
+			name: "it should alert staff when the claim spends most of the budget",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: claimable,
+							UserGetByStub:            player,
+							PromotionSpendStub: func(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (types.Promotion, error) {
+								require.Equal(t, "10", amount.String())
+								return budgeted(types.PromotionBudget{Amount: &hundred, Spent: decimal.NewFromInt(85), Claims: 9}), nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+			expectedAlerts: 1,
+		},
+		{
+			name: "it should fail to claim when the budget is exhausted",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: claimable,
+							UserGetByStub:            player,
+							PromotionSpendStub: func(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (types.Promotion, error) {
+								return types.Promotion{}, pgx.ErrNoRows
+							},
+							PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+								return budgeted(types.PromotionBudget{Amount: &hundred, Spent: decimal.NewFromInt(95)}), nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+			expectedError: types.ErrBudgetExhausted,
+		},
+		{
+			name: "it should fail to claim when the promotion reached its claim limit",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: claimable,
+							UserGetByStub:            player,
+							PromotionSpendStub: func(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (types.Promotion, error) {
+								return types.Promotion{}, pgx.ErrNoRows
+							},
+							PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+								return budgeted(types.PromotionBudget{MaxClaims: &ten, Claims: 10}), nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+			expectedError: types.ErrClaimLimitReached,
+		},
+		{
+			name: "it should fail to claim when the player reached the claim limit",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							GetUserPromotionByIDStub: claimable,
+							UserGetByStub:            player,
+							PromotionSpendStub: func(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (types.Promotion, error) {
+								return budgeted(types.PromotionBudget{MaxClaimsPerUser: &one, Spent: decimal.NewFromInt(10), Claims: 2}), nil
+							},
+							UserPromotionClaimCountStub: func(ctx context.Context, u uuid.UUID, p uuid.UUID) (int, error) {
+								require.Equal(t, userID, u)
+								return 1, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				ID: ID,
+			},
+			expectedError: types.ErrUserClaimLimitReached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+			err := c.ClaimPromotion(context.Background(), tt.args.ID)
+
+			require.ErrorIs(t, err, tt.expectedError)
+
+			pubsub := tt.fields.pubsub.(*fakes.FakePubSub)
+			require.Equal(t, tt.expectedAlerts, pubsub.PublishCallCount())
+			if tt.expectedAlerts > 0 {
+				_, channel, alert := pubsub.PublishArgsForCall(0)
+				require.Equal(t, redis_pub_sub.StaffNotificationsChannel, channel)
+				require.Equal(t, "85.00 EUR", alert.(*types.PromotionBudgetAlert).Spent.String())
+			}
+		})
+	}
+}
+
+func TestDeleteUserPromotion(t *testing.T) {
+	type args struct {
+		ID uuid.UUID
+	}
+
+	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		fields        fields
+		vars          map[string]string
+		args          args
+		expectedError error
+	}{
+		{
+			name: "it should delete user promotion",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					DeleteUserPromotionStub: func(ctx context.Context, u uuid.UUID) error {
 						return nil
 					},
 				},
@@ -692,10 +1188,594 @@ func TestDeleteUserPromotion(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub)
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
 			err := c.DeleteUserPromotion(context.Background(), tt.args.ID)
 
 			require.ErrorIs(t, err, tt.expectedError)
 		})
 	}
 }
+
+func TestRevokePromotion(t *testing.T) {
+	userID := uuid.New()
+	staffID := uuid.New()
+	userPromotionID := uuid.New()
+	converted := time.Now()
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	found := func(status types.UserPromotionStatus, promotionType types.PromotionType) func(context.Context, uuid.UUID) (types.UserPromotion, error) {
+		return func(ctx context.Context, id uuid.UUID) (types.UserPromotion, error) {
+			return types.UserPromotion{
+				ID:        id,
+				UserID:    userID,
+				Status:    status,
+				Promotion: &types.Promotion{Type: promotionType},
+			}, nil
+		}
+	}
+
+	revoked := func(userPromotion types.UserPromotion) func(context.Context, uuid.UUID, types.UserPromotionStatus) (types.UserPromotion, error) {
+		return func(ctx context.Context, id uuid.UUID, status types.UserPromotionStatus) (types.UserPromotion, error) {
+			userPromotion.ID = id
+			userPromotion.UserID = userID
+			return userPromotion, nil
+		}
+	}
+
+	balances := func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+		return types.User{ID: userID, Balance: eur(4), BonusBalance: eur(6)}, nil
+	}
+
+	tests := []struct {
+		name               string
+		stub               *fakes.FakePersistent
+		policy             types.ClawbackPolicy
+		expectedClawedBack string
+		expectedAccount    types.LedgerAccount
+		expectedVoids      int
+		expectedError      error
+	}{
+		{
+			name: "it should revoke an assigned promotion without a clawback",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionAssigned, types.Regular),
+				UserPromotionRevokeStub:  revoked(types.UserPromotion{BonusAmount: eur(0)}),
+			},
+			policy:             types.ClawbackCapAtZero,
+			expectedClawedBack: "0",
+		},
+		{
+			name: "it should claw back converted cash down to zero",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionClaimed, types.Regular),
+				UserPromotionRevokeStub:  revoked(types.UserPromotion{BonusAmount: eur(10), Converted: &converted}),
+				UserGetByStub:            balances,
+				UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+					require.Equal(t, types.LedgerSourceClawback, e.Source)
+					require.Equal(t, types.LedgerAccountPlayerCash, e.DebitAccount)
+					require.Equal(t, types.LedgerAccountPromotions, e.CreditAccount)
+					require.Equal(t, eur(4), e.Amount)
+					return types.User{ID: userID}, nil
+				},
+			},
+			policy:             types.ClawbackCapAtZero,
+			expectedClawedBack: "4",
+			expectedAccount:    types.LedgerAccountPlayerCash,
+		},
+		{
+			name: "it should claw back the whole bonus when a negative balance is allowed",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionClaimed, types.Regular),
+				UserPromotionRevokeStub:  revoked(types.UserPromotion{BonusAmount: eur(10)}),
+				UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+					require.Equal(t, types.LedgerAccountPlayerBonus, e.DebitAccount)
+					require.Equal(t, eur(10), e.Amount)
+					return types.User{ID: userID, BonusBalance: eur(-4)}, nil
+				},
+			},
+			policy:             types.ClawbackAllowNegative,
+			expectedClawedBack: "10",
+			expectedAccount:    types.LedgerAccountPlayerBonus,
+		},
+		{
+			name: "it should void the unplayed free spins of a claim",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionClaimed, types.FreeSpins),
+				UserPromotionRevokeStub:  revoked(types.UserPromotion{BonusAmount: eur(0)}),
+				UserGetByStub:            balances,
+			},
+			policy:             types.ClawbackCapAtZero,
+			expectedClawedBack: "0",
+			expectedAccount:    types.LedgerAccountPlayerBonus,
+			expectedVoids:      1,
+		},
+		{
+			name: "it should fail to revoke a promotion of another user",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: func(ctx context.Context, id uuid.UUID) (types.UserPromotion, error) {
+					return types.UserPromotion{ID: id, UserID: uuid.New(), Status: types.UserPromotionAssigned}, nil
+				},
+			},
+			expectedError: pgx.ErrNoRows,
+		},
+		{
+			name: "it should fail to revoke a revoked promotion",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionRevoked, types.Regular),
+			},
+			expectedError: types.ErrPromotionRevoked,
+		},
+		{
+			name: "it should fail to revoke a forfeited bonus",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionForfeited, types.Regular),
+			},
+			expectedError: types.ErrPromotionNotRevocable,
+		},
+		{
+			name: "it should fail when the promotion was claimed in the meantime",
+			stub: &fakes.FakePersistent{
+				GetUserPromotionByIDStub: found(types.UserPromotionAssigned, types.Regular),
+				UserPromotionRevokeStub: func(ctx context.Context, id uuid.UUID, status types.UserPromotionStatus) (types.UserPromotion, error) {
+					require.Equal(t, types.UserPromotionAssigned, status)
+					return types.UserPromotion{}, pgx.ErrNoRows
+				},
+			},
+			expectedError: types.ErrUserPromotionChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubsub := &fakes.FakePubSub{}
+			c := userpromotion.New(&fakes.FakePersistent{WithTxStub: tx(tt.stub)}, pubsub, budgetAlertThreshold, tt.policy, reminderLeadTimes)
+			revocation, err := c.RevokePromotion(context.Background(), types.UserPromotionRevocation{
+				UserPromotionID: userPromotionID,
+				UserID:          userID,
+				RevokedBy:       staffID,
+				Reason:          types.RevocationBonusAbuse,
+			})
+
+			require.ErrorIs(t, err, tt.expectedError)
+
+			if tt.expectedError != nil {
+				require.Equal(t, 0, tt.stub.UserPromotionRevocationCreateCallCount())
+				require.Equal(t, 0, tt.stub.CommitTxCallCount())
+				require.Equal(t, 0, pubsub.PublishCallCount())
+				return
+			}
+
+			require.Equal(t, tt.expectedClawedBack, revocation.ClawedBack.Amount.String())
+			require.Equal(t, tt.expectedAccount, revocation.Account)
+			require.Equal(t, tt.policy, revocation.Policy)
+			require.Equal(t, staffID, revocation.RevokedBy)
+			require.Equal(t, 1, tt.stub.UserPromotionRevocationCreateCallCount())
+			_, recorded := tt.stub.UserPromotionRevocationCreateArgsForCall(0)
+			require.Equal(t, revocation, recorded)
+			require.Equal(t, tt.expectedVoids, tt.stub.FreeSpinsVoidCallCount())
+			require.Equal(t, 1, tt.stub.CommitTxCallCount())
+			require.Equal(t, 1, pubsub.PublishCallCount())
+		})
+	}
+}
+
+func TestRecordWager(t *testing.T) {
+	type args struct {
+		userID uuid.UUID
+		amount types.Money
+	}
+
+	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+	userID, err := uuid.Parse("8c3524e5-a297-42aa-85d3-faca261cbfb8")
+	require.NoError(t, err)
+	betID, err := uuid.Parse("1f0a3c52-6a4e-4f7b-9a51-3a8d5c1e2b40")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		fields        fields
+		args          args
+		expectedError error
+	}{
+		{
+			name: "it should convert bonus when wagering is met",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							UserPromotionsWagerStub: func(ctx context.Context, u uuid.UUID, b uuid.UUID, m types.Money) ([]types.UserPromotion, error) {
+								require.Equal(t, betID, b)
+								require.Equal(t, eur(50), m)
+								return []types.UserPromotion{
+									{
+										ID:               ID,
+										UserID:           userID,
+										BonusAmount:      eur(10),
+										WageringRequired: eur(300),
+										Wagered:          eur(300),
+									},
+									{
+										ID:               uuid.New(),
+										UserID:           userID,
+										BonusAmount:      eur(10),
+										WageringRequired: eur(300),
+										Wagered:          eur(50),
+									},
+								}, nil
+							},
+							UserPromotionConvertStub: func(ctx context.Context, u uuid.UUID) error {
+								require.Equal(t, ID, u)
+								return nil
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, Balance: eur(0), BonusBalance: eur(4)}, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerSourceBonusConvert, e.Source)
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.DebitAccount)
+								require.Equal(t, types.LedgerAccountPlayerCash, e.CreditAccount)
+								require.Equal(t, eur(4), e.Amount)
+								return types.User{}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				userID: userID,
+				amount: eur(50),
+			},
+		},
+		{
+			name: "it should fail non positive amount",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				userID: userID,
+				amount: eur(0),
+			},
+			expectedError: types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+			err := c.RecordWager(context.Background(), tt.args.userID, betID, tt.args.amount)
+
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestForfeitExpiredBonuses(t *testing.T) {
+	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+	userID, err := uuid.Parse("8c3524e5-a297-42aa-85d3-faca261cbfb8")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		fields         fields
+		expectedOutput int
+		expectedError  error
+	}{
+		{
+			name: "it should forfeit expired bonuses",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					GetExpiredUserPromotionBonusesStub: func(ctx context.Context, before time.Time, limit int) ([]types.UserPromotion, error) {
+						return []types.UserPromotion{
+							{ID: ID, UserID: userID, BonusAmount: eur(10)},
+							{ID: uuid.New(), UserID: userID, BonusAmount: eur(10)},
+						}, nil
+					},
+					WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+						return &fakes.FakePersistent{
+							UserPromotionForfeitStub: func(ctx context.Context, u uuid.UUID) error {
+								if u != ID {
+									// settled by another replica
+									return pgx.ErrNoRows
+								}
+								return nil
+							},
+							UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+								return types.User{ID: userID, BonusBalance: eur(10)}, nil
+							},
+							UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+								require.Equal(t, types.LedgerSourceBonusForfeit, e.Source)
+								require.Equal(t, types.LedgerAccountPlayerBonus, e.DebitAccount)
+								require.Equal(t, types.LedgerAccountPromotions, e.CreditAccount)
+								require.Equal(t, eur(10), e.Amount)
+								return types.User{}, nil
+							},
+						}, nil
+					},
+				},
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedOutput: 1,
+		},
+		{
+			name: "it should forfeit batches until one comes back short",
+			fields: fields{
+				persistentStore: func() *fakes.FakePersistent {
+					persistent := &fakes.FakePersistent{
+						WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+							return &fakes.FakePersistent{
+								UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+									return types.User{ID: userID}, nil
+								},
+							}, nil
+						},
+					}
+					batch := make([]types.UserPromotion, 100)
+					for i := range batch {
+						batch[i] = types.UserPromotion{ID: uuid.New(), UserID: userID, BonusAmount: eur(10)}
+					}
+					persistent.GetExpiredUserPromotionBonusesReturnsOnCall(0, batch, nil)
+					persistent.GetExpiredUserPromotionBonusesReturnsOnCall(1, batch[:3], nil)
+					return persistent
+				}(),
+				pubsub: &fakes.FakePubSub{},
+			},
+			expectedOutput: 103,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := userpromotion.New(tt.fields.persistentStore, tt.fields.pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+			forfeited, err := c.ForfeitExpiredBonuses(context.Background())
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedOutput, forfeited)
+		})
+	}
+}
+
+func TestRemindExpiringPromotions(t *testing.T) {
+	userID := uuid.New()
+
+	var leadTimes []time.Duration
+	persistent := &fakes.FakePersistent{
+		UserPromotionsRemindStub: func(ctx context.Context, now time.Time, leadTime time.Duration) ([]types.PromotionExpiryReminder, error) {
+			leadTimes = append(leadTimes, leadTime)
+			if leadTime == time.Hour {
+				return []types.PromotionExpiryReminder{{UserID: userID, UserPromotionID: uuid.New(), EndDate: now.Add(leadTime)}}, nil
+			}
+			return []types.PromotionExpiryReminder{
+				{UserID: userID, UserPromotionID: uuid.New(), EndDate: now.Add(leadTime)},
+				{UserID: uuid.New(), UserPromotionID: uuid.New(), EndDate: now.Add(leadTime)},
+			}, nil
+		},
+	}
+	pubsub := &fakes.FakePubSub{}
+
+	c := userpromotion.New(persistent, pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+	reminded, err := c.RemindExpiringPromotions(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 3, reminded)
+	require.Equal(t, []time.Duration{time.Hour, 12 * time.Hour}, leadTimes)
+	require.Equal(t, 3, pubsub.PublishCallCount())
+
+	_, channel, notice := pubsub.PublishArgsForCall(0)
+	require.Equal(t, "notifications:"+userID.String(), channel)
+	require.Equal(t, userID, notice.(types.PromotionExpiryReminder).UserID)
+}
+
+func TestStartWelcomePackage(t *testing.T) {
+	userID := uuid.New()
+	welcomeBonus := uuid.New()
+	freeSpins := uuid.New()
+
+	welcomePackage := types.WelcomePackage{
+		ID:      uuid.New(),
+		Version: 3,
+		Steps: []types.WelcomeStep{
+			{Step: 1, PromotionID: welcomeBonus, Unlock: types.WelcomeUnlockRegistration, ValidityHours: 48},
+			{Step: 2, PromotionID: freeSpins, Unlock: types.WelcomeUnlockRegistration, ValidityHours: 24},
+			{Step: 3, PromotionID: uuid.New(), Unlock: types.WelcomeUnlockDeposit, Deposits: 1, ValidityHours: 72},
+		},
+	}
+
+	current := func(ctx context.Context) (types.WelcomePackage, error) {
+		return welcomePackage, nil
+	}
+
+	tx := func(stub *fakes.FakePersistent) func(context.Context) (store.Persistent, error) {
+		return func(ctx context.Context) (store.Persistent, error) {
+			return stub, nil
+		}
+	}
+
+	assigned := func(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error) {
+		return userPromotion, nil
+	}
+
+	started := func(ok bool) func(context.Context, uuid.UUID, uuid.UUID) (bool, error) {
+		return func(ctx context.Context, id uuid.UUID, packageID uuid.UUID) (bool, error) {
+			require.Equal(t, welcomePackage.ID, packageID)
+			return ok, nil
+		}
+	}
+
+	unlocked := func(ctx context.Context, id uuid.UUID, step int, userPromotionID uuid.NullUUID, at time.Time) (bool, error) {
+		return true, nil
+	}
+
+	tests := []struct {
+		name               string
+		persistent         *fakes.FakePersistent
+		expectedPromotions []uuid.UUID
+		expectedSteps      int
+		expectedError      error
+	}{
+		{
+			name: "it should assign the registration steps of the current package",
+			persistent: &fakes.FakePersistent{
+				WelcomePackageGetCurrentStub: current,
+				WithTxStub: tx(&fakes.FakePersistent{
+					UserWelcomePackageCreateStub: started(true),
+					PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{ID: id, IsActive: true}, nil
+					},
+					AddPromotionStub:          assigned,
+					UserWelcomeStepCreateStub: unlocked,
+				}),
+			},
+			expectedPromotions: []uuid.UUID{welcomeBonus, freeSpins},
+			expectedSteps:      2,
+		},
+		{
+			name: "it should unlock a step of an inactive promotion without assigning it",
+			persistent: &fakes.FakePersistent{
+				WelcomePackageGetCurrentStub: current,
+				WithTxStub: tx(&fakes.FakePersistent{
+					UserWelcomePackageCreateStub: started(true),
+					PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{ID: id, IsActive: id != freeSpins}, nil
+					},
+					AddPromotionStub:          assigned,
+					UserWelcomeStepCreateStub: unlocked,
+				}),
+			},
+			expectedPromotions: []uuid.UUID{welcomeBonus},
+			expectedSteps:      2,
+		},
+		{
+			name: "it should unlock a step of a promotion no longer assignable without assigning it",
+			persistent: &fakes.FakePersistent{
+				WelcomePackageGetCurrentStub: current,
+				WithTxStub: tx(&fakes.FakePersistent{
+					UserWelcomePackageCreateStub: started(true),
+					PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+						if id == freeSpins {
+							// changed to cashback after the package was created
+							return types.Promotion{ID: id, IsActive: true, Type: types.Cashback}, nil
+						}
+						return types.Promotion{ID: id, IsActive: true}, nil
+					},
+					AddPromotionStub:          assigned,
+					UserWelcomeStepCreateStub: unlocked,
+				}),
+			},
+			expectedPromotions: []uuid.UUID{welcomeBonus},
+			expectedSteps:      2,
+		},
+		{
+			name: "it should not start a package twice",
+			persistent: &fakes.FakePersistent{
+				WelcomePackageGetCurrentStub: current,
+				WithTxStub: tx(&fakes.FakePersistent{
+					UserWelcomePackageCreateStub: started(false),
+				}),
+			},
+			expectedError: types.ErrWelcomePackageStarted,
+		},
+		{
+			name: "it should assign the welcome bonus without a package",
+			persistent: &fakes.FakePersistent{
+				WelcomePackageGetCurrentStub: func(ctx context.Context) (types.WelcomePackage, error) {
+					return types.WelcomePackage{}, pgx.ErrNoRows
+				},
+				PromotionGetByTypeStub: func(ctx context.Context, promotionType types.PromotionType) (types.Promotion, error) {
+					require.Equal(t, types.WelcomeBonus, promotionType)
+					return types.Promotion{ID: welcomeBonus, IsActive: true}, nil
+				},
+				AddPromotionStub: assigned,
+			},
+			expectedPromotions: []uuid.UUID{welcomeBonus},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pubsub := &fakes.FakePubSub{}
+			c := userpromotion.New(tt.persistent, pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+			userPromotions, err := c.StartWelcomePackage(context.Background(), userID)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Len(t, userPromotions, len(tt.expectedPromotions))
+			require.Equal(t, len(tt.expectedPromotions), pubsub.PublishCallCount())
+
+			for i, userPromotion := range userPromotions {
+				require.Equal(t, userID, userPromotion.UserID)
+				require.Equal(t, tt.expectedPromotions[i], userPromotion.PromotionID)
+			}
+
+			if tt.expectedSteps > 0 {
+				db, _ := tt.persistent.WithTx(context.Background())
+				fake := db.(*fakes.FakePersistent)
+				require.Equal(t, tt.expectedSteps, fake.UserWelcomeStepCreateCallCount())
+				require.Equal(t, 1, fake.CommitTxCallCount())
+
+				require.WithinDuration(t, time.Now().Add(48*time.Hour), userPromotions[0].EndDate, time.Second)
+			}
+		})
+	}
+}
+
+func TestUnlockWelcomeSteps(t *testing.T) {
+	userID := uuid.New()
+	deposited := time.Now().Add(-time.Hour)
+
+	step := types.UnlockedWelcomeStep{
+		WelcomeStep: types.WelcomeStep{Step: 2, PromotionID: uuid.New(), Unlock: types.WelcomeUnlockDeposit, Deposits: 1, ValidityHours: 72},
+		UserID:      userID,
+		Unlocked:    deposited,
+	}
+	other := types.UnlockedWelcomeStep{
+		WelcomeStep: types.WelcomeStep{Step: 3, PromotionID: uuid.New(), Unlock: types.WelcomeUnlockDeposit, Deposits: 2, ValidityHours: 72},
+		UserID:      userID,
+		Unlocked:    deposited,
+	}
+
+	var assigned []types.UserPromotion
+	db := &fakes.FakePersistent{
+		PromotionGetByIDStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+			return types.Promotion{ID: id, IsActive: true}, nil
+		},
+		AddPromotionStub: func(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error) {
+			assigned = append(assigned, userPromotion)
+			return userPromotion, nil
+		},
+		UserWelcomeStepCreateStub: func(ctx context.Context, id uuid.UUID, n int, userPromotionID uuid.NullUUID, at time.Time) (bool, error) {
+			require.True(t, userPromotionID.Valid)
+			require.Equal(t, deposited, at)
+			// step 3 was unlocked by another replica
+			return n == step.Step, nil
+		},
+	}
+	persistent := &fakes.FakePersistent{
+		GetUnlockedWelcomeStepsStub: func(ctx context.Context, limit int) ([]types.UnlockedWelcomeStep, error) {
+			return []types.UnlockedWelcomeStep{step, other}, nil
+		},
+		WithTxStub: func(ctx context.Context) (store.Persistent, error) {
+			return db, nil
+		},
+	}
+	pubsub := &fakes.FakePubSub{}
+
+	c := userpromotion.New(persistent, pubsub, budgetAlertThreshold, types.ClawbackCapAtZero, reminderLeadTimes)
+	unlocked, err := c.UnlockWelcomeSteps(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, unlocked)
+	require.Equal(t, 1, db.CommitTxCallCount())
+	require.Equal(t, 1, pubsub.PublishCallCount())
+
+	// the match bonus of the step matches the deposit that unlocked it
+	require.Equal(t, deposited, assigned[0].StartDate)
+	require.Equal(t, deposited.Add(72*time.Hour), assigned[0].EndDate)
+}
@@ -2,7 +2,10 @@ package users
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"net/mail"
+	"strings"
 	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
@@ -11,20 +14,29 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type UserProvider interface {
-	Register(ctx context.Context, user types.User) (types.User, string, error)
+	Register(ctx context.Context, user types.User, referralCode string) (types.User, string, error)
 	Login(ctx context.Context, req types.User) (types.User, string, error)
 	Auth(ctx context.Context, token string, path string, method string) (types.User, error)
 	GetUsers(ctx context.Context) ([]types.User, error)
 	GetUser(ctx context.Context, userID uuid.UUID) (types.User, error)
 	UpdateUser(ctx context.Context, user types.User) (types.User, error)
-	UpdateUserBalance(ctx context.Context, user types.User, value float64, transacrionType types.TransactionType) (types.User, error)
+	UpdateUserBalance(ctx context.Context, userID uuid.UUID, value types.Money, transacrionType types.TransactionType, source types.LedgerSource, referenceID uuid.NullUUID) (types.User, error)
+	ReconcileUserBalance(ctx context.Context, userID uuid.UUID) (types.BalanceReconciliation, error)
+	RebuildUserBalance(ctx context.Context, userID uuid.UUID) (types.User, error)
+	GetUserTransactions(ctx context.Context, filter types.LedgerFilter, cursor string) (types.LedgerPage, error)
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
 }
 
+const (
+	defaultTransactionsLimit = 50
+	maxTransactionsLimit     = 100
+)
+
 type component struct {
 	persistent  store.Persistent
 	pubsub      store.PubSub
@@ -43,18 +55,36 @@ func New(persistent store.Persistent, pubsub store.PubSub, jwtKey []byte, jwtDur
 	}
 }
 
-func (c *component) Register(ctx context.Context, user types.User) (types.User, string, error) {
+// Register creates the user with a referral code of their own. When they
+// register with the referral code of another player, in any case, the
+// referral is stored with them, unless the code belongs to an alias of their
+// own mailbox or the mailbox already has an account.
+func (c *component) Register(ctx context.Context, user types.User, referralCode string) (types.User, string, error) {
 	_, err := mail.ParseAddress(user.Email)
 	if err != nil {
 		return types.User{}, "", err
 	}
 
+	referralCode = normalizeReferralCode(referralCode)
+	if referralCode != "" {
+		user.ReferrerID, err = c.referrer(ctx, user.Email, referralCode)
+		if err != nil {
+			return types.User{}, "", err
+		}
+	}
+
+	user.ReferralCode, err = newReferralCode()
+	if err != nil {
+		return types.User{}, "", err
+	}
+
 	user.Password, err = hashPassword(user.Password)
 	if err != nil {
 		return types.User{}, "", err
 	}
 
 	user.ID = uuid.New()
+	user.Balance = types.NewMoney(decimal.Zero, types.DefaultCurrency)
 
 	now := time.Now()
 	user.Created = now
@@ -84,6 +114,48 @@ func (c *component) Register(ctx context.Context, user types.User) (types.User,
 	return createdUser, tokenString, err
 }
 
+func (c *component) referrer(ctx context.Context, email string, referralCode string) (uuid.NullUUID, error) {
+	referrer, err := c.persistent.UserGetBy(ctx, types.UserFilter{ByReferralCode: &referralCode})
+	if store.IsErrNotFound(err) {
+		return uuid.NullUUID{}, types.ErrInvalidReferralCode
+	}
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	mailbox := types.Mailbox(email)
+	if types.Mailbox(referrer.Email) == mailbox {
+		return uuid.NullUUID{}, types.ErrSelfReferral
+	}
+
+	_, err = c.persistent.UserGetBy(ctx, types.UserFilter{ByMailbox: &mailbox})
+	if err == nil {
+		return uuid.NullUUID{}, types.ErrDuplicateReferral
+	}
+	if !store.IsErrNotFound(err) {
+		return uuid.NullUUID{}, err
+	}
+
+	return uuid.NullUUID{UUID: referrer.ID, Valid: true}, nil
+}
+
+// normalizeReferralCode matches the upper case codes players are given.
+func normalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// newReferralCode returns a random code of 8 hexadecimal characters, the
+// format the database gives existing users.
+func newReferralCode() (string, error) {
+	code := make([]byte, 4)
+	_, err := rand.Read(code)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(hex.EncodeToString(code)), nil
+}
+
 func (c *component) Login(ctx context.Context, req types.User) (types.User, string, error) {
 	user, err := c.persistent.UserGetBy(ctx, types.UserFilter{ByEmail: &req.Email})
 	if store.IsErrNotFound(err) {
@@ -154,19 +226,46 @@ func (c *component) UpdateUser(ctx context.Context, user types.User) (types.User
 	return c.persistent.UserUpdate(ctx, user)
 }
 
-func (c *component) UpdateUserBalance(ctx context.Context, user types.User, value float64, transacrionType types.TransactionType) (types.User, error) {
-	if transacrionType == types.TransactionTypeRemove && user.Balance-value < 0 {
-		return types.User{}, types.ErrInsufficientBalance
+func (c *component) UpdateUserBalance(ctx context.Context, userID uuid.UUID, value types.Money, transacrionType types.TransactionType, source types.LedgerSource, referenceID uuid.NullUUID) (types.User, error) {
+	if !value.IsPositive() {
+		return types.User{}, types.ErrInvalidAmount
 	}
 
-	if transacrionType == types.TransactionTypeRemove && value > 0 {
-		value = value * -1
+	user, err := c.GetUser(ctx, userID)
+	if err != nil {
+		return types.User{}, err
+	}
+
+	if value.Currency == "" {
+		value.Currency = user.Balance.Currency
+	}
+
+	if value.Currency != user.Balance.Currency {
+		return types.User{}, types.ErrCurrencyMismatch
+	}
+
+	if transacrionType != types.TransactionTypeRemove {
+		return c.persistent.UserBalanceUpdate(ctx, types.NewPlayerCashEntry(userID, source, referenceID, value))
+	}
+
+	// the balance read above may be outdated by now, the withdrawal checks
+	// it again when it is written
+	user, err = c.persistent.UserBalanceWithdraw(ctx, types.NewPlayerCashEntry(userID, source, referenceID, value.Neg()))
+	if store.IsErrNotFound(err) {
+		return types.User{}, types.ErrInsufficientBalance
 	}
 
-	user, err := c.persistent.UserBalanceUpdate(ctx, user.ID, value)
 	return user, err
 }
 
+func (c *component) ReconcileUserBalance(ctx context.Context, userID uuid.UUID) (types.BalanceReconciliation, error) {
+	return c.persistent.UserBalanceReconcile(ctx, userID)
+}
+
+func (c *component) RebuildUserBalance(ctx context.Context, userID uuid.UUID) (types.User, error) {
+	return c.persistent.UserBalanceRebuild(ctx, userID)
+}
+
 func hashPassword(password string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -179,3 +278,40 @@ func comparePasswords(hashedPassword string, password string) (bool, error) {
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 	return err == nil, err
 }
+
+func (c *component) GetUserTransactions(ctx context.Context, filter types.LedgerFilter, cursor string) (types.LedgerPage, error) {
+	if cursor != "" {
+		after, err := types.ParseLedgerCursor(cursor)
+		if err != nil {
+			return types.LedgerPage{}, err
+		}
+		filter.After = &after
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultTransactionsLimit
+	}
+	filter.Limit = min(filter.Limit, maxTransactionsLimit)
+
+	limit := filter.Limit
+	// fetch one extra entry to know whether another page exists
+	filter.Limit++
+
+	entries, err := c.persistent.LedgerEntriesGet(ctx, filter)
+	if err != nil {
+		return types.LedgerPage{}, err
+	}
+
+	page := types.LedgerPage{Entries: entries}
+	if len(entries) > limit {
+		page.Entries = entries[:limit]
+		last := page.Entries[limit-1]
+		page.NextCursor = types.LedgerCursor{Created: last.Created, ID: last.ID}.String()
+	}
+
+	if page.Entries == nil {
+		page.Entries = []types.LedgerEntry{}
+	}
+
+	return page, nil
+}
@@ -3,6 +3,7 @@ package users_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -12,9 +13,14 @@ import (
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
 const (
 	jwtKey      = "test_key"
 	jwtDuration = time.Duration(time.Hour)
@@ -53,7 +59,7 @@ func TestGetUser(t *testing.T) {
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     1,
-							Balance:  0,
+							Balance:  eur(0),
 						}, nil
 					},
 				},
@@ -67,7 +73,7 @@ func TestGetUser(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password",
 				Role:     1,
-				Balance:  0,
+				Balance:  eur(0),
 			},
 		},
 	}
@@ -87,7 +93,8 @@ func TestGetUser(t *testing.T) {
 
 func TestRegister(t *testing.T) {
 	type args struct {
-		user types.User
+		user         types.User
+		referralCode string
 	}
 
 	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
@@ -95,6 +102,26 @@ func TestRegister(t *testing.T) {
 
 	conflictErr := errors.New(`ERROR: duplicate key value violates unique constraint \"users_email_key\" (SQLSTATE 23505)`)
 
+	referrer := types.User{
+		ID:           uuid.New(),
+		Name:         "Jane",
+		Email:        "jane@example.com",
+		ReferralCode: "A1B2C3D4",
+	}
+
+	// accounts finds the referrer by code and the accounts in mailboxes
+	accounts := func(mailboxes ...string) func(context.Context, types.UserFilter) (types.User, error) {
+		return func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+			if uf.ByReferralCode != nil && *uf.ByReferralCode == referrer.ReferralCode {
+				return referrer, nil
+			}
+			if uf.ByMailbox != nil && slices.Contains(mailboxes, *uf.ByMailbox) {
+				return types.User{ID: uuid.New()}, nil
+			}
+			return types.User{}, pgx.ErrNoRows
+		}
+	}
+
 	tests := []struct {
 		name           string
 		fields         fields
@@ -114,19 +141,19 @@ func TestRegister(t *testing.T) {
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     1,
-							Balance:  0,
+							Balance:  eur(0),
 						}, nil
 					},
 				},
 				tester: &fakes.FakeUserProvider{
-					RegisterStub: func(ctx context.Context, u types.User) (types.User, string, error) {
+					RegisterStub: func(ctx context.Context, u types.User, referralCode string) (types.User, string, error) {
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     1,
-							Balance:  0,
+							Balance:  eur(0),
 						}, "token", nil
 					},
 				},
@@ -143,7 +170,7 @@ func TestRegister(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password",
 				Role:     1,
-				Balance:  0,
+				Balance:  eur(0),
 			},
 		},
 		{
@@ -165,12 +192,116 @@ func TestRegister(t *testing.T) {
 			expectedOutput: types.User{},
 			expectedError:  conflictErr,
 		},
+		{
+			name: "it should register a referred user",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserGetByStub: accounts(),
+					UserCreateStub: func(ctx context.Context, u types.User) (types.User, error) {
+						require.Equal(t, uuid.NullUUID{UUID: referrer.ID, Valid: true}, u.ReferrerID)
+						require.Len(t, u.ReferralCode, 8)
+						return types.User{ID: ID, Name: u.Name, Email: u.Email}, nil
+					},
+				},
+				tester: &fakes.FakeUserProvider{},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				user: types.User{
+					Name:     "John",
+					Email:    "john@example.com",
+					Password: "password",
+				},
+				referralCode: referrer.ReferralCode,
+			},
+			expectedOutput: types.User{ID: ID, Name: "John", Email: "john@example.com"},
+		},
+		{
+			name: "it should find the referrer by a lower case code",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserGetByStub: accounts(),
+					UserCreateStub: func(ctx context.Context, u types.User) (types.User, error) {
+						require.Equal(t, uuid.NullUUID{UUID: referrer.ID, Valid: true}, u.ReferrerID)
+						return types.User{ID: ID, Name: "John", Email: "john@example.com"}, nil
+					},
+				},
+				tester: &fakes.FakeUserProvider{},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				user: types.User{
+					Name:     "John",
+					Email:    "john@example.com",
+					Password: "password",
+				},
+				referralCode: " a1b2c3d4 ",
+			},
+			expectedOutput: types.User{ID: ID, Name: "John", Email: "john@example.com"},
+		},
+		{
+			name: "it should fail an unknown referral code",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserGetByStub: accounts(),
+				},
+				tester: &fakes.FakeUserProvider{},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				user: types.User{
+					Name:     "John",
+					Email:    "john@example.com",
+					Password: "password",
+				},
+				referralCode: "FFFFFFFF",
+			},
+			expectedError: types.ErrInvalidReferralCode,
+		},
+		{
+			name: "it should fail a self referral from an alias",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserGetByStub: accounts(),
+				},
+				tester: &fakes.FakeUserProvider{},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				user: types.User{
+					Name:     "Jane",
+					Email:    "Jane+bonus@example.com",
+					Password: "password",
+				},
+				referralCode: referrer.ReferralCode,
+			},
+			expectedError: types.ErrSelfReferral,
+		},
+		{
+			name: "it should fail a referral of a mailbox with an account",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserGetByStub: accounts("john@example.com"),
+				},
+				tester: &fakes.FakeUserProvider{},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				user: types.User{
+					Name:     "John",
+					Email:    "john+2@example.com",
+					Password: "password",
+				},
+				referralCode: referrer.ReferralCode,
+			},
+			expectedError: types.ErrDuplicateReferral,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := users.New(tt.fields.persistentStore, tt.fields.pubsub, []byte(jwtKey), jwtDuration)
-			res, token, err := c.Register(context.Background(), tt.args.user)
+			res, token, err := c.Register(context.Background(), tt.args.user, tt.args.referralCode)
 
 			require.ErrorIs(t, err, tt.expectedError)
 			if tt.expectedError == nil {
@@ -194,7 +325,7 @@ func TestLogin(t *testing.T) {
 		Name:    "John",
 		Email:   "john@example.com",
 		Role:    1,
-		Balance: 0,
+		Balance: eur(0),
 	}
 
 	tests := []struct {
@@ -216,7 +347,7 @@ func TestLogin(t *testing.T) {
 							Email:    "john@example.com",
 							Password: "$2a$10$slqGr93DMCar8kc6BkCY0.EeZ3/a70D7bq1/gD25pcSw2k0c9d2gW",
 							Role:     1,
-							Balance:  0,
+							Balance:  eur(0),
 						}, nil
 					},
 				},
@@ -313,7 +444,7 @@ func TestGetUsers(t *testing.T) {
 								Email:    "john@example.com",
 								Password: "password",
 								Role:     1,
-								Balance:  0,
+								Balance:  eur(0),
 							},
 						}, nil
 					},
@@ -327,7 +458,7 @@ func TestGetUsers(t *testing.T) {
 								Email:    "john@example.com",
 								Password: "password",
 								Role:     1,
-								Balance:  0,
+								Balance:  eur(0),
 							},
 						}, nil
 					},
@@ -341,7 +472,7 @@ func TestGetUsers(t *testing.T) {
 					Email:    "john@example.com",
 					Password: "password",
 					Role:     1,
-					Balance:  0,
+					Balance:  eur(0),
 				},
 			},
 		},
@@ -387,7 +518,7 @@ func TestUpdateUser(t *testing.T) {
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  0,
+							Balance:  eur(0),
 						}, nil
 					},
 				},
@@ -399,7 +530,7 @@ func TestUpdateUser(t *testing.T) {
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  0,
+							Balance:  eur(0),
 						}, nil
 					},
 				},
@@ -412,7 +543,7 @@ func TestUpdateUser(t *testing.T) {
 					Email:    "john@example.com",
 					Password: "password",
 					Role:     0,
-					Balance:  0,
+					Balance:  eur(0),
 				},
 			},
 			expectedOutput: types.User{
@@ -421,7 +552,7 @@ func TestUpdateUser(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password",
 				Role:     0,
-				Balance:  0,
+				Balance:  eur(0),
 			},
 		},
 		{
@@ -446,7 +577,7 @@ func TestUpdateUser(t *testing.T) {
 					Email:    "john@example.com",
 					Password: "password",
 					Role:     1,
-					Balance:  0,
+					Balance:  eur(0),
 				},
 			},
 			expectedOutput: types.User{},
@@ -472,9 +603,9 @@ func TestUpdateUserBalance(t *testing.T) {
 	require.NoError(t, err)
 
 	type args struct {
-		user        types.User
+		userID      uuid.UUID
 		transaction types.TransactionType
-		value       float64
+		value       types.Money
 	}
 
 	tests := []struct {
@@ -489,42 +620,41 @@ func TestUpdateUserBalance(t *testing.T) {
 			name: "it should update balance add",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+					UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+						return types.User{ID: ID, Balance: eur(0)}, nil
+					},
+					UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+						require.Equal(t, types.LedgerAccountCashier, e.DebitAccount)
+						require.Equal(t, types.LedgerAccountPlayerCash, e.CreditAccount)
+						require.Equal(t, eur(10), e.Amount)
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  10,
+							Balance:  eur(10),
 						}, nil
 					},
 				},
 				tester: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, u types.User, f float64, tt types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, u uuid.UUID, f types.Money, tt types.TransactionType, s types.LedgerSource, r uuid.NullUUID) (types.User, error) {
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  10,
+							Balance:  eur(10),
 						}, nil
 					},
 				},
 				pubsub: &fakes.FakePubSub{},
 			},
 			args: args{
-				user: types.User{
-					ID:       ID,
-					Name:     "John",
-					Email:    "john@example.com",
-					Password: "password",
-					Role:     0,
-					Balance:  0,
-				},
+				userID:      ID,
 				transaction: types.TransactionTypeAdd,
-				value:       10,
+				value:       eur(10),
 			},
 			expectedOutput: types.User{
 				ID:       ID,
@@ -532,49 +662,48 @@ func TestUpdateUserBalance(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password",
 				Role:     0,
-				Balance:  10,
+				Balance:  eur(10),
 			},
 		},
 		{
 			name: "it should update balance remove",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+					UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+						return types.User{ID: ID, Balance: eur(20)}, nil
+					},
+					UserBalanceWithdrawStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+						require.Equal(t, types.LedgerAccountPlayerCash, e.DebitAccount)
+						require.Equal(t, types.LedgerAccountCashier, e.CreditAccount)
+						require.Equal(t, eur(10), e.Amount)
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  10,
+							Balance:  eur(10),
 						}, nil
 					},
 				},
 				tester: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, u types.User, f float64, tt types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, u uuid.UUID, f types.Money, tt types.TransactionType, s types.LedgerSource, r uuid.NullUUID) (types.User, error) {
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  10,
+							Balance:  eur(10),
 						}, nil
 					},
 				},
 				pubsub: &fakes.FakePubSub{},
 			},
 			args: args{
-				user: types.User{
-					ID:       ID,
-					Name:     "John",
-					Email:    "john@example.com",
-					Password: "password",
-					Role:     0,
-					Balance:  20,
-				},
+				userID:      ID,
 				transaction: types.TransactionTypeRemove,
-				value:       10,
+				value:       eur(10),
 			},
 			expectedOutput: types.User{
 				ID:       ID,
@@ -582,35 +711,31 @@ func TestUpdateUserBalance(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password",
 				Role:     0,
-				Balance:  10,
+				Balance:  eur(10),
 			},
 		},
 		{
 			name: "it should fail update balance remove insufficient funds",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
-						return types.User{}, nil
+					UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+						return types.User{ID: ID, Balance: eur(5)}, nil
+					},
+					UserBalanceWithdrawStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
+						return types.User{}, pgx.ErrNoRows
 					},
 				},
 				tester: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, u types.User, f float64, tt types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, u uuid.UUID, f types.Money, tt types.TransactionType, s types.LedgerSource, r uuid.NullUUID) (types.User, error) {
 						return types.User{}, types.ErrInsufficientBalance
 					},
 				},
 				pubsub: &fakes.FakePubSub{},
 			},
 			args: args{
-				user: types.User{
-					ID:       ID,
-					Name:     "John",
-					Email:    "john@example.com",
-					Password: "password",
-					Role:     0,
-					Balance:  5,
-				},
+				userID:      ID,
 				transaction: types.TransactionTypeRemove,
-				value:       10,
+				value:       eur(10),
 			},
 			expectedOutput: types.User{},
 			expectedError:  types.ErrInsufficientBalance,
@@ -619,38 +744,68 @@ func TestUpdateUserBalance(t *testing.T) {
 			name: "it should fail not found",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+					UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+						return types.User{}, pgx.ErrNoRows
+					},
+					UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
 						return types.User{}, pgx.ErrNoRows
 					},
 				},
 				tester: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, u types.User, f float64, tt types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, u uuid.UUID, f types.Money, tt types.TransactionType, s types.LedgerSource, r uuid.NullUUID) (types.User, error) {
 						return types.User{}, pgx.ErrNoRows
 					},
 				},
 				pubsub: &fakes.FakePubSub{},
 			},
 			args: args{
-				user: types.User{
-					ID:       ID,
-					Name:     "John",
-					Email:    "john@example.com",
-					Password: "password",
-					Role:     1,
-					Balance:  0,
-				},
+				userID:      ID,
 				transaction: types.TransactionTypeAdd,
-				value:       10,
+				value:       eur(10),
 			},
 			expectedOutput: types.User{},
 			expectedError:  pgx.ErrNoRows,
 		},
+		{
+			name: "it should fail update balance currency mismatch",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					UserGetByStub: func(ctx context.Context, uf types.UserFilter) (types.User, error) {
+						return types.User{ID: ID, Balance: types.NewMoney(decimal.NewFromInt(10), "USD")}, nil
+					},
+				},
+				tester: &fakes.FakeUserProvider{},
+				pubsub: &fakes.FakePubSub{},
+			},
+			args: args{
+				userID:      ID,
+				transaction: types.TransactionTypeAdd,
+				value:       eur(10),
+			},
+			expectedOutput: types.User{},
+			expectedError:  types.ErrCurrencyMismatch,
+		},
+		{
+			name: "it should fail update balance with non positive amount",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+				tester:          &fakes.FakeUserProvider{},
+				pubsub:          &fakes.FakePubSub{},
+			},
+			args: args{
+				userID:      ID,
+				transaction: types.TransactionTypeAdd,
+				value:       eur(0),
+			},
+			expectedOutput: types.User{},
+			expectedError:  types.ErrInvalidAmount,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := users.New(tt.fields.persistentStore, tt.fields.pubsub, []byte(jwtKey), jwtDuration)
-			res, err := c.UpdateUserBalance(context.Background(), tt.args.user, tt.args.value, tt.args.transaction)
+			res, err := c.UpdateUserBalance(context.Background(), tt.args.userID, tt.args.value, tt.args.transaction, types.LedgerSourceManual, uuid.NullUUID{})
 
 			require.ErrorIs(t, err, tt.expectedError)
 			if tt.expectedError == nil {
@@ -679,26 +834,26 @@ func TestDeleteUser(t *testing.T) {
 			name: "it should delete user",
 			fields: fields{
 				persistentStore: &fakes.FakePersistent{
-					UserBalanceUpdateStub: func(ctx context.Context, u uuid.UUID, f float64) (types.User, error) {
+					UserBalanceUpdateStub: func(ctx context.Context, e types.LedgerEntry) (types.User, error) {
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  10,
+							Balance:  eur(10),
 						}, nil
 					},
 				},
 				tester: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, u types.User, f float64, tt types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, u uuid.UUID, f types.Money, tt types.TransactionType, s types.LedgerSource, r uuid.NullUUID) (types.User, error) {
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     0,
-							Balance:  10,
+							Balance:  eur(10),
 						}, nil
 					},
 				},
@@ -739,3 +894,108 @@ func TestDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUserTransactions(t *testing.T) {
+	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	entries := []types.LedgerEntry{
+		types.NewPlayerCashEntry(ID, types.LedgerSourceManual, uuid.NullUUID{}, eur(10)),
+		types.NewPlayerCashEntry(ID, types.LedgerSourceManual, uuid.NullUUID{}, eur(5)),
+	}
+	entries[0].ID, entries[0].Created = uuid.New(), created
+	entries[1].ID, entries[1].Created = uuid.New(), created.Add(-time.Hour)
+
+	cursor := types.LedgerCursor{Created: created, ID: entries[0].ID}
+
+	type args struct {
+		filter types.LedgerFilter
+		cursor string
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		expectedOutput types.LedgerPage
+		expectedError  error
+	}{
+		{
+			name: "it should return a page with a next cursor",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					LedgerEntriesGetStub: func(ctx context.Context, f types.LedgerFilter) ([]types.LedgerEntry, error) {
+						require.Equal(t, 2, f.Limit)
+						require.Nil(t, f.After)
+						return entries, nil
+					},
+				},
+			},
+			args: args{
+				filter: types.LedgerFilter{UserID: ID, Limit: 1},
+			},
+			expectedOutput: types.LedgerPage{
+				Entries:    entries[:1],
+				NextCursor: cursor.String(),
+			},
+		},
+		{
+			name: "it should continue from the cursor",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					LedgerEntriesGetStub: func(ctx context.Context, f types.LedgerFilter) ([]types.LedgerEntry, error) {
+						require.Equal(t, 51, f.Limit)
+						require.Equal(t, &cursor, f.After)
+						return entries[1:], nil
+					},
+				},
+			},
+			args: args{
+				filter: types.LedgerFilter{UserID: ID},
+				cursor: cursor.String(),
+			},
+			expectedOutput: types.LedgerPage{
+				Entries: entries[1:],
+			},
+		},
+		{
+			name: "it should cap the limit and return an empty page",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{
+					LedgerEntriesGetStub: func(ctx context.Context, f types.LedgerFilter) ([]types.LedgerEntry, error) {
+						require.Equal(t, 101, f.Limit)
+						return nil, nil
+					},
+				},
+			},
+			args: args{
+				filter: types.LedgerFilter{UserID: ID, Limit: 1000},
+			},
+			expectedOutput: types.LedgerPage{
+				Entries: []types.LedgerEntry{},
+			},
+		},
+		{
+			name: "it should fail invalid cursor",
+			fields: fields{
+				persistentStore: &fakes.FakePersistent{},
+			},
+			args: args{
+				filter: types.LedgerFilter{UserID: ID},
+				cursor: "not-a-cursor",
+			},
+			expectedError: types.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := users.New(tt.fields.persistentStore, tt.fields.pubsub, []byte(jwtKey), jwtDuration)
+			page, err := c.GetUserTransactions(context.Background(), tt.args.filter, tt.args.cursor)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedOutput, page)
+		})
+	}
+}
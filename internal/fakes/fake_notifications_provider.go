@@ -6,17 +6,19 @@ import (
 	"sync"
 
 	notifications "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/notificaitons"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/coder/websocket"
 	"github.com/google/uuid"
 )
 
 type FakeNotificationProvider struct {
-	ListenToNotificationsStub        func(context.Context, *websocket.Conn, uuid.UUID) error
+	ListenToNotificationsStub        func(context.Context, *websocket.Conn, uuid.UUID, types.UserType) error
 	listenToNotificationsMutex       sync.RWMutex
 	listenToNotificationsArgsForCall []struct {
 		arg1 context.Context
 		arg2 *websocket.Conn
 		arg3 uuid.UUID
+		arg4 types.UserType
 	}
 	listenToNotificationsReturns struct {
 		result1 error
@@ -28,20 +30,21 @@ type FakeNotificationProvider struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeNotificationProvider) ListenToNotifications(arg1 context.Context, arg2 *websocket.Conn, arg3 uuid.UUID) error {
+func (fake *FakeNotificationProvider) ListenToNotifications(arg1 context.Context, arg2 *websocket.Conn, arg3 uuid.UUID, arg4 types.UserType) error {
 	fake.listenToNotificationsMutex.Lock()
 	ret, specificReturn := fake.listenToNotificationsReturnsOnCall[len(fake.listenToNotificationsArgsForCall)]
 	fake.listenToNotificationsArgsForCall = append(fake.listenToNotificationsArgsForCall, struct {
 		arg1 context.Context
 		arg2 *websocket.Conn
 		arg3 uuid.UUID
-	}{arg1, arg2, arg3})
+		arg4 types.UserType
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.ListenToNotificationsStub
 	fakeReturns := fake.listenToNotificationsReturns
-	fake.recordInvocation("ListenToNotifications", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("ListenToNotifications", []interface{}{arg1, arg2, arg3, arg4})
 	fake.listenToNotificationsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -55,17 +58,17 @@ func (fake *FakeNotificationProvider) ListenToNotificationsCallCount() int {
 	return len(fake.listenToNotificationsArgsForCall)
 }
 
-func (fake *FakeNotificationProvider) ListenToNotificationsCalls(stub func(context.Context, *websocket.Conn, uuid.UUID) error) {
+func (fake *FakeNotificationProvider) ListenToNotificationsCalls(stub func(context.Context, *websocket.Conn, uuid.UUID, types.UserType) error) {
 	fake.listenToNotificationsMutex.Lock()
 	defer fake.listenToNotificationsMutex.Unlock()
 	fake.ListenToNotificationsStub = stub
 }
 
-func (fake *FakeNotificationProvider) ListenToNotificationsArgsForCall(i int) (context.Context, *websocket.Conn, uuid.UUID) {
+func (fake *FakeNotificationProvider) ListenToNotificationsArgsForCall(i int) (context.Context, *websocket.Conn, uuid.UUID, types.UserType) {
 	fake.listenToNotificationsMutex.RLock()
 	defer fake.listenToNotificationsMutex.RUnlock()
 	argsForCall := fake.listenToNotificationsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeNotificationProvider) ListenToNotificationsReturns(result1 error) {
@@ -4,10 +4,12 @@ package fakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type FakePersistent struct {
@@ -25,11 +27,91 @@ type FakePersistent struct {
 		result1 types.UserPromotion
 		result2 error
 	}
-	ClaimPromotionStub        func(context.Context, uuid.UUID) error
+	CashbackCalculationCreateStub        func(context.Context, types.CashbackCalculation) (bool, error)
+	cashbackCalculationCreateMutex       sync.RWMutex
+	cashbackCalculationCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.CashbackCalculation
+	}
+	cashbackCalculationCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	cashbackCalculationCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CatalogItemCreateStub        func(context.Context, types.CatalogItem) (types.CatalogItem, error)
+	catalogItemCreateMutex       sync.RWMutex
+	catalogItemCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.CatalogItem
+	}
+	catalogItemCreateReturns struct {
+		result1 types.CatalogItem
+		result2 error
+	}
+	catalogItemCreateReturnsOnCall map[int]struct {
+		result1 types.CatalogItem
+		result2 error
+	}
+	CatalogItemDeleteStub        func(context.Context, uuid.UUID) error
+	catalogItemDeleteMutex       sync.RWMutex
+	catalogItemDeleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	catalogItemDeleteReturns struct {
+		result1 error
+	}
+	catalogItemDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CatalogItemGetByIDStub        func(context.Context, uuid.UUID) (types.CatalogItem, error)
+	catalogItemGetByIDMutex       sync.RWMutex
+	catalogItemGetByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	catalogItemGetByIDReturns struct {
+		result1 types.CatalogItem
+		result2 error
+	}
+	catalogItemGetByIDReturnsOnCall map[int]struct {
+		result1 types.CatalogItem
+		result2 error
+	}
+	CatalogItemReserveStub        func(context.Context, uuid.UUID) error
+	catalogItemReserveMutex       sync.RWMutex
+	catalogItemReserveArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	catalogItemReserveReturns struct {
+		result1 error
+	}
+	catalogItemReserveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CatalogItemUpdateStub        func(context.Context, types.CatalogItem) (types.CatalogItem, error)
+	catalogItemUpdateMutex       sync.RWMutex
+	catalogItemUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.CatalogItem
+	}
+	catalogItemUpdateReturns struct {
+		result1 types.CatalogItem
+		result2 error
+	}
+	catalogItemUpdateReturnsOnCall map[int]struct {
+		result1 types.CatalogItem
+		result2 error
+	}
+	ClaimPromotionStub        func(context.Context, types.UserPromotion) error
 	claimPromotionMutex       sync.RWMutex
 	claimPromotionArgsForCall []struct {
 		arg1 context.Context
-		arg2 uuid.UUID
+		arg2 types.UserPromotion
 	}
 	claimPromotionReturns struct {
 		result1 error
@@ -60,236 +142,7706 @@ type FakePersistent struct {
 	deleteUserPromotionReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetPromotionsStub        func(context.Context) ([]types.Promotion, error)
-	getPromotionsMutex       sync.RWMutex
-	getPromotionsArgsForCall []struct {
+	FreeSpinRoundCreateStub        func(context.Context, uuid.UUID, types.FreeSpin) (bool, error)
+	freeSpinRoundCreateMutex       sync.RWMutex
+	freeSpinRoundCreateArgsForCall []struct {
 		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.FreeSpin
 	}
-	getPromotionsReturns struct {
-		result1 []types.Promotion
+	freeSpinRoundCreateReturns struct {
+		result1 bool
 		result2 error
 	}
-	getPromotionsReturnsOnCall map[int]struct {
-		result1 []types.Promotion
+	freeSpinRoundCreateReturnsOnCall map[int]struct {
+		result1 bool
 		result2 error
 	}
-	GetUserPromotionByIDStub        func(context.Context, uuid.UUID) (types.UserPromotion, error)
-	getUserPromotionByIDMutex       sync.RWMutex
-	getUserPromotionByIDArgsForCall []struct {
+	FreeSpinsConsumeStub        func(context.Context, uuid.UUID, types.Money) (types.FreeSpinsEntitlement, error)
+	freeSpinsConsumeMutex       sync.RWMutex
+	freeSpinsConsumeArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 types.Money
 	}
-	getUserPromotionByIDReturns struct {
-		result1 types.UserPromotion
+	freeSpinsConsumeReturns struct {
+		result1 types.FreeSpinsEntitlement
 		result2 error
 	}
-	getUserPromotionByIDReturnsOnCall map[int]struct {
-		result1 types.UserPromotion
+	freeSpinsConsumeReturnsOnCall map[int]struct {
+		result1 types.FreeSpinsEntitlement
 		result2 error
 	}
-	GetUserPromotionsStub        func(context.Context, uuid.UUID) ([]types.UserPromotion, error)
-	getUserPromotionsMutex       sync.RWMutex
-	getUserPromotionsArgsForCall []struct {
+	FreeSpinsCreateStub        func(context.Context, types.FreeSpinsEntitlement) error
+	freeSpinsCreateMutex       sync.RWMutex
+	freeSpinsCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.FreeSpinsEntitlement
+	}
+	freeSpinsCreateReturns struct {
+		result1 error
+	}
+	freeSpinsCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FreeSpinsGetByIDStub        func(context.Context, uuid.UUID) (types.FreeSpinsEntitlement, error)
+	freeSpinsGetByIDMutex       sync.RWMutex
+	freeSpinsGetByIDArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
 	}
-	getUserPromotionsReturns struct {
+	freeSpinsGetByIDReturns struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}
+	freeSpinsGetByIDReturnsOnCall map[int]struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}
+	FreeSpinsSettleStub        func(context.Context, uuid.UUID) (types.FreeSpinsEntitlement, error)
+	freeSpinsSettleMutex       sync.RWMutex
+	freeSpinsSettleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	freeSpinsSettleReturns struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}
+	freeSpinsSettleReturnsOnCall map[int]struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}
+	FreeSpinsVoidStub        func(context.Context, uuid.UUID) error
+	freeSpinsVoidMutex       sync.RWMutex
+	freeSpinsVoidArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	freeSpinsVoidReturns struct {
+		result1 error
+	}
+	freeSpinsVoidReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GameEventCreateStub        func(context.Context, types.GameEvent) (bool, error)
+	gameEventCreateMutex       sync.RWMutex
+	gameEventCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.GameEvent
+	}
+	gameEventCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	gameEventCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GameEventGetStub        func(context.Context, string, string, types.GameEventType) (types.GameEvent, error)
+	gameEventGetMutex       sync.RWMutex
+	gameEventGetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 types.GameEventType
+	}
+	gameEventGetReturns struct {
+		result1 types.GameEvent
+		result2 error
+	}
+	gameEventGetReturnsOnCall map[int]struct {
+		result1 types.GameEvent
+		result2 error
+	}
+	GameEventGetByEventIDStub        func(context.Context, string, string) (types.GameEvent, error)
+	gameEventGetByEventIDMutex       sync.RWMutex
+	gameEventGetByEventIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	gameEventGetByEventIDReturns struct {
+		result1 types.GameEvent
+		result2 error
+	}
+	gameEventGetByEventIDReturnsOnCall map[int]struct {
+		result1 types.GameEvent
+		result2 error
+	}
+	GetActiveFreeSpinsStub        func(context.Context, uuid.UUID, string) ([]types.FreeSpinsEntitlement, error)
+	getActiveFreeSpinsMutex       sync.RWMutex
+	getActiveFreeSpinsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	getActiveFreeSpinsReturns struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}
+	getActiveFreeSpinsReturnsOnCall map[int]struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}
+	GetCashbackCalculationsStub        func(context.Context, uuid.UUID) ([]types.CashbackCalculation, error)
+	getCashbackCalculationsMutex       sync.RWMutex
+	getCashbackCalculationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getCashbackCalculationsReturns struct {
+		result1 []types.CashbackCalculation
+		result2 error
+	}
+	getCashbackCalculationsReturnsOnCall map[int]struct {
+		result1 []types.CashbackCalculation
+		result2 error
+	}
+	GetCatalogItemsStub        func(context.Context, bool) ([]types.CatalogItem, error)
+	getCatalogItemsMutex       sync.RWMutex
+	getCatalogItemsArgsForCall []struct {
+		arg1 context.Context
+		arg2 bool
+	}
+	getCatalogItemsReturns struct {
+		result1 []types.CatalogItem
+		result2 error
+	}
+	getCatalogItemsReturnsOnCall map[int]struct {
+		result1 []types.CatalogItem
+		result2 error
+	}
+	GetEligibilityProfileStub        func(context.Context, uuid.UUID) (types.EligibilityProfile, error)
+	getEligibilityProfileMutex       sync.RWMutex
+	getEligibilityProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getEligibilityProfileReturns struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}
+	getEligibilityProfileReturnsOnCall map[int]struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}
+	GetEndedTournamentsStub        func(context.Context, time.Time) ([]types.Tournament, error)
+	getEndedTournamentsMutex       sync.RWMutex
+	getEndedTournamentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getEndedTournamentsReturns struct {
+		result1 []types.Tournament
+		result2 error
+	}
+	getEndedTournamentsReturnsOnCall map[int]struct {
+		result1 []types.Tournament
+		result2 error
+	}
+	GetExpiredFreeSpinsStub        func(context.Context, time.Time, int) ([]types.FreeSpinsEntitlement, error)
+	getExpiredFreeSpinsMutex       sync.RWMutex
+	getExpiredFreeSpinsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	getExpiredFreeSpinsReturns struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}
+	getExpiredFreeSpinsReturnsOnCall map[int]struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}
+	GetExpiredUserPromotionBonusesStub        func(context.Context, time.Time, int) ([]types.UserPromotion, error)
+	getExpiredUserPromotionBonusesMutex       sync.RWMutex
+	getExpiredUserPromotionBonusesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	getExpiredUserPromotionBonusesReturns struct {
 		result1 []types.UserPromotion
 		result2 error
 	}
-	getUserPromotionsReturnsOnCall map[int]struct {
+	getExpiredUserPromotionBonusesReturnsOnCall map[int]struct {
 		result1 []types.UserPromotion
 		result2 error
 	}
-	GetUsersStub        func(context.Context) ([]types.User, error)
-	getUsersMutex       sync.RWMutex
-	getUsersArgsForCall []struct {
+	GetNetLossesStub        func(context.Context, types.Currency, time.Time, time.Time) ([]types.CashbackCalculation, error)
+	getNetLossesMutex       sync.RWMutex
+	getNetLossesArgsForCall []struct {
 		arg1 context.Context
+		arg2 types.Currency
+		arg3 time.Time
+		arg4 time.Time
 	}
-	getUsersReturns struct {
-		result1 []types.User
+	getNetLossesReturns struct {
+		result1 []types.CashbackCalculation
 		result2 error
 	}
-	getUsersReturnsOnCall map[int]struct {
-		result1 []types.User
+	getNetLossesReturnsOnCall map[int]struct {
+		result1 []types.CashbackCalculation
 		result2 error
 	}
-	PromotionCreateStub        func(context.Context, types.Promotion) (types.Promotion, error)
-	promotionCreateMutex       sync.RWMutex
-	promotionCreateArgsForCall []struct {
+	GetPointsLiabilityStub        func(context.Context, time.Time, time.Time) ([]types.PointsLiability, error)
+	getPointsLiabilityMutex       sync.RWMutex
+	getPointsLiabilityArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.Promotion
+		arg2 time.Time
+		arg3 time.Time
 	}
-	promotionCreateReturns struct {
-		result1 types.Promotion
+	getPointsLiabilityReturns struct {
+		result1 []types.PointsLiability
 		result2 error
 	}
-	promotionCreateReturnsOnCall map[int]struct {
-		result1 types.Promotion
+	getPointsLiabilityReturnsOnCall map[int]struct {
+		result1 []types.PointsLiability
+		result2 error
+	}
+	GetPointsRatesStub        func(context.Context) ([]types.PointsRate, error)
+	getPointsRatesMutex       sync.RWMutex
+	getPointsRatesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getPointsRatesReturns struct {
+		result1 []types.PointsRate
+		result2 error
+	}
+	getPointsRatesReturnsOnCall map[int]struct {
+		result1 []types.PointsRate
 		result2 error
 	}
-	PromotionDeleteStub        func(context.Context, uuid.UUID) error
-	promotionDeleteMutex       sync.RWMutex
-	promotionDeleteArgsForCall []struct {
+	GetPromotionCodesStub        func(context.Context, uuid.UUID) ([]types.PromotionCode, error)
+	getPromotionCodesMutex       sync.RWMutex
+	getPromotionCodesArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
 	}
-	promotionDeleteReturns struct {
-		result1 error
+	getPromotionCodesReturns struct {
+		result1 []types.PromotionCode
+		result2 error
 	}
-	promotionDeleteReturnsOnCall map[int]struct {
-		result1 error
+	getPromotionCodesReturnsOnCall map[int]struct {
+		result1 []types.PromotionCode
+		result2 error
 	}
-	PromotionGetByIDStub        func(context.Context, uuid.UUID) (types.Promotion, error)
-	promotionGetByIDMutex       sync.RWMutex
-	promotionGetByIDArgsForCall []struct {
+	GetPromotionStateChangesStub        func(context.Context, uuid.UUID) ([]types.PromotionStateChange, error)
+	getPromotionStateChangesMutex       sync.RWMutex
+	getPromotionStateChangesArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
 	}
-	promotionGetByIDReturns struct {
-		result1 types.Promotion
+	getPromotionStateChangesReturns struct {
+		result1 []types.PromotionStateChange
 		result2 error
 	}
-	promotionGetByIDReturnsOnCall map[int]struct {
-		result1 types.Promotion
+	getPromotionStateChangesReturnsOnCall map[int]struct {
+		result1 []types.PromotionStateChange
 		result2 error
 	}
-	PromotionGetByTypeStub        func(context.Context, types.PromotionType) (types.Promotion, error)
-	promotionGetByTypeMutex       sync.RWMutex
-	promotionGetByTypeArgsForCall []struct {
+	GetPromotionsStub        func(context.Context, types.PromotionFilter) ([]types.Promotion, error)
+	getPromotionsMutex       sync.RWMutex
+	getPromotionsArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.PromotionType
+		arg2 types.PromotionFilter
 	}
-	promotionGetByTypeReturns struct {
-		result1 types.Promotion
+	getPromotionsReturns struct {
+		result1 []types.Promotion
 		result2 error
 	}
-	promotionGetByTypeReturnsOnCall map[int]struct {
-		result1 types.Promotion
+	getPromotionsReturnsOnCall map[int]struct {
+		result1 []types.Promotion
 		result2 error
 	}
-	PromotionUpdateStub        func(context.Context, types.Promotion) (types.Promotion, error)
-	promotionUpdateMutex       sync.RWMutex
-	promotionUpdateArgsForCall []struct {
+	GetQualifiedReferralsStub        func(context.Context, types.ReferralCondition, decimal.Decimal, int) ([]types.Referral, error)
+	getQualifiedReferralsMutex       sync.RWMutex
+	getQualifiedReferralsArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.Promotion
+		arg2 types.ReferralCondition
+		arg3 decimal.Decimal
+		arg4 int
 	}
-	promotionUpdateReturns struct {
-		result1 types.Promotion
+	getQualifiedReferralsReturns struct {
+		result1 []types.Referral
 		result2 error
 	}
-	promotionUpdateReturnsOnCall map[int]struct {
-		result1 types.Promotion
+	getQualifiedReferralsReturnsOnCall map[int]struct {
+		result1 []types.Referral
 		result2 error
 	}
-	RollbackTxStub        func(context.Context) error
-	rollbackTxMutex       sync.RWMutex
-	rollbackTxArgsForCall []struct {
+	GetRedemptionsStub        func(context.Context, types.RedemptionFilter) ([]types.Redemption, error)
+	getRedemptionsMutex       sync.RWMutex
+	getRedemptionsArgsForCall []struct {
 		arg1 context.Context
+		arg2 types.RedemptionFilter
 	}
-	rollbackTxReturns struct {
-		result1 error
+	getRedemptionsReturns struct {
+		result1 []types.Redemption
+		result2 error
 	}
-	rollbackTxReturnsOnCall map[int]struct {
-		result1 error
+	getRedemptionsReturnsOnCall map[int]struct {
+		result1 []types.Redemption
+		result2 error
 	}
-	UserBalanceUpdateStub        func(context.Context, uuid.UUID, float64) (types.User, error)
-	userBalanceUpdateMutex       sync.RWMutex
-	userBalanceUpdateArgsForCall []struct {
+	GetReferralReportStub        func(context.Context) ([]types.ReferralReport, error)
+	getReferralReportMutex       sync.RWMutex
+	getReferralReportArgsForCall []struct {
 		arg1 context.Context
-		arg2 uuid.UUID
-		arg3 float64
 	}
-	userBalanceUpdateReturns struct {
-		result1 types.User
+	getReferralReportReturns struct {
+		result1 []types.ReferralReport
 		result2 error
 	}
-	userBalanceUpdateReturnsOnCall map[int]struct {
-		result1 types.User
+	getReferralReportReturnsOnCall map[int]struct {
+		result1 []types.ReferralReport
 		result2 error
 	}
-	UserCreateStub        func(context.Context, types.User) (types.User, error)
-	userCreateMutex       sync.RWMutex
-	userCreateArgsForCall []struct {
+	GetRunningTournamentsStub        func(context.Context, time.Time) ([]types.Tournament, error)
+	getRunningTournamentsMutex       sync.RWMutex
+	getRunningTournamentsArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.User
+		arg2 time.Time
 	}
-	userCreateReturns struct {
-		result1 types.User
+	getRunningTournamentsReturns struct {
+		result1 []types.Tournament
 		result2 error
 	}
-	userCreateReturnsOnCall map[int]struct {
-		result1 types.User
+	getRunningTournamentsReturnsOnCall map[int]struct {
+		result1 []types.Tournament
 		result2 error
 	}
-	UserDeleteStub        func(context.Context, uuid.UUID) error
-	userDeleteMutex       sync.RWMutex
-	userDeleteArgsForCall []struct {
+	GetTierHistoryStub        func(context.Context, uuid.UUID) ([]types.TierHistoryEntry, error)
+	getTierHistoryMutex       sync.RWMutex
+	getTierHistoryArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
 	}
-	userDeleteReturns struct {
-		result1 error
+	getTierHistoryReturns struct {
+		result1 []types.TierHistoryEntry
+		result2 error
 	}
-	userDeleteReturnsOnCall map[int]struct {
-		result1 error
+	getTierHistoryReturnsOnCall map[int]struct {
+		result1 []types.TierHistoryEntry
+		result2 error
 	}
-	UserGetByStub        func(context.Context, types.UserFilter) (types.User, error)
-	userGetByMutex       sync.RWMutex
-	userGetByArgsForCall []struct {
+	GetTiersStub        func(context.Context) ([]types.Tier, error)
+	getTiersMutex       sync.RWMutex
+	getTiersArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.UserFilter
 	}
-	userGetByReturns struct {
-		result1 types.User
+	getTiersReturns struct {
+		result1 []types.Tier
 		result2 error
 	}
-	userGetByReturnsOnCall map[int]struct {
-		result1 types.User
+	getTiersReturnsOnCall map[int]struct {
+		result1 []types.Tier
 		result2 error
 	}
-	UserUpdateStub        func(context.Context, types.User) (types.User, error)
-	userUpdateMutex       sync.RWMutex
-	userUpdateArgsForCall []struct {
+	GetTournamentResultsStub        func(context.Context, uuid.UUID) ([]types.TournamentResult, error)
+	getTournamentResultsMutex       sync.RWMutex
+	getTournamentResultsArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.User
+		arg2 uuid.UUID
 	}
-	userUpdateReturns struct {
-		result1 types.User
+	getTournamentResultsReturns struct {
+		result1 []types.TournamentResult
 		result2 error
 	}
-	userUpdateReturnsOnCall map[int]struct {
-		result1 types.User
+	getTournamentResultsReturnsOnCall map[int]struct {
+		result1 []types.TournamentResult
 		result2 error
 	}
-	WithTxStub        func(context.Context) (store.Persistent, error)
-	withTxMutex       sync.RWMutex
-	withTxArgsForCall []struct {
+	GetTournamentsStub        func(context.Context) ([]types.Tournament, error)
+	getTournamentsMutex       sync.RWMutex
+	getTournamentsArgsForCall []struct {
 		arg1 context.Context
 	}
-	withTxReturns struct {
-		result1 store.Persistent
+	getTournamentsReturns struct {
+		result1 []types.Tournament
 		result2 error
 	}
-	withTxReturnsOnCall map[int]struct {
-		result1 store.Persistent
+	getTournamentsReturnsOnCall map[int]struct {
+		result1 []types.Tournament
 		result2 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
+	GetUnlockedWelcomeStepsStub        func(context.Context, int) ([]types.UnlockedWelcomeStep, error)
+	getUnlockedWelcomeStepsMutex       sync.RWMutex
+	getUnlockedWelcomeStepsArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	getUnlockedWelcomeStepsReturns struct {
+		result1 []types.UnlockedWelcomeStep
+		result2 error
+	}
+	getUnlockedWelcomeStepsReturnsOnCall map[int]struct {
+		result1 []types.UnlockedWelcomeStep
+		result2 error
+	}
+	GetUnmatchedDepositStub        func(context.Context, uuid.UUID, time.Time, types.Money) (types.LedgerEntry, error)
+	getUnmatchedDepositMutex       sync.RWMutex
+	getUnmatchedDepositArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 types.Money
+	}
+	getUnmatchedDepositReturns struct {
+		result1 types.LedgerEntry
+		result2 error
+	}
+	getUnmatchedDepositReturnsOnCall map[int]struct {
+		result1 types.LedgerEntry
+		result2 error
+	}
+	GetUnpaidTournamentResultsStub        func(context.Context) ([]types.TournamentResult, error)
+	getUnpaidTournamentResultsMutex       sync.RWMutex
+	getUnpaidTournamentResultsArgsForCall []struct {
+		arg1 context.Context
+	}
+	getUnpaidTournamentResultsReturns struct {
+		result1 []types.TournamentResult
+		result2 error
+	}
+	getUnpaidTournamentResultsReturnsOnCall map[int]struct {
+		result1 []types.TournamentResult
+		result2 error
+	}
+	GetUserPromotionByIDStub        func(context.Context, uuid.UUID) (types.UserPromotion, error)
+	getUserPromotionByIDMutex       sync.RWMutex
+	getUserPromotionByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserPromotionByIDReturns struct {
+		result1 types.UserPromotion
+		result2 error
+	}
+	getUserPromotionByIDReturnsOnCall map[int]struct {
+		result1 types.UserPromotion
+		result2 error
+	}
+	GetUserPromotionRevocationsStub        func(context.Context, uuid.UUID) ([]types.UserPromotionRevocation, error)
+	getUserPromotionRevocationsMutex       sync.RWMutex
+	getUserPromotionRevocationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserPromotionRevocationsReturns struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}
+	getUserPromotionRevocationsReturnsOnCall map[int]struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}
+	GetUserPromotionsStub        func(context.Context, uuid.UUID, types.UserPromotionFilter) ([]types.UserPromotion, error)
+	getUserPromotionsMutex       sync.RWMutex
+	getUserPromotionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.UserPromotionFilter
+	}
+	getUserPromotionsReturns struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	getUserPromotionsReturnsOnCall map[int]struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	GetUsersStub        func(context.Context) ([]types.User, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		arg1 context.Context
+	}
+	getUsersReturns struct {
+		result1 []types.User
+		result2 error
+	}
+	getUsersReturnsOnCall map[int]struct {
+		result1 []types.User
+		result2 error
+	}
+	GetWelcomePackagesStub        func(context.Context) ([]types.WelcomePackage, error)
+	getWelcomePackagesMutex       sync.RWMutex
+	getWelcomePackagesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getWelcomePackagesReturns struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}
+	getWelcomePackagesReturnsOnCall map[int]struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}
+	IdempotencyKeyCompleteStub        func(context.Context, types.IdempotencyKey) error
+	idempotencyKeyCompleteMutex       sync.RWMutex
+	idempotencyKeyCompleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.IdempotencyKey
+	}
+	idempotencyKeyCompleteReturns struct {
+		result1 error
+	}
+	idempotencyKeyCompleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IdempotencyKeyCreateStub        func(context.Context, types.IdempotencyKey) (bool, error)
+	idempotencyKeyCreateMutex       sync.RWMutex
+	idempotencyKeyCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.IdempotencyKey
+	}
+	idempotencyKeyCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	idempotencyKeyCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	IdempotencyKeyDeleteStub        func(context.Context, uuid.UUID, string) error
+	idempotencyKeyDeleteMutex       sync.RWMutex
+	idempotencyKeyDeleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	idempotencyKeyDeleteReturns struct {
+		result1 error
+	}
+	idempotencyKeyDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IdempotencyKeyGetStub        func(context.Context, uuid.UUID, string) (types.IdempotencyKey, error)
+	idempotencyKeyGetMutex       sync.RWMutex
+	idempotencyKeyGetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	idempotencyKeyGetReturns struct {
+		result1 types.IdempotencyKey
+		result2 error
+	}
+	idempotencyKeyGetReturnsOnCall map[int]struct {
+		result1 types.IdempotencyKey
+		result2 error
+	}
+	LedgerEntriesGetStub        func(context.Context, types.LedgerFilter) ([]types.LedgerEntry, error)
+	ledgerEntriesGetMutex       sync.RWMutex
+	ledgerEntriesGetArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.LedgerFilter
+	}
+	ledgerEntriesGetReturns struct {
+		result1 []types.LedgerEntry
+		result2 error
+	}
+	ledgerEntriesGetReturnsOnCall map[int]struct {
+		result1 []types.LedgerEntry
+		result2 error
+	}
+	PointsEntryCreateStub        func(context.Context, types.PointsEntry) (bool, error)
+	pointsEntryCreateMutex       sync.RWMutex
+	pointsEntryCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.PointsEntry
+	}
+	pointsEntryCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	pointsEntryCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PointsEntryGetStub        func(context.Context, types.PointsSource, uuid.UUID) (types.PointsEntry, error)
+	pointsEntryGetMutex       sync.RWMutex
+	pointsEntryGetArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.PointsSource
+		arg3 uuid.UUID
+	}
+	pointsEntryGetReturns struct {
+		result1 types.PointsEntry
+		result2 error
+	}
+	pointsEntryGetReturnsOnCall map[int]struct {
+		result1 types.PointsEntry
+		result2 error
+	}
+	PointsLotsConsumeStub        func(context.Context, uuid.UUID, decimal.Decimal) error
+	pointsLotsConsumeMutex       sync.RWMutex
+	pointsLotsConsumeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 decimal.Decimal
+	}
+	pointsLotsConsumeReturns struct {
+		result1 error
+	}
+	pointsLotsConsumeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PointsLotsExpireStub        func(context.Context, time.Time, int) ([]types.PointsExpiryNotice, error)
+	pointsLotsExpireMutex       sync.RWMutex
+	pointsLotsExpireArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	pointsLotsExpireReturns struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}
+	pointsLotsExpireReturnsOnCall map[int]struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}
+	PointsLotsWarnStub        func(context.Context, time.Time, time.Time) ([]types.PointsExpiryNotice, error)
+	pointsLotsWarnMutex       sync.RWMutex
+	pointsLotsWarnArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}
+	pointsLotsWarnReturns struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}
+	pointsLotsWarnReturnsOnCall map[int]struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}
+	PointsRateDeleteStub        func(context.Context, string) error
+	pointsRateDeleteMutex       sync.RWMutex
+	pointsRateDeleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	pointsRateDeleteReturns struct {
+		result1 error
+	}
+	pointsRateDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PointsRateGetStub        func(context.Context, string) (types.PointsRate, error)
+	pointsRateGetMutex       sync.RWMutex
+	pointsRateGetArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	pointsRateGetReturns struct {
+		result1 types.PointsRate
+		result2 error
+	}
+	pointsRateGetReturnsOnCall map[int]struct {
+		result1 types.PointsRate
+		result2 error
+	}
+	PointsRateUpsertStub        func(context.Context, types.PointsRate) (types.PointsRate, error)
+	pointsRateUpsertMutex       sync.RWMutex
+	pointsRateUpsertArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.PointsRate
+	}
+	pointsRateUpsertReturns struct {
+		result1 types.PointsRate
+		result2 error
+	}
+	pointsRateUpsertReturnsOnCall map[int]struct {
+		result1 types.PointsRate
+		result2 error
+	}
+	PromotionArchiveStub        func(context.Context, uuid.UUID) error
+	promotionArchiveMutex       sync.RWMutex
+	promotionArchiveArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	promotionArchiveReturns struct {
+		result1 error
+	}
+	promotionArchiveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PromotionCodeGetByCodeStub        func(context.Context, string) (types.PromotionCode, error)
+	promotionCodeGetByCodeMutex       sync.RWMutex
+	promotionCodeGetByCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	promotionCodeGetByCodeReturns struct {
+		result1 types.PromotionCode
+		result2 error
+	}
+	promotionCodeGetByCodeReturnsOnCall map[int]struct {
+		result1 types.PromotionCode
+		result2 error
+	}
+	PromotionCodeRedeemStub        func(context.Context, uuid.UUID) error
+	promotionCodeRedeemMutex       sync.RWMutex
+	promotionCodeRedeemArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	promotionCodeRedeemReturns struct {
+		result1 error
+	}
+	promotionCodeRedeemReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PromotionCodeRedemptionCreateStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (bool, error)
+	promotionCodeRedemptionCreateMutex       sync.RWMutex
+	promotionCodeRedemptionCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	promotionCodeRedemptionCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	promotionCodeRedemptionCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PromotionCodesCreateStub        func(context.Context, []types.PromotionCode) error
+	promotionCodesCreateMutex       sync.RWMutex
+	promotionCodesCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 []types.PromotionCode
+	}
+	promotionCodesCreateReturns struct {
+		result1 error
+	}
+	promotionCodesCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PromotionCreateStub        func(context.Context, types.Promotion) (types.Promotion, error)
+	promotionCreateMutex       sync.RWMutex
+	promotionCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Promotion
+	}
+	promotionCreateReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionCreateReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionGetByIDStub        func(context.Context, uuid.UUID) (types.Promotion, error)
+	promotionGetByIDMutex       sync.RWMutex
+	promotionGetByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	promotionGetByIDReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionGetByIDReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionGetByTypeStub        func(context.Context, types.PromotionType) (types.Promotion, error)
+	promotionGetByTypeMutex       sync.RWMutex
+	promotionGetByTypeArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.PromotionType
+	}
+	promotionGetByTypeReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionGetByTypeReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionRestoreStub        func(context.Context, uuid.UUID) (types.Promotion, error)
+	promotionRestoreMutex       sync.RWMutex
+	promotionRestoreArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	promotionRestoreReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionRestoreReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionSpendStub        func(context.Context, uuid.UUID, decimal.Decimal) (types.Promotion, error)
+	promotionSpendMutex       sync.RWMutex
+	promotionSpendArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 decimal.Decimal
+	}
+	promotionSpendReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionSpendReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionStateChangeCreateStub        func(context.Context, types.PromotionStateChange) error
+	promotionStateChangeCreateMutex       sync.RWMutex
+	promotionStateChangeCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.PromotionStateChange
+	}
+	promotionStateChangeCreateReturns struct {
+		result1 error
+	}
+	promotionStateChangeCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PromotionUpdateStub        func(context.Context, types.Promotion) (types.Promotion, error)
+	promotionUpdateMutex       sync.RWMutex
+	promotionUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Promotion
+	}
+	promotionUpdateReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionUpdateReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionsApplyScheduleStub        func(context.Context, time.Time) ([]types.PromotionStateChange, error)
+	promotionsApplyScheduleMutex       sync.RWMutex
+	promotionsApplyScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	promotionsApplyScheduleReturns struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	promotionsApplyScheduleReturnsOnCall map[int]struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	RedemptionCreateStub        func(context.Context, types.Redemption) (types.Redemption, error)
+	redemptionCreateMutex       sync.RWMutex
+	redemptionCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Redemption
+	}
+	redemptionCreateReturns struct {
+		result1 types.Redemption
+		result2 error
+	}
+	redemptionCreateReturnsOnCall map[int]struct {
+		result1 types.Redemption
+		result2 error
+	}
+	RedemptionFulfilStub        func(context.Context, uuid.UUID) (types.Redemption, error)
+	redemptionFulfilMutex       sync.RWMutex
+	redemptionFulfilArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	redemptionFulfilReturns struct {
+		result1 types.Redemption
+		result2 error
+	}
+	redemptionFulfilReturnsOnCall map[int]struct {
+		result1 types.Redemption
+		result2 error
+	}
+	ReferralRewardStub        func(context.Context, uuid.UUID, time.Time) error
+	referralRewardMutex       sync.RWMutex
+	referralRewardArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	referralRewardReturns struct {
+		result1 error
+	}
+	referralRewardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RollbackTxStub        func(context.Context) error
+	rollbackTxMutex       sync.RWMutex
+	rollbackTxArgsForCall []struct {
+		arg1 context.Context
+	}
+	rollbackTxReturns struct {
+		result1 error
+	}
+	rollbackTxReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TierCreateStub        func(context.Context, types.Tier) (types.Tier, error)
+	tierCreateMutex       sync.RWMutex
+	tierCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Tier
+	}
+	tierCreateReturns struct {
+		result1 types.Tier
+		result2 error
+	}
+	tierCreateReturnsOnCall map[int]struct {
+		result1 types.Tier
+		result2 error
+	}
+	TierDeleteStub        func(context.Context, uuid.UUID) error
+	tierDeleteMutex       sync.RWMutex
+	tierDeleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	tierDeleteReturns struct {
+		result1 error
+	}
+	tierDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TierUpdateStub        func(context.Context, types.Tier) (types.Tier, error)
+	tierUpdateMutex       sync.RWMutex
+	tierUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Tier
+	}
+	tierUpdateReturns struct {
+		result1 types.Tier
+		result2 error
+	}
+	tierUpdateReturnsOnCall map[int]struct {
+		result1 types.Tier
+		result2 error
+	}
+	TournamentCreateStub        func(context.Context, types.Tournament) (types.Tournament, error)
+	tournamentCreateMutex       sync.RWMutex
+	tournamentCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Tournament
+	}
+	tournamentCreateReturns struct {
+		result1 types.Tournament
+		result2 error
+	}
+	tournamentCreateReturnsOnCall map[int]struct {
+		result1 types.Tournament
+		result2 error
+	}
+	TournamentDeleteStub        func(context.Context, uuid.UUID) error
+	tournamentDeleteMutex       sync.RWMutex
+	tournamentDeleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	tournamentDeleteReturns struct {
+		result1 error
+	}
+	tournamentDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TournamentFinishStub        func(context.Context, uuid.UUID, time.Time) error
+	tournamentFinishMutex       sync.RWMutex
+	tournamentFinishArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	tournamentFinishReturns struct {
+		result1 error
+	}
+	tournamentFinishReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TournamentGetByIDStub        func(context.Context, uuid.UUID) (types.Tournament, error)
+	tournamentGetByIDMutex       sync.RWMutex
+	tournamentGetByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	tournamentGetByIDReturns struct {
+		result1 types.Tournament
+		result2 error
+	}
+	tournamentGetByIDReturnsOnCall map[int]struct {
+		result1 types.Tournament
+		result2 error
+	}
+	TournamentResultCreateStub        func(context.Context, types.TournamentResult) error
+	tournamentResultCreateMutex       sync.RWMutex
+	tournamentResultCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.TournamentResult
+	}
+	tournamentResultCreateReturns struct {
+		result1 error
+	}
+	tournamentResultCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TournamentResultLockStub        func(context.Context, uuid.UUID, uuid.UUID) (types.TournamentResult, error)
+	tournamentResultLockMutex       sync.RWMutex
+	tournamentResultLockArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	tournamentResultLockReturns struct {
+		result1 types.TournamentResult
+		result2 error
+	}
+	tournamentResultLockReturnsOnCall map[int]struct {
+		result1 types.TournamentResult
+		result2 error
+	}
+	TournamentResultPrizeStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	tournamentResultPrizeMutex       sync.RWMutex
+	tournamentResultPrizeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	tournamentResultPrizeReturns struct {
+		result1 error
+	}
+	tournamentResultPrizeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TournamentResultPrizeFailStub        func(context.Context, uuid.UUID, uuid.UUID, string) error
+	tournamentResultPrizeFailMutex       sync.RWMutex
+	tournamentResultPrizeFailArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}
+	tournamentResultPrizeFailReturns struct {
+		result1 error
+	}
+	tournamentResultPrizeFailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TournamentUpdateStub        func(context.Context, types.Tournament) (types.Tournament, error)
+	tournamentUpdateMutex       sync.RWMutex
+	tournamentUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.Tournament
+	}
+	tournamentUpdateReturns struct {
+		result1 types.Tournament
+		result2 error
+	}
+	tournamentUpdateReturnsOnCall map[int]struct {
+		result1 types.Tournament
+		result2 error
+	}
+	UserBalanceRebuildStub        func(context.Context, uuid.UUID) (types.User, error)
+	userBalanceRebuildMutex       sync.RWMutex
+	userBalanceRebuildArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userBalanceRebuildReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userBalanceRebuildReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	UserBalanceReconcileStub        func(context.Context, uuid.UUID) (types.BalanceReconciliation, error)
+	userBalanceReconcileMutex       sync.RWMutex
+	userBalanceReconcileArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userBalanceReconcileReturns struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}
+	userBalanceReconcileReturnsOnCall map[int]struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}
+	UserBalanceUpdateStub        func(context.Context, types.LedgerEntry) (types.User, error)
+	userBalanceUpdateMutex       sync.RWMutex
+	userBalanceUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.LedgerEntry
+	}
+	userBalanceUpdateReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userBalanceUpdateReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	UserBalanceWithdrawStub        func(context.Context, types.LedgerEntry) (types.User, error)
+	userBalanceWithdrawMutex       sync.RWMutex
+	userBalanceWithdrawArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.LedgerEntry
+	}
+	userBalanceWithdrawReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userBalanceWithdrawReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	UserCreateStub        func(context.Context, types.User) (types.User, error)
+	userCreateMutex       sync.RWMutex
+	userCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.User
+	}
+	userCreateReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userCreateReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	UserDeleteStub        func(context.Context, uuid.UUID) error
+	userDeleteMutex       sync.RWMutex
+	userDeleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userDeleteReturns struct {
+		result1 error
+	}
+	userDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserGetByStub        func(context.Context, types.UserFilter) (types.User, error)
+	userGetByMutex       sync.RWMutex
+	userGetByArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.UserFilter
+	}
+	userGetByReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userGetByReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	UserPromotionClaimCountStub        func(context.Context, uuid.UUID, uuid.UUID) (int, error)
+	userPromotionClaimCountMutex       sync.RWMutex
+	userPromotionClaimCountArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	userPromotionClaimCountReturns struct {
+		result1 int
+		result2 error
+	}
+	userPromotionClaimCountReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UserPromotionConvertStub        func(context.Context, uuid.UUID) error
+	userPromotionConvertMutex       sync.RWMutex
+	userPromotionConvertArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userPromotionConvertReturns struct {
+		result1 error
+	}
+	userPromotionConvertReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionForfeitStub        func(context.Context, uuid.UUID) error
+	userPromotionForfeitMutex       sync.RWMutex
+	userPromotionForfeitArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userPromotionForfeitReturns struct {
+		result1 error
+	}
+	userPromotionForfeitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionRevocationCreateStub        func(context.Context, types.UserPromotionRevocation) error
+	userPromotionRevocationCreateMutex       sync.RWMutex
+	userPromotionRevocationCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.UserPromotionRevocation
+	}
+	userPromotionRevocationCreateReturns struct {
+		result1 error
+	}
+	userPromotionRevocationCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionRevokeStub        func(context.Context, uuid.UUID, types.UserPromotionStatus) (types.UserPromotion, error)
+	userPromotionRevokeMutex       sync.RWMutex
+	userPromotionRevokeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.UserPromotionStatus
+	}
+	userPromotionRevokeReturns struct {
+		result1 types.UserPromotion
+		result2 error
+	}
+	userPromotionRevokeReturnsOnCall map[int]struct {
+		result1 types.UserPromotion
+		result2 error
+	}
+	UserPromotionSettleStub        func(context.Context, types.UserPromotion) error
+	userPromotionSettleMutex       sync.RWMutex
+	userPromotionSettleArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.UserPromotion
+	}
+	userPromotionSettleReturns struct {
+		result1 error
+	}
+	userPromotionSettleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionsExpireStub        func(context.Context, time.Time) (int, error)
+	userPromotionsExpireMutex       sync.RWMutex
+	userPromotionsExpireArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	userPromotionsExpireReturns struct {
+		result1 int
+		result2 error
+	}
+	userPromotionsExpireReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UserPromotionsRemindStub        func(context.Context, time.Time, time.Duration) ([]types.PromotionExpiryReminder, error)
+	userPromotionsRemindMutex       sync.RWMutex
+	userPromotionsRemindArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Duration
+	}
+	userPromotionsRemindReturns struct {
+		result1 []types.PromotionExpiryReminder
+		result2 error
+	}
+	userPromotionsRemindReturnsOnCall map[int]struct {
+		result1 []types.PromotionExpiryReminder
+		result2 error
+	}
+	UserPromotionsUnwagerStub        func(context.Context, uuid.UUID, uuid.UUID) (int, error)
+	userPromotionsUnwagerMutex       sync.RWMutex
+	userPromotionsUnwagerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	userPromotionsUnwagerReturns struct {
+		result1 int
+		result2 error
+	}
+	userPromotionsUnwagerReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UserPromotionsWagerStub        func(context.Context, uuid.UUID, uuid.UUID, types.Money) ([]types.UserPromotion, error)
+	userPromotionsWagerMutex       sync.RWMutex
+	userPromotionsWagerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 types.Money
+	}
+	userPromotionsWagerReturns struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	userPromotionsWagerReturnsOnCall map[int]struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	UserTiersDemoteStub        func(context.Context, uuid.NullUUID, time.Time, time.Time) ([]types.TierChange, error)
+	userTiersDemoteMutex       sync.RWMutex
+	userTiersDemoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.NullUUID
+		arg3 time.Time
+		arg4 time.Time
+	}
+	userTiersDemoteReturns struct {
+		result1 []types.TierChange
+		result2 error
+	}
+	userTiersDemoteReturnsOnCall map[int]struct {
+		result1 []types.TierChange
+		result2 error
+	}
+	UserTiersQualifyStub        func(context.Context, uuid.NullUUID, time.Time) ([]types.TierChange, error)
+	userTiersQualifyMutex       sync.RWMutex
+	userTiersQualifyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.NullUUID
+		arg3 time.Time
+	}
+	userTiersQualifyReturns struct {
+		result1 []types.TierChange
+		result2 error
+	}
+	userTiersQualifyReturnsOnCall map[int]struct {
+		result1 []types.TierChange
+		result2 error
+	}
+	UserTiersWarnStub        func(context.Context, uuid.NullUUID, time.Time, time.Time) ([]types.TierWarning, error)
+	userTiersWarnMutex       sync.RWMutex
+	userTiersWarnArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.NullUUID
+		arg3 time.Time
+		arg4 time.Time
+	}
+	userTiersWarnReturns struct {
+		result1 []types.TierWarning
+		result2 error
+	}
+	userTiersWarnReturnsOnCall map[int]struct {
+		result1 []types.TierWarning
+		result2 error
+	}
+	UserUpdateStub        func(context.Context, types.User) (types.User, error)
+	userUpdateMutex       sync.RWMutex
+	userUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.User
+	}
+	userUpdateReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userUpdateReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	UserWelcomePackageCreateStub        func(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	userWelcomePackageCreateMutex       sync.RWMutex
+	userWelcomePackageCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	userWelcomePackageCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	userWelcomePackageCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	UserWelcomePackageGetStub        func(context.Context, uuid.UUID) (types.UserWelcomePackage, error)
+	userWelcomePackageGetMutex       sync.RWMutex
+	userWelcomePackageGetArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userWelcomePackageGetReturns struct {
+		result1 types.UserWelcomePackage
+		result2 error
+	}
+	userWelcomePackageGetReturnsOnCall map[int]struct {
+		result1 types.UserWelcomePackage
+		result2 error
+	}
+	UserWelcomeStepCreateStub        func(context.Context, uuid.UUID, int, uuid.NullUUID, time.Time) (bool, error)
+	userWelcomeStepCreateMutex       sync.RWMutex
+	userWelcomeStepCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 uuid.NullUUID
+		arg5 time.Time
+	}
+	userWelcomeStepCreateReturns struct {
+		result1 bool
+		result2 error
+	}
+	userWelcomeStepCreateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	WelcomePackageCreateStub        func(context.Context, types.WelcomePackage) (types.WelcomePackage, error)
+	welcomePackageCreateMutex       sync.RWMutex
+	welcomePackageCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.WelcomePackage
+	}
+	welcomePackageCreateReturns struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	welcomePackageCreateReturnsOnCall map[int]struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	WelcomePackageGetCurrentStub        func(context.Context) (types.WelcomePackage, error)
+	welcomePackageGetCurrentMutex       sync.RWMutex
+	welcomePackageGetCurrentArgsForCall []struct {
+		arg1 context.Context
+	}
+	welcomePackageGetCurrentReturns struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	welcomePackageGetCurrentReturnsOnCall map[int]struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	WithTxStub        func(context.Context) (store.Persistent, error)
+	withTxMutex       sync.RWMutex
+	withTxArgsForCall []struct {
+		arg1 context.Context
+	}
+	withTxReturns struct {
+		result1 store.Persistent
+		result2 error
+	}
+	withTxReturnsOnCall map[int]struct {
+		result1 store.Persistent
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePersistent) AddPromotion(arg1 context.Context, arg2 types.UserPromotion) (types.UserPromotion, error) {
+	fake.addPromotionMutex.Lock()
+	ret, specificReturn := fake.addPromotionReturnsOnCall[len(fake.addPromotionArgsForCall)]
+	fake.addPromotionArgsForCall = append(fake.addPromotionArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.UserPromotion
+	}{arg1, arg2})
+	stub := fake.AddPromotionStub
+	fakeReturns := fake.addPromotionReturns
+	fake.recordInvocation("AddPromotion", []interface{}{arg1, arg2})
+	fake.addPromotionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) AddPromotionCallCount() int {
+	fake.addPromotionMutex.RLock()
+	defer fake.addPromotionMutex.RUnlock()
+	return len(fake.addPromotionArgsForCall)
+}
+
+func (fake *FakePersistent) AddPromotionCalls(stub func(context.Context, types.UserPromotion) (types.UserPromotion, error)) {
+	fake.addPromotionMutex.Lock()
+	defer fake.addPromotionMutex.Unlock()
+	fake.AddPromotionStub = stub
+}
+
+func (fake *FakePersistent) AddPromotionArgsForCall(i int) (context.Context, types.UserPromotion) {
+	fake.addPromotionMutex.RLock()
+	defer fake.addPromotionMutex.RUnlock()
+	argsForCall := fake.addPromotionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) AddPromotionReturns(result1 types.UserPromotion, result2 error) {
+	fake.addPromotionMutex.Lock()
+	defer fake.addPromotionMutex.Unlock()
+	fake.AddPromotionStub = nil
+	fake.addPromotionReturns = struct {
+		result1 types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) AddPromotionReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
+	fake.addPromotionMutex.Lock()
+	defer fake.addPromotionMutex.Unlock()
+	fake.AddPromotionStub = nil
+	if fake.addPromotionReturnsOnCall == nil {
+		fake.addPromotionReturnsOnCall = make(map[int]struct {
+			result1 types.UserPromotion
+			result2 error
+		})
+	}
+	fake.addPromotionReturnsOnCall[i] = struct {
+		result1 types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CashbackCalculationCreate(arg1 context.Context, arg2 types.CashbackCalculation) (bool, error) {
+	fake.cashbackCalculationCreateMutex.Lock()
+	ret, specificReturn := fake.cashbackCalculationCreateReturnsOnCall[len(fake.cashbackCalculationCreateArgsForCall)]
+	fake.cashbackCalculationCreateArgsForCall = append(fake.cashbackCalculationCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.CashbackCalculation
+	}{arg1, arg2})
+	stub := fake.CashbackCalculationCreateStub
+	fakeReturns := fake.cashbackCalculationCreateReturns
+	fake.recordInvocation("CashbackCalculationCreate", []interface{}{arg1, arg2})
+	fake.cashbackCalculationCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) CashbackCalculationCreateCallCount() int {
+	fake.cashbackCalculationCreateMutex.RLock()
+	defer fake.cashbackCalculationCreateMutex.RUnlock()
+	return len(fake.cashbackCalculationCreateArgsForCall)
+}
+
+func (fake *FakePersistent) CashbackCalculationCreateCalls(stub func(context.Context, types.CashbackCalculation) (bool, error)) {
+	fake.cashbackCalculationCreateMutex.Lock()
+	defer fake.cashbackCalculationCreateMutex.Unlock()
+	fake.CashbackCalculationCreateStub = stub
+}
+
+func (fake *FakePersistent) CashbackCalculationCreateArgsForCall(i int) (context.Context, types.CashbackCalculation) {
+	fake.cashbackCalculationCreateMutex.RLock()
+	defer fake.cashbackCalculationCreateMutex.RUnlock()
+	argsForCall := fake.cashbackCalculationCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) CashbackCalculationCreateReturns(result1 bool, result2 error) {
+	fake.cashbackCalculationCreateMutex.Lock()
+	defer fake.cashbackCalculationCreateMutex.Unlock()
+	fake.CashbackCalculationCreateStub = nil
+	fake.cashbackCalculationCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CashbackCalculationCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.cashbackCalculationCreateMutex.Lock()
+	defer fake.cashbackCalculationCreateMutex.Unlock()
+	fake.CashbackCalculationCreateStub = nil
+	if fake.cashbackCalculationCreateReturnsOnCall == nil {
+		fake.cashbackCalculationCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.cashbackCalculationCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CatalogItemCreate(arg1 context.Context, arg2 types.CatalogItem) (types.CatalogItem, error) {
+	fake.catalogItemCreateMutex.Lock()
+	ret, specificReturn := fake.catalogItemCreateReturnsOnCall[len(fake.catalogItemCreateArgsForCall)]
+	fake.catalogItemCreateArgsForCall = append(fake.catalogItemCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.CatalogItem
+	}{arg1, arg2})
+	stub := fake.CatalogItemCreateStub
+	fakeReturns := fake.catalogItemCreateReturns
+	fake.recordInvocation("CatalogItemCreate", []interface{}{arg1, arg2})
+	fake.catalogItemCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) CatalogItemCreateCallCount() int {
+	fake.catalogItemCreateMutex.RLock()
+	defer fake.catalogItemCreateMutex.RUnlock()
+	return len(fake.catalogItemCreateArgsForCall)
+}
+
+func (fake *FakePersistent) CatalogItemCreateCalls(stub func(context.Context, types.CatalogItem) (types.CatalogItem, error)) {
+	fake.catalogItemCreateMutex.Lock()
+	defer fake.catalogItemCreateMutex.Unlock()
+	fake.CatalogItemCreateStub = stub
+}
+
+func (fake *FakePersistent) CatalogItemCreateArgsForCall(i int) (context.Context, types.CatalogItem) {
+	fake.catalogItemCreateMutex.RLock()
+	defer fake.catalogItemCreateMutex.RUnlock()
+	argsForCall := fake.catalogItemCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) CatalogItemCreateReturns(result1 types.CatalogItem, result2 error) {
+	fake.catalogItemCreateMutex.Lock()
+	defer fake.catalogItemCreateMutex.Unlock()
+	fake.CatalogItemCreateStub = nil
+	fake.catalogItemCreateReturns = struct {
+		result1 types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CatalogItemCreateReturnsOnCall(i int, result1 types.CatalogItem, result2 error) {
+	fake.catalogItemCreateMutex.Lock()
+	defer fake.catalogItemCreateMutex.Unlock()
+	fake.CatalogItemCreateStub = nil
+	if fake.catalogItemCreateReturnsOnCall == nil {
+		fake.catalogItemCreateReturnsOnCall = make(map[int]struct {
+			result1 types.CatalogItem
+			result2 error
+		})
+	}
+	fake.catalogItemCreateReturnsOnCall[i] = struct {
+		result1 types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CatalogItemDelete(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.catalogItemDeleteMutex.Lock()
+	ret, specificReturn := fake.catalogItemDeleteReturnsOnCall[len(fake.catalogItemDeleteArgsForCall)]
+	fake.catalogItemDeleteArgsForCall = append(fake.catalogItemDeleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CatalogItemDeleteStub
+	fakeReturns := fake.catalogItemDeleteReturns
+	fake.recordInvocation("CatalogItemDelete", []interface{}{arg1, arg2})
+	fake.catalogItemDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) CatalogItemDeleteCallCount() int {
+	fake.catalogItemDeleteMutex.RLock()
+	defer fake.catalogItemDeleteMutex.RUnlock()
+	return len(fake.catalogItemDeleteArgsForCall)
+}
+
+func (fake *FakePersistent) CatalogItemDeleteCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.catalogItemDeleteMutex.Lock()
+	defer fake.catalogItemDeleteMutex.Unlock()
+	fake.CatalogItemDeleteStub = stub
+}
+
+func (fake *FakePersistent) CatalogItemDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.catalogItemDeleteMutex.RLock()
+	defer fake.catalogItemDeleteMutex.RUnlock()
+	argsForCall := fake.catalogItemDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) CatalogItemDeleteReturns(result1 error) {
+	fake.catalogItemDeleteMutex.Lock()
+	defer fake.catalogItemDeleteMutex.Unlock()
+	fake.CatalogItemDeleteStub = nil
+	fake.catalogItemDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) CatalogItemDeleteReturnsOnCall(i int, result1 error) {
+	fake.catalogItemDeleteMutex.Lock()
+	defer fake.catalogItemDeleteMutex.Unlock()
+	fake.CatalogItemDeleteStub = nil
+	if fake.catalogItemDeleteReturnsOnCall == nil {
+		fake.catalogItemDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.catalogItemDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) CatalogItemGetByID(arg1 context.Context, arg2 uuid.UUID) (types.CatalogItem, error) {
+	fake.catalogItemGetByIDMutex.Lock()
+	ret, specificReturn := fake.catalogItemGetByIDReturnsOnCall[len(fake.catalogItemGetByIDArgsForCall)]
+	fake.catalogItemGetByIDArgsForCall = append(fake.catalogItemGetByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CatalogItemGetByIDStub
+	fakeReturns := fake.catalogItemGetByIDReturns
+	fake.recordInvocation("CatalogItemGetByID", []interface{}{arg1, arg2})
+	fake.catalogItemGetByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) CatalogItemGetByIDCallCount() int {
+	fake.catalogItemGetByIDMutex.RLock()
+	defer fake.catalogItemGetByIDMutex.RUnlock()
+	return len(fake.catalogItemGetByIDArgsForCall)
+}
+
+func (fake *FakePersistent) CatalogItemGetByIDCalls(stub func(context.Context, uuid.UUID) (types.CatalogItem, error)) {
+	fake.catalogItemGetByIDMutex.Lock()
+	defer fake.catalogItemGetByIDMutex.Unlock()
+	fake.CatalogItemGetByIDStub = stub
+}
+
+func (fake *FakePersistent) CatalogItemGetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.catalogItemGetByIDMutex.RLock()
+	defer fake.catalogItemGetByIDMutex.RUnlock()
+	argsForCall := fake.catalogItemGetByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) CatalogItemGetByIDReturns(result1 types.CatalogItem, result2 error) {
+	fake.catalogItemGetByIDMutex.Lock()
+	defer fake.catalogItemGetByIDMutex.Unlock()
+	fake.CatalogItemGetByIDStub = nil
+	fake.catalogItemGetByIDReturns = struct {
+		result1 types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CatalogItemGetByIDReturnsOnCall(i int, result1 types.CatalogItem, result2 error) {
+	fake.catalogItemGetByIDMutex.Lock()
+	defer fake.catalogItemGetByIDMutex.Unlock()
+	fake.CatalogItemGetByIDStub = nil
+	if fake.catalogItemGetByIDReturnsOnCall == nil {
+		fake.catalogItemGetByIDReturnsOnCall = make(map[int]struct {
+			result1 types.CatalogItem
+			result2 error
+		})
+	}
+	fake.catalogItemGetByIDReturnsOnCall[i] = struct {
+		result1 types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CatalogItemReserve(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.catalogItemReserveMutex.Lock()
+	ret, specificReturn := fake.catalogItemReserveReturnsOnCall[len(fake.catalogItemReserveArgsForCall)]
+	fake.catalogItemReserveArgsForCall = append(fake.catalogItemReserveArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CatalogItemReserveStub
+	fakeReturns := fake.catalogItemReserveReturns
+	fake.recordInvocation("CatalogItemReserve", []interface{}{arg1, arg2})
+	fake.catalogItemReserveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) CatalogItemReserveCallCount() int {
+	fake.catalogItemReserveMutex.RLock()
+	defer fake.catalogItemReserveMutex.RUnlock()
+	return len(fake.catalogItemReserveArgsForCall)
+}
+
+func (fake *FakePersistent) CatalogItemReserveCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.catalogItemReserveMutex.Lock()
+	defer fake.catalogItemReserveMutex.Unlock()
+	fake.CatalogItemReserveStub = stub
+}
+
+func (fake *FakePersistent) CatalogItemReserveArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.catalogItemReserveMutex.RLock()
+	defer fake.catalogItemReserveMutex.RUnlock()
+	argsForCall := fake.catalogItemReserveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) CatalogItemReserveReturns(result1 error) {
+	fake.catalogItemReserveMutex.Lock()
+	defer fake.catalogItemReserveMutex.Unlock()
+	fake.CatalogItemReserveStub = nil
+	fake.catalogItemReserveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) CatalogItemReserveReturnsOnCall(i int, result1 error) {
+	fake.catalogItemReserveMutex.Lock()
+	defer fake.catalogItemReserveMutex.Unlock()
+	fake.CatalogItemReserveStub = nil
+	if fake.catalogItemReserveReturnsOnCall == nil {
+		fake.catalogItemReserveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.catalogItemReserveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) CatalogItemUpdate(arg1 context.Context, arg2 types.CatalogItem) (types.CatalogItem, error) {
+	fake.catalogItemUpdateMutex.Lock()
+	ret, specificReturn := fake.catalogItemUpdateReturnsOnCall[len(fake.catalogItemUpdateArgsForCall)]
+	fake.catalogItemUpdateArgsForCall = append(fake.catalogItemUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.CatalogItem
+	}{arg1, arg2})
+	stub := fake.CatalogItemUpdateStub
+	fakeReturns := fake.catalogItemUpdateReturns
+	fake.recordInvocation("CatalogItemUpdate", []interface{}{arg1, arg2})
+	fake.catalogItemUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) CatalogItemUpdateCallCount() int {
+	fake.catalogItemUpdateMutex.RLock()
+	defer fake.catalogItemUpdateMutex.RUnlock()
+	return len(fake.catalogItemUpdateArgsForCall)
+}
+
+func (fake *FakePersistent) CatalogItemUpdateCalls(stub func(context.Context, types.CatalogItem) (types.CatalogItem, error)) {
+	fake.catalogItemUpdateMutex.Lock()
+	defer fake.catalogItemUpdateMutex.Unlock()
+	fake.CatalogItemUpdateStub = stub
+}
+
+func (fake *FakePersistent) CatalogItemUpdateArgsForCall(i int) (context.Context, types.CatalogItem) {
+	fake.catalogItemUpdateMutex.RLock()
+	defer fake.catalogItemUpdateMutex.RUnlock()
+	argsForCall := fake.catalogItemUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) CatalogItemUpdateReturns(result1 types.CatalogItem, result2 error) {
+	fake.catalogItemUpdateMutex.Lock()
+	defer fake.catalogItemUpdateMutex.Unlock()
+	fake.CatalogItemUpdateStub = nil
+	fake.catalogItemUpdateReturns = struct {
+		result1 types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) CatalogItemUpdateReturnsOnCall(i int, result1 types.CatalogItem, result2 error) {
+	fake.catalogItemUpdateMutex.Lock()
+	defer fake.catalogItemUpdateMutex.Unlock()
+	fake.CatalogItemUpdateStub = nil
+	if fake.catalogItemUpdateReturnsOnCall == nil {
+		fake.catalogItemUpdateReturnsOnCall = make(map[int]struct {
+			result1 types.CatalogItem
+			result2 error
+		})
+	}
+	fake.catalogItemUpdateReturnsOnCall[i] = struct {
+		result1 types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) ClaimPromotion(arg1 context.Context, arg2 types.UserPromotion) error {
+	fake.claimPromotionMutex.Lock()
+	ret, specificReturn := fake.claimPromotionReturnsOnCall[len(fake.claimPromotionArgsForCall)]
+	fake.claimPromotionArgsForCall = append(fake.claimPromotionArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.UserPromotion
+	}{arg1, arg2})
+	stub := fake.ClaimPromotionStub
+	fakeReturns := fake.claimPromotionReturns
+	fake.recordInvocation("ClaimPromotion", []interface{}{arg1, arg2})
+	fake.claimPromotionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) ClaimPromotionCallCount() int {
+	fake.claimPromotionMutex.RLock()
+	defer fake.claimPromotionMutex.RUnlock()
+	return len(fake.claimPromotionArgsForCall)
+}
+
+func (fake *FakePersistent) ClaimPromotionCalls(stub func(context.Context, types.UserPromotion) error) {
+	fake.claimPromotionMutex.Lock()
+	defer fake.claimPromotionMutex.Unlock()
+	fake.ClaimPromotionStub = stub
+}
+
+func (fake *FakePersistent) ClaimPromotionArgsForCall(i int) (context.Context, types.UserPromotion) {
+	fake.claimPromotionMutex.RLock()
+	defer fake.claimPromotionMutex.RUnlock()
+	argsForCall := fake.claimPromotionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) ClaimPromotionReturns(result1 error) {
+	fake.claimPromotionMutex.Lock()
+	defer fake.claimPromotionMutex.Unlock()
+	fake.ClaimPromotionStub = nil
+	fake.claimPromotionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) ClaimPromotionReturnsOnCall(i int, result1 error) {
+	fake.claimPromotionMutex.Lock()
+	defer fake.claimPromotionMutex.Unlock()
+	fake.ClaimPromotionStub = nil
+	if fake.claimPromotionReturnsOnCall == nil {
+		fake.claimPromotionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.claimPromotionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) CommitTx(arg1 context.Context) error {
+	fake.commitTxMutex.Lock()
+	ret, specificReturn := fake.commitTxReturnsOnCall[len(fake.commitTxArgsForCall)]
+	fake.commitTxArgsForCall = append(fake.commitTxArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CommitTxStub
+	fakeReturns := fake.commitTxReturns
+	fake.recordInvocation("CommitTx", []interface{}{arg1})
+	fake.commitTxMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) CommitTxCallCount() int {
+	fake.commitTxMutex.RLock()
+	defer fake.commitTxMutex.RUnlock()
+	return len(fake.commitTxArgsForCall)
+}
+
+func (fake *FakePersistent) CommitTxCalls(stub func(context.Context) error) {
+	fake.commitTxMutex.Lock()
+	defer fake.commitTxMutex.Unlock()
+	fake.CommitTxStub = stub
+}
+
+func (fake *FakePersistent) CommitTxArgsForCall(i int) context.Context {
+	fake.commitTxMutex.RLock()
+	defer fake.commitTxMutex.RUnlock()
+	argsForCall := fake.commitTxArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) CommitTxReturns(result1 error) {
+	fake.commitTxMutex.Lock()
+	defer fake.commitTxMutex.Unlock()
+	fake.CommitTxStub = nil
+	fake.commitTxReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) CommitTxReturnsOnCall(i int, result1 error) {
+	fake.commitTxMutex.Lock()
+	defer fake.commitTxMutex.Unlock()
+	fake.CommitTxStub = nil
+	if fake.commitTxReturnsOnCall == nil {
+		fake.commitTxReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.commitTxReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) DeleteUserPromotion(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteUserPromotionMutex.Lock()
+	ret, specificReturn := fake.deleteUserPromotionReturnsOnCall[len(fake.deleteUserPromotionArgsForCall)]
+	fake.deleteUserPromotionArgsForCall = append(fake.deleteUserPromotionArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteUserPromotionStub
+	fakeReturns := fake.deleteUserPromotionReturns
+	fake.recordInvocation("DeleteUserPromotion", []interface{}{arg1, arg2})
+	fake.deleteUserPromotionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) DeleteUserPromotionCallCount() int {
+	fake.deleteUserPromotionMutex.RLock()
+	defer fake.deleteUserPromotionMutex.RUnlock()
+	return len(fake.deleteUserPromotionArgsForCall)
+}
+
+func (fake *FakePersistent) DeleteUserPromotionCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteUserPromotionMutex.Lock()
+	defer fake.deleteUserPromotionMutex.Unlock()
+	fake.DeleteUserPromotionStub = stub
+}
+
+func (fake *FakePersistent) DeleteUserPromotionArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteUserPromotionMutex.RLock()
+	defer fake.deleteUserPromotionMutex.RUnlock()
+	argsForCall := fake.deleteUserPromotionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) DeleteUserPromotionReturns(result1 error) {
+	fake.deleteUserPromotionMutex.Lock()
+	defer fake.deleteUserPromotionMutex.Unlock()
+	fake.DeleteUserPromotionStub = nil
+	fake.deleteUserPromotionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) DeleteUserPromotionReturnsOnCall(i int, result1 error) {
+	fake.deleteUserPromotionMutex.Lock()
+	defer fake.deleteUserPromotionMutex.Unlock()
+	fake.DeleteUserPromotionStub = nil
+	if fake.deleteUserPromotionReturnsOnCall == nil {
+		fake.deleteUserPromotionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserPromotionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) FreeSpinRoundCreate(arg1 context.Context, arg2 uuid.UUID, arg3 types.FreeSpin) (bool, error) {
+	fake.freeSpinRoundCreateMutex.Lock()
+	ret, specificReturn := fake.freeSpinRoundCreateReturnsOnCall[len(fake.freeSpinRoundCreateArgsForCall)]
+	fake.freeSpinRoundCreateArgsForCall = append(fake.freeSpinRoundCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.FreeSpin
+	}{arg1, arg2, arg3})
+	stub := fake.FreeSpinRoundCreateStub
+	fakeReturns := fake.freeSpinRoundCreateReturns
+	fake.recordInvocation("FreeSpinRoundCreate", []interface{}{arg1, arg2, arg3})
+	fake.freeSpinRoundCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) FreeSpinRoundCreateCallCount() int {
+	fake.freeSpinRoundCreateMutex.RLock()
+	defer fake.freeSpinRoundCreateMutex.RUnlock()
+	return len(fake.freeSpinRoundCreateArgsForCall)
+}
+
+func (fake *FakePersistent) FreeSpinRoundCreateCalls(stub func(context.Context, uuid.UUID, types.FreeSpin) (bool, error)) {
+	fake.freeSpinRoundCreateMutex.Lock()
+	defer fake.freeSpinRoundCreateMutex.Unlock()
+	fake.FreeSpinRoundCreateStub = stub
+}
+
+func (fake *FakePersistent) FreeSpinRoundCreateArgsForCall(i int) (context.Context, uuid.UUID, types.FreeSpin) {
+	fake.freeSpinRoundCreateMutex.RLock()
+	defer fake.freeSpinRoundCreateMutex.RUnlock()
+	argsForCall := fake.freeSpinRoundCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) FreeSpinRoundCreateReturns(result1 bool, result2 error) {
+	fake.freeSpinRoundCreateMutex.Lock()
+	defer fake.freeSpinRoundCreateMutex.Unlock()
+	fake.FreeSpinRoundCreateStub = nil
+	fake.freeSpinRoundCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinRoundCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.freeSpinRoundCreateMutex.Lock()
+	defer fake.freeSpinRoundCreateMutex.Unlock()
+	fake.FreeSpinRoundCreateStub = nil
+	if fake.freeSpinRoundCreateReturnsOnCall == nil {
+		fake.freeSpinRoundCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.freeSpinRoundCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsConsume(arg1 context.Context, arg2 uuid.UUID, arg3 types.Money) (types.FreeSpinsEntitlement, error) {
+	fake.freeSpinsConsumeMutex.Lock()
+	ret, specificReturn := fake.freeSpinsConsumeReturnsOnCall[len(fake.freeSpinsConsumeArgsForCall)]
+	fake.freeSpinsConsumeArgsForCall = append(fake.freeSpinsConsumeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.Money
+	}{arg1, arg2, arg3})
+	stub := fake.FreeSpinsConsumeStub
+	fakeReturns := fake.freeSpinsConsumeReturns
+	fake.recordInvocation("FreeSpinsConsume", []interface{}{arg1, arg2, arg3})
+	fake.freeSpinsConsumeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) FreeSpinsConsumeCallCount() int {
+	fake.freeSpinsConsumeMutex.RLock()
+	defer fake.freeSpinsConsumeMutex.RUnlock()
+	return len(fake.freeSpinsConsumeArgsForCall)
+}
+
+func (fake *FakePersistent) FreeSpinsConsumeCalls(stub func(context.Context, uuid.UUID, types.Money) (types.FreeSpinsEntitlement, error)) {
+	fake.freeSpinsConsumeMutex.Lock()
+	defer fake.freeSpinsConsumeMutex.Unlock()
+	fake.FreeSpinsConsumeStub = stub
+}
+
+func (fake *FakePersistent) FreeSpinsConsumeArgsForCall(i int) (context.Context, uuid.UUID, types.Money) {
+	fake.freeSpinsConsumeMutex.RLock()
+	defer fake.freeSpinsConsumeMutex.RUnlock()
+	argsForCall := fake.freeSpinsConsumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) FreeSpinsConsumeReturns(result1 types.FreeSpinsEntitlement, result2 error) {
+	fake.freeSpinsConsumeMutex.Lock()
+	defer fake.freeSpinsConsumeMutex.Unlock()
+	fake.FreeSpinsConsumeStub = nil
+	fake.freeSpinsConsumeReturns = struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsConsumeReturnsOnCall(i int, result1 types.FreeSpinsEntitlement, result2 error) {
+	fake.freeSpinsConsumeMutex.Lock()
+	defer fake.freeSpinsConsumeMutex.Unlock()
+	fake.FreeSpinsConsumeStub = nil
+	if fake.freeSpinsConsumeReturnsOnCall == nil {
+		fake.freeSpinsConsumeReturnsOnCall = make(map[int]struct {
+			result1 types.FreeSpinsEntitlement
+			result2 error
+		})
+	}
+	fake.freeSpinsConsumeReturnsOnCall[i] = struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsCreate(arg1 context.Context, arg2 types.FreeSpinsEntitlement) error {
+	fake.freeSpinsCreateMutex.Lock()
+	ret, specificReturn := fake.freeSpinsCreateReturnsOnCall[len(fake.freeSpinsCreateArgsForCall)]
+	fake.freeSpinsCreateArgsForCall = append(fake.freeSpinsCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.FreeSpinsEntitlement
+	}{arg1, arg2})
+	stub := fake.FreeSpinsCreateStub
+	fakeReturns := fake.freeSpinsCreateReturns
+	fake.recordInvocation("FreeSpinsCreate", []interface{}{arg1, arg2})
+	fake.freeSpinsCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) FreeSpinsCreateCallCount() int {
+	fake.freeSpinsCreateMutex.RLock()
+	defer fake.freeSpinsCreateMutex.RUnlock()
+	return len(fake.freeSpinsCreateArgsForCall)
+}
+
+func (fake *FakePersistent) FreeSpinsCreateCalls(stub func(context.Context, types.FreeSpinsEntitlement) error) {
+	fake.freeSpinsCreateMutex.Lock()
+	defer fake.freeSpinsCreateMutex.Unlock()
+	fake.FreeSpinsCreateStub = stub
+}
+
+func (fake *FakePersistent) FreeSpinsCreateArgsForCall(i int) (context.Context, types.FreeSpinsEntitlement) {
+	fake.freeSpinsCreateMutex.RLock()
+	defer fake.freeSpinsCreateMutex.RUnlock()
+	argsForCall := fake.freeSpinsCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) FreeSpinsCreateReturns(result1 error) {
+	fake.freeSpinsCreateMutex.Lock()
+	defer fake.freeSpinsCreateMutex.Unlock()
+	fake.FreeSpinsCreateStub = nil
+	fake.freeSpinsCreateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) FreeSpinsCreateReturnsOnCall(i int, result1 error) {
+	fake.freeSpinsCreateMutex.Lock()
+	defer fake.freeSpinsCreateMutex.Unlock()
+	fake.FreeSpinsCreateStub = nil
+	if fake.freeSpinsCreateReturnsOnCall == nil {
+		fake.freeSpinsCreateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.freeSpinsCreateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) FreeSpinsGetByID(arg1 context.Context, arg2 uuid.UUID) (types.FreeSpinsEntitlement, error) {
+	fake.freeSpinsGetByIDMutex.Lock()
+	ret, specificReturn := fake.freeSpinsGetByIDReturnsOnCall[len(fake.freeSpinsGetByIDArgsForCall)]
+	fake.freeSpinsGetByIDArgsForCall = append(fake.freeSpinsGetByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.FreeSpinsGetByIDStub
+	fakeReturns := fake.freeSpinsGetByIDReturns
+	fake.recordInvocation("FreeSpinsGetByID", []interface{}{arg1, arg2})
+	fake.freeSpinsGetByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) FreeSpinsGetByIDCallCount() int {
+	fake.freeSpinsGetByIDMutex.RLock()
+	defer fake.freeSpinsGetByIDMutex.RUnlock()
+	return len(fake.freeSpinsGetByIDArgsForCall)
+}
+
+func (fake *FakePersistent) FreeSpinsGetByIDCalls(stub func(context.Context, uuid.UUID) (types.FreeSpinsEntitlement, error)) {
+	fake.freeSpinsGetByIDMutex.Lock()
+	defer fake.freeSpinsGetByIDMutex.Unlock()
+	fake.FreeSpinsGetByIDStub = stub
+}
+
+func (fake *FakePersistent) FreeSpinsGetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.freeSpinsGetByIDMutex.RLock()
+	defer fake.freeSpinsGetByIDMutex.RUnlock()
+	argsForCall := fake.freeSpinsGetByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) FreeSpinsGetByIDReturns(result1 types.FreeSpinsEntitlement, result2 error) {
+	fake.freeSpinsGetByIDMutex.Lock()
+	defer fake.freeSpinsGetByIDMutex.Unlock()
+	fake.FreeSpinsGetByIDStub = nil
+	fake.freeSpinsGetByIDReturns = struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsGetByIDReturnsOnCall(i int, result1 types.FreeSpinsEntitlement, result2 error) {
+	fake.freeSpinsGetByIDMutex.Lock()
+	defer fake.freeSpinsGetByIDMutex.Unlock()
+	fake.FreeSpinsGetByIDStub = nil
+	if fake.freeSpinsGetByIDReturnsOnCall == nil {
+		fake.freeSpinsGetByIDReturnsOnCall = make(map[int]struct {
+			result1 types.FreeSpinsEntitlement
+			result2 error
+		})
+	}
+	fake.freeSpinsGetByIDReturnsOnCall[i] = struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsSettle(arg1 context.Context, arg2 uuid.UUID) (types.FreeSpinsEntitlement, error) {
+	fake.freeSpinsSettleMutex.Lock()
+	ret, specificReturn := fake.freeSpinsSettleReturnsOnCall[len(fake.freeSpinsSettleArgsForCall)]
+	fake.freeSpinsSettleArgsForCall = append(fake.freeSpinsSettleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.FreeSpinsSettleStub
+	fakeReturns := fake.freeSpinsSettleReturns
+	fake.recordInvocation("FreeSpinsSettle", []interface{}{arg1, arg2})
+	fake.freeSpinsSettleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) FreeSpinsSettleCallCount() int {
+	fake.freeSpinsSettleMutex.RLock()
+	defer fake.freeSpinsSettleMutex.RUnlock()
+	return len(fake.freeSpinsSettleArgsForCall)
+}
+
+func (fake *FakePersistent) FreeSpinsSettleCalls(stub func(context.Context, uuid.UUID) (types.FreeSpinsEntitlement, error)) {
+	fake.freeSpinsSettleMutex.Lock()
+	defer fake.freeSpinsSettleMutex.Unlock()
+	fake.FreeSpinsSettleStub = stub
+}
+
+func (fake *FakePersistent) FreeSpinsSettleArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.freeSpinsSettleMutex.RLock()
+	defer fake.freeSpinsSettleMutex.RUnlock()
+	argsForCall := fake.freeSpinsSettleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) FreeSpinsSettleReturns(result1 types.FreeSpinsEntitlement, result2 error) {
+	fake.freeSpinsSettleMutex.Lock()
+	defer fake.freeSpinsSettleMutex.Unlock()
+	fake.FreeSpinsSettleStub = nil
+	fake.freeSpinsSettleReturns = struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsSettleReturnsOnCall(i int, result1 types.FreeSpinsEntitlement, result2 error) {
+	fake.freeSpinsSettleMutex.Lock()
+	defer fake.freeSpinsSettleMutex.Unlock()
+	fake.FreeSpinsSettleStub = nil
+	if fake.freeSpinsSettleReturnsOnCall == nil {
+		fake.freeSpinsSettleReturnsOnCall = make(map[int]struct {
+			result1 types.FreeSpinsEntitlement
+			result2 error
+		})
+	}
+	fake.freeSpinsSettleReturnsOnCall[i] = struct {
+		result1 types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) FreeSpinsVoid(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.freeSpinsVoidMutex.Lock()
+	ret, specificReturn := fake.freeSpinsVoidReturnsOnCall[len(fake.freeSpinsVoidArgsForCall)]
+	fake.freeSpinsVoidArgsForCall = append(fake.freeSpinsVoidArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.FreeSpinsVoidStub
+	fakeReturns := fake.freeSpinsVoidReturns
+	fake.recordInvocation("FreeSpinsVoid", []interface{}{arg1, arg2})
+	fake.freeSpinsVoidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) FreeSpinsVoidCallCount() int {
+	fake.freeSpinsVoidMutex.RLock()
+	defer fake.freeSpinsVoidMutex.RUnlock()
+	return len(fake.freeSpinsVoidArgsForCall)
+}
+
+func (fake *FakePersistent) FreeSpinsVoidCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.freeSpinsVoidMutex.Lock()
+	defer fake.freeSpinsVoidMutex.Unlock()
+	fake.FreeSpinsVoidStub = stub
+}
+
+func (fake *FakePersistent) FreeSpinsVoidArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.freeSpinsVoidMutex.RLock()
+	defer fake.freeSpinsVoidMutex.RUnlock()
+	argsForCall := fake.freeSpinsVoidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) FreeSpinsVoidReturns(result1 error) {
+	fake.freeSpinsVoidMutex.Lock()
+	defer fake.freeSpinsVoidMutex.Unlock()
+	fake.FreeSpinsVoidStub = nil
+	fake.freeSpinsVoidReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) FreeSpinsVoidReturnsOnCall(i int, result1 error) {
+	fake.freeSpinsVoidMutex.Lock()
+	defer fake.freeSpinsVoidMutex.Unlock()
+	fake.FreeSpinsVoidStub = nil
+	if fake.freeSpinsVoidReturnsOnCall == nil {
+		fake.freeSpinsVoidReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.freeSpinsVoidReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) GameEventCreate(arg1 context.Context, arg2 types.GameEvent) (bool, error) {
+	fake.gameEventCreateMutex.Lock()
+	ret, specificReturn := fake.gameEventCreateReturnsOnCall[len(fake.gameEventCreateArgsForCall)]
+	fake.gameEventCreateArgsForCall = append(fake.gameEventCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.GameEvent
+	}{arg1, arg2})
+	stub := fake.GameEventCreateStub
+	fakeReturns := fake.gameEventCreateReturns
+	fake.recordInvocation("GameEventCreate", []interface{}{arg1, arg2})
+	fake.gameEventCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GameEventCreateCallCount() int {
+	fake.gameEventCreateMutex.RLock()
+	defer fake.gameEventCreateMutex.RUnlock()
+	return len(fake.gameEventCreateArgsForCall)
+}
+
+func (fake *FakePersistent) GameEventCreateCalls(stub func(context.Context, types.GameEvent) (bool, error)) {
+	fake.gameEventCreateMutex.Lock()
+	defer fake.gameEventCreateMutex.Unlock()
+	fake.GameEventCreateStub = stub
+}
+
+func (fake *FakePersistent) GameEventCreateArgsForCall(i int) (context.Context, types.GameEvent) {
+	fake.gameEventCreateMutex.RLock()
+	defer fake.gameEventCreateMutex.RUnlock()
+	argsForCall := fake.gameEventCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GameEventCreateReturns(result1 bool, result2 error) {
+	fake.gameEventCreateMutex.Lock()
+	defer fake.gameEventCreateMutex.Unlock()
+	fake.GameEventCreateStub = nil
+	fake.gameEventCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GameEventCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.gameEventCreateMutex.Lock()
+	defer fake.gameEventCreateMutex.Unlock()
+	fake.GameEventCreateStub = nil
+	if fake.gameEventCreateReturnsOnCall == nil {
+		fake.gameEventCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.gameEventCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GameEventGet(arg1 context.Context, arg2 string, arg3 string, arg4 types.GameEventType) (types.GameEvent, error) {
+	fake.gameEventGetMutex.Lock()
+	ret, specificReturn := fake.gameEventGetReturnsOnCall[len(fake.gameEventGetArgsForCall)]
+	fake.gameEventGetArgsForCall = append(fake.gameEventGetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 types.GameEventType
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GameEventGetStub
+	fakeReturns := fake.gameEventGetReturns
+	fake.recordInvocation("GameEventGet", []interface{}{arg1, arg2, arg3, arg4})
+	fake.gameEventGetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GameEventGetCallCount() int {
+	fake.gameEventGetMutex.RLock()
+	defer fake.gameEventGetMutex.RUnlock()
+	return len(fake.gameEventGetArgsForCall)
+}
+
+func (fake *FakePersistent) GameEventGetCalls(stub func(context.Context, string, string, types.GameEventType) (types.GameEvent, error)) {
+	fake.gameEventGetMutex.Lock()
+	defer fake.gameEventGetMutex.Unlock()
+	fake.GameEventGetStub = stub
+}
+
+func (fake *FakePersistent) GameEventGetArgsForCall(i int) (context.Context, string, string, types.GameEventType) {
+	fake.gameEventGetMutex.RLock()
+	defer fake.gameEventGetMutex.RUnlock()
+	argsForCall := fake.gameEventGetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) GameEventGetReturns(result1 types.GameEvent, result2 error) {
+	fake.gameEventGetMutex.Lock()
+	defer fake.gameEventGetMutex.Unlock()
+	fake.GameEventGetStub = nil
+	fake.gameEventGetReturns = struct {
+		result1 types.GameEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GameEventGetReturnsOnCall(i int, result1 types.GameEvent, result2 error) {
+	fake.gameEventGetMutex.Lock()
+	defer fake.gameEventGetMutex.Unlock()
+	fake.GameEventGetStub = nil
+	if fake.gameEventGetReturnsOnCall == nil {
+		fake.gameEventGetReturnsOnCall = make(map[int]struct {
+			result1 types.GameEvent
+			result2 error
+		})
+	}
+	fake.gameEventGetReturnsOnCall[i] = struct {
+		result1 types.GameEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GameEventGetByEventID(arg1 context.Context, arg2 string, arg3 string) (types.GameEvent, error) {
+	fake.gameEventGetByEventIDMutex.Lock()
+	ret, specificReturn := fake.gameEventGetByEventIDReturnsOnCall[len(fake.gameEventGetByEventIDArgsForCall)]
+	fake.gameEventGetByEventIDArgsForCall = append(fake.gameEventGetByEventIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GameEventGetByEventIDStub
+	fakeReturns := fake.gameEventGetByEventIDReturns
+	fake.recordInvocation("GameEventGetByEventID", []interface{}{arg1, arg2, arg3})
+	fake.gameEventGetByEventIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GameEventGetByEventIDCallCount() int {
+	fake.gameEventGetByEventIDMutex.RLock()
+	defer fake.gameEventGetByEventIDMutex.RUnlock()
+	return len(fake.gameEventGetByEventIDArgsForCall)
+}
+
+func (fake *FakePersistent) GameEventGetByEventIDCalls(stub func(context.Context, string, string) (types.GameEvent, error)) {
+	fake.gameEventGetByEventIDMutex.Lock()
+	defer fake.gameEventGetByEventIDMutex.Unlock()
+	fake.GameEventGetByEventIDStub = stub
+}
+
+func (fake *FakePersistent) GameEventGetByEventIDArgsForCall(i int) (context.Context, string, string) {
+	fake.gameEventGetByEventIDMutex.RLock()
+	defer fake.gameEventGetByEventIDMutex.RUnlock()
+	argsForCall := fake.gameEventGetByEventIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) GameEventGetByEventIDReturns(result1 types.GameEvent, result2 error) {
+	fake.gameEventGetByEventIDMutex.Lock()
+	defer fake.gameEventGetByEventIDMutex.Unlock()
+	fake.GameEventGetByEventIDStub = nil
+	fake.gameEventGetByEventIDReturns = struct {
+		result1 types.GameEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GameEventGetByEventIDReturnsOnCall(i int, result1 types.GameEvent, result2 error) {
+	fake.gameEventGetByEventIDMutex.Lock()
+	defer fake.gameEventGetByEventIDMutex.Unlock()
+	fake.GameEventGetByEventIDStub = nil
+	if fake.gameEventGetByEventIDReturnsOnCall == nil {
+		fake.gameEventGetByEventIDReturnsOnCall = make(map[int]struct {
+			result1 types.GameEvent
+			result2 error
+		})
+	}
+	fake.gameEventGetByEventIDReturnsOnCall[i] = struct {
+		result1 types.GameEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetActiveFreeSpins(arg1 context.Context, arg2 uuid.UUID, arg3 string) ([]types.FreeSpinsEntitlement, error) {
+	fake.getActiveFreeSpinsMutex.Lock()
+	ret, specificReturn := fake.getActiveFreeSpinsReturnsOnCall[len(fake.getActiveFreeSpinsArgsForCall)]
+	fake.getActiveFreeSpinsArgsForCall = append(fake.getActiveFreeSpinsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetActiveFreeSpinsStub
+	fakeReturns := fake.getActiveFreeSpinsReturns
+	fake.recordInvocation("GetActiveFreeSpins", []interface{}{arg1, arg2, arg3})
+	fake.getActiveFreeSpinsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetActiveFreeSpinsCallCount() int {
+	fake.getActiveFreeSpinsMutex.RLock()
+	defer fake.getActiveFreeSpinsMutex.RUnlock()
+	return len(fake.getActiveFreeSpinsArgsForCall)
+}
+
+func (fake *FakePersistent) GetActiveFreeSpinsCalls(stub func(context.Context, uuid.UUID, string) ([]types.FreeSpinsEntitlement, error)) {
+	fake.getActiveFreeSpinsMutex.Lock()
+	defer fake.getActiveFreeSpinsMutex.Unlock()
+	fake.GetActiveFreeSpinsStub = stub
+}
+
+func (fake *FakePersistent) GetActiveFreeSpinsArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.getActiveFreeSpinsMutex.RLock()
+	defer fake.getActiveFreeSpinsMutex.RUnlock()
+	argsForCall := fake.getActiveFreeSpinsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) GetActiveFreeSpinsReturns(result1 []types.FreeSpinsEntitlement, result2 error) {
+	fake.getActiveFreeSpinsMutex.Lock()
+	defer fake.getActiveFreeSpinsMutex.Unlock()
+	fake.GetActiveFreeSpinsStub = nil
+	fake.getActiveFreeSpinsReturns = struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetActiveFreeSpinsReturnsOnCall(i int, result1 []types.FreeSpinsEntitlement, result2 error) {
+	fake.getActiveFreeSpinsMutex.Lock()
+	defer fake.getActiveFreeSpinsMutex.Unlock()
+	fake.GetActiveFreeSpinsStub = nil
+	if fake.getActiveFreeSpinsReturnsOnCall == nil {
+		fake.getActiveFreeSpinsReturnsOnCall = make(map[int]struct {
+			result1 []types.FreeSpinsEntitlement
+			result2 error
+		})
+	}
+	fake.getActiveFreeSpinsReturnsOnCall[i] = struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetCashbackCalculations(arg1 context.Context, arg2 uuid.UUID) ([]types.CashbackCalculation, error) {
+	fake.getCashbackCalculationsMutex.Lock()
+	ret, specificReturn := fake.getCashbackCalculationsReturnsOnCall[len(fake.getCashbackCalculationsArgsForCall)]
+	fake.getCashbackCalculationsArgsForCall = append(fake.getCashbackCalculationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetCashbackCalculationsStub
+	fakeReturns := fake.getCashbackCalculationsReturns
+	fake.recordInvocation("GetCashbackCalculations", []interface{}{arg1, arg2})
+	fake.getCashbackCalculationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetCashbackCalculationsCallCount() int {
+	fake.getCashbackCalculationsMutex.RLock()
+	defer fake.getCashbackCalculationsMutex.RUnlock()
+	return len(fake.getCashbackCalculationsArgsForCall)
+}
+
+func (fake *FakePersistent) GetCashbackCalculationsCalls(stub func(context.Context, uuid.UUID) ([]types.CashbackCalculation, error)) {
+	fake.getCashbackCalculationsMutex.Lock()
+	defer fake.getCashbackCalculationsMutex.Unlock()
+	fake.GetCashbackCalculationsStub = stub
+}
+
+func (fake *FakePersistent) GetCashbackCalculationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getCashbackCalculationsMutex.RLock()
+	defer fake.getCashbackCalculationsMutex.RUnlock()
+	argsForCall := fake.getCashbackCalculationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetCashbackCalculationsReturns(result1 []types.CashbackCalculation, result2 error) {
+	fake.getCashbackCalculationsMutex.Lock()
+	defer fake.getCashbackCalculationsMutex.Unlock()
+	fake.GetCashbackCalculationsStub = nil
+	fake.getCashbackCalculationsReturns = struct {
+		result1 []types.CashbackCalculation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetCashbackCalculationsReturnsOnCall(i int, result1 []types.CashbackCalculation, result2 error) {
+	fake.getCashbackCalculationsMutex.Lock()
+	defer fake.getCashbackCalculationsMutex.Unlock()
+	fake.GetCashbackCalculationsStub = nil
+	if fake.getCashbackCalculationsReturnsOnCall == nil {
+		fake.getCashbackCalculationsReturnsOnCall = make(map[int]struct {
+			result1 []types.CashbackCalculation
+			result2 error
+		})
+	}
+	fake.getCashbackCalculationsReturnsOnCall[i] = struct {
+		result1 []types.CashbackCalculation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetCatalogItems(arg1 context.Context, arg2 bool) ([]types.CatalogItem, error) {
+	fake.getCatalogItemsMutex.Lock()
+	ret, specificReturn := fake.getCatalogItemsReturnsOnCall[len(fake.getCatalogItemsArgsForCall)]
+	fake.getCatalogItemsArgsForCall = append(fake.getCatalogItemsArgsForCall, struct {
+		arg1 context.Context
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.GetCatalogItemsStub
+	fakeReturns := fake.getCatalogItemsReturns
+	fake.recordInvocation("GetCatalogItems", []interface{}{arg1, arg2})
+	fake.getCatalogItemsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetCatalogItemsCallCount() int {
+	fake.getCatalogItemsMutex.RLock()
+	defer fake.getCatalogItemsMutex.RUnlock()
+	return len(fake.getCatalogItemsArgsForCall)
+}
+
+func (fake *FakePersistent) GetCatalogItemsCalls(stub func(context.Context, bool) ([]types.CatalogItem, error)) {
+	fake.getCatalogItemsMutex.Lock()
+	defer fake.getCatalogItemsMutex.Unlock()
+	fake.GetCatalogItemsStub = stub
+}
+
+func (fake *FakePersistent) GetCatalogItemsArgsForCall(i int) (context.Context, bool) {
+	fake.getCatalogItemsMutex.RLock()
+	defer fake.getCatalogItemsMutex.RUnlock()
+	argsForCall := fake.getCatalogItemsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetCatalogItemsReturns(result1 []types.CatalogItem, result2 error) {
+	fake.getCatalogItemsMutex.Lock()
+	defer fake.getCatalogItemsMutex.Unlock()
+	fake.GetCatalogItemsStub = nil
+	fake.getCatalogItemsReturns = struct {
+		result1 []types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetCatalogItemsReturnsOnCall(i int, result1 []types.CatalogItem, result2 error) {
+	fake.getCatalogItemsMutex.Lock()
+	defer fake.getCatalogItemsMutex.Unlock()
+	fake.GetCatalogItemsStub = nil
+	if fake.getCatalogItemsReturnsOnCall == nil {
+		fake.getCatalogItemsReturnsOnCall = make(map[int]struct {
+			result1 []types.CatalogItem
+			result2 error
+		})
+	}
+	fake.getCatalogItemsReturnsOnCall[i] = struct {
+		result1 []types.CatalogItem
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetEligibilityProfile(arg1 context.Context, arg2 uuid.UUID) (types.EligibilityProfile, error) {
+	fake.getEligibilityProfileMutex.Lock()
+	ret, specificReturn := fake.getEligibilityProfileReturnsOnCall[len(fake.getEligibilityProfileArgsForCall)]
+	fake.getEligibilityProfileArgsForCall = append(fake.getEligibilityProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetEligibilityProfileStub
+	fakeReturns := fake.getEligibilityProfileReturns
+	fake.recordInvocation("GetEligibilityProfile", []interface{}{arg1, arg2})
+	fake.getEligibilityProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetEligibilityProfileCallCount() int {
+	fake.getEligibilityProfileMutex.RLock()
+	defer fake.getEligibilityProfileMutex.RUnlock()
+	return len(fake.getEligibilityProfileArgsForCall)
+}
+
+func (fake *FakePersistent) GetEligibilityProfileCalls(stub func(context.Context, uuid.UUID) (types.EligibilityProfile, error)) {
+	fake.getEligibilityProfileMutex.Lock()
+	defer fake.getEligibilityProfileMutex.Unlock()
+	fake.GetEligibilityProfileStub = stub
+}
+
+func (fake *FakePersistent) GetEligibilityProfileArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getEligibilityProfileMutex.RLock()
+	defer fake.getEligibilityProfileMutex.RUnlock()
+	argsForCall := fake.getEligibilityProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetEligibilityProfileReturns(result1 types.EligibilityProfile, result2 error) {
+	fake.getEligibilityProfileMutex.Lock()
+	defer fake.getEligibilityProfileMutex.Unlock()
+	fake.GetEligibilityProfileStub = nil
+	fake.getEligibilityProfileReturns = struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetEligibilityProfileReturnsOnCall(i int, result1 types.EligibilityProfile, result2 error) {
+	fake.getEligibilityProfileMutex.Lock()
+	defer fake.getEligibilityProfileMutex.Unlock()
+	fake.GetEligibilityProfileStub = nil
+	if fake.getEligibilityProfileReturnsOnCall == nil {
+		fake.getEligibilityProfileReturnsOnCall = make(map[int]struct {
+			result1 types.EligibilityProfile
+			result2 error
+		})
+	}
+	fake.getEligibilityProfileReturnsOnCall[i] = struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetEndedTournaments(arg1 context.Context, arg2 time.Time) ([]types.Tournament, error) {
+	fake.getEndedTournamentsMutex.Lock()
+	ret, specificReturn := fake.getEndedTournamentsReturnsOnCall[len(fake.getEndedTournamentsArgsForCall)]
+	fake.getEndedTournamentsArgsForCall = append(fake.getEndedTournamentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetEndedTournamentsStub
+	fakeReturns := fake.getEndedTournamentsReturns
+	fake.recordInvocation("GetEndedTournaments", []interface{}{arg1, arg2})
+	fake.getEndedTournamentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetEndedTournamentsCallCount() int {
+	fake.getEndedTournamentsMutex.RLock()
+	defer fake.getEndedTournamentsMutex.RUnlock()
+	return len(fake.getEndedTournamentsArgsForCall)
+}
+
+func (fake *FakePersistent) GetEndedTournamentsCalls(stub func(context.Context, time.Time) ([]types.Tournament, error)) {
+	fake.getEndedTournamentsMutex.Lock()
+	defer fake.getEndedTournamentsMutex.Unlock()
+	fake.GetEndedTournamentsStub = stub
+}
+
+func (fake *FakePersistent) GetEndedTournamentsArgsForCall(i int) (context.Context, time.Time) {
+	fake.getEndedTournamentsMutex.RLock()
+	defer fake.getEndedTournamentsMutex.RUnlock()
+	argsForCall := fake.getEndedTournamentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetEndedTournamentsReturns(result1 []types.Tournament, result2 error) {
+	fake.getEndedTournamentsMutex.Lock()
+	defer fake.getEndedTournamentsMutex.Unlock()
+	fake.GetEndedTournamentsStub = nil
+	fake.getEndedTournamentsReturns = struct {
+		result1 []types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetEndedTournamentsReturnsOnCall(i int, result1 []types.Tournament, result2 error) {
+	fake.getEndedTournamentsMutex.Lock()
+	defer fake.getEndedTournamentsMutex.Unlock()
+	fake.GetEndedTournamentsStub = nil
+	if fake.getEndedTournamentsReturnsOnCall == nil {
+		fake.getEndedTournamentsReturnsOnCall = make(map[int]struct {
+			result1 []types.Tournament
+			result2 error
+		})
+	}
+	fake.getEndedTournamentsReturnsOnCall[i] = struct {
+		result1 []types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetExpiredFreeSpins(arg1 context.Context, arg2 time.Time, arg3 int) ([]types.FreeSpinsEntitlement, error) {
+	fake.getExpiredFreeSpinsMutex.Lock()
+	ret, specificReturn := fake.getExpiredFreeSpinsReturnsOnCall[len(fake.getExpiredFreeSpinsArgsForCall)]
+	fake.getExpiredFreeSpinsArgsForCall = append(fake.getExpiredFreeSpinsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetExpiredFreeSpinsStub
+	fakeReturns := fake.getExpiredFreeSpinsReturns
+	fake.recordInvocation("GetExpiredFreeSpins", []interface{}{arg1, arg2, arg3})
+	fake.getExpiredFreeSpinsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetExpiredFreeSpinsCallCount() int {
+	fake.getExpiredFreeSpinsMutex.RLock()
+	defer fake.getExpiredFreeSpinsMutex.RUnlock()
+	return len(fake.getExpiredFreeSpinsArgsForCall)
+}
+
+func (fake *FakePersistent) GetExpiredFreeSpinsCalls(stub func(context.Context, time.Time, int) ([]types.FreeSpinsEntitlement, error)) {
+	fake.getExpiredFreeSpinsMutex.Lock()
+	defer fake.getExpiredFreeSpinsMutex.Unlock()
+	fake.GetExpiredFreeSpinsStub = stub
+}
+
+func (fake *FakePersistent) GetExpiredFreeSpinsArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.getExpiredFreeSpinsMutex.RLock()
+	defer fake.getExpiredFreeSpinsMutex.RUnlock()
+	argsForCall := fake.getExpiredFreeSpinsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) GetExpiredFreeSpinsReturns(result1 []types.FreeSpinsEntitlement, result2 error) {
+	fake.getExpiredFreeSpinsMutex.Lock()
+	defer fake.getExpiredFreeSpinsMutex.Unlock()
+	fake.GetExpiredFreeSpinsStub = nil
+	fake.getExpiredFreeSpinsReturns = struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetExpiredFreeSpinsReturnsOnCall(i int, result1 []types.FreeSpinsEntitlement, result2 error) {
+	fake.getExpiredFreeSpinsMutex.Lock()
+	defer fake.getExpiredFreeSpinsMutex.Unlock()
+	fake.GetExpiredFreeSpinsStub = nil
+	if fake.getExpiredFreeSpinsReturnsOnCall == nil {
+		fake.getExpiredFreeSpinsReturnsOnCall = make(map[int]struct {
+			result1 []types.FreeSpinsEntitlement
+			result2 error
+		})
+	}
+	fake.getExpiredFreeSpinsReturnsOnCall[i] = struct {
+		result1 []types.FreeSpinsEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetExpiredUserPromotionBonuses(arg1 context.Context, arg2 time.Time, arg3 int) ([]types.UserPromotion, error) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	ret, specificReturn := fake.getExpiredUserPromotionBonusesReturnsOnCall[len(fake.getExpiredUserPromotionBonusesArgsForCall)]
+	fake.getExpiredUserPromotionBonusesArgsForCall = append(fake.getExpiredUserPromotionBonusesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetExpiredUserPromotionBonusesStub
+	fakeReturns := fake.getExpiredUserPromotionBonusesReturns
+	fake.recordInvocation("GetExpiredUserPromotionBonuses", []interface{}{arg1, arg2, arg3})
+	fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetExpiredUserPromotionBonusesCallCount() int {
+	fake.getExpiredUserPromotionBonusesMutex.RLock()
+	defer fake.getExpiredUserPromotionBonusesMutex.RUnlock()
+	return len(fake.getExpiredUserPromotionBonusesArgsForCall)
+}
+
+func (fake *FakePersistent) GetExpiredUserPromotionBonusesCalls(stub func(context.Context, time.Time, int) ([]types.UserPromotion, error)) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	defer fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	fake.GetExpiredUserPromotionBonusesStub = stub
+}
+
+func (fake *FakePersistent) GetExpiredUserPromotionBonusesArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.getExpiredUserPromotionBonusesMutex.RLock()
+	defer fake.getExpiredUserPromotionBonusesMutex.RUnlock()
+	argsForCall := fake.getExpiredUserPromotionBonusesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) GetExpiredUserPromotionBonusesReturns(result1 []types.UserPromotion, result2 error) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	defer fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	fake.GetExpiredUserPromotionBonusesStub = nil
+	fake.getExpiredUserPromotionBonusesReturns = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetExpiredUserPromotionBonusesReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	defer fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	fake.GetExpiredUserPromotionBonusesStub = nil
+	if fake.getExpiredUserPromotionBonusesReturnsOnCall == nil {
+		fake.getExpiredUserPromotionBonusesReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotion
+			result2 error
+		})
+	}
+	fake.getExpiredUserPromotionBonusesReturnsOnCall[i] = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetNetLosses(arg1 context.Context, arg2 types.Currency, arg3 time.Time, arg4 time.Time) ([]types.CashbackCalculation, error) {
+	fake.getNetLossesMutex.Lock()
+	ret, specificReturn := fake.getNetLossesReturnsOnCall[len(fake.getNetLossesArgsForCall)]
+	fake.getNetLossesArgsForCall = append(fake.getNetLossesArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Currency
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetNetLossesStub
+	fakeReturns := fake.getNetLossesReturns
+	fake.recordInvocation("GetNetLosses", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getNetLossesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetNetLossesCallCount() int {
+	fake.getNetLossesMutex.RLock()
+	defer fake.getNetLossesMutex.RUnlock()
+	return len(fake.getNetLossesArgsForCall)
+}
+
+func (fake *FakePersistent) GetNetLossesCalls(stub func(context.Context, types.Currency, time.Time, time.Time) ([]types.CashbackCalculation, error)) {
+	fake.getNetLossesMutex.Lock()
+	defer fake.getNetLossesMutex.Unlock()
+	fake.GetNetLossesStub = stub
+}
+
+func (fake *FakePersistent) GetNetLossesArgsForCall(i int) (context.Context, types.Currency, time.Time, time.Time) {
+	fake.getNetLossesMutex.RLock()
+	defer fake.getNetLossesMutex.RUnlock()
+	argsForCall := fake.getNetLossesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) GetNetLossesReturns(result1 []types.CashbackCalculation, result2 error) {
+	fake.getNetLossesMutex.Lock()
+	defer fake.getNetLossesMutex.Unlock()
+	fake.GetNetLossesStub = nil
+	fake.getNetLossesReturns = struct {
+		result1 []types.CashbackCalculation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetNetLossesReturnsOnCall(i int, result1 []types.CashbackCalculation, result2 error) {
+	fake.getNetLossesMutex.Lock()
+	defer fake.getNetLossesMutex.Unlock()
+	fake.GetNetLossesStub = nil
+	if fake.getNetLossesReturnsOnCall == nil {
+		fake.getNetLossesReturnsOnCall = make(map[int]struct {
+			result1 []types.CashbackCalculation
+			result2 error
+		})
+	}
+	fake.getNetLossesReturnsOnCall[i] = struct {
+		result1 []types.CashbackCalculation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPointsLiability(arg1 context.Context, arg2 time.Time, arg3 time.Time) ([]types.PointsLiability, error) {
+	fake.getPointsLiabilityMutex.Lock()
+	ret, specificReturn := fake.getPointsLiabilityReturnsOnCall[len(fake.getPointsLiabilityArgsForCall)]
+	fake.getPointsLiabilityArgsForCall = append(fake.getPointsLiabilityArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.GetPointsLiabilityStub
+	fakeReturns := fake.getPointsLiabilityReturns
+	fake.recordInvocation("GetPointsLiability", []interface{}{arg1, arg2, arg3})
+	fake.getPointsLiabilityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetPointsLiabilityCallCount() int {
+	fake.getPointsLiabilityMutex.RLock()
+	defer fake.getPointsLiabilityMutex.RUnlock()
+	return len(fake.getPointsLiabilityArgsForCall)
+}
+
+func (fake *FakePersistent) GetPointsLiabilityCalls(stub func(context.Context, time.Time, time.Time) ([]types.PointsLiability, error)) {
+	fake.getPointsLiabilityMutex.Lock()
+	defer fake.getPointsLiabilityMutex.Unlock()
+	fake.GetPointsLiabilityStub = stub
+}
+
+func (fake *FakePersistent) GetPointsLiabilityArgsForCall(i int) (context.Context, time.Time, time.Time) {
+	fake.getPointsLiabilityMutex.RLock()
+	defer fake.getPointsLiabilityMutex.RUnlock()
+	argsForCall := fake.getPointsLiabilityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) GetPointsLiabilityReturns(result1 []types.PointsLiability, result2 error) {
+	fake.getPointsLiabilityMutex.Lock()
+	defer fake.getPointsLiabilityMutex.Unlock()
+	fake.GetPointsLiabilityStub = nil
+	fake.getPointsLiabilityReturns = struct {
+		result1 []types.PointsLiability
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPointsLiabilityReturnsOnCall(i int, result1 []types.PointsLiability, result2 error) {
+	fake.getPointsLiabilityMutex.Lock()
+	defer fake.getPointsLiabilityMutex.Unlock()
+	fake.GetPointsLiabilityStub = nil
+	if fake.getPointsLiabilityReturnsOnCall == nil {
+		fake.getPointsLiabilityReturnsOnCall = make(map[int]struct {
+			result1 []types.PointsLiability
+			result2 error
+		})
+	}
+	fake.getPointsLiabilityReturnsOnCall[i] = struct {
+		result1 []types.PointsLiability
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPointsRates(arg1 context.Context) ([]types.PointsRate, error) {
+	fake.getPointsRatesMutex.Lock()
+	ret, specificReturn := fake.getPointsRatesReturnsOnCall[len(fake.getPointsRatesArgsForCall)]
+	fake.getPointsRatesArgsForCall = append(fake.getPointsRatesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetPointsRatesStub
+	fakeReturns := fake.getPointsRatesReturns
+	fake.recordInvocation("GetPointsRates", []interface{}{arg1})
+	fake.getPointsRatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetPointsRatesCallCount() int {
+	fake.getPointsRatesMutex.RLock()
+	defer fake.getPointsRatesMutex.RUnlock()
+	return len(fake.getPointsRatesArgsForCall)
+}
+
+func (fake *FakePersistent) GetPointsRatesCalls(stub func(context.Context) ([]types.PointsRate, error)) {
+	fake.getPointsRatesMutex.Lock()
+	defer fake.getPointsRatesMutex.Unlock()
+	fake.GetPointsRatesStub = stub
+}
+
+func (fake *FakePersistent) GetPointsRatesArgsForCall(i int) context.Context {
+	fake.getPointsRatesMutex.RLock()
+	defer fake.getPointsRatesMutex.RUnlock()
+	argsForCall := fake.getPointsRatesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetPointsRatesReturns(result1 []types.PointsRate, result2 error) {
+	fake.getPointsRatesMutex.Lock()
+	defer fake.getPointsRatesMutex.Unlock()
+	fake.GetPointsRatesStub = nil
+	fake.getPointsRatesReturns = struct {
+		result1 []types.PointsRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPointsRatesReturnsOnCall(i int, result1 []types.PointsRate, result2 error) {
+	fake.getPointsRatesMutex.Lock()
+	defer fake.getPointsRatesMutex.Unlock()
+	fake.GetPointsRatesStub = nil
+	if fake.getPointsRatesReturnsOnCall == nil {
+		fake.getPointsRatesReturnsOnCall = make(map[int]struct {
+			result1 []types.PointsRate
+			result2 error
+		})
+	}
+	fake.getPointsRatesReturnsOnCall[i] = struct {
+		result1 []types.PointsRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPromotionCodes(arg1 context.Context, arg2 uuid.UUID) ([]types.PromotionCode, error) {
+	fake.getPromotionCodesMutex.Lock()
+	ret, specificReturn := fake.getPromotionCodesReturnsOnCall[len(fake.getPromotionCodesArgsForCall)]
+	fake.getPromotionCodesArgsForCall = append(fake.getPromotionCodesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPromotionCodesStub
+	fakeReturns := fake.getPromotionCodesReturns
+	fake.recordInvocation("GetPromotionCodes", []interface{}{arg1, arg2})
+	fake.getPromotionCodesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetPromotionCodesCallCount() int {
+	fake.getPromotionCodesMutex.RLock()
+	defer fake.getPromotionCodesMutex.RUnlock()
+	return len(fake.getPromotionCodesArgsForCall)
+}
+
+func (fake *FakePersistent) GetPromotionCodesCalls(stub func(context.Context, uuid.UUID) ([]types.PromotionCode, error)) {
+	fake.getPromotionCodesMutex.Lock()
+	defer fake.getPromotionCodesMutex.Unlock()
+	fake.GetPromotionCodesStub = stub
+}
+
+func (fake *FakePersistent) GetPromotionCodesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPromotionCodesMutex.RLock()
+	defer fake.getPromotionCodesMutex.RUnlock()
+	argsForCall := fake.getPromotionCodesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetPromotionCodesReturns(result1 []types.PromotionCode, result2 error) {
+	fake.getPromotionCodesMutex.Lock()
+	defer fake.getPromotionCodesMutex.Unlock()
+	fake.GetPromotionCodesStub = nil
+	fake.getPromotionCodesReturns = struct {
+		result1 []types.PromotionCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPromotionCodesReturnsOnCall(i int, result1 []types.PromotionCode, result2 error) {
+	fake.getPromotionCodesMutex.Lock()
+	defer fake.getPromotionCodesMutex.Unlock()
+	fake.GetPromotionCodesStub = nil
+	if fake.getPromotionCodesReturnsOnCall == nil {
+		fake.getPromotionCodesReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionCode
+			result2 error
+		})
+	}
+	fake.getPromotionCodesReturnsOnCall[i] = struct {
+		result1 []types.PromotionCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPromotionStateChanges(arg1 context.Context, arg2 uuid.UUID) ([]types.PromotionStateChange, error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	ret, specificReturn := fake.getPromotionStateChangesReturnsOnCall[len(fake.getPromotionStateChangesArgsForCall)]
+	fake.getPromotionStateChangesArgsForCall = append(fake.getPromotionStateChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPromotionStateChangesStub
+	fakeReturns := fake.getPromotionStateChangesReturns
+	fake.recordInvocation("GetPromotionStateChanges", []interface{}{arg1, arg2})
+	fake.getPromotionStateChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetPromotionStateChangesCallCount() int {
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
+	return len(fake.getPromotionStateChangesArgsForCall)
+}
+
+func (fake *FakePersistent) GetPromotionStateChangesCalls(stub func(context.Context, uuid.UUID) ([]types.PromotionStateChange, error)) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = stub
+}
+
+func (fake *FakePersistent) GetPromotionStateChangesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
+	argsForCall := fake.getPromotionStateChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetPromotionStateChangesReturns(result1 []types.PromotionStateChange, result2 error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = nil
+	fake.getPromotionStateChangesReturns = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPromotionStateChangesReturnsOnCall(i int, result1 []types.PromotionStateChange, result2 error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = nil
+	if fake.getPromotionStateChangesReturnsOnCall == nil {
+		fake.getPromotionStateChangesReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionStateChange
+			result2 error
+		})
+	}
+	fake.getPromotionStateChangesReturnsOnCall[i] = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPromotions(arg1 context.Context, arg2 types.PromotionFilter) ([]types.Promotion, error) {
+	fake.getPromotionsMutex.Lock()
+	ret, specificReturn := fake.getPromotionsReturnsOnCall[len(fake.getPromotionsArgsForCall)]
+	fake.getPromotionsArgsForCall = append(fake.getPromotionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PromotionFilter
+	}{arg1, arg2})
+	stub := fake.GetPromotionsStub
+	fakeReturns := fake.getPromotionsReturns
+	fake.recordInvocation("GetPromotions", []interface{}{arg1, arg2})
+	fake.getPromotionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetPromotionsCallCount() int {
+	fake.getPromotionsMutex.RLock()
+	defer fake.getPromotionsMutex.RUnlock()
+	return len(fake.getPromotionsArgsForCall)
+}
+
+func (fake *FakePersistent) GetPromotionsCalls(stub func(context.Context, types.PromotionFilter) ([]types.Promotion, error)) {
+	fake.getPromotionsMutex.Lock()
+	defer fake.getPromotionsMutex.Unlock()
+	fake.GetPromotionsStub = stub
+}
+
+func (fake *FakePersistent) GetPromotionsArgsForCall(i int) (context.Context, types.PromotionFilter) {
+	fake.getPromotionsMutex.RLock()
+	defer fake.getPromotionsMutex.RUnlock()
+	argsForCall := fake.getPromotionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetPromotionsReturns(result1 []types.Promotion, result2 error) {
+	fake.getPromotionsMutex.Lock()
+	defer fake.getPromotionsMutex.Unlock()
+	fake.GetPromotionsStub = nil
+	fake.getPromotionsReturns = struct {
+		result1 []types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetPromotionsReturnsOnCall(i int, result1 []types.Promotion, result2 error) {
+	fake.getPromotionsMutex.Lock()
+	defer fake.getPromotionsMutex.Unlock()
+	fake.GetPromotionsStub = nil
+	if fake.getPromotionsReturnsOnCall == nil {
+		fake.getPromotionsReturnsOnCall = make(map[int]struct {
+			result1 []types.Promotion
+			result2 error
+		})
+	}
+	fake.getPromotionsReturnsOnCall[i] = struct {
+		result1 []types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetQualifiedReferrals(arg1 context.Context, arg2 types.ReferralCondition, arg3 decimal.Decimal, arg4 int) ([]types.Referral, error) {
+	fake.getQualifiedReferralsMutex.Lock()
+	ret, specificReturn := fake.getQualifiedReferralsReturnsOnCall[len(fake.getQualifiedReferralsArgsForCall)]
+	fake.getQualifiedReferralsArgsForCall = append(fake.getQualifiedReferralsArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.ReferralCondition
+		arg3 decimal.Decimal
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetQualifiedReferralsStub
+	fakeReturns := fake.getQualifiedReferralsReturns
+	fake.recordInvocation("GetQualifiedReferrals", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getQualifiedReferralsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetQualifiedReferralsCallCount() int {
+	fake.getQualifiedReferralsMutex.RLock()
+	defer fake.getQualifiedReferralsMutex.RUnlock()
+	return len(fake.getQualifiedReferralsArgsForCall)
+}
+
+func (fake *FakePersistent) GetQualifiedReferralsCalls(stub func(context.Context, types.ReferralCondition, decimal.Decimal, int) ([]types.Referral, error)) {
+	fake.getQualifiedReferralsMutex.Lock()
+	defer fake.getQualifiedReferralsMutex.Unlock()
+	fake.GetQualifiedReferralsStub = stub
+}
+
+func (fake *FakePersistent) GetQualifiedReferralsArgsForCall(i int) (context.Context, types.ReferralCondition, decimal.Decimal, int) {
+	fake.getQualifiedReferralsMutex.RLock()
+	defer fake.getQualifiedReferralsMutex.RUnlock()
+	argsForCall := fake.getQualifiedReferralsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) GetQualifiedReferralsReturns(result1 []types.Referral, result2 error) {
+	fake.getQualifiedReferralsMutex.Lock()
+	defer fake.getQualifiedReferralsMutex.Unlock()
+	fake.GetQualifiedReferralsStub = nil
+	fake.getQualifiedReferralsReturns = struct {
+		result1 []types.Referral
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetQualifiedReferralsReturnsOnCall(i int, result1 []types.Referral, result2 error) {
+	fake.getQualifiedReferralsMutex.Lock()
+	defer fake.getQualifiedReferralsMutex.Unlock()
+	fake.GetQualifiedReferralsStub = nil
+	if fake.getQualifiedReferralsReturnsOnCall == nil {
+		fake.getQualifiedReferralsReturnsOnCall = make(map[int]struct {
+			result1 []types.Referral
+			result2 error
+		})
+	}
+	fake.getQualifiedReferralsReturnsOnCall[i] = struct {
+		result1 []types.Referral
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetRedemptions(arg1 context.Context, arg2 types.RedemptionFilter) ([]types.Redemption, error) {
+	fake.getRedemptionsMutex.Lock()
+	ret, specificReturn := fake.getRedemptionsReturnsOnCall[len(fake.getRedemptionsArgsForCall)]
+	fake.getRedemptionsArgsForCall = append(fake.getRedemptionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.RedemptionFilter
+	}{arg1, arg2})
+	stub := fake.GetRedemptionsStub
+	fakeReturns := fake.getRedemptionsReturns
+	fake.recordInvocation("GetRedemptions", []interface{}{arg1, arg2})
+	fake.getRedemptionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetRedemptionsCallCount() int {
+	fake.getRedemptionsMutex.RLock()
+	defer fake.getRedemptionsMutex.RUnlock()
+	return len(fake.getRedemptionsArgsForCall)
+}
+
+func (fake *FakePersistent) GetRedemptionsCalls(stub func(context.Context, types.RedemptionFilter) ([]types.Redemption, error)) {
+	fake.getRedemptionsMutex.Lock()
+	defer fake.getRedemptionsMutex.Unlock()
+	fake.GetRedemptionsStub = stub
+}
+
+func (fake *FakePersistent) GetRedemptionsArgsForCall(i int) (context.Context, types.RedemptionFilter) {
+	fake.getRedemptionsMutex.RLock()
+	defer fake.getRedemptionsMutex.RUnlock()
+	argsForCall := fake.getRedemptionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetRedemptionsReturns(result1 []types.Redemption, result2 error) {
+	fake.getRedemptionsMutex.Lock()
+	defer fake.getRedemptionsMutex.Unlock()
+	fake.GetRedemptionsStub = nil
+	fake.getRedemptionsReturns = struct {
+		result1 []types.Redemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetRedemptionsReturnsOnCall(i int, result1 []types.Redemption, result2 error) {
+	fake.getRedemptionsMutex.Lock()
+	defer fake.getRedemptionsMutex.Unlock()
+	fake.GetRedemptionsStub = nil
+	if fake.getRedemptionsReturnsOnCall == nil {
+		fake.getRedemptionsReturnsOnCall = make(map[int]struct {
+			result1 []types.Redemption
+			result2 error
+		})
+	}
+	fake.getRedemptionsReturnsOnCall[i] = struct {
+		result1 []types.Redemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetReferralReport(arg1 context.Context) ([]types.ReferralReport, error) {
+	fake.getReferralReportMutex.Lock()
+	ret, specificReturn := fake.getReferralReportReturnsOnCall[len(fake.getReferralReportArgsForCall)]
+	fake.getReferralReportArgsForCall = append(fake.getReferralReportArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetReferralReportStub
+	fakeReturns := fake.getReferralReportReturns
+	fake.recordInvocation("GetReferralReport", []interface{}{arg1})
+	fake.getReferralReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetReferralReportCallCount() int {
+	fake.getReferralReportMutex.RLock()
+	defer fake.getReferralReportMutex.RUnlock()
+	return len(fake.getReferralReportArgsForCall)
+}
+
+func (fake *FakePersistent) GetReferralReportCalls(stub func(context.Context) ([]types.ReferralReport, error)) {
+	fake.getReferralReportMutex.Lock()
+	defer fake.getReferralReportMutex.Unlock()
+	fake.GetReferralReportStub = stub
+}
+
+func (fake *FakePersistent) GetReferralReportArgsForCall(i int) context.Context {
+	fake.getReferralReportMutex.RLock()
+	defer fake.getReferralReportMutex.RUnlock()
+	argsForCall := fake.getReferralReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetReferralReportReturns(result1 []types.ReferralReport, result2 error) {
+	fake.getReferralReportMutex.Lock()
+	defer fake.getReferralReportMutex.Unlock()
+	fake.GetReferralReportStub = nil
+	fake.getReferralReportReturns = struct {
+		result1 []types.ReferralReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetReferralReportReturnsOnCall(i int, result1 []types.ReferralReport, result2 error) {
+	fake.getReferralReportMutex.Lock()
+	defer fake.getReferralReportMutex.Unlock()
+	fake.GetReferralReportStub = nil
+	if fake.getReferralReportReturnsOnCall == nil {
+		fake.getReferralReportReturnsOnCall = make(map[int]struct {
+			result1 []types.ReferralReport
+			result2 error
+		})
+	}
+	fake.getReferralReportReturnsOnCall[i] = struct {
+		result1 []types.ReferralReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetRunningTournaments(arg1 context.Context, arg2 time.Time) ([]types.Tournament, error) {
+	fake.getRunningTournamentsMutex.Lock()
+	ret, specificReturn := fake.getRunningTournamentsReturnsOnCall[len(fake.getRunningTournamentsArgsForCall)]
+	fake.getRunningTournamentsArgsForCall = append(fake.getRunningTournamentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetRunningTournamentsStub
+	fakeReturns := fake.getRunningTournamentsReturns
+	fake.recordInvocation("GetRunningTournaments", []interface{}{arg1, arg2})
+	fake.getRunningTournamentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetRunningTournamentsCallCount() int {
+	fake.getRunningTournamentsMutex.RLock()
+	defer fake.getRunningTournamentsMutex.RUnlock()
+	return len(fake.getRunningTournamentsArgsForCall)
+}
+
+func (fake *FakePersistent) GetRunningTournamentsCalls(stub func(context.Context, time.Time) ([]types.Tournament, error)) {
+	fake.getRunningTournamentsMutex.Lock()
+	defer fake.getRunningTournamentsMutex.Unlock()
+	fake.GetRunningTournamentsStub = stub
+}
+
+func (fake *FakePersistent) GetRunningTournamentsArgsForCall(i int) (context.Context, time.Time) {
+	fake.getRunningTournamentsMutex.RLock()
+	defer fake.getRunningTournamentsMutex.RUnlock()
+	argsForCall := fake.getRunningTournamentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetRunningTournamentsReturns(result1 []types.Tournament, result2 error) {
+	fake.getRunningTournamentsMutex.Lock()
+	defer fake.getRunningTournamentsMutex.Unlock()
+	fake.GetRunningTournamentsStub = nil
+	fake.getRunningTournamentsReturns = struct {
+		result1 []types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetRunningTournamentsReturnsOnCall(i int, result1 []types.Tournament, result2 error) {
+	fake.getRunningTournamentsMutex.Lock()
+	defer fake.getRunningTournamentsMutex.Unlock()
+	fake.GetRunningTournamentsStub = nil
+	if fake.getRunningTournamentsReturnsOnCall == nil {
+		fake.getRunningTournamentsReturnsOnCall = make(map[int]struct {
+			result1 []types.Tournament
+			result2 error
+		})
+	}
+	fake.getRunningTournamentsReturnsOnCall[i] = struct {
+		result1 []types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTierHistory(arg1 context.Context, arg2 uuid.UUID) ([]types.TierHistoryEntry, error) {
+	fake.getTierHistoryMutex.Lock()
+	ret, specificReturn := fake.getTierHistoryReturnsOnCall[len(fake.getTierHistoryArgsForCall)]
+	fake.getTierHistoryArgsForCall = append(fake.getTierHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetTierHistoryStub
+	fakeReturns := fake.getTierHistoryReturns
+	fake.recordInvocation("GetTierHistory", []interface{}{arg1, arg2})
+	fake.getTierHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetTierHistoryCallCount() int {
+	fake.getTierHistoryMutex.RLock()
+	defer fake.getTierHistoryMutex.RUnlock()
+	return len(fake.getTierHistoryArgsForCall)
+}
+
+func (fake *FakePersistent) GetTierHistoryCalls(stub func(context.Context, uuid.UUID) ([]types.TierHistoryEntry, error)) {
+	fake.getTierHistoryMutex.Lock()
+	defer fake.getTierHistoryMutex.Unlock()
+	fake.GetTierHistoryStub = stub
+}
+
+func (fake *FakePersistent) GetTierHistoryArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getTierHistoryMutex.RLock()
+	defer fake.getTierHistoryMutex.RUnlock()
+	argsForCall := fake.getTierHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetTierHistoryReturns(result1 []types.TierHistoryEntry, result2 error) {
+	fake.getTierHistoryMutex.Lock()
+	defer fake.getTierHistoryMutex.Unlock()
+	fake.GetTierHistoryStub = nil
+	fake.getTierHistoryReturns = struct {
+		result1 []types.TierHistoryEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTierHistoryReturnsOnCall(i int, result1 []types.TierHistoryEntry, result2 error) {
+	fake.getTierHistoryMutex.Lock()
+	defer fake.getTierHistoryMutex.Unlock()
+	fake.GetTierHistoryStub = nil
+	if fake.getTierHistoryReturnsOnCall == nil {
+		fake.getTierHistoryReturnsOnCall = make(map[int]struct {
+			result1 []types.TierHistoryEntry
+			result2 error
+		})
+	}
+	fake.getTierHistoryReturnsOnCall[i] = struct {
+		result1 []types.TierHistoryEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTiers(arg1 context.Context) ([]types.Tier, error) {
+	fake.getTiersMutex.Lock()
+	ret, specificReturn := fake.getTiersReturnsOnCall[len(fake.getTiersArgsForCall)]
+	fake.getTiersArgsForCall = append(fake.getTiersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetTiersStub
+	fakeReturns := fake.getTiersReturns
+	fake.recordInvocation("GetTiers", []interface{}{arg1})
+	fake.getTiersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetTiersCallCount() int {
+	fake.getTiersMutex.RLock()
+	defer fake.getTiersMutex.RUnlock()
+	return len(fake.getTiersArgsForCall)
+}
+
+func (fake *FakePersistent) GetTiersCalls(stub func(context.Context) ([]types.Tier, error)) {
+	fake.getTiersMutex.Lock()
+	defer fake.getTiersMutex.Unlock()
+	fake.GetTiersStub = stub
+}
+
+func (fake *FakePersistent) GetTiersArgsForCall(i int) context.Context {
+	fake.getTiersMutex.RLock()
+	defer fake.getTiersMutex.RUnlock()
+	argsForCall := fake.getTiersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetTiersReturns(result1 []types.Tier, result2 error) {
+	fake.getTiersMutex.Lock()
+	defer fake.getTiersMutex.Unlock()
+	fake.GetTiersStub = nil
+	fake.getTiersReturns = struct {
+		result1 []types.Tier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTiersReturnsOnCall(i int, result1 []types.Tier, result2 error) {
+	fake.getTiersMutex.Lock()
+	defer fake.getTiersMutex.Unlock()
+	fake.GetTiersStub = nil
+	if fake.getTiersReturnsOnCall == nil {
+		fake.getTiersReturnsOnCall = make(map[int]struct {
+			result1 []types.Tier
+			result2 error
+		})
+	}
+	fake.getTiersReturnsOnCall[i] = struct {
+		result1 []types.Tier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTournamentResults(arg1 context.Context, arg2 uuid.UUID) ([]types.TournamentResult, error) {
+	fake.getTournamentResultsMutex.Lock()
+	ret, specificReturn := fake.getTournamentResultsReturnsOnCall[len(fake.getTournamentResultsArgsForCall)]
+	fake.getTournamentResultsArgsForCall = append(fake.getTournamentResultsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetTournamentResultsStub
+	fakeReturns := fake.getTournamentResultsReturns
+	fake.recordInvocation("GetTournamentResults", []interface{}{arg1, arg2})
+	fake.getTournamentResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetTournamentResultsCallCount() int {
+	fake.getTournamentResultsMutex.RLock()
+	defer fake.getTournamentResultsMutex.RUnlock()
+	return len(fake.getTournamentResultsArgsForCall)
+}
+
+func (fake *FakePersistent) GetTournamentResultsCalls(stub func(context.Context, uuid.UUID) ([]types.TournamentResult, error)) {
+	fake.getTournamentResultsMutex.Lock()
+	defer fake.getTournamentResultsMutex.Unlock()
+	fake.GetTournamentResultsStub = stub
+}
+
+func (fake *FakePersistent) GetTournamentResultsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getTournamentResultsMutex.RLock()
+	defer fake.getTournamentResultsMutex.RUnlock()
+	argsForCall := fake.getTournamentResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetTournamentResultsReturns(result1 []types.TournamentResult, result2 error) {
+	fake.getTournamentResultsMutex.Lock()
+	defer fake.getTournamentResultsMutex.Unlock()
+	fake.GetTournamentResultsStub = nil
+	fake.getTournamentResultsReturns = struct {
+		result1 []types.TournamentResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTournamentResultsReturnsOnCall(i int, result1 []types.TournamentResult, result2 error) {
+	fake.getTournamentResultsMutex.Lock()
+	defer fake.getTournamentResultsMutex.Unlock()
+	fake.GetTournamentResultsStub = nil
+	if fake.getTournamentResultsReturnsOnCall == nil {
+		fake.getTournamentResultsReturnsOnCall = make(map[int]struct {
+			result1 []types.TournamentResult
+			result2 error
+		})
+	}
+	fake.getTournamentResultsReturnsOnCall[i] = struct {
+		result1 []types.TournamentResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTournaments(arg1 context.Context) ([]types.Tournament, error) {
+	fake.getTournamentsMutex.Lock()
+	ret, specificReturn := fake.getTournamentsReturnsOnCall[len(fake.getTournamentsArgsForCall)]
+	fake.getTournamentsArgsForCall = append(fake.getTournamentsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetTournamentsStub
+	fakeReturns := fake.getTournamentsReturns
+	fake.recordInvocation("GetTournaments", []interface{}{arg1})
+	fake.getTournamentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetTournamentsCallCount() int {
+	fake.getTournamentsMutex.RLock()
+	defer fake.getTournamentsMutex.RUnlock()
+	return len(fake.getTournamentsArgsForCall)
+}
+
+func (fake *FakePersistent) GetTournamentsCalls(stub func(context.Context) ([]types.Tournament, error)) {
+	fake.getTournamentsMutex.Lock()
+	defer fake.getTournamentsMutex.Unlock()
+	fake.GetTournamentsStub = stub
+}
+
+func (fake *FakePersistent) GetTournamentsArgsForCall(i int) context.Context {
+	fake.getTournamentsMutex.RLock()
+	defer fake.getTournamentsMutex.RUnlock()
+	argsForCall := fake.getTournamentsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetTournamentsReturns(result1 []types.Tournament, result2 error) {
+	fake.getTournamentsMutex.Lock()
+	defer fake.getTournamentsMutex.Unlock()
+	fake.GetTournamentsStub = nil
+	fake.getTournamentsReturns = struct {
+		result1 []types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetTournamentsReturnsOnCall(i int, result1 []types.Tournament, result2 error) {
+	fake.getTournamentsMutex.Lock()
+	defer fake.getTournamentsMutex.Unlock()
+	fake.GetTournamentsStub = nil
+	if fake.getTournamentsReturnsOnCall == nil {
+		fake.getTournamentsReturnsOnCall = make(map[int]struct {
+			result1 []types.Tournament
+			result2 error
+		})
+	}
+	fake.getTournamentsReturnsOnCall[i] = struct {
+		result1 []types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUnlockedWelcomeSteps(arg1 context.Context, arg2 int) ([]types.UnlockedWelcomeStep, error) {
+	fake.getUnlockedWelcomeStepsMutex.Lock()
+	ret, specificReturn := fake.getUnlockedWelcomeStepsReturnsOnCall[len(fake.getUnlockedWelcomeStepsArgsForCall)]
+	fake.getUnlockedWelcomeStepsArgsForCall = append(fake.getUnlockedWelcomeStepsArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.GetUnlockedWelcomeStepsStub
+	fakeReturns := fake.getUnlockedWelcomeStepsReturns
+	fake.recordInvocation("GetUnlockedWelcomeSteps", []interface{}{arg1, arg2})
+	fake.getUnlockedWelcomeStepsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUnlockedWelcomeStepsCallCount() int {
+	fake.getUnlockedWelcomeStepsMutex.RLock()
+	defer fake.getUnlockedWelcomeStepsMutex.RUnlock()
+	return len(fake.getUnlockedWelcomeStepsArgsForCall)
+}
+
+func (fake *FakePersistent) GetUnlockedWelcomeStepsCalls(stub func(context.Context, int) ([]types.UnlockedWelcomeStep, error)) {
+	fake.getUnlockedWelcomeStepsMutex.Lock()
+	defer fake.getUnlockedWelcomeStepsMutex.Unlock()
+	fake.GetUnlockedWelcomeStepsStub = stub
+}
+
+func (fake *FakePersistent) GetUnlockedWelcomeStepsArgsForCall(i int) (context.Context, int) {
+	fake.getUnlockedWelcomeStepsMutex.RLock()
+	defer fake.getUnlockedWelcomeStepsMutex.RUnlock()
+	argsForCall := fake.getUnlockedWelcomeStepsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetUnlockedWelcomeStepsReturns(result1 []types.UnlockedWelcomeStep, result2 error) {
+	fake.getUnlockedWelcomeStepsMutex.Lock()
+	defer fake.getUnlockedWelcomeStepsMutex.Unlock()
+	fake.GetUnlockedWelcomeStepsStub = nil
+	fake.getUnlockedWelcomeStepsReturns = struct {
+		result1 []types.UnlockedWelcomeStep
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUnlockedWelcomeStepsReturnsOnCall(i int, result1 []types.UnlockedWelcomeStep, result2 error) {
+	fake.getUnlockedWelcomeStepsMutex.Lock()
+	defer fake.getUnlockedWelcomeStepsMutex.Unlock()
+	fake.GetUnlockedWelcomeStepsStub = nil
+	if fake.getUnlockedWelcomeStepsReturnsOnCall == nil {
+		fake.getUnlockedWelcomeStepsReturnsOnCall = make(map[int]struct {
+			result1 []types.UnlockedWelcomeStep
+			result2 error
+		})
+	}
+	fake.getUnlockedWelcomeStepsReturnsOnCall[i] = struct {
+		result1 []types.UnlockedWelcomeStep
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUnmatchedDeposit(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time, arg4 types.Money) (types.LedgerEntry, error) {
+	fake.getUnmatchedDepositMutex.Lock()
+	ret, specificReturn := fake.getUnmatchedDepositReturnsOnCall[len(fake.getUnmatchedDepositArgsForCall)]
+	fake.getUnmatchedDepositArgsForCall = append(fake.getUnmatchedDepositArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+		arg4 types.Money
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetUnmatchedDepositStub
+	fakeReturns := fake.getUnmatchedDepositReturns
+	fake.recordInvocation("GetUnmatchedDeposit", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getUnmatchedDepositMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUnmatchedDepositCallCount() int {
+	fake.getUnmatchedDepositMutex.RLock()
+	defer fake.getUnmatchedDepositMutex.RUnlock()
+	return len(fake.getUnmatchedDepositArgsForCall)
+}
+
+func (fake *FakePersistent) GetUnmatchedDepositCalls(stub func(context.Context, uuid.UUID, time.Time, types.Money) (types.LedgerEntry, error)) {
+	fake.getUnmatchedDepositMutex.Lock()
+	defer fake.getUnmatchedDepositMutex.Unlock()
+	fake.GetUnmatchedDepositStub = stub
+}
+
+func (fake *FakePersistent) GetUnmatchedDepositArgsForCall(i int) (context.Context, uuid.UUID, time.Time, types.Money) {
+	fake.getUnmatchedDepositMutex.RLock()
+	defer fake.getUnmatchedDepositMutex.RUnlock()
+	argsForCall := fake.getUnmatchedDepositArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) GetUnmatchedDepositReturns(result1 types.LedgerEntry, result2 error) {
+	fake.getUnmatchedDepositMutex.Lock()
+	defer fake.getUnmatchedDepositMutex.Unlock()
+	fake.GetUnmatchedDepositStub = nil
+	fake.getUnmatchedDepositReturns = struct {
+		result1 types.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUnmatchedDepositReturnsOnCall(i int, result1 types.LedgerEntry, result2 error) {
+	fake.getUnmatchedDepositMutex.Lock()
+	defer fake.getUnmatchedDepositMutex.Unlock()
+	fake.GetUnmatchedDepositStub = nil
+	if fake.getUnmatchedDepositReturnsOnCall == nil {
+		fake.getUnmatchedDepositReturnsOnCall = make(map[int]struct {
+			result1 types.LedgerEntry
+			result2 error
+		})
+	}
+	fake.getUnmatchedDepositReturnsOnCall[i] = struct {
+		result1 types.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUnpaidTournamentResults(arg1 context.Context) ([]types.TournamentResult, error) {
+	fake.getUnpaidTournamentResultsMutex.Lock()
+	ret, specificReturn := fake.getUnpaidTournamentResultsReturnsOnCall[len(fake.getUnpaidTournamentResultsArgsForCall)]
+	fake.getUnpaidTournamentResultsArgsForCall = append(fake.getUnpaidTournamentResultsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetUnpaidTournamentResultsStub
+	fakeReturns := fake.getUnpaidTournamentResultsReturns
+	fake.recordInvocation("GetUnpaidTournamentResults", []interface{}{arg1})
+	fake.getUnpaidTournamentResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUnpaidTournamentResultsCallCount() int {
+	fake.getUnpaidTournamentResultsMutex.RLock()
+	defer fake.getUnpaidTournamentResultsMutex.RUnlock()
+	return len(fake.getUnpaidTournamentResultsArgsForCall)
+}
+
+func (fake *FakePersistent) GetUnpaidTournamentResultsCalls(stub func(context.Context) ([]types.TournamentResult, error)) {
+	fake.getUnpaidTournamentResultsMutex.Lock()
+	defer fake.getUnpaidTournamentResultsMutex.Unlock()
+	fake.GetUnpaidTournamentResultsStub = stub
+}
+
+func (fake *FakePersistent) GetUnpaidTournamentResultsArgsForCall(i int) context.Context {
+	fake.getUnpaidTournamentResultsMutex.RLock()
+	defer fake.getUnpaidTournamentResultsMutex.RUnlock()
+	argsForCall := fake.getUnpaidTournamentResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetUnpaidTournamentResultsReturns(result1 []types.TournamentResult, result2 error) {
+	fake.getUnpaidTournamentResultsMutex.Lock()
+	defer fake.getUnpaidTournamentResultsMutex.Unlock()
+	fake.GetUnpaidTournamentResultsStub = nil
+	fake.getUnpaidTournamentResultsReturns = struct {
+		result1 []types.TournamentResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUnpaidTournamentResultsReturnsOnCall(i int, result1 []types.TournamentResult, result2 error) {
+	fake.getUnpaidTournamentResultsMutex.Lock()
+	defer fake.getUnpaidTournamentResultsMutex.Unlock()
+	fake.GetUnpaidTournamentResultsStub = nil
+	if fake.getUnpaidTournamentResultsReturnsOnCall == nil {
+		fake.getUnpaidTournamentResultsReturnsOnCall = make(map[int]struct {
+			result1 []types.TournamentResult
+			result2 error
+		})
+	}
+	fake.getUnpaidTournamentResultsReturnsOnCall[i] = struct {
+		result1 []types.TournamentResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUserPromotionByID(arg1 context.Context, arg2 uuid.UUID) (types.UserPromotion, error) {
+	fake.getUserPromotionByIDMutex.Lock()
+	ret, specificReturn := fake.getUserPromotionByIDReturnsOnCall[len(fake.getUserPromotionByIDArgsForCall)]
+	fake.getUserPromotionByIDArgsForCall = append(fake.getUserPromotionByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserPromotionByIDStub
+	fakeReturns := fake.getUserPromotionByIDReturns
+	fake.recordInvocation("GetUserPromotionByID", []interface{}{arg1, arg2})
+	fake.getUserPromotionByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUserPromotionByIDCallCount() int {
+	fake.getUserPromotionByIDMutex.RLock()
+	defer fake.getUserPromotionByIDMutex.RUnlock()
+	return len(fake.getUserPromotionByIDArgsForCall)
+}
+
+func (fake *FakePersistent) GetUserPromotionByIDCalls(stub func(context.Context, uuid.UUID) (types.UserPromotion, error)) {
+	fake.getUserPromotionByIDMutex.Lock()
+	defer fake.getUserPromotionByIDMutex.Unlock()
+	fake.GetUserPromotionByIDStub = stub
+}
+
+func (fake *FakePersistent) GetUserPromotionByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserPromotionByIDMutex.RLock()
+	defer fake.getUserPromotionByIDMutex.RUnlock()
+	argsForCall := fake.getUserPromotionByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetUserPromotionByIDReturns(result1 types.UserPromotion, result2 error) {
+	fake.getUserPromotionByIDMutex.Lock()
+	defer fake.getUserPromotionByIDMutex.Unlock()
+	fake.GetUserPromotionByIDStub = nil
+	fake.getUserPromotionByIDReturns = struct {
+		result1 types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUserPromotionByIDReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
+	fake.getUserPromotionByIDMutex.Lock()
+	defer fake.getUserPromotionByIDMutex.Unlock()
+	fake.GetUserPromotionByIDStub = nil
+	if fake.getUserPromotionByIDReturnsOnCall == nil {
+		fake.getUserPromotionByIDReturnsOnCall = make(map[int]struct {
+			result1 types.UserPromotion
+			result2 error
+		})
+	}
+	fake.getUserPromotionByIDReturnsOnCall[i] = struct {
+		result1 types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUserPromotionRevocations(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotionRevocation, error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	ret, specificReturn := fake.getUserPromotionRevocationsReturnsOnCall[len(fake.getUserPromotionRevocationsArgsForCall)]
+	fake.getUserPromotionRevocationsArgsForCall = append(fake.getUserPromotionRevocationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserPromotionRevocationsStub
+	fakeReturns := fake.getUserPromotionRevocationsReturns
+	fake.recordInvocation("GetUserPromotionRevocations", []interface{}{arg1, arg2})
+	fake.getUserPromotionRevocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUserPromotionRevocationsCallCount() int {
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
+	return len(fake.getUserPromotionRevocationsArgsForCall)
+}
+
+func (fake *FakePersistent) GetUserPromotionRevocationsCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotionRevocation, error)) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = stub
+}
+
+func (fake *FakePersistent) GetUserPromotionRevocationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
+	argsForCall := fake.getUserPromotionRevocationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) GetUserPromotionRevocationsReturns(result1 []types.UserPromotionRevocation, result2 error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = nil
+	fake.getUserPromotionRevocationsReturns = struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUserPromotionRevocationsReturnsOnCall(i int, result1 []types.UserPromotionRevocation, result2 error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = nil
+	if fake.getUserPromotionRevocationsReturnsOnCall == nil {
+		fake.getUserPromotionRevocationsReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotionRevocation
+			result2 error
+		})
+	}
+	fake.getUserPromotionRevocationsReturnsOnCall[i] = struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUserPromotions(arg1 context.Context, arg2 uuid.UUID, arg3 types.UserPromotionFilter) ([]types.UserPromotion, error) {
+	fake.getUserPromotionsMutex.Lock()
+	ret, specificReturn := fake.getUserPromotionsReturnsOnCall[len(fake.getUserPromotionsArgsForCall)]
+	fake.getUserPromotionsArgsForCall = append(fake.getUserPromotionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.UserPromotionFilter
+	}{arg1, arg2, arg3})
+	stub := fake.GetUserPromotionsStub
+	fakeReturns := fake.getUserPromotionsReturns
+	fake.recordInvocation("GetUserPromotions", []interface{}{arg1, arg2, arg3})
+	fake.getUserPromotionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUserPromotionsCallCount() int {
+	fake.getUserPromotionsMutex.RLock()
+	defer fake.getUserPromotionsMutex.RUnlock()
+	return len(fake.getUserPromotionsArgsForCall)
+}
+
+func (fake *FakePersistent) GetUserPromotionsCalls(stub func(context.Context, uuid.UUID, types.UserPromotionFilter) ([]types.UserPromotion, error)) {
+	fake.getUserPromotionsMutex.Lock()
+	defer fake.getUserPromotionsMutex.Unlock()
+	fake.GetUserPromotionsStub = stub
+}
+
+func (fake *FakePersistent) GetUserPromotionsArgsForCall(i int) (context.Context, uuid.UUID, types.UserPromotionFilter) {
+	fake.getUserPromotionsMutex.RLock()
+	defer fake.getUserPromotionsMutex.RUnlock()
+	argsForCall := fake.getUserPromotionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) GetUserPromotionsReturns(result1 []types.UserPromotion, result2 error) {
+	fake.getUserPromotionsMutex.Lock()
+	defer fake.getUserPromotionsMutex.Unlock()
+	fake.GetUserPromotionsStub = nil
+	fake.getUserPromotionsReturns = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUserPromotionsReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
+	fake.getUserPromotionsMutex.Lock()
+	defer fake.getUserPromotionsMutex.Unlock()
+	fake.GetUserPromotionsStub = nil
+	if fake.getUserPromotionsReturnsOnCall == nil {
+		fake.getUserPromotionsReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotion
+			result2 error
+		})
+	}
+	fake.getUserPromotionsReturnsOnCall[i] = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUsers(arg1 context.Context) ([]types.User, error) {
+	fake.getUsersMutex.Lock()
+	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetUsersStub
+	fakeReturns := fake.getUsersReturns
+	fake.recordInvocation("GetUsers", []interface{}{arg1})
+	fake.getUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakePersistent) GetUsersCalls(stub func(context.Context) ([]types.User, error)) {
+	fake.getUsersMutex.Lock()
+	defer fake.getUsersMutex.Unlock()
+	fake.GetUsersStub = stub
+}
+
+func (fake *FakePersistent) GetUsersArgsForCall(i int) context.Context {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	argsForCall := fake.getUsersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetUsersReturns(result1 []types.User, result2 error) {
+	fake.getUsersMutex.Lock()
+	defer fake.getUsersMutex.Unlock()
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 []types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetUsersReturnsOnCall(i int, result1 []types.User, result2 error) {
+	fake.getUsersMutex.Lock()
+	defer fake.getUsersMutex.Unlock()
+	fake.GetUsersStub = nil
+	if fake.getUsersReturnsOnCall == nil {
+		fake.getUsersReturnsOnCall = make(map[int]struct {
+			result1 []types.User
+			result2 error
+		})
+	}
+	fake.getUsersReturnsOnCall[i] = struct {
+		result1 []types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetWelcomePackages(arg1 context.Context) ([]types.WelcomePackage, error) {
+	fake.getWelcomePackagesMutex.Lock()
+	ret, specificReturn := fake.getWelcomePackagesReturnsOnCall[len(fake.getWelcomePackagesArgsForCall)]
+	fake.getWelcomePackagesArgsForCall = append(fake.getWelcomePackagesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetWelcomePackagesStub
+	fakeReturns := fake.getWelcomePackagesReturns
+	fake.recordInvocation("GetWelcomePackages", []interface{}{arg1})
+	fake.getWelcomePackagesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) GetWelcomePackagesCallCount() int {
+	fake.getWelcomePackagesMutex.RLock()
+	defer fake.getWelcomePackagesMutex.RUnlock()
+	return len(fake.getWelcomePackagesArgsForCall)
+}
+
+func (fake *FakePersistent) GetWelcomePackagesCalls(stub func(context.Context) ([]types.WelcomePackage, error)) {
+	fake.getWelcomePackagesMutex.Lock()
+	defer fake.getWelcomePackagesMutex.Unlock()
+	fake.GetWelcomePackagesStub = stub
+}
+
+func (fake *FakePersistent) GetWelcomePackagesArgsForCall(i int) context.Context {
+	fake.getWelcomePackagesMutex.RLock()
+	defer fake.getWelcomePackagesMutex.RUnlock()
+	argsForCall := fake.getWelcomePackagesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) GetWelcomePackagesReturns(result1 []types.WelcomePackage, result2 error) {
+	fake.getWelcomePackagesMutex.Lock()
+	defer fake.getWelcomePackagesMutex.Unlock()
+	fake.GetWelcomePackagesStub = nil
+	fake.getWelcomePackagesReturns = struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) GetWelcomePackagesReturnsOnCall(i int, result1 []types.WelcomePackage, result2 error) {
+	fake.getWelcomePackagesMutex.Lock()
+	defer fake.getWelcomePackagesMutex.Unlock()
+	fake.GetWelcomePackagesStub = nil
+	if fake.getWelcomePackagesReturnsOnCall == nil {
+		fake.getWelcomePackagesReturnsOnCall = make(map[int]struct {
+			result1 []types.WelcomePackage
+			result2 error
+		})
+	}
+	fake.getWelcomePackagesReturnsOnCall[i] = struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) IdempotencyKeyComplete(arg1 context.Context, arg2 types.IdempotencyKey) error {
+	fake.idempotencyKeyCompleteMutex.Lock()
+	ret, specificReturn := fake.idempotencyKeyCompleteReturnsOnCall[len(fake.idempotencyKeyCompleteArgsForCall)]
+	fake.idempotencyKeyCompleteArgsForCall = append(fake.idempotencyKeyCompleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.IdempotencyKey
+	}{arg1, arg2})
+	stub := fake.IdempotencyKeyCompleteStub
+	fakeReturns := fake.idempotencyKeyCompleteReturns
+	fake.recordInvocation("IdempotencyKeyComplete", []interface{}{arg1, arg2})
+	fake.idempotencyKeyCompleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) IdempotencyKeyCompleteCallCount() int {
+	fake.idempotencyKeyCompleteMutex.RLock()
+	defer fake.idempotencyKeyCompleteMutex.RUnlock()
+	return len(fake.idempotencyKeyCompleteArgsForCall)
+}
+
+func (fake *FakePersistent) IdempotencyKeyCompleteCalls(stub func(context.Context, types.IdempotencyKey) error) {
+	fake.idempotencyKeyCompleteMutex.Lock()
+	defer fake.idempotencyKeyCompleteMutex.Unlock()
+	fake.IdempotencyKeyCompleteStub = stub
+}
+
+func (fake *FakePersistent) IdempotencyKeyCompleteArgsForCall(i int) (context.Context, types.IdempotencyKey) {
+	fake.idempotencyKeyCompleteMutex.RLock()
+	defer fake.idempotencyKeyCompleteMutex.RUnlock()
+	argsForCall := fake.idempotencyKeyCompleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) IdempotencyKeyCompleteReturns(result1 error) {
+	fake.idempotencyKeyCompleteMutex.Lock()
+	defer fake.idempotencyKeyCompleteMutex.Unlock()
+	fake.IdempotencyKeyCompleteStub = nil
+	fake.idempotencyKeyCompleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) IdempotencyKeyCompleteReturnsOnCall(i int, result1 error) {
+	fake.idempotencyKeyCompleteMutex.Lock()
+	defer fake.idempotencyKeyCompleteMutex.Unlock()
+	fake.IdempotencyKeyCompleteStub = nil
+	if fake.idempotencyKeyCompleteReturnsOnCall == nil {
+		fake.idempotencyKeyCompleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.idempotencyKeyCompleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) IdempotencyKeyCreate(arg1 context.Context, arg2 types.IdempotencyKey) (bool, error) {
+	fake.idempotencyKeyCreateMutex.Lock()
+	ret, specificReturn := fake.idempotencyKeyCreateReturnsOnCall[len(fake.idempotencyKeyCreateArgsForCall)]
+	fake.idempotencyKeyCreateArgsForCall = append(fake.idempotencyKeyCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.IdempotencyKey
+	}{arg1, arg2})
+	stub := fake.IdempotencyKeyCreateStub
+	fakeReturns := fake.idempotencyKeyCreateReturns
+	fake.recordInvocation("IdempotencyKeyCreate", []interface{}{arg1, arg2})
+	fake.idempotencyKeyCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) IdempotencyKeyCreateCallCount() int {
+	fake.idempotencyKeyCreateMutex.RLock()
+	defer fake.idempotencyKeyCreateMutex.RUnlock()
+	return len(fake.idempotencyKeyCreateArgsForCall)
+}
+
+func (fake *FakePersistent) IdempotencyKeyCreateCalls(stub func(context.Context, types.IdempotencyKey) (bool, error)) {
+	fake.idempotencyKeyCreateMutex.Lock()
+	defer fake.idempotencyKeyCreateMutex.Unlock()
+	fake.IdempotencyKeyCreateStub = stub
+}
+
+func (fake *FakePersistent) IdempotencyKeyCreateArgsForCall(i int) (context.Context, types.IdempotencyKey) {
+	fake.idempotencyKeyCreateMutex.RLock()
+	defer fake.idempotencyKeyCreateMutex.RUnlock()
+	argsForCall := fake.idempotencyKeyCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) IdempotencyKeyCreateReturns(result1 bool, result2 error) {
+	fake.idempotencyKeyCreateMutex.Lock()
+	defer fake.idempotencyKeyCreateMutex.Unlock()
+	fake.IdempotencyKeyCreateStub = nil
+	fake.idempotencyKeyCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) IdempotencyKeyCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.idempotencyKeyCreateMutex.Lock()
+	defer fake.idempotencyKeyCreateMutex.Unlock()
+	fake.IdempotencyKeyCreateStub = nil
+	if fake.idempotencyKeyCreateReturnsOnCall == nil {
+		fake.idempotencyKeyCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.idempotencyKeyCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) IdempotencyKeyDelete(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.idempotencyKeyDeleteMutex.Lock()
+	ret, specificReturn := fake.idempotencyKeyDeleteReturnsOnCall[len(fake.idempotencyKeyDeleteArgsForCall)]
+	fake.idempotencyKeyDeleteArgsForCall = append(fake.idempotencyKeyDeleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.IdempotencyKeyDeleteStub
+	fakeReturns := fake.idempotencyKeyDeleteReturns
+	fake.recordInvocation("IdempotencyKeyDelete", []interface{}{arg1, arg2, arg3})
+	fake.idempotencyKeyDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) IdempotencyKeyDeleteCallCount() int {
+	fake.idempotencyKeyDeleteMutex.RLock()
+	defer fake.idempotencyKeyDeleteMutex.RUnlock()
+	return len(fake.idempotencyKeyDeleteArgsForCall)
+}
+
+func (fake *FakePersistent) IdempotencyKeyDeleteCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.idempotencyKeyDeleteMutex.Lock()
+	defer fake.idempotencyKeyDeleteMutex.Unlock()
+	fake.IdempotencyKeyDeleteStub = stub
+}
+
+func (fake *FakePersistent) IdempotencyKeyDeleteArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.idempotencyKeyDeleteMutex.RLock()
+	defer fake.idempotencyKeyDeleteMutex.RUnlock()
+	argsForCall := fake.idempotencyKeyDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) IdempotencyKeyDeleteReturns(result1 error) {
+	fake.idempotencyKeyDeleteMutex.Lock()
+	defer fake.idempotencyKeyDeleteMutex.Unlock()
+	fake.IdempotencyKeyDeleteStub = nil
+	fake.idempotencyKeyDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) IdempotencyKeyDeleteReturnsOnCall(i int, result1 error) {
+	fake.idempotencyKeyDeleteMutex.Lock()
+	defer fake.idempotencyKeyDeleteMutex.Unlock()
+	fake.IdempotencyKeyDeleteStub = nil
+	if fake.idempotencyKeyDeleteReturnsOnCall == nil {
+		fake.idempotencyKeyDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.idempotencyKeyDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) IdempotencyKeyGet(arg1 context.Context, arg2 uuid.UUID, arg3 string) (types.IdempotencyKey, error) {
+	fake.idempotencyKeyGetMutex.Lock()
+	ret, specificReturn := fake.idempotencyKeyGetReturnsOnCall[len(fake.idempotencyKeyGetArgsForCall)]
+	fake.idempotencyKeyGetArgsForCall = append(fake.idempotencyKeyGetArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.IdempotencyKeyGetStub
+	fakeReturns := fake.idempotencyKeyGetReturns
+	fake.recordInvocation("IdempotencyKeyGet", []interface{}{arg1, arg2, arg3})
+	fake.idempotencyKeyGetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) IdempotencyKeyGetCallCount() int {
+	fake.idempotencyKeyGetMutex.RLock()
+	defer fake.idempotencyKeyGetMutex.RUnlock()
+	return len(fake.idempotencyKeyGetArgsForCall)
+}
+
+func (fake *FakePersistent) IdempotencyKeyGetCalls(stub func(context.Context, uuid.UUID, string) (types.IdempotencyKey, error)) {
+	fake.idempotencyKeyGetMutex.Lock()
+	defer fake.idempotencyKeyGetMutex.Unlock()
+	fake.IdempotencyKeyGetStub = stub
+}
+
+func (fake *FakePersistent) IdempotencyKeyGetArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.idempotencyKeyGetMutex.RLock()
+	defer fake.idempotencyKeyGetMutex.RUnlock()
+	argsForCall := fake.idempotencyKeyGetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) IdempotencyKeyGetReturns(result1 types.IdempotencyKey, result2 error) {
+	fake.idempotencyKeyGetMutex.Lock()
+	defer fake.idempotencyKeyGetMutex.Unlock()
+	fake.IdempotencyKeyGetStub = nil
+	fake.idempotencyKeyGetReturns = struct {
+		result1 types.IdempotencyKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) IdempotencyKeyGetReturnsOnCall(i int, result1 types.IdempotencyKey, result2 error) {
+	fake.idempotencyKeyGetMutex.Lock()
+	defer fake.idempotencyKeyGetMutex.Unlock()
+	fake.IdempotencyKeyGetStub = nil
+	if fake.idempotencyKeyGetReturnsOnCall == nil {
+		fake.idempotencyKeyGetReturnsOnCall = make(map[int]struct {
+			result1 types.IdempotencyKey
+			result2 error
+		})
+	}
+	fake.idempotencyKeyGetReturnsOnCall[i] = struct {
+		result1 types.IdempotencyKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) LedgerEntriesGet(arg1 context.Context, arg2 types.LedgerFilter) ([]types.LedgerEntry, error) {
+	fake.ledgerEntriesGetMutex.Lock()
+	ret, specificReturn := fake.ledgerEntriesGetReturnsOnCall[len(fake.ledgerEntriesGetArgsForCall)]
+	fake.ledgerEntriesGetArgsForCall = append(fake.ledgerEntriesGetArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.LedgerFilter
+	}{arg1, arg2})
+	stub := fake.LedgerEntriesGetStub
+	fakeReturns := fake.ledgerEntriesGetReturns
+	fake.recordInvocation("LedgerEntriesGet", []interface{}{arg1, arg2})
+	fake.ledgerEntriesGetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) LedgerEntriesGetCallCount() int {
+	fake.ledgerEntriesGetMutex.RLock()
+	defer fake.ledgerEntriesGetMutex.RUnlock()
+	return len(fake.ledgerEntriesGetArgsForCall)
+}
+
+func (fake *FakePersistent) LedgerEntriesGetCalls(stub func(context.Context, types.LedgerFilter) ([]types.LedgerEntry, error)) {
+	fake.ledgerEntriesGetMutex.Lock()
+	defer fake.ledgerEntriesGetMutex.Unlock()
+	fake.LedgerEntriesGetStub = stub
+}
+
+func (fake *FakePersistent) LedgerEntriesGetArgsForCall(i int) (context.Context, types.LedgerFilter) {
+	fake.ledgerEntriesGetMutex.RLock()
+	defer fake.ledgerEntriesGetMutex.RUnlock()
+	argsForCall := fake.ledgerEntriesGetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) LedgerEntriesGetReturns(result1 []types.LedgerEntry, result2 error) {
+	fake.ledgerEntriesGetMutex.Lock()
+	defer fake.ledgerEntriesGetMutex.Unlock()
+	fake.LedgerEntriesGetStub = nil
+	fake.ledgerEntriesGetReturns = struct {
+		result1 []types.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) LedgerEntriesGetReturnsOnCall(i int, result1 []types.LedgerEntry, result2 error) {
+	fake.ledgerEntriesGetMutex.Lock()
+	defer fake.ledgerEntriesGetMutex.Unlock()
+	fake.LedgerEntriesGetStub = nil
+	if fake.ledgerEntriesGetReturnsOnCall == nil {
+		fake.ledgerEntriesGetReturnsOnCall = make(map[int]struct {
+			result1 []types.LedgerEntry
+			result2 error
+		})
+	}
+	fake.ledgerEntriesGetReturnsOnCall[i] = struct {
+		result1 []types.LedgerEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsEntryCreate(arg1 context.Context, arg2 types.PointsEntry) (bool, error) {
+	fake.pointsEntryCreateMutex.Lock()
+	ret, specificReturn := fake.pointsEntryCreateReturnsOnCall[len(fake.pointsEntryCreateArgsForCall)]
+	fake.pointsEntryCreateArgsForCall = append(fake.pointsEntryCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PointsEntry
+	}{arg1, arg2})
+	stub := fake.PointsEntryCreateStub
+	fakeReturns := fake.pointsEntryCreateReturns
+	fake.recordInvocation("PointsEntryCreate", []interface{}{arg1, arg2})
+	fake.pointsEntryCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PointsEntryCreateCallCount() int {
+	fake.pointsEntryCreateMutex.RLock()
+	defer fake.pointsEntryCreateMutex.RUnlock()
+	return len(fake.pointsEntryCreateArgsForCall)
+}
+
+func (fake *FakePersistent) PointsEntryCreateCalls(stub func(context.Context, types.PointsEntry) (bool, error)) {
+	fake.pointsEntryCreateMutex.Lock()
+	defer fake.pointsEntryCreateMutex.Unlock()
+	fake.PointsEntryCreateStub = stub
+}
+
+func (fake *FakePersistent) PointsEntryCreateArgsForCall(i int) (context.Context, types.PointsEntry) {
+	fake.pointsEntryCreateMutex.RLock()
+	defer fake.pointsEntryCreateMutex.RUnlock()
+	argsForCall := fake.pointsEntryCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PointsEntryCreateReturns(result1 bool, result2 error) {
+	fake.pointsEntryCreateMutex.Lock()
+	defer fake.pointsEntryCreateMutex.Unlock()
+	fake.PointsEntryCreateStub = nil
+	fake.pointsEntryCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsEntryCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pointsEntryCreateMutex.Lock()
+	defer fake.pointsEntryCreateMutex.Unlock()
+	fake.PointsEntryCreateStub = nil
+	if fake.pointsEntryCreateReturnsOnCall == nil {
+		fake.pointsEntryCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pointsEntryCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsEntryGet(arg1 context.Context, arg2 types.PointsSource, arg3 uuid.UUID) (types.PointsEntry, error) {
+	fake.pointsEntryGetMutex.Lock()
+	ret, specificReturn := fake.pointsEntryGetReturnsOnCall[len(fake.pointsEntryGetArgsForCall)]
+	fake.pointsEntryGetArgsForCall = append(fake.pointsEntryGetArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PointsSource
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.PointsEntryGetStub
+	fakeReturns := fake.pointsEntryGetReturns
+	fake.recordInvocation("PointsEntryGet", []interface{}{arg1, arg2, arg3})
+	fake.pointsEntryGetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PointsEntryGetCallCount() int {
+	fake.pointsEntryGetMutex.RLock()
+	defer fake.pointsEntryGetMutex.RUnlock()
+	return len(fake.pointsEntryGetArgsForCall)
+}
+
+func (fake *FakePersistent) PointsEntryGetCalls(stub func(context.Context, types.PointsSource, uuid.UUID) (types.PointsEntry, error)) {
+	fake.pointsEntryGetMutex.Lock()
+	defer fake.pointsEntryGetMutex.Unlock()
+	fake.PointsEntryGetStub = stub
+}
+
+func (fake *FakePersistent) PointsEntryGetArgsForCall(i int) (context.Context, types.PointsSource, uuid.UUID) {
+	fake.pointsEntryGetMutex.RLock()
+	defer fake.pointsEntryGetMutex.RUnlock()
+	argsForCall := fake.pointsEntryGetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) PointsEntryGetReturns(result1 types.PointsEntry, result2 error) {
+	fake.pointsEntryGetMutex.Lock()
+	defer fake.pointsEntryGetMutex.Unlock()
+	fake.PointsEntryGetStub = nil
+	fake.pointsEntryGetReturns = struct {
+		result1 types.PointsEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsEntryGetReturnsOnCall(i int, result1 types.PointsEntry, result2 error) {
+	fake.pointsEntryGetMutex.Lock()
+	defer fake.pointsEntryGetMutex.Unlock()
+	fake.PointsEntryGetStub = nil
+	if fake.pointsEntryGetReturnsOnCall == nil {
+		fake.pointsEntryGetReturnsOnCall = make(map[int]struct {
+			result1 types.PointsEntry
+			result2 error
+		})
+	}
+	fake.pointsEntryGetReturnsOnCall[i] = struct {
+		result1 types.PointsEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsLotsConsume(arg1 context.Context, arg2 uuid.UUID, arg3 decimal.Decimal) error {
+	fake.pointsLotsConsumeMutex.Lock()
+	ret, specificReturn := fake.pointsLotsConsumeReturnsOnCall[len(fake.pointsLotsConsumeArgsForCall)]
+	fake.pointsLotsConsumeArgsForCall = append(fake.pointsLotsConsumeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 decimal.Decimal
+	}{arg1, arg2, arg3})
+	stub := fake.PointsLotsConsumeStub
+	fakeReturns := fake.pointsLotsConsumeReturns
+	fake.recordInvocation("PointsLotsConsume", []interface{}{arg1, arg2, arg3})
+	fake.pointsLotsConsumeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) PointsLotsConsumeCallCount() int {
+	fake.pointsLotsConsumeMutex.RLock()
+	defer fake.pointsLotsConsumeMutex.RUnlock()
+	return len(fake.pointsLotsConsumeArgsForCall)
+}
+
+func (fake *FakePersistent) PointsLotsConsumeCalls(stub func(context.Context, uuid.UUID, decimal.Decimal) error) {
+	fake.pointsLotsConsumeMutex.Lock()
+	defer fake.pointsLotsConsumeMutex.Unlock()
+	fake.PointsLotsConsumeStub = stub
+}
+
+func (fake *FakePersistent) PointsLotsConsumeArgsForCall(i int) (context.Context, uuid.UUID, decimal.Decimal) {
+	fake.pointsLotsConsumeMutex.RLock()
+	defer fake.pointsLotsConsumeMutex.RUnlock()
+	argsForCall := fake.pointsLotsConsumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) PointsLotsConsumeReturns(result1 error) {
+	fake.pointsLotsConsumeMutex.Lock()
+	defer fake.pointsLotsConsumeMutex.Unlock()
+	fake.PointsLotsConsumeStub = nil
+	fake.pointsLotsConsumeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PointsLotsConsumeReturnsOnCall(i int, result1 error) {
+	fake.pointsLotsConsumeMutex.Lock()
+	defer fake.pointsLotsConsumeMutex.Unlock()
+	fake.PointsLotsConsumeStub = nil
+	if fake.pointsLotsConsumeReturnsOnCall == nil {
+		fake.pointsLotsConsumeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pointsLotsConsumeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PointsLotsExpire(arg1 context.Context, arg2 time.Time, arg3 int) ([]types.PointsExpiryNotice, error) {
+	fake.pointsLotsExpireMutex.Lock()
+	ret, specificReturn := fake.pointsLotsExpireReturnsOnCall[len(fake.pointsLotsExpireArgsForCall)]
+	fake.pointsLotsExpireArgsForCall = append(fake.pointsLotsExpireArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.PointsLotsExpireStub
+	fakeReturns := fake.pointsLotsExpireReturns
+	fake.recordInvocation("PointsLotsExpire", []interface{}{arg1, arg2, arg3})
+	fake.pointsLotsExpireMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PointsLotsExpireCallCount() int {
+	fake.pointsLotsExpireMutex.RLock()
+	defer fake.pointsLotsExpireMutex.RUnlock()
+	return len(fake.pointsLotsExpireArgsForCall)
+}
+
+func (fake *FakePersistent) PointsLotsExpireCalls(stub func(context.Context, time.Time, int) ([]types.PointsExpiryNotice, error)) {
+	fake.pointsLotsExpireMutex.Lock()
+	defer fake.pointsLotsExpireMutex.Unlock()
+	fake.PointsLotsExpireStub = stub
+}
+
+func (fake *FakePersistent) PointsLotsExpireArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.pointsLotsExpireMutex.RLock()
+	defer fake.pointsLotsExpireMutex.RUnlock()
+	argsForCall := fake.pointsLotsExpireArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) PointsLotsExpireReturns(result1 []types.PointsExpiryNotice, result2 error) {
+	fake.pointsLotsExpireMutex.Lock()
+	defer fake.pointsLotsExpireMutex.Unlock()
+	fake.PointsLotsExpireStub = nil
+	fake.pointsLotsExpireReturns = struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsLotsExpireReturnsOnCall(i int, result1 []types.PointsExpiryNotice, result2 error) {
+	fake.pointsLotsExpireMutex.Lock()
+	defer fake.pointsLotsExpireMutex.Unlock()
+	fake.PointsLotsExpireStub = nil
+	if fake.pointsLotsExpireReturnsOnCall == nil {
+		fake.pointsLotsExpireReturnsOnCall = make(map[int]struct {
+			result1 []types.PointsExpiryNotice
+			result2 error
+		})
+	}
+	fake.pointsLotsExpireReturnsOnCall[i] = struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsLotsWarn(arg1 context.Context, arg2 time.Time, arg3 time.Time) ([]types.PointsExpiryNotice, error) {
+	fake.pointsLotsWarnMutex.Lock()
+	ret, specificReturn := fake.pointsLotsWarnReturnsOnCall[len(fake.pointsLotsWarnArgsForCall)]
+	fake.pointsLotsWarnArgsForCall = append(fake.pointsLotsWarnArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.PointsLotsWarnStub
+	fakeReturns := fake.pointsLotsWarnReturns
+	fake.recordInvocation("PointsLotsWarn", []interface{}{arg1, arg2, arg3})
+	fake.pointsLotsWarnMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PointsLotsWarnCallCount() int {
+	fake.pointsLotsWarnMutex.RLock()
+	defer fake.pointsLotsWarnMutex.RUnlock()
+	return len(fake.pointsLotsWarnArgsForCall)
+}
+
+func (fake *FakePersistent) PointsLotsWarnCalls(stub func(context.Context, time.Time, time.Time) ([]types.PointsExpiryNotice, error)) {
+	fake.pointsLotsWarnMutex.Lock()
+	defer fake.pointsLotsWarnMutex.Unlock()
+	fake.PointsLotsWarnStub = stub
+}
+
+func (fake *FakePersistent) PointsLotsWarnArgsForCall(i int) (context.Context, time.Time, time.Time) {
+	fake.pointsLotsWarnMutex.RLock()
+	defer fake.pointsLotsWarnMutex.RUnlock()
+	argsForCall := fake.pointsLotsWarnArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) PointsLotsWarnReturns(result1 []types.PointsExpiryNotice, result2 error) {
+	fake.pointsLotsWarnMutex.Lock()
+	defer fake.pointsLotsWarnMutex.Unlock()
+	fake.PointsLotsWarnStub = nil
+	fake.pointsLotsWarnReturns = struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsLotsWarnReturnsOnCall(i int, result1 []types.PointsExpiryNotice, result2 error) {
+	fake.pointsLotsWarnMutex.Lock()
+	defer fake.pointsLotsWarnMutex.Unlock()
+	fake.PointsLotsWarnStub = nil
+	if fake.pointsLotsWarnReturnsOnCall == nil {
+		fake.pointsLotsWarnReturnsOnCall = make(map[int]struct {
+			result1 []types.PointsExpiryNotice
+			result2 error
+		})
+	}
+	fake.pointsLotsWarnReturnsOnCall[i] = struct {
+		result1 []types.PointsExpiryNotice
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsRateDelete(arg1 context.Context, arg2 string) error {
+	fake.pointsRateDeleteMutex.Lock()
+	ret, specificReturn := fake.pointsRateDeleteReturnsOnCall[len(fake.pointsRateDeleteArgsForCall)]
+	fake.pointsRateDeleteArgsForCall = append(fake.pointsRateDeleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PointsRateDeleteStub
+	fakeReturns := fake.pointsRateDeleteReturns
+	fake.recordInvocation("PointsRateDelete", []interface{}{arg1, arg2})
+	fake.pointsRateDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) PointsRateDeleteCallCount() int {
+	fake.pointsRateDeleteMutex.RLock()
+	defer fake.pointsRateDeleteMutex.RUnlock()
+	return len(fake.pointsRateDeleteArgsForCall)
+}
+
+func (fake *FakePersistent) PointsRateDeleteCalls(stub func(context.Context, string) error) {
+	fake.pointsRateDeleteMutex.Lock()
+	defer fake.pointsRateDeleteMutex.Unlock()
+	fake.PointsRateDeleteStub = stub
+}
+
+func (fake *FakePersistent) PointsRateDeleteArgsForCall(i int) (context.Context, string) {
+	fake.pointsRateDeleteMutex.RLock()
+	defer fake.pointsRateDeleteMutex.RUnlock()
+	argsForCall := fake.pointsRateDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PointsRateDeleteReturns(result1 error) {
+	fake.pointsRateDeleteMutex.Lock()
+	defer fake.pointsRateDeleteMutex.Unlock()
+	fake.PointsRateDeleteStub = nil
+	fake.pointsRateDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PointsRateDeleteReturnsOnCall(i int, result1 error) {
+	fake.pointsRateDeleteMutex.Lock()
+	defer fake.pointsRateDeleteMutex.Unlock()
+	fake.PointsRateDeleteStub = nil
+	if fake.pointsRateDeleteReturnsOnCall == nil {
+		fake.pointsRateDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pointsRateDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PointsRateGet(arg1 context.Context, arg2 string) (types.PointsRate, error) {
+	fake.pointsRateGetMutex.Lock()
+	ret, specificReturn := fake.pointsRateGetReturnsOnCall[len(fake.pointsRateGetArgsForCall)]
+	fake.pointsRateGetArgsForCall = append(fake.pointsRateGetArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PointsRateGetStub
+	fakeReturns := fake.pointsRateGetReturns
+	fake.recordInvocation("PointsRateGet", []interface{}{arg1, arg2})
+	fake.pointsRateGetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PointsRateGetCallCount() int {
+	fake.pointsRateGetMutex.RLock()
+	defer fake.pointsRateGetMutex.RUnlock()
+	return len(fake.pointsRateGetArgsForCall)
+}
+
+func (fake *FakePersistent) PointsRateGetCalls(stub func(context.Context, string) (types.PointsRate, error)) {
+	fake.pointsRateGetMutex.Lock()
+	defer fake.pointsRateGetMutex.Unlock()
+	fake.PointsRateGetStub = stub
+}
+
+func (fake *FakePersistent) PointsRateGetArgsForCall(i int) (context.Context, string) {
+	fake.pointsRateGetMutex.RLock()
+	defer fake.pointsRateGetMutex.RUnlock()
+	argsForCall := fake.pointsRateGetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PointsRateGetReturns(result1 types.PointsRate, result2 error) {
+	fake.pointsRateGetMutex.Lock()
+	defer fake.pointsRateGetMutex.Unlock()
+	fake.PointsRateGetStub = nil
+	fake.pointsRateGetReturns = struct {
+		result1 types.PointsRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsRateGetReturnsOnCall(i int, result1 types.PointsRate, result2 error) {
+	fake.pointsRateGetMutex.Lock()
+	defer fake.pointsRateGetMutex.Unlock()
+	fake.PointsRateGetStub = nil
+	if fake.pointsRateGetReturnsOnCall == nil {
+		fake.pointsRateGetReturnsOnCall = make(map[int]struct {
+			result1 types.PointsRate
+			result2 error
+		})
+	}
+	fake.pointsRateGetReturnsOnCall[i] = struct {
+		result1 types.PointsRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsRateUpsert(arg1 context.Context, arg2 types.PointsRate) (types.PointsRate, error) {
+	fake.pointsRateUpsertMutex.Lock()
+	ret, specificReturn := fake.pointsRateUpsertReturnsOnCall[len(fake.pointsRateUpsertArgsForCall)]
+	fake.pointsRateUpsertArgsForCall = append(fake.pointsRateUpsertArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PointsRate
+	}{arg1, arg2})
+	stub := fake.PointsRateUpsertStub
+	fakeReturns := fake.pointsRateUpsertReturns
+	fake.recordInvocation("PointsRateUpsert", []interface{}{arg1, arg2})
+	fake.pointsRateUpsertMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PointsRateUpsertCallCount() int {
+	fake.pointsRateUpsertMutex.RLock()
+	defer fake.pointsRateUpsertMutex.RUnlock()
+	return len(fake.pointsRateUpsertArgsForCall)
+}
+
+func (fake *FakePersistent) PointsRateUpsertCalls(stub func(context.Context, types.PointsRate) (types.PointsRate, error)) {
+	fake.pointsRateUpsertMutex.Lock()
+	defer fake.pointsRateUpsertMutex.Unlock()
+	fake.PointsRateUpsertStub = stub
+}
+
+func (fake *FakePersistent) PointsRateUpsertArgsForCall(i int) (context.Context, types.PointsRate) {
+	fake.pointsRateUpsertMutex.RLock()
+	defer fake.pointsRateUpsertMutex.RUnlock()
+	argsForCall := fake.pointsRateUpsertArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PointsRateUpsertReturns(result1 types.PointsRate, result2 error) {
+	fake.pointsRateUpsertMutex.Lock()
+	defer fake.pointsRateUpsertMutex.Unlock()
+	fake.PointsRateUpsertStub = nil
+	fake.pointsRateUpsertReturns = struct {
+		result1 types.PointsRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PointsRateUpsertReturnsOnCall(i int, result1 types.PointsRate, result2 error) {
+	fake.pointsRateUpsertMutex.Lock()
+	defer fake.pointsRateUpsertMutex.Unlock()
+	fake.PointsRateUpsertStub = nil
+	if fake.pointsRateUpsertReturnsOnCall == nil {
+		fake.pointsRateUpsertReturnsOnCall = make(map[int]struct {
+			result1 types.PointsRate
+			result2 error
+		})
+	}
+	fake.pointsRateUpsertReturnsOnCall[i] = struct {
+		result1 types.PointsRate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionArchive(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.promotionArchiveMutex.Lock()
+	ret, specificReturn := fake.promotionArchiveReturnsOnCall[len(fake.promotionArchiveArgsForCall)]
+	fake.promotionArchiveArgsForCall = append(fake.promotionArchiveArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PromotionArchiveStub
+	fakeReturns := fake.promotionArchiveReturns
+	fake.recordInvocation("PromotionArchive", []interface{}{arg1, arg2})
+	fake.promotionArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) PromotionArchiveCallCount() int {
+	fake.promotionArchiveMutex.RLock()
+	defer fake.promotionArchiveMutex.RUnlock()
+	return len(fake.promotionArchiveArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionArchiveCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.promotionArchiveMutex.Lock()
+	defer fake.promotionArchiveMutex.Unlock()
+	fake.PromotionArchiveStub = stub
+}
+
+func (fake *FakePersistent) PromotionArchiveArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.promotionArchiveMutex.RLock()
+	defer fake.promotionArchiveMutex.RUnlock()
+	argsForCall := fake.promotionArchiveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionArchiveReturns(result1 error) {
+	fake.promotionArchiveMutex.Lock()
+	defer fake.promotionArchiveMutex.Unlock()
+	fake.PromotionArchiveStub = nil
+	fake.promotionArchiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionArchiveReturnsOnCall(i int, result1 error) {
+	fake.promotionArchiveMutex.Lock()
+	defer fake.promotionArchiveMutex.Unlock()
+	fake.PromotionArchiveStub = nil
+	if fake.promotionArchiveReturnsOnCall == nil {
+		fake.promotionArchiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promotionArchiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionCodeGetByCode(arg1 context.Context, arg2 string) (types.PromotionCode, error) {
+	fake.promotionCodeGetByCodeMutex.Lock()
+	ret, specificReturn := fake.promotionCodeGetByCodeReturnsOnCall[len(fake.promotionCodeGetByCodeArgsForCall)]
+	fake.promotionCodeGetByCodeArgsForCall = append(fake.promotionCodeGetByCodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PromotionCodeGetByCodeStub
+	fakeReturns := fake.promotionCodeGetByCodeReturns
+	fake.recordInvocation("PromotionCodeGetByCode", []interface{}{arg1, arg2})
+	fake.promotionCodeGetByCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionCodeGetByCodeCallCount() int {
+	fake.promotionCodeGetByCodeMutex.RLock()
+	defer fake.promotionCodeGetByCodeMutex.RUnlock()
+	return len(fake.promotionCodeGetByCodeArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionCodeGetByCodeCalls(stub func(context.Context, string) (types.PromotionCode, error)) {
+	fake.promotionCodeGetByCodeMutex.Lock()
+	defer fake.promotionCodeGetByCodeMutex.Unlock()
+	fake.PromotionCodeGetByCodeStub = stub
+}
+
+func (fake *FakePersistent) PromotionCodeGetByCodeArgsForCall(i int) (context.Context, string) {
+	fake.promotionCodeGetByCodeMutex.RLock()
+	defer fake.promotionCodeGetByCodeMutex.RUnlock()
+	argsForCall := fake.promotionCodeGetByCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionCodeGetByCodeReturns(result1 types.PromotionCode, result2 error) {
+	fake.promotionCodeGetByCodeMutex.Lock()
+	defer fake.promotionCodeGetByCodeMutex.Unlock()
+	fake.PromotionCodeGetByCodeStub = nil
+	fake.promotionCodeGetByCodeReturns = struct {
+		result1 types.PromotionCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionCodeGetByCodeReturnsOnCall(i int, result1 types.PromotionCode, result2 error) {
+	fake.promotionCodeGetByCodeMutex.Lock()
+	defer fake.promotionCodeGetByCodeMutex.Unlock()
+	fake.PromotionCodeGetByCodeStub = nil
+	if fake.promotionCodeGetByCodeReturnsOnCall == nil {
+		fake.promotionCodeGetByCodeReturnsOnCall = make(map[int]struct {
+			result1 types.PromotionCode
+			result2 error
+		})
+	}
+	fake.promotionCodeGetByCodeReturnsOnCall[i] = struct {
+		result1 types.PromotionCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionCodeRedeem(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.promotionCodeRedeemMutex.Lock()
+	ret, specificReturn := fake.promotionCodeRedeemReturnsOnCall[len(fake.promotionCodeRedeemArgsForCall)]
+	fake.promotionCodeRedeemArgsForCall = append(fake.promotionCodeRedeemArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PromotionCodeRedeemStub
+	fakeReturns := fake.promotionCodeRedeemReturns
+	fake.recordInvocation("PromotionCodeRedeem", []interface{}{arg1, arg2})
+	fake.promotionCodeRedeemMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) PromotionCodeRedeemCallCount() int {
+	fake.promotionCodeRedeemMutex.RLock()
+	defer fake.promotionCodeRedeemMutex.RUnlock()
+	return len(fake.promotionCodeRedeemArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionCodeRedeemCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.promotionCodeRedeemMutex.Lock()
+	defer fake.promotionCodeRedeemMutex.Unlock()
+	fake.PromotionCodeRedeemStub = stub
+}
+
+func (fake *FakePersistent) PromotionCodeRedeemArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.promotionCodeRedeemMutex.RLock()
+	defer fake.promotionCodeRedeemMutex.RUnlock()
+	argsForCall := fake.promotionCodeRedeemArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionCodeRedeemReturns(result1 error) {
+	fake.promotionCodeRedeemMutex.Lock()
+	defer fake.promotionCodeRedeemMutex.Unlock()
+	fake.PromotionCodeRedeemStub = nil
+	fake.promotionCodeRedeemReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionCodeRedeemReturnsOnCall(i int, result1 error) {
+	fake.promotionCodeRedeemMutex.Lock()
+	defer fake.promotionCodeRedeemMutex.Unlock()
+	fake.PromotionCodeRedeemStub = nil
+	if fake.promotionCodeRedeemReturnsOnCall == nil {
+		fake.promotionCodeRedeemReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promotionCodeRedeemReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionCodeRedemptionCreate(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) (bool, error) {
+	fake.promotionCodeRedemptionCreateMutex.Lock()
+	ret, specificReturn := fake.promotionCodeRedemptionCreateReturnsOnCall[len(fake.promotionCodeRedemptionCreateArgsForCall)]
+	fake.promotionCodeRedemptionCreateArgsForCall = append(fake.promotionCodeRedemptionCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PromotionCodeRedemptionCreateStub
+	fakeReturns := fake.promotionCodeRedemptionCreateReturns
+	fake.recordInvocation("PromotionCodeRedemptionCreate", []interface{}{arg1, arg2, arg3, arg4})
+	fake.promotionCodeRedemptionCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionCodeRedemptionCreateCallCount() int {
+	fake.promotionCodeRedemptionCreateMutex.RLock()
+	defer fake.promotionCodeRedemptionCreateMutex.RUnlock()
+	return len(fake.promotionCodeRedemptionCreateArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionCodeRedemptionCreateCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (bool, error)) {
+	fake.promotionCodeRedemptionCreateMutex.Lock()
+	defer fake.promotionCodeRedemptionCreateMutex.Unlock()
+	fake.PromotionCodeRedemptionCreateStub = stub
+}
+
+func (fake *FakePersistent) PromotionCodeRedemptionCreateArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.promotionCodeRedemptionCreateMutex.RLock()
+	defer fake.promotionCodeRedemptionCreateMutex.RUnlock()
+	argsForCall := fake.promotionCodeRedemptionCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) PromotionCodeRedemptionCreateReturns(result1 bool, result2 error) {
+	fake.promotionCodeRedemptionCreateMutex.Lock()
+	defer fake.promotionCodeRedemptionCreateMutex.Unlock()
+	fake.PromotionCodeRedemptionCreateStub = nil
+	fake.promotionCodeRedemptionCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionCodeRedemptionCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.promotionCodeRedemptionCreateMutex.Lock()
+	defer fake.promotionCodeRedemptionCreateMutex.Unlock()
+	fake.PromotionCodeRedemptionCreateStub = nil
+	if fake.promotionCodeRedemptionCreateReturnsOnCall == nil {
+		fake.promotionCodeRedemptionCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.promotionCodeRedemptionCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionCodesCreate(arg1 context.Context, arg2 []types.PromotionCode) error {
+	var arg2Copy []types.PromotionCode
+	if arg2 != nil {
+		arg2Copy = make([]types.PromotionCode, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.promotionCodesCreateMutex.Lock()
+	ret, specificReturn := fake.promotionCodesCreateReturnsOnCall[len(fake.promotionCodesCreateArgsForCall)]
+	fake.promotionCodesCreateArgsForCall = append(fake.promotionCodesCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 []types.PromotionCode
+	}{arg1, arg2Copy})
+	stub := fake.PromotionCodesCreateStub
+	fakeReturns := fake.promotionCodesCreateReturns
+	fake.recordInvocation("PromotionCodesCreate", []interface{}{arg1, arg2Copy})
+	fake.promotionCodesCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) PromotionCodesCreateCallCount() int {
+	fake.promotionCodesCreateMutex.RLock()
+	defer fake.promotionCodesCreateMutex.RUnlock()
+	return len(fake.promotionCodesCreateArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionCodesCreateCalls(stub func(context.Context, []types.PromotionCode) error) {
+	fake.promotionCodesCreateMutex.Lock()
+	defer fake.promotionCodesCreateMutex.Unlock()
+	fake.PromotionCodesCreateStub = stub
+}
+
+func (fake *FakePersistent) PromotionCodesCreateArgsForCall(i int) (context.Context, []types.PromotionCode) {
+	fake.promotionCodesCreateMutex.RLock()
+	defer fake.promotionCodesCreateMutex.RUnlock()
+	argsForCall := fake.promotionCodesCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionCodesCreateReturns(result1 error) {
+	fake.promotionCodesCreateMutex.Lock()
+	defer fake.promotionCodesCreateMutex.Unlock()
+	fake.PromotionCodesCreateStub = nil
+	fake.promotionCodesCreateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionCodesCreateReturnsOnCall(i int, result1 error) {
+	fake.promotionCodesCreateMutex.Lock()
+	defer fake.promotionCodesCreateMutex.Unlock()
+	fake.PromotionCodesCreateStub = nil
+	if fake.promotionCodesCreateReturnsOnCall == nil {
+		fake.promotionCodesCreateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promotionCodesCreateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionCreate(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
+	fake.promotionCreateMutex.Lock()
+	ret, specificReturn := fake.promotionCreateReturnsOnCall[len(fake.promotionCreateArgsForCall)]
+	fake.promotionCreateArgsForCall = append(fake.promotionCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Promotion
+	}{arg1, arg2})
+	stub := fake.PromotionCreateStub
+	fakeReturns := fake.promotionCreateReturns
+	fake.recordInvocation("PromotionCreate", []interface{}{arg1, arg2})
+	fake.promotionCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionCreateCallCount() int {
+	fake.promotionCreateMutex.RLock()
+	defer fake.promotionCreateMutex.RUnlock()
+	return len(fake.promotionCreateArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionCreateCalls(stub func(context.Context, types.Promotion) (types.Promotion, error)) {
+	fake.promotionCreateMutex.Lock()
+	defer fake.promotionCreateMutex.Unlock()
+	fake.PromotionCreateStub = stub
+}
+
+func (fake *FakePersistent) PromotionCreateArgsForCall(i int) (context.Context, types.Promotion) {
+	fake.promotionCreateMutex.RLock()
+	defer fake.promotionCreateMutex.RUnlock()
+	argsForCall := fake.promotionCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionCreateReturns(result1 types.Promotion, result2 error) {
+	fake.promotionCreateMutex.Lock()
+	defer fake.promotionCreateMutex.Unlock()
+	fake.PromotionCreateStub = nil
+	fake.promotionCreateReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionCreateReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionCreateMutex.Lock()
+	defer fake.promotionCreateMutex.Unlock()
+	fake.PromotionCreateStub = nil
+	if fake.promotionCreateReturnsOnCall == nil {
+		fake.promotionCreateReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionCreateReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionGetByID(arg1 context.Context, arg2 uuid.UUID) (types.Promotion, error) {
+	fake.promotionGetByIDMutex.Lock()
+	ret, specificReturn := fake.promotionGetByIDReturnsOnCall[len(fake.promotionGetByIDArgsForCall)]
+	fake.promotionGetByIDArgsForCall = append(fake.promotionGetByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PromotionGetByIDStub
+	fakeReturns := fake.promotionGetByIDReturns
+	fake.recordInvocation("PromotionGetByID", []interface{}{arg1, arg2})
+	fake.promotionGetByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionGetByIDCallCount() int {
+	fake.promotionGetByIDMutex.RLock()
+	defer fake.promotionGetByIDMutex.RUnlock()
+	return len(fake.promotionGetByIDArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionGetByIDCalls(stub func(context.Context, uuid.UUID) (types.Promotion, error)) {
+	fake.promotionGetByIDMutex.Lock()
+	defer fake.promotionGetByIDMutex.Unlock()
+	fake.PromotionGetByIDStub = stub
+}
+
+func (fake *FakePersistent) PromotionGetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.promotionGetByIDMutex.RLock()
+	defer fake.promotionGetByIDMutex.RUnlock()
+	argsForCall := fake.promotionGetByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionGetByIDReturns(result1 types.Promotion, result2 error) {
+	fake.promotionGetByIDMutex.Lock()
+	defer fake.promotionGetByIDMutex.Unlock()
+	fake.PromotionGetByIDStub = nil
+	fake.promotionGetByIDReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionGetByIDReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionGetByIDMutex.Lock()
+	defer fake.promotionGetByIDMutex.Unlock()
+	fake.PromotionGetByIDStub = nil
+	if fake.promotionGetByIDReturnsOnCall == nil {
+		fake.promotionGetByIDReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionGetByIDReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionGetByType(arg1 context.Context, arg2 types.PromotionType) (types.Promotion, error) {
+	fake.promotionGetByTypeMutex.Lock()
+	ret, specificReturn := fake.promotionGetByTypeReturnsOnCall[len(fake.promotionGetByTypeArgsForCall)]
+	fake.promotionGetByTypeArgsForCall = append(fake.promotionGetByTypeArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PromotionType
+	}{arg1, arg2})
+	stub := fake.PromotionGetByTypeStub
+	fakeReturns := fake.promotionGetByTypeReturns
+	fake.recordInvocation("PromotionGetByType", []interface{}{arg1, arg2})
+	fake.promotionGetByTypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionGetByTypeCallCount() int {
+	fake.promotionGetByTypeMutex.RLock()
+	defer fake.promotionGetByTypeMutex.RUnlock()
+	return len(fake.promotionGetByTypeArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionGetByTypeCalls(stub func(context.Context, types.PromotionType) (types.Promotion, error)) {
+	fake.promotionGetByTypeMutex.Lock()
+	defer fake.promotionGetByTypeMutex.Unlock()
+	fake.PromotionGetByTypeStub = stub
+}
+
+func (fake *FakePersistent) PromotionGetByTypeArgsForCall(i int) (context.Context, types.PromotionType) {
+	fake.promotionGetByTypeMutex.RLock()
+	defer fake.promotionGetByTypeMutex.RUnlock()
+	argsForCall := fake.promotionGetByTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionGetByTypeReturns(result1 types.Promotion, result2 error) {
+	fake.promotionGetByTypeMutex.Lock()
+	defer fake.promotionGetByTypeMutex.Unlock()
+	fake.PromotionGetByTypeStub = nil
+	fake.promotionGetByTypeReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionGetByTypeReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionGetByTypeMutex.Lock()
+	defer fake.promotionGetByTypeMutex.Unlock()
+	fake.PromotionGetByTypeStub = nil
+	if fake.promotionGetByTypeReturnsOnCall == nil {
+		fake.promotionGetByTypeReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionGetByTypeReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionRestore(arg1 context.Context, arg2 uuid.UUID) (types.Promotion, error) {
+	fake.promotionRestoreMutex.Lock()
+	ret, specificReturn := fake.promotionRestoreReturnsOnCall[len(fake.promotionRestoreArgsForCall)]
+	fake.promotionRestoreArgsForCall = append(fake.promotionRestoreArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PromotionRestoreStub
+	fakeReturns := fake.promotionRestoreReturns
+	fake.recordInvocation("PromotionRestore", []interface{}{arg1, arg2})
+	fake.promotionRestoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionRestoreCallCount() int {
+	fake.promotionRestoreMutex.RLock()
+	defer fake.promotionRestoreMutex.RUnlock()
+	return len(fake.promotionRestoreArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionRestoreCalls(stub func(context.Context, uuid.UUID) (types.Promotion, error)) {
+	fake.promotionRestoreMutex.Lock()
+	defer fake.promotionRestoreMutex.Unlock()
+	fake.PromotionRestoreStub = stub
+}
+
+func (fake *FakePersistent) PromotionRestoreArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.promotionRestoreMutex.RLock()
+	defer fake.promotionRestoreMutex.RUnlock()
+	argsForCall := fake.promotionRestoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionRestoreReturns(result1 types.Promotion, result2 error) {
+	fake.promotionRestoreMutex.Lock()
+	defer fake.promotionRestoreMutex.Unlock()
+	fake.PromotionRestoreStub = nil
+	fake.promotionRestoreReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionRestoreReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionRestoreMutex.Lock()
+	defer fake.promotionRestoreMutex.Unlock()
+	fake.PromotionRestoreStub = nil
+	if fake.promotionRestoreReturnsOnCall == nil {
+		fake.promotionRestoreReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionRestoreReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionSpend(arg1 context.Context, arg2 uuid.UUID, arg3 decimal.Decimal) (types.Promotion, error) {
+	fake.promotionSpendMutex.Lock()
+	ret, specificReturn := fake.promotionSpendReturnsOnCall[len(fake.promotionSpendArgsForCall)]
+	fake.promotionSpendArgsForCall = append(fake.promotionSpendArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 decimal.Decimal
+	}{arg1, arg2, arg3})
+	stub := fake.PromotionSpendStub
+	fakeReturns := fake.promotionSpendReturns
+	fake.recordInvocation("PromotionSpend", []interface{}{arg1, arg2, arg3})
+	fake.promotionSpendMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionSpendCallCount() int {
+	fake.promotionSpendMutex.RLock()
+	defer fake.promotionSpendMutex.RUnlock()
+	return len(fake.promotionSpendArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionSpendCalls(stub func(context.Context, uuid.UUID, decimal.Decimal) (types.Promotion, error)) {
+	fake.promotionSpendMutex.Lock()
+	defer fake.promotionSpendMutex.Unlock()
+	fake.PromotionSpendStub = stub
+}
+
+func (fake *FakePersistent) PromotionSpendArgsForCall(i int) (context.Context, uuid.UUID, decimal.Decimal) {
+	fake.promotionSpendMutex.RLock()
+	defer fake.promotionSpendMutex.RUnlock()
+	argsForCall := fake.promotionSpendArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) PromotionSpendReturns(result1 types.Promotion, result2 error) {
+	fake.promotionSpendMutex.Lock()
+	defer fake.promotionSpendMutex.Unlock()
+	fake.PromotionSpendStub = nil
+	fake.promotionSpendReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionSpendReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionSpendMutex.Lock()
+	defer fake.promotionSpendMutex.Unlock()
+	fake.PromotionSpendStub = nil
+	if fake.promotionSpendReturnsOnCall == nil {
+		fake.promotionSpendReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionSpendReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionStateChangeCreate(arg1 context.Context, arg2 types.PromotionStateChange) error {
+	fake.promotionStateChangeCreateMutex.Lock()
+	ret, specificReturn := fake.promotionStateChangeCreateReturnsOnCall[len(fake.promotionStateChangeCreateArgsForCall)]
+	fake.promotionStateChangeCreateArgsForCall = append(fake.promotionStateChangeCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PromotionStateChange
+	}{arg1, arg2})
+	stub := fake.PromotionStateChangeCreateStub
+	fakeReturns := fake.promotionStateChangeCreateReturns
+	fake.recordInvocation("PromotionStateChangeCreate", []interface{}{arg1, arg2})
+	fake.promotionStateChangeCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) PromotionStateChangeCreateCallCount() int {
+	fake.promotionStateChangeCreateMutex.RLock()
+	defer fake.promotionStateChangeCreateMutex.RUnlock()
+	return len(fake.promotionStateChangeCreateArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionStateChangeCreateCalls(stub func(context.Context, types.PromotionStateChange) error) {
+	fake.promotionStateChangeCreateMutex.Lock()
+	defer fake.promotionStateChangeCreateMutex.Unlock()
+	fake.PromotionStateChangeCreateStub = stub
+}
+
+func (fake *FakePersistent) PromotionStateChangeCreateArgsForCall(i int) (context.Context, types.PromotionStateChange) {
+	fake.promotionStateChangeCreateMutex.RLock()
+	defer fake.promotionStateChangeCreateMutex.RUnlock()
+	argsForCall := fake.promotionStateChangeCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionStateChangeCreateReturns(result1 error) {
+	fake.promotionStateChangeCreateMutex.Lock()
+	defer fake.promotionStateChangeCreateMutex.Unlock()
+	fake.PromotionStateChangeCreateStub = nil
+	fake.promotionStateChangeCreateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionStateChangeCreateReturnsOnCall(i int, result1 error) {
+	fake.promotionStateChangeCreateMutex.Lock()
+	defer fake.promotionStateChangeCreateMutex.Unlock()
+	fake.PromotionStateChangeCreateStub = nil
+	if fake.promotionStateChangeCreateReturnsOnCall == nil {
+		fake.promotionStateChangeCreateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promotionStateChangeCreateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) PromotionUpdate(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
+	fake.promotionUpdateMutex.Lock()
+	ret, specificReturn := fake.promotionUpdateReturnsOnCall[len(fake.promotionUpdateArgsForCall)]
+	fake.promotionUpdateArgsForCall = append(fake.promotionUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Promotion
+	}{arg1, arg2})
+	stub := fake.PromotionUpdateStub
+	fakeReturns := fake.promotionUpdateReturns
+	fake.recordInvocation("PromotionUpdate", []interface{}{arg1, arg2})
+	fake.promotionUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionUpdateCallCount() int {
+	fake.promotionUpdateMutex.RLock()
+	defer fake.promotionUpdateMutex.RUnlock()
+	return len(fake.promotionUpdateArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionUpdateCalls(stub func(context.Context, types.Promotion) (types.Promotion, error)) {
+	fake.promotionUpdateMutex.Lock()
+	defer fake.promotionUpdateMutex.Unlock()
+	fake.PromotionUpdateStub = stub
+}
+
+func (fake *FakePersistent) PromotionUpdateArgsForCall(i int) (context.Context, types.Promotion) {
+	fake.promotionUpdateMutex.RLock()
+	defer fake.promotionUpdateMutex.RUnlock()
+	argsForCall := fake.promotionUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionUpdateReturns(result1 types.Promotion, result2 error) {
+	fake.promotionUpdateMutex.Lock()
+	defer fake.promotionUpdateMutex.Unlock()
+	fake.PromotionUpdateStub = nil
+	fake.promotionUpdateReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionUpdateReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionUpdateMutex.Lock()
+	defer fake.promotionUpdateMutex.Unlock()
+	fake.PromotionUpdateStub = nil
+	if fake.promotionUpdateReturnsOnCall == nil {
+		fake.promotionUpdateReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionUpdateReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionsApplySchedule(arg1 context.Context, arg2 time.Time) ([]types.PromotionStateChange, error) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	ret, specificReturn := fake.promotionsApplyScheduleReturnsOnCall[len(fake.promotionsApplyScheduleArgsForCall)]
+	fake.promotionsApplyScheduleArgsForCall = append(fake.promotionsApplyScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.PromotionsApplyScheduleStub
+	fakeReturns := fake.promotionsApplyScheduleReturns
+	fake.recordInvocation("PromotionsApplySchedule", []interface{}{arg1, arg2})
+	fake.promotionsApplyScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) PromotionsApplyScheduleCallCount() int {
+	fake.promotionsApplyScheduleMutex.RLock()
+	defer fake.promotionsApplyScheduleMutex.RUnlock()
+	return len(fake.promotionsApplyScheduleArgsForCall)
+}
+
+func (fake *FakePersistent) PromotionsApplyScheduleCalls(stub func(context.Context, time.Time) ([]types.PromotionStateChange, error)) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	defer fake.promotionsApplyScheduleMutex.Unlock()
+	fake.PromotionsApplyScheduleStub = stub
+}
+
+func (fake *FakePersistent) PromotionsApplyScheduleArgsForCall(i int) (context.Context, time.Time) {
+	fake.promotionsApplyScheduleMutex.RLock()
+	defer fake.promotionsApplyScheduleMutex.RUnlock()
+	argsForCall := fake.promotionsApplyScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) PromotionsApplyScheduleReturns(result1 []types.PromotionStateChange, result2 error) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	defer fake.promotionsApplyScheduleMutex.Unlock()
+	fake.PromotionsApplyScheduleStub = nil
+	fake.promotionsApplyScheduleReturns = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) PromotionsApplyScheduleReturnsOnCall(i int, result1 []types.PromotionStateChange, result2 error) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	defer fake.promotionsApplyScheduleMutex.Unlock()
+	fake.PromotionsApplyScheduleStub = nil
+	if fake.promotionsApplyScheduleReturnsOnCall == nil {
+		fake.promotionsApplyScheduleReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionStateChange
+			result2 error
+		})
+	}
+	fake.promotionsApplyScheduleReturnsOnCall[i] = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) RedemptionCreate(arg1 context.Context, arg2 types.Redemption) (types.Redemption, error) {
+	fake.redemptionCreateMutex.Lock()
+	ret, specificReturn := fake.redemptionCreateReturnsOnCall[len(fake.redemptionCreateArgsForCall)]
+	fake.redemptionCreateArgsForCall = append(fake.redemptionCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Redemption
+	}{arg1, arg2})
+	stub := fake.RedemptionCreateStub
+	fakeReturns := fake.redemptionCreateReturns
+	fake.recordInvocation("RedemptionCreate", []interface{}{arg1, arg2})
+	fake.redemptionCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) RedemptionCreateCallCount() int {
+	fake.redemptionCreateMutex.RLock()
+	defer fake.redemptionCreateMutex.RUnlock()
+	return len(fake.redemptionCreateArgsForCall)
+}
+
+func (fake *FakePersistent) RedemptionCreateCalls(stub func(context.Context, types.Redemption) (types.Redemption, error)) {
+	fake.redemptionCreateMutex.Lock()
+	defer fake.redemptionCreateMutex.Unlock()
+	fake.RedemptionCreateStub = stub
+}
+
+func (fake *FakePersistent) RedemptionCreateArgsForCall(i int) (context.Context, types.Redemption) {
+	fake.redemptionCreateMutex.RLock()
+	defer fake.redemptionCreateMutex.RUnlock()
+	argsForCall := fake.redemptionCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) RedemptionCreateReturns(result1 types.Redemption, result2 error) {
+	fake.redemptionCreateMutex.Lock()
+	defer fake.redemptionCreateMutex.Unlock()
+	fake.RedemptionCreateStub = nil
+	fake.redemptionCreateReturns = struct {
+		result1 types.Redemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) RedemptionCreateReturnsOnCall(i int, result1 types.Redemption, result2 error) {
+	fake.redemptionCreateMutex.Lock()
+	defer fake.redemptionCreateMutex.Unlock()
+	fake.RedemptionCreateStub = nil
+	if fake.redemptionCreateReturnsOnCall == nil {
+		fake.redemptionCreateReturnsOnCall = make(map[int]struct {
+			result1 types.Redemption
+			result2 error
+		})
+	}
+	fake.redemptionCreateReturnsOnCall[i] = struct {
+		result1 types.Redemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) RedemptionFulfil(arg1 context.Context, arg2 uuid.UUID) (types.Redemption, error) {
+	fake.redemptionFulfilMutex.Lock()
+	ret, specificReturn := fake.redemptionFulfilReturnsOnCall[len(fake.redemptionFulfilArgsForCall)]
+	fake.redemptionFulfilArgsForCall = append(fake.redemptionFulfilArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RedemptionFulfilStub
+	fakeReturns := fake.redemptionFulfilReturns
+	fake.recordInvocation("RedemptionFulfil", []interface{}{arg1, arg2})
+	fake.redemptionFulfilMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) RedemptionFulfilCallCount() int {
+	fake.redemptionFulfilMutex.RLock()
+	defer fake.redemptionFulfilMutex.RUnlock()
+	return len(fake.redemptionFulfilArgsForCall)
+}
+
+func (fake *FakePersistent) RedemptionFulfilCalls(stub func(context.Context, uuid.UUID) (types.Redemption, error)) {
+	fake.redemptionFulfilMutex.Lock()
+	defer fake.redemptionFulfilMutex.Unlock()
+	fake.RedemptionFulfilStub = stub
+}
+
+func (fake *FakePersistent) RedemptionFulfilArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.redemptionFulfilMutex.RLock()
+	defer fake.redemptionFulfilMutex.RUnlock()
+	argsForCall := fake.redemptionFulfilArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) RedemptionFulfilReturns(result1 types.Redemption, result2 error) {
+	fake.redemptionFulfilMutex.Lock()
+	defer fake.redemptionFulfilMutex.Unlock()
+	fake.RedemptionFulfilStub = nil
+	fake.redemptionFulfilReturns = struct {
+		result1 types.Redemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) RedemptionFulfilReturnsOnCall(i int, result1 types.Redemption, result2 error) {
+	fake.redemptionFulfilMutex.Lock()
+	defer fake.redemptionFulfilMutex.Unlock()
+	fake.RedemptionFulfilStub = nil
+	if fake.redemptionFulfilReturnsOnCall == nil {
+		fake.redemptionFulfilReturnsOnCall = make(map[int]struct {
+			result1 types.Redemption
+			result2 error
+		})
+	}
+	fake.redemptionFulfilReturnsOnCall[i] = struct {
+		result1 types.Redemption
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) ReferralReward(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.referralRewardMutex.Lock()
+	ret, specificReturn := fake.referralRewardReturnsOnCall[len(fake.referralRewardArgsForCall)]
+	fake.referralRewardArgsForCall = append(fake.referralRewardArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.ReferralRewardStub
+	fakeReturns := fake.referralRewardReturns
+	fake.recordInvocation("ReferralReward", []interface{}{arg1, arg2, arg3})
+	fake.referralRewardMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) ReferralRewardCallCount() int {
+	fake.referralRewardMutex.RLock()
+	defer fake.referralRewardMutex.RUnlock()
+	return len(fake.referralRewardArgsForCall)
+}
+
+func (fake *FakePersistent) ReferralRewardCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.referralRewardMutex.Lock()
+	defer fake.referralRewardMutex.Unlock()
+	fake.ReferralRewardStub = stub
+}
+
+func (fake *FakePersistent) ReferralRewardArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.referralRewardMutex.RLock()
+	defer fake.referralRewardMutex.RUnlock()
+	argsForCall := fake.referralRewardArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) ReferralRewardReturns(result1 error) {
+	fake.referralRewardMutex.Lock()
+	defer fake.referralRewardMutex.Unlock()
+	fake.ReferralRewardStub = nil
+	fake.referralRewardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) ReferralRewardReturnsOnCall(i int, result1 error) {
+	fake.referralRewardMutex.Lock()
+	defer fake.referralRewardMutex.Unlock()
+	fake.ReferralRewardStub = nil
+	if fake.referralRewardReturnsOnCall == nil {
+		fake.referralRewardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.referralRewardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) RollbackTx(arg1 context.Context) error {
+	fake.rollbackTxMutex.Lock()
+	ret, specificReturn := fake.rollbackTxReturnsOnCall[len(fake.rollbackTxArgsForCall)]
+	fake.rollbackTxArgsForCall = append(fake.rollbackTxArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RollbackTxStub
+	fakeReturns := fake.rollbackTxReturns
+	fake.recordInvocation("RollbackTx", []interface{}{arg1})
+	fake.rollbackTxMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) RollbackTxCallCount() int {
+	fake.rollbackTxMutex.RLock()
+	defer fake.rollbackTxMutex.RUnlock()
+	return len(fake.rollbackTxArgsForCall)
+}
+
+func (fake *FakePersistent) RollbackTxCalls(stub func(context.Context) error) {
+	fake.rollbackTxMutex.Lock()
+	defer fake.rollbackTxMutex.Unlock()
+	fake.RollbackTxStub = stub
+}
+
+func (fake *FakePersistent) RollbackTxArgsForCall(i int) context.Context {
+	fake.rollbackTxMutex.RLock()
+	defer fake.rollbackTxMutex.RUnlock()
+	argsForCall := fake.rollbackTxArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePersistent) RollbackTxReturns(result1 error) {
+	fake.rollbackTxMutex.Lock()
+	defer fake.rollbackTxMutex.Unlock()
+	fake.RollbackTxStub = nil
+	fake.rollbackTxReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) RollbackTxReturnsOnCall(i int, result1 error) {
+	fake.rollbackTxMutex.Lock()
+	defer fake.rollbackTxMutex.Unlock()
+	fake.RollbackTxStub = nil
+	if fake.rollbackTxReturnsOnCall == nil {
+		fake.rollbackTxReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rollbackTxReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TierCreate(arg1 context.Context, arg2 types.Tier) (types.Tier, error) {
+	fake.tierCreateMutex.Lock()
+	ret, specificReturn := fake.tierCreateReturnsOnCall[len(fake.tierCreateArgsForCall)]
+	fake.tierCreateArgsForCall = append(fake.tierCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Tier
+	}{arg1, arg2})
+	stub := fake.TierCreateStub
+	fakeReturns := fake.tierCreateReturns
+	fake.recordInvocation("TierCreate", []interface{}{arg1, arg2})
+	fake.tierCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) TierCreateCallCount() int {
+	fake.tierCreateMutex.RLock()
+	defer fake.tierCreateMutex.RUnlock()
+	return len(fake.tierCreateArgsForCall)
+}
+
+func (fake *FakePersistent) TierCreateCalls(stub func(context.Context, types.Tier) (types.Tier, error)) {
+	fake.tierCreateMutex.Lock()
+	defer fake.tierCreateMutex.Unlock()
+	fake.TierCreateStub = stub
+}
+
+func (fake *FakePersistent) TierCreateArgsForCall(i int) (context.Context, types.Tier) {
+	fake.tierCreateMutex.RLock()
+	defer fake.tierCreateMutex.RUnlock()
+	argsForCall := fake.tierCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TierCreateReturns(result1 types.Tier, result2 error) {
+	fake.tierCreateMutex.Lock()
+	defer fake.tierCreateMutex.Unlock()
+	fake.TierCreateStub = nil
+	fake.tierCreateReturns = struct {
+		result1 types.Tier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TierCreateReturnsOnCall(i int, result1 types.Tier, result2 error) {
+	fake.tierCreateMutex.Lock()
+	defer fake.tierCreateMutex.Unlock()
+	fake.TierCreateStub = nil
+	if fake.tierCreateReturnsOnCall == nil {
+		fake.tierCreateReturnsOnCall = make(map[int]struct {
+			result1 types.Tier
+			result2 error
+		})
+	}
+	fake.tierCreateReturnsOnCall[i] = struct {
+		result1 types.Tier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TierDelete(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.tierDeleteMutex.Lock()
+	ret, specificReturn := fake.tierDeleteReturnsOnCall[len(fake.tierDeleteArgsForCall)]
+	fake.tierDeleteArgsForCall = append(fake.tierDeleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.TierDeleteStub
+	fakeReturns := fake.tierDeleteReturns
+	fake.recordInvocation("TierDelete", []interface{}{arg1, arg2})
+	fake.tierDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) TierDeleteCallCount() int {
+	fake.tierDeleteMutex.RLock()
+	defer fake.tierDeleteMutex.RUnlock()
+	return len(fake.tierDeleteArgsForCall)
+}
+
+func (fake *FakePersistent) TierDeleteCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.tierDeleteMutex.Lock()
+	defer fake.tierDeleteMutex.Unlock()
+	fake.TierDeleteStub = stub
+}
+
+func (fake *FakePersistent) TierDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.tierDeleteMutex.RLock()
+	defer fake.tierDeleteMutex.RUnlock()
+	argsForCall := fake.tierDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TierDeleteReturns(result1 error) {
+	fake.tierDeleteMutex.Lock()
+	defer fake.tierDeleteMutex.Unlock()
+	fake.TierDeleteStub = nil
+	fake.tierDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TierDeleteReturnsOnCall(i int, result1 error) {
+	fake.tierDeleteMutex.Lock()
+	defer fake.tierDeleteMutex.Unlock()
+	fake.TierDeleteStub = nil
+	if fake.tierDeleteReturnsOnCall == nil {
+		fake.tierDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.tierDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TierUpdate(arg1 context.Context, arg2 types.Tier) (types.Tier, error) {
+	fake.tierUpdateMutex.Lock()
+	ret, specificReturn := fake.tierUpdateReturnsOnCall[len(fake.tierUpdateArgsForCall)]
+	fake.tierUpdateArgsForCall = append(fake.tierUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Tier
+	}{arg1, arg2})
+	stub := fake.TierUpdateStub
+	fakeReturns := fake.tierUpdateReturns
+	fake.recordInvocation("TierUpdate", []interface{}{arg1, arg2})
+	fake.tierUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) TierUpdateCallCount() int {
+	fake.tierUpdateMutex.RLock()
+	defer fake.tierUpdateMutex.RUnlock()
+	return len(fake.tierUpdateArgsForCall)
+}
+
+func (fake *FakePersistent) TierUpdateCalls(stub func(context.Context, types.Tier) (types.Tier, error)) {
+	fake.tierUpdateMutex.Lock()
+	defer fake.tierUpdateMutex.Unlock()
+	fake.TierUpdateStub = stub
+}
+
+func (fake *FakePersistent) TierUpdateArgsForCall(i int) (context.Context, types.Tier) {
+	fake.tierUpdateMutex.RLock()
+	defer fake.tierUpdateMutex.RUnlock()
+	argsForCall := fake.tierUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TierUpdateReturns(result1 types.Tier, result2 error) {
+	fake.tierUpdateMutex.Lock()
+	defer fake.tierUpdateMutex.Unlock()
+	fake.TierUpdateStub = nil
+	fake.tierUpdateReturns = struct {
+		result1 types.Tier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TierUpdateReturnsOnCall(i int, result1 types.Tier, result2 error) {
+	fake.tierUpdateMutex.Lock()
+	defer fake.tierUpdateMutex.Unlock()
+	fake.TierUpdateStub = nil
+	if fake.tierUpdateReturnsOnCall == nil {
+		fake.tierUpdateReturnsOnCall = make(map[int]struct {
+			result1 types.Tier
+			result2 error
+		})
+	}
+	fake.tierUpdateReturnsOnCall[i] = struct {
+		result1 types.Tier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentCreate(arg1 context.Context, arg2 types.Tournament) (types.Tournament, error) {
+	fake.tournamentCreateMutex.Lock()
+	ret, specificReturn := fake.tournamentCreateReturnsOnCall[len(fake.tournamentCreateArgsForCall)]
+	fake.tournamentCreateArgsForCall = append(fake.tournamentCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Tournament
+	}{arg1, arg2})
+	stub := fake.TournamentCreateStub
+	fakeReturns := fake.tournamentCreateReturns
+	fake.recordInvocation("TournamentCreate", []interface{}{arg1, arg2})
+	fake.tournamentCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) TournamentCreateCallCount() int {
+	fake.tournamentCreateMutex.RLock()
+	defer fake.tournamentCreateMutex.RUnlock()
+	return len(fake.tournamentCreateArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentCreateCalls(stub func(context.Context, types.Tournament) (types.Tournament, error)) {
+	fake.tournamentCreateMutex.Lock()
+	defer fake.tournamentCreateMutex.Unlock()
+	fake.TournamentCreateStub = stub
+}
+
+func (fake *FakePersistent) TournamentCreateArgsForCall(i int) (context.Context, types.Tournament) {
+	fake.tournamentCreateMutex.RLock()
+	defer fake.tournamentCreateMutex.RUnlock()
+	argsForCall := fake.tournamentCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TournamentCreateReturns(result1 types.Tournament, result2 error) {
+	fake.tournamentCreateMutex.Lock()
+	defer fake.tournamentCreateMutex.Unlock()
+	fake.TournamentCreateStub = nil
+	fake.tournamentCreateReturns = struct {
+		result1 types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentCreateReturnsOnCall(i int, result1 types.Tournament, result2 error) {
+	fake.tournamentCreateMutex.Lock()
+	defer fake.tournamentCreateMutex.Unlock()
+	fake.TournamentCreateStub = nil
+	if fake.tournamentCreateReturnsOnCall == nil {
+		fake.tournamentCreateReturnsOnCall = make(map[int]struct {
+			result1 types.Tournament
+			result2 error
+		})
+	}
+	fake.tournamentCreateReturnsOnCall[i] = struct {
+		result1 types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentDelete(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.tournamentDeleteMutex.Lock()
+	ret, specificReturn := fake.tournamentDeleteReturnsOnCall[len(fake.tournamentDeleteArgsForCall)]
+	fake.tournamentDeleteArgsForCall = append(fake.tournamentDeleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.TournamentDeleteStub
+	fakeReturns := fake.tournamentDeleteReturns
+	fake.recordInvocation("TournamentDelete", []interface{}{arg1, arg2})
+	fake.tournamentDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) TournamentDeleteCallCount() int {
+	fake.tournamentDeleteMutex.RLock()
+	defer fake.tournamentDeleteMutex.RUnlock()
+	return len(fake.tournamentDeleteArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentDeleteCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.tournamentDeleteMutex.Lock()
+	defer fake.tournamentDeleteMutex.Unlock()
+	fake.TournamentDeleteStub = stub
+}
+
+func (fake *FakePersistent) TournamentDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.tournamentDeleteMutex.RLock()
+	defer fake.tournamentDeleteMutex.RUnlock()
+	argsForCall := fake.tournamentDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TournamentDeleteReturns(result1 error) {
+	fake.tournamentDeleteMutex.Lock()
+	defer fake.tournamentDeleteMutex.Unlock()
+	fake.TournamentDeleteStub = nil
+	fake.tournamentDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentDeleteReturnsOnCall(i int, result1 error) {
+	fake.tournamentDeleteMutex.Lock()
+	defer fake.tournamentDeleteMutex.Unlock()
+	fake.TournamentDeleteStub = nil
+	if fake.tournamentDeleteReturnsOnCall == nil {
+		fake.tournamentDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.tournamentDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentFinish(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.tournamentFinishMutex.Lock()
+	ret, specificReturn := fake.tournamentFinishReturnsOnCall[len(fake.tournamentFinishArgsForCall)]
+	fake.tournamentFinishArgsForCall = append(fake.tournamentFinishArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.TournamentFinishStub
+	fakeReturns := fake.tournamentFinishReturns
+	fake.recordInvocation("TournamentFinish", []interface{}{arg1, arg2, arg3})
+	fake.tournamentFinishMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) TournamentFinishCallCount() int {
+	fake.tournamentFinishMutex.RLock()
+	defer fake.tournamentFinishMutex.RUnlock()
+	return len(fake.tournamentFinishArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentFinishCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.tournamentFinishMutex.Lock()
+	defer fake.tournamentFinishMutex.Unlock()
+	fake.TournamentFinishStub = stub
+}
+
+func (fake *FakePersistent) TournamentFinishArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.tournamentFinishMutex.RLock()
+	defer fake.tournamentFinishMutex.RUnlock()
+	argsForCall := fake.tournamentFinishArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) TournamentFinishReturns(result1 error) {
+	fake.tournamentFinishMutex.Lock()
+	defer fake.tournamentFinishMutex.Unlock()
+	fake.TournamentFinishStub = nil
+	fake.tournamentFinishReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentFinishReturnsOnCall(i int, result1 error) {
+	fake.tournamentFinishMutex.Lock()
+	defer fake.tournamentFinishMutex.Unlock()
+	fake.TournamentFinishStub = nil
+	if fake.tournamentFinishReturnsOnCall == nil {
+		fake.tournamentFinishReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.tournamentFinishReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentGetByID(arg1 context.Context, arg2 uuid.UUID) (types.Tournament, error) {
+	fake.tournamentGetByIDMutex.Lock()
+	ret, specificReturn := fake.tournamentGetByIDReturnsOnCall[len(fake.tournamentGetByIDArgsForCall)]
+	fake.tournamentGetByIDArgsForCall = append(fake.tournamentGetByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.TournamentGetByIDStub
+	fakeReturns := fake.tournamentGetByIDReturns
+	fake.recordInvocation("TournamentGetByID", []interface{}{arg1, arg2})
+	fake.tournamentGetByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) TournamentGetByIDCallCount() int {
+	fake.tournamentGetByIDMutex.RLock()
+	defer fake.tournamentGetByIDMutex.RUnlock()
+	return len(fake.tournamentGetByIDArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentGetByIDCalls(stub func(context.Context, uuid.UUID) (types.Tournament, error)) {
+	fake.tournamentGetByIDMutex.Lock()
+	defer fake.tournamentGetByIDMutex.Unlock()
+	fake.TournamentGetByIDStub = stub
+}
+
+func (fake *FakePersistent) TournamentGetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.tournamentGetByIDMutex.RLock()
+	defer fake.tournamentGetByIDMutex.RUnlock()
+	argsForCall := fake.tournamentGetByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TournamentGetByIDReturns(result1 types.Tournament, result2 error) {
+	fake.tournamentGetByIDMutex.Lock()
+	defer fake.tournamentGetByIDMutex.Unlock()
+	fake.TournamentGetByIDStub = nil
+	fake.tournamentGetByIDReturns = struct {
+		result1 types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentGetByIDReturnsOnCall(i int, result1 types.Tournament, result2 error) {
+	fake.tournamentGetByIDMutex.Lock()
+	defer fake.tournamentGetByIDMutex.Unlock()
+	fake.TournamentGetByIDStub = nil
+	if fake.tournamentGetByIDReturnsOnCall == nil {
+		fake.tournamentGetByIDReturnsOnCall = make(map[int]struct {
+			result1 types.Tournament
+			result2 error
+		})
+	}
+	fake.tournamentGetByIDReturnsOnCall[i] = struct {
+		result1 types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentResultCreate(arg1 context.Context, arg2 types.TournamentResult) error {
+	fake.tournamentResultCreateMutex.Lock()
+	ret, specificReturn := fake.tournamentResultCreateReturnsOnCall[len(fake.tournamentResultCreateArgsForCall)]
+	fake.tournamentResultCreateArgsForCall = append(fake.tournamentResultCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.TournamentResult
+	}{arg1, arg2})
+	stub := fake.TournamentResultCreateStub
+	fakeReturns := fake.tournamentResultCreateReturns
+	fake.recordInvocation("TournamentResultCreate", []interface{}{arg1, arg2})
+	fake.tournamentResultCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) TournamentResultCreateCallCount() int {
+	fake.tournamentResultCreateMutex.RLock()
+	defer fake.tournamentResultCreateMutex.RUnlock()
+	return len(fake.tournamentResultCreateArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentResultCreateCalls(stub func(context.Context, types.TournamentResult) error) {
+	fake.tournamentResultCreateMutex.Lock()
+	defer fake.tournamentResultCreateMutex.Unlock()
+	fake.TournamentResultCreateStub = stub
+}
+
+func (fake *FakePersistent) TournamentResultCreateArgsForCall(i int) (context.Context, types.TournamentResult) {
+	fake.tournamentResultCreateMutex.RLock()
+	defer fake.tournamentResultCreateMutex.RUnlock()
+	argsForCall := fake.tournamentResultCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TournamentResultCreateReturns(result1 error) {
+	fake.tournamentResultCreateMutex.Lock()
+	defer fake.tournamentResultCreateMutex.Unlock()
+	fake.TournamentResultCreateStub = nil
+	fake.tournamentResultCreateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentResultCreateReturnsOnCall(i int, result1 error) {
+	fake.tournamentResultCreateMutex.Lock()
+	defer fake.tournamentResultCreateMutex.Unlock()
+	fake.TournamentResultCreateStub = nil
+	if fake.tournamentResultCreateReturnsOnCall == nil {
+		fake.tournamentResultCreateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.tournamentResultCreateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentResultLock(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (types.TournamentResult, error) {
+	fake.tournamentResultLockMutex.Lock()
+	ret, specificReturn := fake.tournamentResultLockReturnsOnCall[len(fake.tournamentResultLockArgsForCall)]
+	fake.tournamentResultLockArgsForCall = append(fake.tournamentResultLockArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.TournamentResultLockStub
+	fakeReturns := fake.tournamentResultLockReturns
+	fake.recordInvocation("TournamentResultLock", []interface{}{arg1, arg2, arg3})
+	fake.tournamentResultLockMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) TournamentResultLockCallCount() int {
+	fake.tournamentResultLockMutex.RLock()
+	defer fake.tournamentResultLockMutex.RUnlock()
+	return len(fake.tournamentResultLockArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentResultLockCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (types.TournamentResult, error)) {
+	fake.tournamentResultLockMutex.Lock()
+	defer fake.tournamentResultLockMutex.Unlock()
+	fake.TournamentResultLockStub = stub
+}
+
+func (fake *FakePersistent) TournamentResultLockArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.tournamentResultLockMutex.RLock()
+	defer fake.tournamentResultLockMutex.RUnlock()
+	argsForCall := fake.tournamentResultLockArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePersistent) TournamentResultLockReturns(result1 types.TournamentResult, result2 error) {
+	fake.tournamentResultLockMutex.Lock()
+	defer fake.tournamentResultLockMutex.Unlock()
+	fake.TournamentResultLockStub = nil
+	fake.tournamentResultLockReturns = struct {
+		result1 types.TournamentResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentResultLockReturnsOnCall(i int, result1 types.TournamentResult, result2 error) {
+	fake.tournamentResultLockMutex.Lock()
+	defer fake.tournamentResultLockMutex.Unlock()
+	fake.TournamentResultLockStub = nil
+	if fake.tournamentResultLockReturnsOnCall == nil {
+		fake.tournamentResultLockReturnsOnCall = make(map[int]struct {
+			result1 types.TournamentResult
+			result2 error
+		})
+	}
+	fake.tournamentResultLockReturnsOnCall[i] = struct {
+		result1 types.TournamentResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentResultPrize(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.tournamentResultPrizeMutex.Lock()
+	ret, specificReturn := fake.tournamentResultPrizeReturnsOnCall[len(fake.tournamentResultPrizeArgsForCall)]
+	fake.tournamentResultPrizeArgsForCall = append(fake.tournamentResultPrizeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.TournamentResultPrizeStub
+	fakeReturns := fake.tournamentResultPrizeReturns
+	fake.recordInvocation("TournamentResultPrize", []interface{}{arg1, arg2, arg3, arg4})
+	fake.tournamentResultPrizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) TournamentResultPrizeCallCount() int {
+	fake.tournamentResultPrizeMutex.RLock()
+	defer fake.tournamentResultPrizeMutex.RUnlock()
+	return len(fake.tournamentResultPrizeArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentResultPrizeCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.tournamentResultPrizeMutex.Lock()
+	defer fake.tournamentResultPrizeMutex.Unlock()
+	fake.TournamentResultPrizeStub = stub
+}
+
+func (fake *FakePersistent) TournamentResultPrizeArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.tournamentResultPrizeMutex.RLock()
+	defer fake.tournamentResultPrizeMutex.RUnlock()
+	argsForCall := fake.tournamentResultPrizeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) TournamentResultPrizeReturns(result1 error) {
+	fake.tournamentResultPrizeMutex.Lock()
+	defer fake.tournamentResultPrizeMutex.Unlock()
+	fake.TournamentResultPrizeStub = nil
+	fake.tournamentResultPrizeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentResultPrizeReturnsOnCall(i int, result1 error) {
+	fake.tournamentResultPrizeMutex.Lock()
+	defer fake.tournamentResultPrizeMutex.Unlock()
+	fake.TournamentResultPrizeStub = nil
+	if fake.tournamentResultPrizeReturnsOnCall == nil {
+		fake.tournamentResultPrizeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.tournamentResultPrizeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentResultPrizeFail(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string) error {
+	fake.tournamentResultPrizeFailMutex.Lock()
+	ret, specificReturn := fake.tournamentResultPrizeFailReturnsOnCall[len(fake.tournamentResultPrizeFailArgsForCall)]
+	fake.tournamentResultPrizeFailArgsForCall = append(fake.tournamentResultPrizeFailArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.TournamentResultPrizeFailStub
+	fakeReturns := fake.tournamentResultPrizeFailReturns
+	fake.recordInvocation("TournamentResultPrizeFail", []interface{}{arg1, arg2, arg3, arg4})
+	fake.tournamentResultPrizeFailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) TournamentResultPrizeFailCallCount() int {
+	fake.tournamentResultPrizeFailMutex.RLock()
+	defer fake.tournamentResultPrizeFailMutex.RUnlock()
+	return len(fake.tournamentResultPrizeFailArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentResultPrizeFailCalls(stub func(context.Context, uuid.UUID, uuid.UUID, string) error) {
+	fake.tournamentResultPrizeFailMutex.Lock()
+	defer fake.tournamentResultPrizeFailMutex.Unlock()
+	fake.TournamentResultPrizeFailStub = stub
+}
+
+func (fake *FakePersistent) TournamentResultPrizeFailArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string) {
+	fake.tournamentResultPrizeFailMutex.RLock()
+	defer fake.tournamentResultPrizeFailMutex.RUnlock()
+	argsForCall := fake.tournamentResultPrizeFailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePersistent) TournamentResultPrizeFailReturns(result1 error) {
+	fake.tournamentResultPrizeFailMutex.Lock()
+	defer fake.tournamentResultPrizeFailMutex.Unlock()
+	fake.TournamentResultPrizeFailStub = nil
+	fake.tournamentResultPrizeFailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentResultPrizeFailReturnsOnCall(i int, result1 error) {
+	fake.tournamentResultPrizeFailMutex.Lock()
+	defer fake.tournamentResultPrizeFailMutex.Unlock()
+	fake.TournamentResultPrizeFailStub = nil
+	if fake.tournamentResultPrizeFailReturnsOnCall == nil {
+		fake.tournamentResultPrizeFailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.tournamentResultPrizeFailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) TournamentUpdate(arg1 context.Context, arg2 types.Tournament) (types.Tournament, error) {
+	fake.tournamentUpdateMutex.Lock()
+	ret, specificReturn := fake.tournamentUpdateReturnsOnCall[len(fake.tournamentUpdateArgsForCall)]
+	fake.tournamentUpdateArgsForCall = append(fake.tournamentUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.Tournament
+	}{arg1, arg2})
+	stub := fake.TournamentUpdateStub
+	fakeReturns := fake.tournamentUpdateReturns
+	fake.recordInvocation("TournamentUpdate", []interface{}{arg1, arg2})
+	fake.tournamentUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) TournamentUpdateCallCount() int {
+	fake.tournamentUpdateMutex.RLock()
+	defer fake.tournamentUpdateMutex.RUnlock()
+	return len(fake.tournamentUpdateArgsForCall)
+}
+
+func (fake *FakePersistent) TournamentUpdateCalls(stub func(context.Context, types.Tournament) (types.Tournament, error)) {
+	fake.tournamentUpdateMutex.Lock()
+	defer fake.tournamentUpdateMutex.Unlock()
+	fake.TournamentUpdateStub = stub
+}
+
+func (fake *FakePersistent) TournamentUpdateArgsForCall(i int) (context.Context, types.Tournament) {
+	fake.tournamentUpdateMutex.RLock()
+	defer fake.tournamentUpdateMutex.RUnlock()
+	argsForCall := fake.tournamentUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) TournamentUpdateReturns(result1 types.Tournament, result2 error) {
+	fake.tournamentUpdateMutex.Lock()
+	defer fake.tournamentUpdateMutex.Unlock()
+	fake.TournamentUpdateStub = nil
+	fake.tournamentUpdateReturns = struct {
+		result1 types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) TournamentUpdateReturnsOnCall(i int, result1 types.Tournament, result2 error) {
+	fake.tournamentUpdateMutex.Lock()
+	defer fake.tournamentUpdateMutex.Unlock()
+	fake.TournamentUpdateStub = nil
+	if fake.tournamentUpdateReturnsOnCall == nil {
+		fake.tournamentUpdateReturnsOnCall = make(map[int]struct {
+			result1 types.Tournament
+			result2 error
+		})
+	}
+	fake.tournamentUpdateReturnsOnCall[i] = struct {
+		result1 types.Tournament
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceRebuild(arg1 context.Context, arg2 uuid.UUID) (types.User, error) {
+	fake.userBalanceRebuildMutex.Lock()
+	ret, specificReturn := fake.userBalanceRebuildReturnsOnCall[len(fake.userBalanceRebuildArgsForCall)]
+	fake.userBalanceRebuildArgsForCall = append(fake.userBalanceRebuildArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UserBalanceRebuildStub
+	fakeReturns := fake.userBalanceRebuildReturns
+	fake.recordInvocation("UserBalanceRebuild", []interface{}{arg1, arg2})
+	fake.userBalanceRebuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) UserBalanceRebuildCallCount() int {
+	fake.userBalanceRebuildMutex.RLock()
+	defer fake.userBalanceRebuildMutex.RUnlock()
+	return len(fake.userBalanceRebuildArgsForCall)
+}
+
+func (fake *FakePersistent) UserBalanceRebuildCalls(stub func(context.Context, uuid.UUID) (types.User, error)) {
+	fake.userBalanceRebuildMutex.Lock()
+	defer fake.userBalanceRebuildMutex.Unlock()
+	fake.UserBalanceRebuildStub = stub
+}
+
+func (fake *FakePersistent) UserBalanceRebuildArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userBalanceRebuildMutex.RLock()
+	defer fake.userBalanceRebuildMutex.RUnlock()
+	argsForCall := fake.userBalanceRebuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserBalanceRebuildReturns(result1 types.User, result2 error) {
+	fake.userBalanceRebuildMutex.Lock()
+	defer fake.userBalanceRebuildMutex.Unlock()
+	fake.UserBalanceRebuildStub = nil
+	fake.userBalanceRebuildReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceRebuildReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userBalanceRebuildMutex.Lock()
+	defer fake.userBalanceRebuildMutex.Unlock()
+	fake.UserBalanceRebuildStub = nil
+	if fake.userBalanceRebuildReturnsOnCall == nil {
+		fake.userBalanceRebuildReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.userBalanceRebuildReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceReconcile(arg1 context.Context, arg2 uuid.UUID) (types.BalanceReconciliation, error) {
+	fake.userBalanceReconcileMutex.Lock()
+	ret, specificReturn := fake.userBalanceReconcileReturnsOnCall[len(fake.userBalanceReconcileArgsForCall)]
+	fake.userBalanceReconcileArgsForCall = append(fake.userBalanceReconcileArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UserBalanceReconcileStub
+	fakeReturns := fake.userBalanceReconcileReturns
+	fake.recordInvocation("UserBalanceReconcile", []interface{}{arg1, arg2})
+	fake.userBalanceReconcileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) UserBalanceReconcileCallCount() int {
+	fake.userBalanceReconcileMutex.RLock()
+	defer fake.userBalanceReconcileMutex.RUnlock()
+	return len(fake.userBalanceReconcileArgsForCall)
+}
+
+func (fake *FakePersistent) UserBalanceReconcileCalls(stub func(context.Context, uuid.UUID) (types.BalanceReconciliation, error)) {
+	fake.userBalanceReconcileMutex.Lock()
+	defer fake.userBalanceReconcileMutex.Unlock()
+	fake.UserBalanceReconcileStub = stub
+}
+
+func (fake *FakePersistent) UserBalanceReconcileArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userBalanceReconcileMutex.RLock()
+	defer fake.userBalanceReconcileMutex.RUnlock()
+	argsForCall := fake.userBalanceReconcileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserBalanceReconcileReturns(result1 types.BalanceReconciliation, result2 error) {
+	fake.userBalanceReconcileMutex.Lock()
+	defer fake.userBalanceReconcileMutex.Unlock()
+	fake.UserBalanceReconcileStub = nil
+	fake.userBalanceReconcileReturns = struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceReconcileReturnsOnCall(i int, result1 types.BalanceReconciliation, result2 error) {
+	fake.userBalanceReconcileMutex.Lock()
+	defer fake.userBalanceReconcileMutex.Unlock()
+	fake.UserBalanceReconcileStub = nil
+	if fake.userBalanceReconcileReturnsOnCall == nil {
+		fake.userBalanceReconcileReturnsOnCall = make(map[int]struct {
+			result1 types.BalanceReconciliation
+			result2 error
+		})
+	}
+	fake.userBalanceReconcileReturnsOnCall[i] = struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceUpdate(arg1 context.Context, arg2 types.LedgerEntry) (types.User, error) {
+	fake.userBalanceUpdateMutex.Lock()
+	ret, specificReturn := fake.userBalanceUpdateReturnsOnCall[len(fake.userBalanceUpdateArgsForCall)]
+	fake.userBalanceUpdateArgsForCall = append(fake.userBalanceUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.LedgerEntry
+	}{arg1, arg2})
+	stub := fake.UserBalanceUpdateStub
+	fakeReturns := fake.userBalanceUpdateReturns
+	fake.recordInvocation("UserBalanceUpdate", []interface{}{arg1, arg2})
+	fake.userBalanceUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) UserBalanceUpdateCallCount() int {
+	fake.userBalanceUpdateMutex.RLock()
+	defer fake.userBalanceUpdateMutex.RUnlock()
+	return len(fake.userBalanceUpdateArgsForCall)
+}
+
+func (fake *FakePersistent) UserBalanceUpdateCalls(stub func(context.Context, types.LedgerEntry) (types.User, error)) {
+	fake.userBalanceUpdateMutex.Lock()
+	defer fake.userBalanceUpdateMutex.Unlock()
+	fake.UserBalanceUpdateStub = stub
+}
+
+func (fake *FakePersistent) UserBalanceUpdateArgsForCall(i int) (context.Context, types.LedgerEntry) {
+	fake.userBalanceUpdateMutex.RLock()
+	defer fake.userBalanceUpdateMutex.RUnlock()
+	argsForCall := fake.userBalanceUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserBalanceUpdateReturns(result1 types.User, result2 error) {
+	fake.userBalanceUpdateMutex.Lock()
+	defer fake.userBalanceUpdateMutex.Unlock()
+	fake.UserBalanceUpdateStub = nil
+	fake.userBalanceUpdateReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
 
-func (fake *FakePersistent) AddPromotion(arg1 context.Context, arg2 types.UserPromotion) (types.UserPromotion, error) {
-	fake.addPromotionMutex.Lock()
-	ret, specificReturn := fake.addPromotionReturnsOnCall[len(fake.addPromotionArgsForCall)]
-	fake.addPromotionArgsForCall = append(fake.addPromotionArgsForCall, struct {
+func (fake *FakePersistent) UserBalanceUpdateReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userBalanceUpdateMutex.Lock()
+	defer fake.userBalanceUpdateMutex.Unlock()
+	fake.UserBalanceUpdateStub = nil
+	if fake.userBalanceUpdateReturnsOnCall == nil {
+		fake.userBalanceUpdateReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.userBalanceUpdateReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceWithdraw(arg1 context.Context, arg2 types.LedgerEntry) (types.User, error) {
+	fake.userBalanceWithdrawMutex.Lock()
+	ret, specificReturn := fake.userBalanceWithdrawReturnsOnCall[len(fake.userBalanceWithdrawArgsForCall)]
+	fake.userBalanceWithdrawArgsForCall = append(fake.userBalanceWithdrawArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.UserPromotion
+		arg2 types.LedgerEntry
 	}{arg1, arg2})
-	stub := fake.AddPromotionStub
-	fakeReturns := fake.addPromotionReturns
-	fake.recordInvocation("AddPromotion", []interface{}{arg1, arg2})
-	fake.addPromotionMutex.Unlock()
+	stub := fake.UserBalanceWithdrawStub
+	fakeReturns := fake.userBalanceWithdrawReturns
+	fake.recordInvocation("UserBalanceWithdraw", []interface{}{arg1, arg2})
+	fake.userBalanceWithdrawMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) UserBalanceWithdrawCallCount() int {
+	fake.userBalanceWithdrawMutex.RLock()
+	defer fake.userBalanceWithdrawMutex.RUnlock()
+	return len(fake.userBalanceWithdrawArgsForCall)
+}
+
+func (fake *FakePersistent) UserBalanceWithdrawCalls(stub func(context.Context, types.LedgerEntry) (types.User, error)) {
+	fake.userBalanceWithdrawMutex.Lock()
+	defer fake.userBalanceWithdrawMutex.Unlock()
+	fake.UserBalanceWithdrawStub = stub
+}
+
+func (fake *FakePersistent) UserBalanceWithdrawArgsForCall(i int) (context.Context, types.LedgerEntry) {
+	fake.userBalanceWithdrawMutex.RLock()
+	defer fake.userBalanceWithdrawMutex.RUnlock()
+	argsForCall := fake.userBalanceWithdrawArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserBalanceWithdrawReturns(result1 types.User, result2 error) {
+	fake.userBalanceWithdrawMutex.Lock()
+	defer fake.userBalanceWithdrawMutex.Unlock()
+	fake.UserBalanceWithdrawStub = nil
+	fake.userBalanceWithdrawReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserBalanceWithdrawReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userBalanceWithdrawMutex.Lock()
+	defer fake.userBalanceWithdrawMutex.Unlock()
+	fake.UserBalanceWithdrawStub = nil
+	if fake.userBalanceWithdrawReturnsOnCall == nil {
+		fake.userBalanceWithdrawReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.userBalanceWithdrawReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserCreate(arg1 context.Context, arg2 types.User) (types.User, error) {
+	fake.userCreateMutex.Lock()
+	ret, specificReturn := fake.userCreateReturnsOnCall[len(fake.userCreateArgsForCall)]
+	fake.userCreateArgsForCall = append(fake.userCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.User
+	}{arg1, arg2})
+	stub := fake.UserCreateStub
+	fakeReturns := fake.userCreateReturns
+	fake.recordInvocation("UserCreate", []interface{}{arg1, arg2})
+	fake.userCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) UserCreateCallCount() int {
+	fake.userCreateMutex.RLock()
+	defer fake.userCreateMutex.RUnlock()
+	return len(fake.userCreateArgsForCall)
+}
+
+func (fake *FakePersistent) UserCreateCalls(stub func(context.Context, types.User) (types.User, error)) {
+	fake.userCreateMutex.Lock()
+	defer fake.userCreateMutex.Unlock()
+	fake.UserCreateStub = stub
+}
+
+func (fake *FakePersistent) UserCreateArgsForCall(i int) (context.Context, types.User) {
+	fake.userCreateMutex.RLock()
+	defer fake.userCreateMutex.RUnlock()
+	argsForCall := fake.userCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserCreateReturns(result1 types.User, result2 error) {
+	fake.userCreateMutex.Lock()
+	defer fake.userCreateMutex.Unlock()
+	fake.UserCreateStub = nil
+	fake.userCreateReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserCreateReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userCreateMutex.Lock()
+	defer fake.userCreateMutex.Unlock()
+	fake.UserCreateStub = nil
+	if fake.userCreateReturnsOnCall == nil {
+		fake.userCreateReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.userCreateReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserDelete(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.userDeleteMutex.Lock()
+	ret, specificReturn := fake.userDeleteReturnsOnCall[len(fake.userDeleteArgsForCall)]
+	fake.userDeleteArgsForCall = append(fake.userDeleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UserDeleteStub
+	fakeReturns := fake.userDeleteReturns
+	fake.recordInvocation("UserDelete", []interface{}{arg1, arg2})
+	fake.userDeleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePersistent) UserDeleteCallCount() int {
+	fake.userDeleteMutex.RLock()
+	defer fake.userDeleteMutex.RUnlock()
+	return len(fake.userDeleteArgsForCall)
+}
+
+func (fake *FakePersistent) UserDeleteCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.userDeleteMutex.Lock()
+	defer fake.userDeleteMutex.Unlock()
+	fake.UserDeleteStub = stub
+}
+
+func (fake *FakePersistent) UserDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userDeleteMutex.RLock()
+	defer fake.userDeleteMutex.RUnlock()
+	argsForCall := fake.userDeleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserDeleteReturns(result1 error) {
+	fake.userDeleteMutex.Lock()
+	defer fake.userDeleteMutex.Unlock()
+	fake.UserDeleteStub = nil
+	fake.userDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) UserDeleteReturnsOnCall(i int, result1 error) {
+	fake.userDeleteMutex.Lock()
+	defer fake.userDeleteMutex.Unlock()
+	fake.UserDeleteStub = nil
+	if fake.userDeleteReturnsOnCall == nil {
+		fake.userDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePersistent) UserGetBy(arg1 context.Context, arg2 types.UserFilter) (types.User, error) {
+	fake.userGetByMutex.Lock()
+	ret, specificReturn := fake.userGetByReturnsOnCall[len(fake.userGetByArgsForCall)]
+	fake.userGetByArgsForCall = append(fake.userGetByArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.UserFilter
+	}{arg1, arg2})
+	stub := fake.UserGetByStub
+	fakeReturns := fake.userGetByReturns
+	fake.recordInvocation("UserGetBy", []interface{}{arg1, arg2})
+	fake.userGetByMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -299,62 +7851,128 @@ func (fake *FakePersistent) AddPromotion(arg1 context.Context, arg2 types.UserPr
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) AddPromotionCallCount() int {
-	fake.addPromotionMutex.RLock()
-	defer fake.addPromotionMutex.RUnlock()
-	return len(fake.addPromotionArgsForCall)
+func (fake *FakePersistent) UserGetByCallCount() int {
+	fake.userGetByMutex.RLock()
+	defer fake.userGetByMutex.RUnlock()
+	return len(fake.userGetByArgsForCall)
+}
+
+func (fake *FakePersistent) UserGetByCalls(stub func(context.Context, types.UserFilter) (types.User, error)) {
+	fake.userGetByMutex.Lock()
+	defer fake.userGetByMutex.Unlock()
+	fake.UserGetByStub = stub
+}
+
+func (fake *FakePersistent) UserGetByArgsForCall(i int) (context.Context, types.UserFilter) {
+	fake.userGetByMutex.RLock()
+	defer fake.userGetByMutex.RUnlock()
+	argsForCall := fake.userGetByArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePersistent) UserGetByReturns(result1 types.User, result2 error) {
+	fake.userGetByMutex.Lock()
+	defer fake.userGetByMutex.Unlock()
+	fake.UserGetByStub = nil
+	fake.userGetByReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserGetByReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userGetByMutex.Lock()
+	defer fake.userGetByMutex.Unlock()
+	fake.UserGetByStub = nil
+	if fake.userGetByReturnsOnCall == nil {
+		fake.userGetByReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.userGetByReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserPromotionClaimCount(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (int, error) {
+	fake.userPromotionClaimCountMutex.Lock()
+	ret, specificReturn := fake.userPromotionClaimCountReturnsOnCall[len(fake.userPromotionClaimCountArgsForCall)]
+	fake.userPromotionClaimCountArgsForCall = append(fake.userPromotionClaimCountArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionClaimCountStub
+	fakeReturns := fake.userPromotionClaimCountReturns
+	fake.recordInvocation("UserPromotionClaimCount", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionClaimCountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePersistent) UserPromotionClaimCountCallCount() int {
+	fake.userPromotionClaimCountMutex.RLock()
+	defer fake.userPromotionClaimCountMutex.RUnlock()
+	return len(fake.userPromotionClaimCountArgsForCall)
 }
 
-func (fake *FakePersistent) AddPromotionCalls(stub func(context.Context, types.UserPromotion) (types.UserPromotion, error)) {
-	fake.addPromotionMutex.Lock()
-	defer fake.addPromotionMutex.Unlock()
-	fake.AddPromotionStub = stub
+func (fake *FakePersistent) UserPromotionClaimCountCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (int, error)) {
+	fake.userPromotionClaimCountMutex.Lock()
+	defer fake.userPromotionClaimCountMutex.Unlock()
+	fake.UserPromotionClaimCountStub = stub
 }
 
-func (fake *FakePersistent) AddPromotionArgsForCall(i int) (context.Context, types.UserPromotion) {
-	fake.addPromotionMutex.RLock()
-	defer fake.addPromotionMutex.RUnlock()
-	argsForCall := fake.addPromotionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserPromotionClaimCountArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.userPromotionClaimCountMutex.RLock()
+	defer fake.userPromotionClaimCountMutex.RUnlock()
+	argsForCall := fake.userPromotionClaimCountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePersistent) AddPromotionReturns(result1 types.UserPromotion, result2 error) {
-	fake.addPromotionMutex.Lock()
-	defer fake.addPromotionMutex.Unlock()
-	fake.AddPromotionStub = nil
-	fake.addPromotionReturns = struct {
-		result1 types.UserPromotion
+func (fake *FakePersistent) UserPromotionClaimCountReturns(result1 int, result2 error) {
+	fake.userPromotionClaimCountMutex.Lock()
+	defer fake.userPromotionClaimCountMutex.Unlock()
+	fake.UserPromotionClaimCountStub = nil
+	fake.userPromotionClaimCountReturns = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) AddPromotionReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
-	fake.addPromotionMutex.Lock()
-	defer fake.addPromotionMutex.Unlock()
-	fake.AddPromotionStub = nil
-	if fake.addPromotionReturnsOnCall == nil {
-		fake.addPromotionReturnsOnCall = make(map[int]struct {
-			result1 types.UserPromotion
+func (fake *FakePersistent) UserPromotionClaimCountReturnsOnCall(i int, result1 int, result2 error) {
+	fake.userPromotionClaimCountMutex.Lock()
+	defer fake.userPromotionClaimCountMutex.Unlock()
+	fake.UserPromotionClaimCountStub = nil
+	if fake.userPromotionClaimCountReturnsOnCall == nil {
+		fake.userPromotionClaimCountReturnsOnCall = make(map[int]struct {
+			result1 int
 			result2 error
 		})
 	}
-	fake.addPromotionReturnsOnCall[i] = struct {
-		result1 types.UserPromotion
+	fake.userPromotionClaimCountReturnsOnCall[i] = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) ClaimPromotion(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.claimPromotionMutex.Lock()
-	ret, specificReturn := fake.claimPromotionReturnsOnCall[len(fake.claimPromotionArgsForCall)]
-	fake.claimPromotionArgsForCall = append(fake.claimPromotionArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionConvert(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.userPromotionConvertMutex.Lock()
+	ret, specificReturn := fake.userPromotionConvertReturnsOnCall[len(fake.userPromotionConvertArgsForCall)]
+	fake.userPromotionConvertArgsForCall = append(fake.userPromotionConvertArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
 	}{arg1, arg2})
-	stub := fake.ClaimPromotionStub
-	fakeReturns := fake.claimPromotionReturns
-	fake.recordInvocation("ClaimPromotion", []interface{}{arg1, arg2})
-	fake.claimPromotionMutex.Unlock()
+	stub := fake.UserPromotionConvertStub
+	fakeReturns := fake.userPromotionConvertReturns
+	fake.recordInvocation("UserPromotionConvert", []interface{}{arg1, arg2})
+	fake.userPromotionConvertMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -364,60 +7982,61 @@ func (fake *FakePersistent) ClaimPromotion(arg1 context.Context, arg2 uuid.UUID)
 	return fakeReturns.result1
 }
 
-func (fake *FakePersistent) ClaimPromotionCallCount() int {
-	fake.claimPromotionMutex.RLock()
-	defer fake.claimPromotionMutex.RUnlock()
-	return len(fake.claimPromotionArgsForCall)
+func (fake *FakePersistent) UserPromotionConvertCallCount() int {
+	fake.userPromotionConvertMutex.RLock()
+	defer fake.userPromotionConvertMutex.RUnlock()
+	return len(fake.userPromotionConvertArgsForCall)
 }
 
-func (fake *FakePersistent) ClaimPromotionCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.claimPromotionMutex.Lock()
-	defer fake.claimPromotionMutex.Unlock()
-	fake.ClaimPromotionStub = stub
+func (fake *FakePersistent) UserPromotionConvertCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.userPromotionConvertMutex.Lock()
+	defer fake.userPromotionConvertMutex.Unlock()
+	fake.UserPromotionConvertStub = stub
 }
 
-func (fake *FakePersistent) ClaimPromotionArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.claimPromotionMutex.RLock()
-	defer fake.claimPromotionMutex.RUnlock()
-	argsForCall := fake.claimPromotionArgsForCall[i]
+func (fake *FakePersistent) UserPromotionConvertArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userPromotionConvertMutex.RLock()
+	defer fake.userPromotionConvertMutex.RUnlock()
+	argsForCall := fake.userPromotionConvertArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) ClaimPromotionReturns(result1 error) {
-	fake.claimPromotionMutex.Lock()
-	defer fake.claimPromotionMutex.Unlock()
-	fake.ClaimPromotionStub = nil
-	fake.claimPromotionReturns = struct {
+func (fake *FakePersistent) UserPromotionConvertReturns(result1 error) {
+	fake.userPromotionConvertMutex.Lock()
+	defer fake.userPromotionConvertMutex.Unlock()
+	fake.UserPromotionConvertStub = nil
+	fake.userPromotionConvertReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakePersistent) ClaimPromotionReturnsOnCall(i int, result1 error) {
-	fake.claimPromotionMutex.Lock()
-	defer fake.claimPromotionMutex.Unlock()
-	fake.ClaimPromotionStub = nil
-	if fake.claimPromotionReturnsOnCall == nil {
-		fake.claimPromotionReturnsOnCall = make(map[int]struct {
+func (fake *FakePersistent) UserPromotionConvertReturnsOnCall(i int, result1 error) {
+	fake.userPromotionConvertMutex.Lock()
+	defer fake.userPromotionConvertMutex.Unlock()
+	fake.UserPromotionConvertStub = nil
+	if fake.userPromotionConvertReturnsOnCall == nil {
+		fake.userPromotionConvertReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.claimPromotionReturnsOnCall[i] = struct {
+	fake.userPromotionConvertReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakePersistent) CommitTx(arg1 context.Context) error {
-	fake.commitTxMutex.Lock()
-	ret, specificReturn := fake.commitTxReturnsOnCall[len(fake.commitTxArgsForCall)]
-	fake.commitTxArgsForCall = append(fake.commitTxArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionForfeit(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.userPromotionForfeitMutex.Lock()
+	ret, specificReturn := fake.userPromotionForfeitReturnsOnCall[len(fake.userPromotionForfeitArgsForCall)]
+	fake.userPromotionForfeitArgsForCall = append(fake.userPromotionForfeitArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.CommitTxStub
-	fakeReturns := fake.commitTxReturns
-	fake.recordInvocation("CommitTx", []interface{}{arg1})
-	fake.commitTxMutex.Unlock()
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UserPromotionForfeitStub
+	fakeReturns := fake.userPromotionForfeitReturns
+	fake.recordInvocation("UserPromotionForfeit", []interface{}{arg1, arg2})
+	fake.userPromotionForfeitMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -425,59 +8044,59 @@ func (fake *FakePersistent) CommitTx(arg1 context.Context) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakePersistent) CommitTxCallCount() int {
-	fake.commitTxMutex.RLock()
-	defer fake.commitTxMutex.RUnlock()
-	return len(fake.commitTxArgsForCall)
+func (fake *FakePersistent) UserPromotionForfeitCallCount() int {
+	fake.userPromotionForfeitMutex.RLock()
+	defer fake.userPromotionForfeitMutex.RUnlock()
+	return len(fake.userPromotionForfeitArgsForCall)
 }
 
-func (fake *FakePersistent) CommitTxCalls(stub func(context.Context) error) {
-	fake.commitTxMutex.Lock()
-	defer fake.commitTxMutex.Unlock()
-	fake.CommitTxStub = stub
+func (fake *FakePersistent) UserPromotionForfeitCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.userPromotionForfeitMutex.Lock()
+	defer fake.userPromotionForfeitMutex.Unlock()
+	fake.UserPromotionForfeitStub = stub
 }
 
-func (fake *FakePersistent) CommitTxArgsForCall(i int) context.Context {
-	fake.commitTxMutex.RLock()
-	defer fake.commitTxMutex.RUnlock()
-	argsForCall := fake.commitTxArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakePersistent) UserPromotionForfeitArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userPromotionForfeitMutex.RLock()
+	defer fake.userPromotionForfeitMutex.RUnlock()
+	argsForCall := fake.userPromotionForfeitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) CommitTxReturns(result1 error) {
-	fake.commitTxMutex.Lock()
-	defer fake.commitTxMutex.Unlock()
-	fake.CommitTxStub = nil
-	fake.commitTxReturns = struct {
+func (fake *FakePersistent) UserPromotionForfeitReturns(result1 error) {
+	fake.userPromotionForfeitMutex.Lock()
+	defer fake.userPromotionForfeitMutex.Unlock()
+	fake.UserPromotionForfeitStub = nil
+	fake.userPromotionForfeitReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakePersistent) CommitTxReturnsOnCall(i int, result1 error) {
-	fake.commitTxMutex.Lock()
-	defer fake.commitTxMutex.Unlock()
-	fake.CommitTxStub = nil
-	if fake.commitTxReturnsOnCall == nil {
-		fake.commitTxReturnsOnCall = make(map[int]struct {
+func (fake *FakePersistent) UserPromotionForfeitReturnsOnCall(i int, result1 error) {
+	fake.userPromotionForfeitMutex.Lock()
+	defer fake.userPromotionForfeitMutex.Unlock()
+	fake.UserPromotionForfeitStub = nil
+	if fake.userPromotionForfeitReturnsOnCall == nil {
+		fake.userPromotionForfeitReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.commitTxReturnsOnCall[i] = struct {
+	fake.userPromotionForfeitReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakePersistent) DeleteUserPromotion(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.deleteUserPromotionMutex.Lock()
-	ret, specificReturn := fake.deleteUserPromotionReturnsOnCall[len(fake.deleteUserPromotionArgsForCall)]
-	fake.deleteUserPromotionArgsForCall = append(fake.deleteUserPromotionArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionRevocationCreate(arg1 context.Context, arg2 types.UserPromotionRevocation) error {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	ret, specificReturn := fake.userPromotionRevocationCreateReturnsOnCall[len(fake.userPromotionRevocationCreateArgsForCall)]
+	fake.userPromotionRevocationCreateArgsForCall = append(fake.userPromotionRevocationCreateArgsForCall, struct {
 		arg1 context.Context
-		arg2 uuid.UUID
+		arg2 types.UserPromotionRevocation
 	}{arg1, arg2})
-	stub := fake.DeleteUserPromotionStub
-	fakeReturns := fake.deleteUserPromotionReturns
-	fake.recordInvocation("DeleteUserPromotion", []interface{}{arg1, arg2})
-	fake.deleteUserPromotionMutex.Unlock()
+	stub := fake.UserPromotionRevocationCreateStub
+	fakeReturns := fake.userPromotionRevocationCreateReturns
+	fake.recordInvocation("UserPromotionRevocationCreate", []interface{}{arg1, arg2})
+	fake.userPromotionRevocationCreateMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -487,60 +8106,62 @@ func (fake *FakePersistent) DeleteUserPromotion(arg1 context.Context, arg2 uuid.
 	return fakeReturns.result1
 }
 
-func (fake *FakePersistent) DeleteUserPromotionCallCount() int {
-	fake.deleteUserPromotionMutex.RLock()
-	defer fake.deleteUserPromotionMutex.RUnlock()
-	return len(fake.deleteUserPromotionArgsForCall)
+func (fake *FakePersistent) UserPromotionRevocationCreateCallCount() int {
+	fake.userPromotionRevocationCreateMutex.RLock()
+	defer fake.userPromotionRevocationCreateMutex.RUnlock()
+	return len(fake.userPromotionRevocationCreateArgsForCall)
 }
 
-func (fake *FakePersistent) DeleteUserPromotionCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.deleteUserPromotionMutex.Lock()
-	defer fake.deleteUserPromotionMutex.Unlock()
-	fake.DeleteUserPromotionStub = stub
+func (fake *FakePersistent) UserPromotionRevocationCreateCalls(stub func(context.Context, types.UserPromotionRevocation) error) {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	defer fake.userPromotionRevocationCreateMutex.Unlock()
+	fake.UserPromotionRevocationCreateStub = stub
 }
 
-func (fake *FakePersistent) DeleteUserPromotionArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.deleteUserPromotionMutex.RLock()
-	defer fake.deleteUserPromotionMutex.RUnlock()
-	argsForCall := fake.deleteUserPromotionArgsForCall[i]
+func (fake *FakePersistent) UserPromotionRevocationCreateArgsForCall(i int) (context.Context, types.UserPromotionRevocation) {
+	fake.userPromotionRevocationCreateMutex.RLock()
+	defer fake.userPromotionRevocationCreateMutex.RUnlock()
+	argsForCall := fake.userPromotionRevocationCreateArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) DeleteUserPromotionReturns(result1 error) {
-	fake.deleteUserPromotionMutex.Lock()
-	defer fake.deleteUserPromotionMutex.Unlock()
-	fake.DeleteUserPromotionStub = nil
-	fake.deleteUserPromotionReturns = struct {
+func (fake *FakePersistent) UserPromotionRevocationCreateReturns(result1 error) {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	defer fake.userPromotionRevocationCreateMutex.Unlock()
+	fake.UserPromotionRevocationCreateStub = nil
+	fake.userPromotionRevocationCreateReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakePersistent) DeleteUserPromotionReturnsOnCall(i int, result1 error) {
-	fake.deleteUserPromotionMutex.Lock()
-	defer fake.deleteUserPromotionMutex.Unlock()
-	fake.DeleteUserPromotionStub = nil
-	if fake.deleteUserPromotionReturnsOnCall == nil {
-		fake.deleteUserPromotionReturnsOnCall = make(map[int]struct {
+func (fake *FakePersistent) UserPromotionRevocationCreateReturnsOnCall(i int, result1 error) {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	defer fake.userPromotionRevocationCreateMutex.Unlock()
+	fake.UserPromotionRevocationCreateStub = nil
+	if fake.userPromotionRevocationCreateReturnsOnCall == nil {
+		fake.userPromotionRevocationCreateReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteUserPromotionReturnsOnCall[i] = struct {
+	fake.userPromotionRevocationCreateReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakePersistent) GetPromotions(arg1 context.Context) ([]types.Promotion, error) {
-	fake.getPromotionsMutex.Lock()
-	ret, specificReturn := fake.getPromotionsReturnsOnCall[len(fake.getPromotionsArgsForCall)]
-	fake.getPromotionsArgsForCall = append(fake.getPromotionsArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionRevoke(arg1 context.Context, arg2 uuid.UUID, arg3 types.UserPromotionStatus) (types.UserPromotion, error) {
+	fake.userPromotionRevokeMutex.Lock()
+	ret, specificReturn := fake.userPromotionRevokeReturnsOnCall[len(fake.userPromotionRevokeArgsForCall)]
+	fake.userPromotionRevokeArgsForCall = append(fake.userPromotionRevokeArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetPromotionsStub
-	fakeReturns := fake.getPromotionsReturns
-	fake.recordInvocation("GetPromotions", []interface{}{arg1})
-	fake.getPromotionsMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 types.UserPromotionStatus
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionRevokeStub
+	fakeReturns := fake.userPromotionRevokeReturns
+	fake.recordInvocation("UserPromotionRevoke", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionRevokeMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -548,127 +8169,124 @@ func (fake *FakePersistent) GetPromotions(arg1 context.Context) ([]types.Promoti
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) GetPromotionsCallCount() int {
-	fake.getPromotionsMutex.RLock()
-	defer fake.getPromotionsMutex.RUnlock()
-	return len(fake.getPromotionsArgsForCall)
+func (fake *FakePersistent) UserPromotionRevokeCallCount() int {
+	fake.userPromotionRevokeMutex.RLock()
+	defer fake.userPromotionRevokeMutex.RUnlock()
+	return len(fake.userPromotionRevokeArgsForCall)
 }
 
-func (fake *FakePersistent) GetPromotionsCalls(stub func(context.Context) ([]types.Promotion, error)) {
-	fake.getPromotionsMutex.Lock()
-	defer fake.getPromotionsMutex.Unlock()
-	fake.GetPromotionsStub = stub
+func (fake *FakePersistent) UserPromotionRevokeCalls(stub func(context.Context, uuid.UUID, types.UserPromotionStatus) (types.UserPromotion, error)) {
+	fake.userPromotionRevokeMutex.Lock()
+	defer fake.userPromotionRevokeMutex.Unlock()
+	fake.UserPromotionRevokeStub = stub
 }
 
-func (fake *FakePersistent) GetPromotionsArgsForCall(i int) context.Context {
-	fake.getPromotionsMutex.RLock()
-	defer fake.getPromotionsMutex.RUnlock()
-	argsForCall := fake.getPromotionsArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakePersistent) UserPromotionRevokeArgsForCall(i int) (context.Context, uuid.UUID, types.UserPromotionStatus) {
+	fake.userPromotionRevokeMutex.RLock()
+	defer fake.userPromotionRevokeMutex.RUnlock()
+	argsForCall := fake.userPromotionRevokeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePersistent) GetPromotionsReturns(result1 []types.Promotion, result2 error) {
-	fake.getPromotionsMutex.Lock()
-	defer fake.getPromotionsMutex.Unlock()
-	fake.GetPromotionsStub = nil
-	fake.getPromotionsReturns = struct {
-		result1 []types.Promotion
+func (fake *FakePersistent) UserPromotionRevokeReturns(result1 types.UserPromotion, result2 error) {
+	fake.userPromotionRevokeMutex.Lock()
+	defer fake.userPromotionRevokeMutex.Unlock()
+	fake.UserPromotionRevokeStub = nil
+	fake.userPromotionRevokeReturns = struct {
+		result1 types.UserPromotion
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) GetPromotionsReturnsOnCall(i int, result1 []types.Promotion, result2 error) {
-	fake.getPromotionsMutex.Lock()
-	defer fake.getPromotionsMutex.Unlock()
-	fake.GetPromotionsStub = nil
-	if fake.getPromotionsReturnsOnCall == nil {
-		fake.getPromotionsReturnsOnCall = make(map[int]struct {
-			result1 []types.Promotion
+func (fake *FakePersistent) UserPromotionRevokeReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
+	fake.userPromotionRevokeMutex.Lock()
+	defer fake.userPromotionRevokeMutex.Unlock()
+	fake.UserPromotionRevokeStub = nil
+	if fake.userPromotionRevokeReturnsOnCall == nil {
+		fake.userPromotionRevokeReturnsOnCall = make(map[int]struct {
+			result1 types.UserPromotion
 			result2 error
 		})
 	}
-	fake.getPromotionsReturnsOnCall[i] = struct {
-		result1 []types.Promotion
+	fake.userPromotionRevokeReturnsOnCall[i] = struct {
+		result1 types.UserPromotion
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) GetUserPromotionByID(arg1 context.Context, arg2 uuid.UUID) (types.UserPromotion, error) {
-	fake.getUserPromotionByIDMutex.Lock()
-	ret, specificReturn := fake.getUserPromotionByIDReturnsOnCall[len(fake.getUserPromotionByIDArgsForCall)]
-	fake.getUserPromotionByIDArgsForCall = append(fake.getUserPromotionByIDArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionSettle(arg1 context.Context, arg2 types.UserPromotion) error {
+	fake.userPromotionSettleMutex.Lock()
+	ret, specificReturn := fake.userPromotionSettleReturnsOnCall[len(fake.userPromotionSettleArgsForCall)]
+	fake.userPromotionSettleArgsForCall = append(fake.userPromotionSettleArgsForCall, struct {
 		arg1 context.Context
-		arg2 uuid.UUID
+		arg2 types.UserPromotion
 	}{arg1, arg2})
-	stub := fake.GetUserPromotionByIDStub
-	fakeReturns := fake.getUserPromotionByIDReturns
-	fake.recordInvocation("GetUserPromotionByID", []interface{}{arg1, arg2})
-	fake.getUserPromotionByIDMutex.Unlock()
+	stub := fake.UserPromotionSettleStub
+	fakeReturns := fake.userPromotionSettleReturns
+	fake.recordInvocation("UserPromotionSettle", []interface{}{arg1, arg2})
+	fake.userPromotionSettleMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakePersistent) GetUserPromotionByIDCallCount() int {
-	fake.getUserPromotionByIDMutex.RLock()
-	defer fake.getUserPromotionByIDMutex.RUnlock()
-	return len(fake.getUserPromotionByIDArgsForCall)
+func (fake *FakePersistent) UserPromotionSettleCallCount() int {
+	fake.userPromotionSettleMutex.RLock()
+	defer fake.userPromotionSettleMutex.RUnlock()
+	return len(fake.userPromotionSettleArgsForCall)
 }
 
-func (fake *FakePersistent) GetUserPromotionByIDCalls(stub func(context.Context, uuid.UUID) (types.UserPromotion, error)) {
-	fake.getUserPromotionByIDMutex.Lock()
-	defer fake.getUserPromotionByIDMutex.Unlock()
-	fake.GetUserPromotionByIDStub = stub
+func (fake *FakePersistent) UserPromotionSettleCalls(stub func(context.Context, types.UserPromotion) error) {
+	fake.userPromotionSettleMutex.Lock()
+	defer fake.userPromotionSettleMutex.Unlock()
+	fake.UserPromotionSettleStub = stub
 }
 
-func (fake *FakePersistent) GetUserPromotionByIDArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.getUserPromotionByIDMutex.RLock()
-	defer fake.getUserPromotionByIDMutex.RUnlock()
-	argsForCall := fake.getUserPromotionByIDArgsForCall[i]
+func (fake *FakePersistent) UserPromotionSettleArgsForCall(i int) (context.Context, types.UserPromotion) {
+	fake.userPromotionSettleMutex.RLock()
+	defer fake.userPromotionSettleMutex.RUnlock()
+	argsForCall := fake.userPromotionSettleArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) GetUserPromotionByIDReturns(result1 types.UserPromotion, result2 error) {
-	fake.getUserPromotionByIDMutex.Lock()
-	defer fake.getUserPromotionByIDMutex.Unlock()
-	fake.GetUserPromotionByIDStub = nil
-	fake.getUserPromotionByIDReturns = struct {
-		result1 types.UserPromotion
-		result2 error
-	}{result1, result2}
+func (fake *FakePersistent) UserPromotionSettleReturns(result1 error) {
+	fake.userPromotionSettleMutex.Lock()
+	defer fake.userPromotionSettleMutex.Unlock()
+	fake.UserPromotionSettleStub = nil
+	fake.userPromotionSettleReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakePersistent) GetUserPromotionByIDReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
-	fake.getUserPromotionByIDMutex.Lock()
-	defer fake.getUserPromotionByIDMutex.Unlock()
-	fake.GetUserPromotionByIDStub = nil
-	if fake.getUserPromotionByIDReturnsOnCall == nil {
-		fake.getUserPromotionByIDReturnsOnCall = make(map[int]struct {
-			result1 types.UserPromotion
-			result2 error
+func (fake *FakePersistent) UserPromotionSettleReturnsOnCall(i int, result1 error) {
+	fake.userPromotionSettleMutex.Lock()
+	defer fake.userPromotionSettleMutex.Unlock()
+	fake.UserPromotionSettleStub = nil
+	if fake.userPromotionSettleReturnsOnCall == nil {
+		fake.userPromotionSettleReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getUserPromotionByIDReturnsOnCall[i] = struct {
-		result1 types.UserPromotion
-		result2 error
-	}{result1, result2}
+	fake.userPromotionSettleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakePersistent) GetUserPromotions(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotion, error) {
-	fake.getUserPromotionsMutex.Lock()
-	ret, specificReturn := fake.getUserPromotionsReturnsOnCall[len(fake.getUserPromotionsArgsForCall)]
-	fake.getUserPromotionsArgsForCall = append(fake.getUserPromotionsArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionsExpire(arg1 context.Context, arg2 time.Time) (int, error) {
+	fake.userPromotionsExpireMutex.Lock()
+	ret, specificReturn := fake.userPromotionsExpireReturnsOnCall[len(fake.userPromotionsExpireArgsForCall)]
+	fake.userPromotionsExpireArgsForCall = append(fake.userPromotionsExpireArgsForCall, struct {
 		arg1 context.Context
-		arg2 uuid.UUID
+		arg2 time.Time
 	}{arg1, arg2})
-	stub := fake.GetUserPromotionsStub
-	fakeReturns := fake.getUserPromotionsReturns
-	fake.recordInvocation("GetUserPromotions", []interface{}{arg1, arg2})
-	fake.getUserPromotionsMutex.Unlock()
+	stub := fake.UserPromotionsExpireStub
+	fakeReturns := fake.userPromotionsExpireReturns
+	fake.recordInvocation("UserPromotionsExpire", []interface{}{arg1, arg2})
+	fake.userPromotionsExpireMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -678,63 +8296,65 @@ func (fake *FakePersistent) GetUserPromotions(arg1 context.Context, arg2 uuid.UU
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) GetUserPromotionsCallCount() int {
-	fake.getUserPromotionsMutex.RLock()
-	defer fake.getUserPromotionsMutex.RUnlock()
-	return len(fake.getUserPromotionsArgsForCall)
+func (fake *FakePersistent) UserPromotionsExpireCallCount() int {
+	fake.userPromotionsExpireMutex.RLock()
+	defer fake.userPromotionsExpireMutex.RUnlock()
+	return len(fake.userPromotionsExpireArgsForCall)
 }
 
-func (fake *FakePersistent) GetUserPromotionsCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotion, error)) {
-	fake.getUserPromotionsMutex.Lock()
-	defer fake.getUserPromotionsMutex.Unlock()
-	fake.GetUserPromotionsStub = stub
+func (fake *FakePersistent) UserPromotionsExpireCalls(stub func(context.Context, time.Time) (int, error)) {
+	fake.userPromotionsExpireMutex.Lock()
+	defer fake.userPromotionsExpireMutex.Unlock()
+	fake.UserPromotionsExpireStub = stub
 }
 
-func (fake *FakePersistent) GetUserPromotionsArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.getUserPromotionsMutex.RLock()
-	defer fake.getUserPromotionsMutex.RUnlock()
-	argsForCall := fake.getUserPromotionsArgsForCall[i]
+func (fake *FakePersistent) UserPromotionsExpireArgsForCall(i int) (context.Context, time.Time) {
+	fake.userPromotionsExpireMutex.RLock()
+	defer fake.userPromotionsExpireMutex.RUnlock()
+	argsForCall := fake.userPromotionsExpireArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) GetUserPromotionsReturns(result1 []types.UserPromotion, result2 error) {
-	fake.getUserPromotionsMutex.Lock()
-	defer fake.getUserPromotionsMutex.Unlock()
-	fake.GetUserPromotionsStub = nil
-	fake.getUserPromotionsReturns = struct {
-		result1 []types.UserPromotion
+func (fake *FakePersistent) UserPromotionsExpireReturns(result1 int, result2 error) {
+	fake.userPromotionsExpireMutex.Lock()
+	defer fake.userPromotionsExpireMutex.Unlock()
+	fake.UserPromotionsExpireStub = nil
+	fake.userPromotionsExpireReturns = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) GetUserPromotionsReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
-	fake.getUserPromotionsMutex.Lock()
-	defer fake.getUserPromotionsMutex.Unlock()
-	fake.GetUserPromotionsStub = nil
-	if fake.getUserPromotionsReturnsOnCall == nil {
-		fake.getUserPromotionsReturnsOnCall = make(map[int]struct {
-			result1 []types.UserPromotion
+func (fake *FakePersistent) UserPromotionsExpireReturnsOnCall(i int, result1 int, result2 error) {
+	fake.userPromotionsExpireMutex.Lock()
+	defer fake.userPromotionsExpireMutex.Unlock()
+	fake.UserPromotionsExpireStub = nil
+	if fake.userPromotionsExpireReturnsOnCall == nil {
+		fake.userPromotionsExpireReturnsOnCall = make(map[int]struct {
+			result1 int
 			result2 error
 		})
-	}
-	fake.getUserPromotionsReturnsOnCall[i] = struct {
-		result1 []types.UserPromotion
+	}
+	fake.userPromotionsExpireReturnsOnCall[i] = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) GetUsers(arg1 context.Context) ([]types.User, error) {
-	fake.getUsersMutex.Lock()
-	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
-	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionsRemind(arg1 context.Context, arg2 time.Time, arg3 time.Duration) ([]types.PromotionExpiryReminder, error) {
+	fake.userPromotionsRemindMutex.Lock()
+	ret, specificReturn := fake.userPromotionsRemindReturnsOnCall[len(fake.userPromotionsRemindArgsForCall)]
+	fake.userPromotionsRemindArgsForCall = append(fake.userPromotionsRemindArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.GetUsersStub
-	fakeReturns := fake.getUsersReturns
-	fake.recordInvocation("GetUsers", []interface{}{arg1})
-	fake.getUsersMutex.Unlock()
+		arg2 time.Time
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionsRemindStub
+	fakeReturns := fake.userPromotionsRemindReturns
+	fake.recordInvocation("UserPromotionsRemind", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionsRemindMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -742,64 +8362,65 @@ func (fake *FakePersistent) GetUsers(arg1 context.Context) ([]types.User, error)
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) GetUsersCallCount() int {
-	fake.getUsersMutex.RLock()
-	defer fake.getUsersMutex.RUnlock()
-	return len(fake.getUsersArgsForCall)
+func (fake *FakePersistent) UserPromotionsRemindCallCount() int {
+	fake.userPromotionsRemindMutex.RLock()
+	defer fake.userPromotionsRemindMutex.RUnlock()
+	return len(fake.userPromotionsRemindArgsForCall)
 }
 
-func (fake *FakePersistent) GetUsersCalls(stub func(context.Context) ([]types.User, error)) {
-	fake.getUsersMutex.Lock()
-	defer fake.getUsersMutex.Unlock()
-	fake.GetUsersStub = stub
+func (fake *FakePersistent) UserPromotionsRemindCalls(stub func(context.Context, time.Time, time.Duration) ([]types.PromotionExpiryReminder, error)) {
+	fake.userPromotionsRemindMutex.Lock()
+	defer fake.userPromotionsRemindMutex.Unlock()
+	fake.UserPromotionsRemindStub = stub
 }
 
-func (fake *FakePersistent) GetUsersArgsForCall(i int) context.Context {
-	fake.getUsersMutex.RLock()
-	defer fake.getUsersMutex.RUnlock()
-	argsForCall := fake.getUsersArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakePersistent) UserPromotionsRemindArgsForCall(i int) (context.Context, time.Time, time.Duration) {
+	fake.userPromotionsRemindMutex.RLock()
+	defer fake.userPromotionsRemindMutex.RUnlock()
+	argsForCall := fake.userPromotionsRemindArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePersistent) GetUsersReturns(result1 []types.User, result2 error) {
-	fake.getUsersMutex.Lock()
-	defer fake.getUsersMutex.Unlock()
-	fake.GetUsersStub = nil
-	fake.getUsersReturns = struct {
-		result1 []types.User
+func (fake *FakePersistent) UserPromotionsRemindReturns(result1 []types.PromotionExpiryReminder, result2 error) {
+	fake.userPromotionsRemindMutex.Lock()
+	defer fake.userPromotionsRemindMutex.Unlock()
+	fake.UserPromotionsRemindStub = nil
+	fake.userPromotionsRemindReturns = struct {
+		result1 []types.PromotionExpiryReminder
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) GetUsersReturnsOnCall(i int, result1 []types.User, result2 error) {
-	fake.getUsersMutex.Lock()
-	defer fake.getUsersMutex.Unlock()
-	fake.GetUsersStub = nil
-	if fake.getUsersReturnsOnCall == nil {
-		fake.getUsersReturnsOnCall = make(map[int]struct {
-			result1 []types.User
+func (fake *FakePersistent) UserPromotionsRemindReturnsOnCall(i int, result1 []types.PromotionExpiryReminder, result2 error) {
+	fake.userPromotionsRemindMutex.Lock()
+	defer fake.userPromotionsRemindMutex.Unlock()
+	fake.UserPromotionsRemindStub = nil
+	if fake.userPromotionsRemindReturnsOnCall == nil {
+		fake.userPromotionsRemindReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionExpiryReminder
 			result2 error
 		})
 	}
-	fake.getUsersReturnsOnCall[i] = struct {
-		result1 []types.User
+	fake.userPromotionsRemindReturnsOnCall[i] = struct {
+		result1 []types.PromotionExpiryReminder
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionCreate(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
-	fake.promotionCreateMutex.Lock()
-	ret, specificReturn := fake.promotionCreateReturnsOnCall[len(fake.promotionCreateArgsForCall)]
-	fake.promotionCreateArgsForCall = append(fake.promotionCreateArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionsUnwager(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (int, error) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	ret, specificReturn := fake.userPromotionsUnwagerReturnsOnCall[len(fake.userPromotionsUnwagerArgsForCall)]
+	fake.userPromotionsUnwagerArgsForCall = append(fake.userPromotionsUnwagerArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.Promotion
-	}{arg1, arg2})
-	stub := fake.PromotionCreateStub
-	fakeReturns := fake.promotionCreateReturns
-	fake.recordInvocation("PromotionCreate", []interface{}{arg1, arg2})
-	fake.promotionCreateMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionsUnwagerStub
+	fakeReturns := fake.userPromotionsUnwagerReturns
+	fake.recordInvocation("UserPromotionsUnwager", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionsUnwagerMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -807,126 +8428,133 @@ func (fake *FakePersistent) PromotionCreate(arg1 context.Context, arg2 types.Pro
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) PromotionCreateCallCount() int {
-	fake.promotionCreateMutex.RLock()
-	defer fake.promotionCreateMutex.RUnlock()
-	return len(fake.promotionCreateArgsForCall)
+func (fake *FakePersistent) UserPromotionsUnwagerCallCount() int {
+	fake.userPromotionsUnwagerMutex.RLock()
+	defer fake.userPromotionsUnwagerMutex.RUnlock()
+	return len(fake.userPromotionsUnwagerArgsForCall)
 }
 
-func (fake *FakePersistent) PromotionCreateCalls(stub func(context.Context, types.Promotion) (types.Promotion, error)) {
-	fake.promotionCreateMutex.Lock()
-	defer fake.promotionCreateMutex.Unlock()
-	fake.PromotionCreateStub = stub
+func (fake *FakePersistent) UserPromotionsUnwagerCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (int, error)) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	defer fake.userPromotionsUnwagerMutex.Unlock()
+	fake.UserPromotionsUnwagerStub = stub
 }
 
-func (fake *FakePersistent) PromotionCreateArgsForCall(i int) (context.Context, types.Promotion) {
-	fake.promotionCreateMutex.RLock()
-	defer fake.promotionCreateMutex.RUnlock()
-	argsForCall := fake.promotionCreateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserPromotionsUnwagerArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.userPromotionsUnwagerMutex.RLock()
+	defer fake.userPromotionsUnwagerMutex.RUnlock()
+	argsForCall := fake.userPromotionsUnwagerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePersistent) PromotionCreateReturns(result1 types.Promotion, result2 error) {
-	fake.promotionCreateMutex.Lock()
-	defer fake.promotionCreateMutex.Unlock()
-	fake.PromotionCreateStub = nil
-	fake.promotionCreateReturns = struct {
-		result1 types.Promotion
+func (fake *FakePersistent) UserPromotionsUnwagerReturns(result1 int, result2 error) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	defer fake.userPromotionsUnwagerMutex.Unlock()
+	fake.UserPromotionsUnwagerStub = nil
+	fake.userPromotionsUnwagerReturns = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionCreateReturnsOnCall(i int, result1 types.Promotion, result2 error) {
-	fake.promotionCreateMutex.Lock()
-	defer fake.promotionCreateMutex.Unlock()
-	fake.PromotionCreateStub = nil
-	if fake.promotionCreateReturnsOnCall == nil {
-		fake.promotionCreateReturnsOnCall = make(map[int]struct {
-			result1 types.Promotion
+func (fake *FakePersistent) UserPromotionsUnwagerReturnsOnCall(i int, result1 int, result2 error) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	defer fake.userPromotionsUnwagerMutex.Unlock()
+	fake.UserPromotionsUnwagerStub = nil
+	if fake.userPromotionsUnwagerReturnsOnCall == nil {
+		fake.userPromotionsUnwagerReturnsOnCall = make(map[int]struct {
+			result1 int
 			result2 error
 		})
 	}
-	fake.promotionCreateReturnsOnCall[i] = struct {
-		result1 types.Promotion
+	fake.userPromotionsUnwagerReturnsOnCall[i] = struct {
+		result1 int
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionDelete(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.promotionDeleteMutex.Lock()
-	ret, specificReturn := fake.promotionDeleteReturnsOnCall[len(fake.promotionDeleteArgsForCall)]
-	fake.promotionDeleteArgsForCall = append(fake.promotionDeleteArgsForCall, struct {
+func (fake *FakePersistent) UserPromotionsWager(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 types.Money) ([]types.UserPromotion, error) {
+	fake.userPromotionsWagerMutex.Lock()
+	ret, specificReturn := fake.userPromotionsWagerReturnsOnCall[len(fake.userPromotionsWagerArgsForCall)]
+	fake.userPromotionsWagerArgsForCall = append(fake.userPromotionsWagerArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.PromotionDeleteStub
-	fakeReturns := fake.promotionDeleteReturns
-	fake.recordInvocation("PromotionDelete", []interface{}{arg1, arg2})
-	fake.promotionDeleteMutex.Unlock()
+		arg3 uuid.UUID
+		arg4 types.Money
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UserPromotionsWagerStub
+	fakeReturns := fake.userPromotionsWagerReturns
+	fake.recordInvocation("UserPromotionsWager", []interface{}{arg1, arg2, arg3, arg4})
+	fake.userPromotionsWagerMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) PromotionDeleteCallCount() int {
-	fake.promotionDeleteMutex.RLock()
-	defer fake.promotionDeleteMutex.RUnlock()
-	return len(fake.promotionDeleteArgsForCall)
+func (fake *FakePersistent) UserPromotionsWagerCallCount() int {
+	fake.userPromotionsWagerMutex.RLock()
+	defer fake.userPromotionsWagerMutex.RUnlock()
+	return len(fake.userPromotionsWagerArgsForCall)
 }
 
-func (fake *FakePersistent) PromotionDeleteCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.promotionDeleteMutex.Lock()
-	defer fake.promotionDeleteMutex.Unlock()
-	fake.PromotionDeleteStub = stub
+func (fake *FakePersistent) UserPromotionsWagerCalls(stub func(context.Context, uuid.UUID, uuid.UUID, types.Money) ([]types.UserPromotion, error)) {
+	fake.userPromotionsWagerMutex.Lock()
+	defer fake.userPromotionsWagerMutex.Unlock()
+	fake.UserPromotionsWagerStub = stub
 }
 
-func (fake *FakePersistent) PromotionDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.promotionDeleteMutex.RLock()
-	defer fake.promotionDeleteMutex.RUnlock()
-	argsForCall := fake.promotionDeleteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserPromotionsWagerArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, types.Money) {
+	fake.userPromotionsWagerMutex.RLock()
+	defer fake.userPromotionsWagerMutex.RUnlock()
+	argsForCall := fake.userPromotionsWagerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakePersistent) PromotionDeleteReturns(result1 error) {
-	fake.promotionDeleteMutex.Lock()
-	defer fake.promotionDeleteMutex.Unlock()
-	fake.PromotionDeleteStub = nil
-	fake.promotionDeleteReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakePersistent) UserPromotionsWagerReturns(result1 []types.UserPromotion, result2 error) {
+	fake.userPromotionsWagerMutex.Lock()
+	defer fake.userPromotionsWagerMutex.Unlock()
+	fake.UserPromotionsWagerStub = nil
+	fake.userPromotionsWagerReturns = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionDeleteReturnsOnCall(i int, result1 error) {
-	fake.promotionDeleteMutex.Lock()
-	defer fake.promotionDeleteMutex.Unlock()
-	fake.PromotionDeleteStub = nil
-	if fake.promotionDeleteReturnsOnCall == nil {
-		fake.promotionDeleteReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakePersistent) UserPromotionsWagerReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
+	fake.userPromotionsWagerMutex.Lock()
+	defer fake.userPromotionsWagerMutex.Unlock()
+	fake.UserPromotionsWagerStub = nil
+	if fake.userPromotionsWagerReturnsOnCall == nil {
+		fake.userPromotionsWagerReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotion
+			result2 error
 		})
 	}
-	fake.promotionDeleteReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.userPromotionsWagerReturnsOnCall[i] = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionGetByID(arg1 context.Context, arg2 uuid.UUID) (types.Promotion, error) {
-	fake.promotionGetByIDMutex.Lock()
-	ret, specificReturn := fake.promotionGetByIDReturnsOnCall[len(fake.promotionGetByIDArgsForCall)]
-	fake.promotionGetByIDArgsForCall = append(fake.promotionGetByIDArgsForCall, struct {
+func (fake *FakePersistent) UserTiersDemote(arg1 context.Context, arg2 uuid.NullUUID, arg3 time.Time, arg4 time.Time) ([]types.TierChange, error) {
+	fake.userTiersDemoteMutex.Lock()
+	ret, specificReturn := fake.userTiersDemoteReturnsOnCall[len(fake.userTiersDemoteArgsForCall)]
+	fake.userTiersDemoteArgsForCall = append(fake.userTiersDemoteArgsForCall, struct {
 		arg1 context.Context
-		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.PromotionGetByIDStub
-	fakeReturns := fake.promotionGetByIDReturns
-	fake.recordInvocation("PromotionGetByID", []interface{}{arg1, arg2})
-	fake.promotionGetByIDMutex.Unlock()
+		arg2 uuid.NullUUID
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UserTiersDemoteStub
+	fakeReturns := fake.userTiersDemoteReturns
+	fake.recordInvocation("UserTiersDemote", []interface{}{arg1, arg2, arg3, arg4})
+	fake.userTiersDemoteMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -934,64 +8562,65 @@ func (fake *FakePersistent) PromotionGetByID(arg1 context.Context, arg2 uuid.UUI
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) PromotionGetByIDCallCount() int {
-	fake.promotionGetByIDMutex.RLock()
-	defer fake.promotionGetByIDMutex.RUnlock()
-	return len(fake.promotionGetByIDArgsForCall)
+func (fake *FakePersistent) UserTiersDemoteCallCount() int {
+	fake.userTiersDemoteMutex.RLock()
+	defer fake.userTiersDemoteMutex.RUnlock()
+	return len(fake.userTiersDemoteArgsForCall)
 }
 
-func (fake *FakePersistent) PromotionGetByIDCalls(stub func(context.Context, uuid.UUID) (types.Promotion, error)) {
-	fake.promotionGetByIDMutex.Lock()
-	defer fake.promotionGetByIDMutex.Unlock()
-	fake.PromotionGetByIDStub = stub
+func (fake *FakePersistent) UserTiersDemoteCalls(stub func(context.Context, uuid.NullUUID, time.Time, time.Time) ([]types.TierChange, error)) {
+	fake.userTiersDemoteMutex.Lock()
+	defer fake.userTiersDemoteMutex.Unlock()
+	fake.UserTiersDemoteStub = stub
 }
 
-func (fake *FakePersistent) PromotionGetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.promotionGetByIDMutex.RLock()
-	defer fake.promotionGetByIDMutex.RUnlock()
-	argsForCall := fake.promotionGetByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserTiersDemoteArgsForCall(i int) (context.Context, uuid.NullUUID, time.Time, time.Time) {
+	fake.userTiersDemoteMutex.RLock()
+	defer fake.userTiersDemoteMutex.RUnlock()
+	argsForCall := fake.userTiersDemoteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakePersistent) PromotionGetByIDReturns(result1 types.Promotion, result2 error) {
-	fake.promotionGetByIDMutex.Lock()
-	defer fake.promotionGetByIDMutex.Unlock()
-	fake.PromotionGetByIDStub = nil
-	fake.promotionGetByIDReturns = struct {
-		result1 types.Promotion
+func (fake *FakePersistent) UserTiersDemoteReturns(result1 []types.TierChange, result2 error) {
+	fake.userTiersDemoteMutex.Lock()
+	defer fake.userTiersDemoteMutex.Unlock()
+	fake.UserTiersDemoteStub = nil
+	fake.userTiersDemoteReturns = struct {
+		result1 []types.TierChange
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionGetByIDReturnsOnCall(i int, result1 types.Promotion, result2 error) {
-	fake.promotionGetByIDMutex.Lock()
-	defer fake.promotionGetByIDMutex.Unlock()
-	fake.PromotionGetByIDStub = nil
-	if fake.promotionGetByIDReturnsOnCall == nil {
-		fake.promotionGetByIDReturnsOnCall = make(map[int]struct {
-			result1 types.Promotion
+func (fake *FakePersistent) UserTiersDemoteReturnsOnCall(i int, result1 []types.TierChange, result2 error) {
+	fake.userTiersDemoteMutex.Lock()
+	defer fake.userTiersDemoteMutex.Unlock()
+	fake.UserTiersDemoteStub = nil
+	if fake.userTiersDemoteReturnsOnCall == nil {
+		fake.userTiersDemoteReturnsOnCall = make(map[int]struct {
+			result1 []types.TierChange
 			result2 error
 		})
 	}
-	fake.promotionGetByIDReturnsOnCall[i] = struct {
-		result1 types.Promotion
+	fake.userTiersDemoteReturnsOnCall[i] = struct {
+		result1 []types.TierChange
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionGetByType(arg1 context.Context, arg2 types.PromotionType) (types.Promotion, error) {
-	fake.promotionGetByTypeMutex.Lock()
-	ret, specificReturn := fake.promotionGetByTypeReturnsOnCall[len(fake.promotionGetByTypeArgsForCall)]
-	fake.promotionGetByTypeArgsForCall = append(fake.promotionGetByTypeArgsForCall, struct {
+func (fake *FakePersistent) UserTiersQualify(arg1 context.Context, arg2 uuid.NullUUID, arg3 time.Time) ([]types.TierChange, error) {
+	fake.userTiersQualifyMutex.Lock()
+	ret, specificReturn := fake.userTiersQualifyReturnsOnCall[len(fake.userTiersQualifyArgsForCall)]
+	fake.userTiersQualifyArgsForCall = append(fake.userTiersQualifyArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.PromotionType
-	}{arg1, arg2})
-	stub := fake.PromotionGetByTypeStub
-	fakeReturns := fake.promotionGetByTypeReturns
-	fake.recordInvocation("PromotionGetByType", []interface{}{arg1, arg2})
-	fake.promotionGetByTypeMutex.Unlock()
+		arg2 uuid.NullUUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.UserTiersQualifyStub
+	fakeReturns := fake.userTiersQualifyReturns
+	fake.recordInvocation("UserTiersQualify", []interface{}{arg1, arg2, arg3})
+	fake.userTiersQualifyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -999,64 +8628,66 @@ func (fake *FakePersistent) PromotionGetByType(arg1 context.Context, arg2 types.
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) PromotionGetByTypeCallCount() int {
-	fake.promotionGetByTypeMutex.RLock()
-	defer fake.promotionGetByTypeMutex.RUnlock()
-	return len(fake.promotionGetByTypeArgsForCall)
+func (fake *FakePersistent) UserTiersQualifyCallCount() int {
+	fake.userTiersQualifyMutex.RLock()
+	defer fake.userTiersQualifyMutex.RUnlock()
+	return len(fake.userTiersQualifyArgsForCall)
 }
 
-func (fake *FakePersistent) PromotionGetByTypeCalls(stub func(context.Context, types.PromotionType) (types.Promotion, error)) {
-	fake.promotionGetByTypeMutex.Lock()
-	defer fake.promotionGetByTypeMutex.Unlock()
-	fake.PromotionGetByTypeStub = stub
+func (fake *FakePersistent) UserTiersQualifyCalls(stub func(context.Context, uuid.NullUUID, time.Time) ([]types.TierChange, error)) {
+	fake.userTiersQualifyMutex.Lock()
+	defer fake.userTiersQualifyMutex.Unlock()
+	fake.UserTiersQualifyStub = stub
 }
 
-func (fake *FakePersistent) PromotionGetByTypeArgsForCall(i int) (context.Context, types.PromotionType) {
-	fake.promotionGetByTypeMutex.RLock()
-	defer fake.promotionGetByTypeMutex.RUnlock()
-	argsForCall := fake.promotionGetByTypeArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserTiersQualifyArgsForCall(i int) (context.Context, uuid.NullUUID, time.Time) {
+	fake.userTiersQualifyMutex.RLock()
+	defer fake.userTiersQualifyMutex.RUnlock()
+	argsForCall := fake.userTiersQualifyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePersistent) PromotionGetByTypeReturns(result1 types.Promotion, result2 error) {
-	fake.promotionGetByTypeMutex.Lock()
-	defer fake.promotionGetByTypeMutex.Unlock()
-	fake.PromotionGetByTypeStub = nil
-	fake.promotionGetByTypeReturns = struct {
-		result1 types.Promotion
+func (fake *FakePersistent) UserTiersQualifyReturns(result1 []types.TierChange, result2 error) {
+	fake.userTiersQualifyMutex.Lock()
+	defer fake.userTiersQualifyMutex.Unlock()
+	fake.UserTiersQualifyStub = nil
+	fake.userTiersQualifyReturns = struct {
+		result1 []types.TierChange
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionGetByTypeReturnsOnCall(i int, result1 types.Promotion, result2 error) {
-	fake.promotionGetByTypeMutex.Lock()
-	defer fake.promotionGetByTypeMutex.Unlock()
-	fake.PromotionGetByTypeStub = nil
-	if fake.promotionGetByTypeReturnsOnCall == nil {
-		fake.promotionGetByTypeReturnsOnCall = make(map[int]struct {
-			result1 types.Promotion
+func (fake *FakePersistent) UserTiersQualifyReturnsOnCall(i int, result1 []types.TierChange, result2 error) {
+	fake.userTiersQualifyMutex.Lock()
+	defer fake.userTiersQualifyMutex.Unlock()
+	fake.UserTiersQualifyStub = nil
+	if fake.userTiersQualifyReturnsOnCall == nil {
+		fake.userTiersQualifyReturnsOnCall = make(map[int]struct {
+			result1 []types.TierChange
 			result2 error
 		})
 	}
-	fake.promotionGetByTypeReturnsOnCall[i] = struct {
-		result1 types.Promotion
+	fake.userTiersQualifyReturnsOnCall[i] = struct {
+		result1 []types.TierChange
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionUpdate(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
-	fake.promotionUpdateMutex.Lock()
-	ret, specificReturn := fake.promotionUpdateReturnsOnCall[len(fake.promotionUpdateArgsForCall)]
-	fake.promotionUpdateArgsForCall = append(fake.promotionUpdateArgsForCall, struct {
+func (fake *FakePersistent) UserTiersWarn(arg1 context.Context, arg2 uuid.NullUUID, arg3 time.Time, arg4 time.Time) ([]types.TierWarning, error) {
+	fake.userTiersWarnMutex.Lock()
+	ret, specificReturn := fake.userTiersWarnReturnsOnCall[len(fake.userTiersWarnArgsForCall)]
+	fake.userTiersWarnArgsForCall = append(fake.userTiersWarnArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.Promotion
-	}{arg1, arg2})
-	stub := fake.PromotionUpdateStub
-	fakeReturns := fake.promotionUpdateReturns
-	fake.recordInvocation("PromotionUpdate", []interface{}{arg1, arg2})
-	fake.promotionUpdateMutex.Unlock()
+		arg2 uuid.NullUUID
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UserTiersWarnStub
+	fakeReturns := fake.userTiersWarnReturns
+	fake.recordInvocation("UserTiersWarn", []interface{}{arg1, arg2, arg3, arg4})
+	fake.userTiersWarnMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1064,124 +8695,128 @@ func (fake *FakePersistent) PromotionUpdate(arg1 context.Context, arg2 types.Pro
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) PromotionUpdateCallCount() int {
-	fake.promotionUpdateMutex.RLock()
-	defer fake.promotionUpdateMutex.RUnlock()
-	return len(fake.promotionUpdateArgsForCall)
+func (fake *FakePersistent) UserTiersWarnCallCount() int {
+	fake.userTiersWarnMutex.RLock()
+	defer fake.userTiersWarnMutex.RUnlock()
+	return len(fake.userTiersWarnArgsForCall)
 }
 
-func (fake *FakePersistent) PromotionUpdateCalls(stub func(context.Context, types.Promotion) (types.Promotion, error)) {
-	fake.promotionUpdateMutex.Lock()
-	defer fake.promotionUpdateMutex.Unlock()
-	fake.PromotionUpdateStub = stub
+func (fake *FakePersistent) UserTiersWarnCalls(stub func(context.Context, uuid.NullUUID, time.Time, time.Time) ([]types.TierWarning, error)) {
+	fake.userTiersWarnMutex.Lock()
+	defer fake.userTiersWarnMutex.Unlock()
+	fake.UserTiersWarnStub = stub
 }
 
-func (fake *FakePersistent) PromotionUpdateArgsForCall(i int) (context.Context, types.Promotion) {
-	fake.promotionUpdateMutex.RLock()
-	defer fake.promotionUpdateMutex.RUnlock()
-	argsForCall := fake.promotionUpdateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserTiersWarnArgsForCall(i int) (context.Context, uuid.NullUUID, time.Time, time.Time) {
+	fake.userTiersWarnMutex.RLock()
+	defer fake.userTiersWarnMutex.RUnlock()
+	argsForCall := fake.userTiersWarnArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakePersistent) PromotionUpdateReturns(result1 types.Promotion, result2 error) {
-	fake.promotionUpdateMutex.Lock()
-	defer fake.promotionUpdateMutex.Unlock()
-	fake.PromotionUpdateStub = nil
-	fake.promotionUpdateReturns = struct {
-		result1 types.Promotion
+func (fake *FakePersistent) UserTiersWarnReturns(result1 []types.TierWarning, result2 error) {
+	fake.userTiersWarnMutex.Lock()
+	defer fake.userTiersWarnMutex.Unlock()
+	fake.UserTiersWarnStub = nil
+	fake.userTiersWarnReturns = struct {
+		result1 []types.TierWarning
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) PromotionUpdateReturnsOnCall(i int, result1 types.Promotion, result2 error) {
-	fake.promotionUpdateMutex.Lock()
-	defer fake.promotionUpdateMutex.Unlock()
-	fake.PromotionUpdateStub = nil
-	if fake.promotionUpdateReturnsOnCall == nil {
-		fake.promotionUpdateReturnsOnCall = make(map[int]struct {
-			result1 types.Promotion
+func (fake *FakePersistent) UserTiersWarnReturnsOnCall(i int, result1 []types.TierWarning, result2 error) {
+	fake.userTiersWarnMutex.Lock()
+	defer fake.userTiersWarnMutex.Unlock()
+	fake.UserTiersWarnStub = nil
+	if fake.userTiersWarnReturnsOnCall == nil {
+		fake.userTiersWarnReturnsOnCall = make(map[int]struct {
+			result1 []types.TierWarning
 			result2 error
 		})
 	}
-	fake.promotionUpdateReturnsOnCall[i] = struct {
-		result1 types.Promotion
+	fake.userTiersWarnReturnsOnCall[i] = struct {
+		result1 []types.TierWarning
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) RollbackTx(arg1 context.Context) error {
-	fake.rollbackTxMutex.Lock()
-	ret, specificReturn := fake.rollbackTxReturnsOnCall[len(fake.rollbackTxArgsForCall)]
-	fake.rollbackTxArgsForCall = append(fake.rollbackTxArgsForCall, struct {
+func (fake *FakePersistent) UserUpdate(arg1 context.Context, arg2 types.User) (types.User, error) {
+	fake.userUpdateMutex.Lock()
+	ret, specificReturn := fake.userUpdateReturnsOnCall[len(fake.userUpdateArgsForCall)]
+	fake.userUpdateArgsForCall = append(fake.userUpdateArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.RollbackTxStub
-	fakeReturns := fake.rollbackTxReturns
-	fake.recordInvocation("RollbackTx", []interface{}{arg1})
-	fake.rollbackTxMutex.Unlock()
+		arg2 types.User
+	}{arg1, arg2})
+	stub := fake.UserUpdateStub
+	fakeReturns := fake.userUpdateReturns
+	fake.recordInvocation("UserUpdate", []interface{}{arg1, arg2})
+	fake.userUpdateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
-}
-
-func (fake *FakePersistent) RollbackTxCallCount() int {
-	fake.rollbackTxMutex.RLock()
-	defer fake.rollbackTxMutex.RUnlock()
-	return len(fake.rollbackTxArgsForCall)
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) RollbackTxCalls(stub func(context.Context) error) {
-	fake.rollbackTxMutex.Lock()
-	defer fake.rollbackTxMutex.Unlock()
-	fake.RollbackTxStub = stub
+func (fake *FakePersistent) UserUpdateCallCount() int {
+	fake.userUpdateMutex.RLock()
+	defer fake.userUpdateMutex.RUnlock()
+	return len(fake.userUpdateArgsForCall)
 }
 
-func (fake *FakePersistent) RollbackTxArgsForCall(i int) context.Context {
-	fake.rollbackTxMutex.RLock()
-	defer fake.rollbackTxMutex.RUnlock()
-	argsForCall := fake.rollbackTxArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakePersistent) UserUpdateCalls(stub func(context.Context, types.User) (types.User, error)) {
+	fake.userUpdateMutex.Lock()
+	defer fake.userUpdateMutex.Unlock()
+	fake.UserUpdateStub = stub
 }
 
-func (fake *FakePersistent) RollbackTxReturns(result1 error) {
-	fake.rollbackTxMutex.Lock()
-	defer fake.rollbackTxMutex.Unlock()
-	fake.RollbackTxStub = nil
-	fake.rollbackTxReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakePersistent) UserUpdateArgsForCall(i int) (context.Context, types.User) {
+	fake.userUpdateMutex.RLock()
+	defer fake.userUpdateMutex.RUnlock()
+	argsForCall := fake.userUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) RollbackTxReturnsOnCall(i int, result1 error) {
-	fake.rollbackTxMutex.Lock()
-	defer fake.rollbackTxMutex.Unlock()
-	fake.RollbackTxStub = nil
-	if fake.rollbackTxReturnsOnCall == nil {
-		fake.rollbackTxReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakePersistent) UserUpdateReturns(result1 types.User, result2 error) {
+	fake.userUpdateMutex.Lock()
+	defer fake.userUpdateMutex.Unlock()
+	fake.UserUpdateStub = nil
+	fake.userUpdateReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePersistent) UserUpdateReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userUpdateMutex.Lock()
+	defer fake.userUpdateMutex.Unlock()
+	fake.UserUpdateStub = nil
+	if fake.userUpdateReturnsOnCall == nil {
+		fake.userUpdateReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
 		})
 	}
-	fake.rollbackTxReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.userUpdateReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserBalanceUpdate(arg1 context.Context, arg2 uuid.UUID, arg3 float64) (types.User, error) {
-	fake.userBalanceUpdateMutex.Lock()
-	ret, specificReturn := fake.userBalanceUpdateReturnsOnCall[len(fake.userBalanceUpdateArgsForCall)]
-	fake.userBalanceUpdateArgsForCall = append(fake.userBalanceUpdateArgsForCall, struct {
+func (fake *FakePersistent) UserWelcomePackageCreate(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (bool, error) {
+	fake.userWelcomePackageCreateMutex.Lock()
+	ret, specificReturn := fake.userWelcomePackageCreateReturnsOnCall[len(fake.userWelcomePackageCreateArgsForCall)]
+	fake.userWelcomePackageCreateArgsForCall = append(fake.userWelcomePackageCreateArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-		arg3 float64
+		arg3 uuid.UUID
 	}{arg1, arg2, arg3})
-	stub := fake.UserBalanceUpdateStub
-	fakeReturns := fake.userBalanceUpdateReturns
-	fake.recordInvocation("UserBalanceUpdate", []interface{}{arg1, arg2, arg3})
-	fake.userBalanceUpdateMutex.Unlock()
+	stub := fake.UserWelcomePackageCreateStub
+	fakeReturns := fake.userWelcomePackageCreateReturns
+	fake.recordInvocation("UserWelcomePackageCreate", []interface{}{arg1, arg2, arg3})
+	fake.userWelcomePackageCreateMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -1191,62 +8826,62 @@ func (fake *FakePersistent) UserBalanceUpdate(arg1 context.Context, arg2 uuid.UU
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) UserBalanceUpdateCallCount() int {
-	fake.userBalanceUpdateMutex.RLock()
-	defer fake.userBalanceUpdateMutex.RUnlock()
-	return len(fake.userBalanceUpdateArgsForCall)
+func (fake *FakePersistent) UserWelcomePackageCreateCallCount() int {
+	fake.userWelcomePackageCreateMutex.RLock()
+	defer fake.userWelcomePackageCreateMutex.RUnlock()
+	return len(fake.userWelcomePackageCreateArgsForCall)
 }
 
-func (fake *FakePersistent) UserBalanceUpdateCalls(stub func(context.Context, uuid.UUID, float64) (types.User, error)) {
-	fake.userBalanceUpdateMutex.Lock()
-	defer fake.userBalanceUpdateMutex.Unlock()
-	fake.UserBalanceUpdateStub = stub
+func (fake *FakePersistent) UserWelcomePackageCreateCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) {
+	fake.userWelcomePackageCreateMutex.Lock()
+	defer fake.userWelcomePackageCreateMutex.Unlock()
+	fake.UserWelcomePackageCreateStub = stub
 }
 
-func (fake *FakePersistent) UserBalanceUpdateArgsForCall(i int) (context.Context, uuid.UUID, float64) {
-	fake.userBalanceUpdateMutex.RLock()
-	defer fake.userBalanceUpdateMutex.RUnlock()
-	argsForCall := fake.userBalanceUpdateArgsForCall[i]
+func (fake *FakePersistent) UserWelcomePackageCreateArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.userWelcomePackageCreateMutex.RLock()
+	defer fake.userWelcomePackageCreateMutex.RUnlock()
+	argsForCall := fake.userWelcomePackageCreateArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePersistent) UserBalanceUpdateReturns(result1 types.User, result2 error) {
-	fake.userBalanceUpdateMutex.Lock()
-	defer fake.userBalanceUpdateMutex.Unlock()
-	fake.UserBalanceUpdateStub = nil
-	fake.userBalanceUpdateReturns = struct {
-		result1 types.User
+func (fake *FakePersistent) UserWelcomePackageCreateReturns(result1 bool, result2 error) {
+	fake.userWelcomePackageCreateMutex.Lock()
+	defer fake.userWelcomePackageCreateMutex.Unlock()
+	fake.UserWelcomePackageCreateStub = nil
+	fake.userWelcomePackageCreateReturns = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserBalanceUpdateReturnsOnCall(i int, result1 types.User, result2 error) {
-	fake.userBalanceUpdateMutex.Lock()
-	defer fake.userBalanceUpdateMutex.Unlock()
-	fake.UserBalanceUpdateStub = nil
-	if fake.userBalanceUpdateReturnsOnCall == nil {
-		fake.userBalanceUpdateReturnsOnCall = make(map[int]struct {
-			result1 types.User
+func (fake *FakePersistent) UserWelcomePackageCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.userWelcomePackageCreateMutex.Lock()
+	defer fake.userWelcomePackageCreateMutex.Unlock()
+	fake.UserWelcomePackageCreateStub = nil
+	if fake.userWelcomePackageCreateReturnsOnCall == nil {
+		fake.userWelcomePackageCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
 			result2 error
 		})
 	}
-	fake.userBalanceUpdateReturnsOnCall[i] = struct {
-		result1 types.User
+	fake.userWelcomePackageCreateReturnsOnCall[i] = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserCreate(arg1 context.Context, arg2 types.User) (types.User, error) {
-	fake.userCreateMutex.Lock()
-	ret, specificReturn := fake.userCreateReturnsOnCall[len(fake.userCreateArgsForCall)]
-	fake.userCreateArgsForCall = append(fake.userCreateArgsForCall, struct {
+func (fake *FakePersistent) UserWelcomePackageGet(arg1 context.Context, arg2 uuid.UUID) (types.UserWelcomePackage, error) {
+	fake.userWelcomePackageGetMutex.Lock()
+	ret, specificReturn := fake.userWelcomePackageGetReturnsOnCall[len(fake.userWelcomePackageGetArgsForCall)]
+	fake.userWelcomePackageGetArgsForCall = append(fake.userWelcomePackageGetArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.User
+		arg2 uuid.UUID
 	}{arg1, arg2})
-	stub := fake.UserCreateStub
-	fakeReturns := fake.userCreateReturns
-	fake.recordInvocation("UserCreate", []interface{}{arg1, arg2})
-	fake.userCreateMutex.Unlock()
+	stub := fake.UserWelcomePackageGetStub
+	fakeReturns := fake.userWelcomePackageGetReturns
+	fake.recordInvocation("UserWelcomePackageGet", []interface{}{arg1, arg2})
+	fake.userWelcomePackageGetMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1256,124 +8891,130 @@ func (fake *FakePersistent) UserCreate(arg1 context.Context, arg2 types.User) (t
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) UserCreateCallCount() int {
-	fake.userCreateMutex.RLock()
-	defer fake.userCreateMutex.RUnlock()
-	return len(fake.userCreateArgsForCall)
+func (fake *FakePersistent) UserWelcomePackageGetCallCount() int {
+	fake.userWelcomePackageGetMutex.RLock()
+	defer fake.userWelcomePackageGetMutex.RUnlock()
+	return len(fake.userWelcomePackageGetArgsForCall)
 }
 
-func (fake *FakePersistent) UserCreateCalls(stub func(context.Context, types.User) (types.User, error)) {
-	fake.userCreateMutex.Lock()
-	defer fake.userCreateMutex.Unlock()
-	fake.UserCreateStub = stub
+func (fake *FakePersistent) UserWelcomePackageGetCalls(stub func(context.Context, uuid.UUID) (types.UserWelcomePackage, error)) {
+	fake.userWelcomePackageGetMutex.Lock()
+	defer fake.userWelcomePackageGetMutex.Unlock()
+	fake.UserWelcomePackageGetStub = stub
 }
 
-func (fake *FakePersistent) UserCreateArgsForCall(i int) (context.Context, types.User) {
-	fake.userCreateMutex.RLock()
-	defer fake.userCreateMutex.RUnlock()
-	argsForCall := fake.userCreateArgsForCall[i]
+func (fake *FakePersistent) UserWelcomePackageGetArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userWelcomePackageGetMutex.RLock()
+	defer fake.userWelcomePackageGetMutex.RUnlock()
+	argsForCall := fake.userWelcomePackageGetArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) UserCreateReturns(result1 types.User, result2 error) {
-	fake.userCreateMutex.Lock()
-	defer fake.userCreateMutex.Unlock()
-	fake.UserCreateStub = nil
-	fake.userCreateReturns = struct {
-		result1 types.User
+func (fake *FakePersistent) UserWelcomePackageGetReturns(result1 types.UserWelcomePackage, result2 error) {
+	fake.userWelcomePackageGetMutex.Lock()
+	defer fake.userWelcomePackageGetMutex.Unlock()
+	fake.UserWelcomePackageGetStub = nil
+	fake.userWelcomePackageGetReturns = struct {
+		result1 types.UserWelcomePackage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserCreateReturnsOnCall(i int, result1 types.User, result2 error) {
-	fake.userCreateMutex.Lock()
-	defer fake.userCreateMutex.Unlock()
-	fake.UserCreateStub = nil
-	if fake.userCreateReturnsOnCall == nil {
-		fake.userCreateReturnsOnCall = make(map[int]struct {
-			result1 types.User
+func (fake *FakePersistent) UserWelcomePackageGetReturnsOnCall(i int, result1 types.UserWelcomePackage, result2 error) {
+	fake.userWelcomePackageGetMutex.Lock()
+	defer fake.userWelcomePackageGetMutex.Unlock()
+	fake.UserWelcomePackageGetStub = nil
+	if fake.userWelcomePackageGetReturnsOnCall == nil {
+		fake.userWelcomePackageGetReturnsOnCall = make(map[int]struct {
+			result1 types.UserWelcomePackage
 			result2 error
 		})
 	}
-	fake.userCreateReturnsOnCall[i] = struct {
-		result1 types.User
+	fake.userWelcomePackageGetReturnsOnCall[i] = struct {
+		result1 types.UserWelcomePackage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserDelete(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.userDeleteMutex.Lock()
-	ret, specificReturn := fake.userDeleteReturnsOnCall[len(fake.userDeleteArgsForCall)]
-	fake.userDeleteArgsForCall = append(fake.userDeleteArgsForCall, struct {
+func (fake *FakePersistent) UserWelcomeStepCreate(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 uuid.NullUUID, arg5 time.Time) (bool, error) {
+	fake.userWelcomeStepCreateMutex.Lock()
+	ret, specificReturn := fake.userWelcomeStepCreateReturnsOnCall[len(fake.userWelcomeStepCreateArgsForCall)]
+	fake.userWelcomeStepCreateArgsForCall = append(fake.userWelcomeStepCreateArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.UserDeleteStub
-	fakeReturns := fake.userDeleteReturns
-	fake.recordInvocation("UserDelete", []interface{}{arg1, arg2})
-	fake.userDeleteMutex.Unlock()
+		arg3 int
+		arg4 uuid.NullUUID
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UserWelcomeStepCreateStub
+	fakeReturns := fake.userWelcomeStepCreateReturns
+	fake.recordInvocation("UserWelcomeStepCreate", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.userWelcomeStepCreateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) UserDeleteCallCount() int {
-	fake.userDeleteMutex.RLock()
-	defer fake.userDeleteMutex.RUnlock()
-	return len(fake.userDeleteArgsForCall)
+func (fake *FakePersistent) UserWelcomeStepCreateCallCount() int {
+	fake.userWelcomeStepCreateMutex.RLock()
+	defer fake.userWelcomeStepCreateMutex.RUnlock()
+	return len(fake.userWelcomeStepCreateArgsForCall)
 }
 
-func (fake *FakePersistent) UserDeleteCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.userDeleteMutex.Lock()
-	defer fake.userDeleteMutex.Unlock()
-	fake.UserDeleteStub = stub
+func (fake *FakePersistent) UserWelcomeStepCreateCalls(stub func(context.Context, uuid.UUID, int, uuid.NullUUID, time.Time) (bool, error)) {
+	fake.userWelcomeStepCreateMutex.Lock()
+	defer fake.userWelcomeStepCreateMutex.Unlock()
+	fake.UserWelcomeStepCreateStub = stub
 }
 
-func (fake *FakePersistent) UserDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.userDeleteMutex.RLock()
-	defer fake.userDeleteMutex.RUnlock()
-	argsForCall := fake.userDeleteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) UserWelcomeStepCreateArgsForCall(i int) (context.Context, uuid.UUID, int, uuid.NullUUID, time.Time) {
+	fake.userWelcomeStepCreateMutex.RLock()
+	defer fake.userWelcomeStepCreateMutex.RUnlock()
+	argsForCall := fake.userWelcomeStepCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakePersistent) UserDeleteReturns(result1 error) {
-	fake.userDeleteMutex.Lock()
-	defer fake.userDeleteMutex.Unlock()
-	fake.UserDeleteStub = nil
-	fake.userDeleteReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakePersistent) UserWelcomeStepCreateReturns(result1 bool, result2 error) {
+	fake.userWelcomeStepCreateMutex.Lock()
+	defer fake.userWelcomeStepCreateMutex.Unlock()
+	fake.UserWelcomeStepCreateStub = nil
+	fake.userWelcomeStepCreateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserDeleteReturnsOnCall(i int, result1 error) {
-	fake.userDeleteMutex.Lock()
-	defer fake.userDeleteMutex.Unlock()
-	fake.UserDeleteStub = nil
-	if fake.userDeleteReturnsOnCall == nil {
-		fake.userDeleteReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakePersistent) UserWelcomeStepCreateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.userWelcomeStepCreateMutex.Lock()
+	defer fake.userWelcomeStepCreateMutex.Unlock()
+	fake.UserWelcomeStepCreateStub = nil
+	if fake.userWelcomeStepCreateReturnsOnCall == nil {
+		fake.userWelcomeStepCreateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
 		})
 	}
-	fake.userDeleteReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.userWelcomeStepCreateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserGetBy(arg1 context.Context, arg2 types.UserFilter) (types.User, error) {
-	fake.userGetByMutex.Lock()
-	ret, specificReturn := fake.userGetByReturnsOnCall[len(fake.userGetByArgsForCall)]
-	fake.userGetByArgsForCall = append(fake.userGetByArgsForCall, struct {
+func (fake *FakePersistent) WelcomePackageCreate(arg1 context.Context, arg2 types.WelcomePackage) (types.WelcomePackage, error) {
+	fake.welcomePackageCreateMutex.Lock()
+	ret, specificReturn := fake.welcomePackageCreateReturnsOnCall[len(fake.welcomePackageCreateArgsForCall)]
+	fake.welcomePackageCreateArgsForCall = append(fake.welcomePackageCreateArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.UserFilter
+		arg2 types.WelcomePackage
 	}{arg1, arg2})
-	stub := fake.UserGetByStub
-	fakeReturns := fake.userGetByReturns
-	fake.recordInvocation("UserGetBy", []interface{}{arg1, arg2})
-	fake.userGetByMutex.Unlock()
+	stub := fake.WelcomePackageCreateStub
+	fakeReturns := fake.welcomePackageCreateReturns
+	fake.recordInvocation("WelcomePackageCreate", []interface{}{arg1, arg2})
+	fake.welcomePackageCreateMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -1383,64 +9024,63 @@ func (fake *FakePersistent) UserGetBy(arg1 context.Context, arg2 types.UserFilte
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) UserGetByCallCount() int {
-	fake.userGetByMutex.RLock()
-	defer fake.userGetByMutex.RUnlock()
-	return len(fake.userGetByArgsForCall)
+func (fake *FakePersistent) WelcomePackageCreateCallCount() int {
+	fake.welcomePackageCreateMutex.RLock()
+	defer fake.welcomePackageCreateMutex.RUnlock()
+	return len(fake.welcomePackageCreateArgsForCall)
 }
 
-func (fake *FakePersistent) UserGetByCalls(stub func(context.Context, types.UserFilter) (types.User, error)) {
-	fake.userGetByMutex.Lock()
-	defer fake.userGetByMutex.Unlock()
-	fake.UserGetByStub = stub
+func (fake *FakePersistent) WelcomePackageCreateCalls(stub func(context.Context, types.WelcomePackage) (types.WelcomePackage, error)) {
+	fake.welcomePackageCreateMutex.Lock()
+	defer fake.welcomePackageCreateMutex.Unlock()
+	fake.WelcomePackageCreateStub = stub
 }
 
-func (fake *FakePersistent) UserGetByArgsForCall(i int) (context.Context, types.UserFilter) {
-	fake.userGetByMutex.RLock()
-	defer fake.userGetByMutex.RUnlock()
-	argsForCall := fake.userGetByArgsForCall[i]
+func (fake *FakePersistent) WelcomePackageCreateArgsForCall(i int) (context.Context, types.WelcomePackage) {
+	fake.welcomePackageCreateMutex.RLock()
+	defer fake.welcomePackageCreateMutex.RUnlock()
+	argsForCall := fake.welcomePackageCreateArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakePersistent) UserGetByReturns(result1 types.User, result2 error) {
-	fake.userGetByMutex.Lock()
-	defer fake.userGetByMutex.Unlock()
-	fake.UserGetByStub = nil
-	fake.userGetByReturns = struct {
-		result1 types.User
+func (fake *FakePersistent) WelcomePackageCreateReturns(result1 types.WelcomePackage, result2 error) {
+	fake.welcomePackageCreateMutex.Lock()
+	defer fake.welcomePackageCreateMutex.Unlock()
+	fake.WelcomePackageCreateStub = nil
+	fake.welcomePackageCreateReturns = struct {
+		result1 types.WelcomePackage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserGetByReturnsOnCall(i int, result1 types.User, result2 error) {
-	fake.userGetByMutex.Lock()
-	defer fake.userGetByMutex.Unlock()
-	fake.UserGetByStub = nil
-	if fake.userGetByReturnsOnCall == nil {
-		fake.userGetByReturnsOnCall = make(map[int]struct {
-			result1 types.User
+func (fake *FakePersistent) WelcomePackageCreateReturnsOnCall(i int, result1 types.WelcomePackage, result2 error) {
+	fake.welcomePackageCreateMutex.Lock()
+	defer fake.welcomePackageCreateMutex.Unlock()
+	fake.WelcomePackageCreateStub = nil
+	if fake.welcomePackageCreateReturnsOnCall == nil {
+		fake.welcomePackageCreateReturnsOnCall = make(map[int]struct {
+			result1 types.WelcomePackage
 			result2 error
 		})
 	}
-	fake.userGetByReturnsOnCall[i] = struct {
-		result1 types.User
+	fake.welcomePackageCreateReturnsOnCall[i] = struct {
+		result1 types.WelcomePackage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserUpdate(arg1 context.Context, arg2 types.User) (types.User, error) {
-	fake.userUpdateMutex.Lock()
-	ret, specificReturn := fake.userUpdateReturnsOnCall[len(fake.userUpdateArgsForCall)]
-	fake.userUpdateArgsForCall = append(fake.userUpdateArgsForCall, struct {
+func (fake *FakePersistent) WelcomePackageGetCurrent(arg1 context.Context) (types.WelcomePackage, error) {
+	fake.welcomePackageGetCurrentMutex.Lock()
+	ret, specificReturn := fake.welcomePackageGetCurrentReturnsOnCall[len(fake.welcomePackageGetCurrentArgsForCall)]
+	fake.welcomePackageGetCurrentArgsForCall = append(fake.welcomePackageGetCurrentArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.User
-	}{arg1, arg2})
-	stub := fake.UserUpdateStub
-	fakeReturns := fake.userUpdateReturns
-	fake.recordInvocation("UserUpdate", []interface{}{arg1, arg2})
-	fake.userUpdateMutex.Unlock()
+	}{arg1})
+	stub := fake.WelcomePackageGetCurrentStub
+	fakeReturns := fake.welcomePackageGetCurrentReturns
+	fake.recordInvocation("WelcomePackageGetCurrent", []interface{}{arg1})
+	fake.welcomePackageGetCurrentMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1448,47 +9088,47 @@ func (fake *FakePersistent) UserUpdate(arg1 context.Context, arg2 types.User) (t
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakePersistent) UserUpdateCallCount() int {
-	fake.userUpdateMutex.RLock()
-	defer fake.userUpdateMutex.RUnlock()
-	return len(fake.userUpdateArgsForCall)
+func (fake *FakePersistent) WelcomePackageGetCurrentCallCount() int {
+	fake.welcomePackageGetCurrentMutex.RLock()
+	defer fake.welcomePackageGetCurrentMutex.RUnlock()
+	return len(fake.welcomePackageGetCurrentArgsForCall)
 }
 
-func (fake *FakePersistent) UserUpdateCalls(stub func(context.Context, types.User) (types.User, error)) {
-	fake.userUpdateMutex.Lock()
-	defer fake.userUpdateMutex.Unlock()
-	fake.UserUpdateStub = stub
+func (fake *FakePersistent) WelcomePackageGetCurrentCalls(stub func(context.Context) (types.WelcomePackage, error)) {
+	fake.welcomePackageGetCurrentMutex.Lock()
+	defer fake.welcomePackageGetCurrentMutex.Unlock()
+	fake.WelcomePackageGetCurrentStub = stub
 }
 
-func (fake *FakePersistent) UserUpdateArgsForCall(i int) (context.Context, types.User) {
-	fake.userUpdateMutex.RLock()
-	defer fake.userUpdateMutex.RUnlock()
-	argsForCall := fake.userUpdateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePersistent) WelcomePackageGetCurrentArgsForCall(i int) context.Context {
+	fake.welcomePackageGetCurrentMutex.RLock()
+	defer fake.welcomePackageGetCurrentMutex.RUnlock()
+	argsForCall := fake.welcomePackageGetCurrentArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakePersistent) UserUpdateReturns(result1 types.User, result2 error) {
-	fake.userUpdateMutex.Lock()
-	defer fake.userUpdateMutex.Unlock()
-	fake.UserUpdateStub = nil
-	fake.userUpdateReturns = struct {
-		result1 types.User
+func (fake *FakePersistent) WelcomePackageGetCurrentReturns(result1 types.WelcomePackage, result2 error) {
+	fake.welcomePackageGetCurrentMutex.Lock()
+	defer fake.welcomePackageGetCurrentMutex.Unlock()
+	fake.WelcomePackageGetCurrentStub = nil
+	fake.welcomePackageGetCurrentReturns = struct {
+		result1 types.WelcomePackage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePersistent) UserUpdateReturnsOnCall(i int, result1 types.User, result2 error) {
-	fake.userUpdateMutex.Lock()
-	defer fake.userUpdateMutex.Unlock()
-	fake.UserUpdateStub = nil
-	if fake.userUpdateReturnsOnCall == nil {
-		fake.userUpdateReturnsOnCall = make(map[int]struct {
-			result1 types.User
+func (fake *FakePersistent) WelcomePackageGetCurrentReturnsOnCall(i int, result1 types.WelcomePackage, result2 error) {
+	fake.welcomePackageGetCurrentMutex.Lock()
+	defer fake.welcomePackageGetCurrentMutex.Unlock()
+	fake.WelcomePackageGetCurrentStub = nil
+	if fake.welcomePackageGetCurrentReturnsOnCall == nil {
+		fake.welcomePackageGetCurrentReturnsOnCall = make(map[int]struct {
+			result1 types.WelcomePackage
 			result2 error
 		})
 	}
-	fake.userUpdateReturnsOnCall[i] = struct {
-		result1 types.User
+	fake.welcomePackageGetCurrentReturnsOnCall[i] = struct {
+		result1 types.WelcomePackage
 		result2 error
 	}{result1, result2}
 }
@@ -1562,42 +9202,236 @@ func (fake *FakePersistent) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addPromotionMutex.RLock()
 	defer fake.addPromotionMutex.RUnlock()
+	fake.cashbackCalculationCreateMutex.RLock()
+	defer fake.cashbackCalculationCreateMutex.RUnlock()
+	fake.catalogItemCreateMutex.RLock()
+	defer fake.catalogItemCreateMutex.RUnlock()
+	fake.catalogItemDeleteMutex.RLock()
+	defer fake.catalogItemDeleteMutex.RUnlock()
+	fake.catalogItemGetByIDMutex.RLock()
+	defer fake.catalogItemGetByIDMutex.RUnlock()
+	fake.catalogItemReserveMutex.RLock()
+	defer fake.catalogItemReserveMutex.RUnlock()
+	fake.catalogItemUpdateMutex.RLock()
+	defer fake.catalogItemUpdateMutex.RUnlock()
 	fake.claimPromotionMutex.RLock()
 	defer fake.claimPromotionMutex.RUnlock()
 	fake.commitTxMutex.RLock()
 	defer fake.commitTxMutex.RUnlock()
 	fake.deleteUserPromotionMutex.RLock()
 	defer fake.deleteUserPromotionMutex.RUnlock()
+	fake.freeSpinRoundCreateMutex.RLock()
+	defer fake.freeSpinRoundCreateMutex.RUnlock()
+	fake.freeSpinsConsumeMutex.RLock()
+	defer fake.freeSpinsConsumeMutex.RUnlock()
+	fake.freeSpinsCreateMutex.RLock()
+	defer fake.freeSpinsCreateMutex.RUnlock()
+	fake.freeSpinsGetByIDMutex.RLock()
+	defer fake.freeSpinsGetByIDMutex.RUnlock()
+	fake.freeSpinsSettleMutex.RLock()
+	defer fake.freeSpinsSettleMutex.RUnlock()
+	fake.freeSpinsVoidMutex.RLock()
+	defer fake.freeSpinsVoidMutex.RUnlock()
+	fake.gameEventCreateMutex.RLock()
+	defer fake.gameEventCreateMutex.RUnlock()
+	fake.gameEventGetMutex.RLock()
+	defer fake.gameEventGetMutex.RUnlock()
+	fake.gameEventGetByEventIDMutex.RLock()
+	defer fake.gameEventGetByEventIDMutex.RUnlock()
+	fake.getActiveFreeSpinsMutex.RLock()
+	defer fake.getActiveFreeSpinsMutex.RUnlock()
+	fake.getCashbackCalculationsMutex.RLock()
+	defer fake.getCashbackCalculationsMutex.RUnlock()
+	fake.getCatalogItemsMutex.RLock()
+	defer fake.getCatalogItemsMutex.RUnlock()
+	fake.getEligibilityProfileMutex.RLock()
+	defer fake.getEligibilityProfileMutex.RUnlock()
+	fake.getEndedTournamentsMutex.RLock()
+	defer fake.getEndedTournamentsMutex.RUnlock()
+	fake.getExpiredFreeSpinsMutex.RLock()
+	defer fake.getExpiredFreeSpinsMutex.RUnlock()
+	fake.getExpiredUserPromotionBonusesMutex.RLock()
+	defer fake.getExpiredUserPromotionBonusesMutex.RUnlock()
+	fake.getNetLossesMutex.RLock()
+	defer fake.getNetLossesMutex.RUnlock()
+	fake.getPointsLiabilityMutex.RLock()
+	defer fake.getPointsLiabilityMutex.RUnlock()
+	fake.getPointsRatesMutex.RLock()
+	defer fake.getPointsRatesMutex.RUnlock()
+	fake.getPromotionCodesMutex.RLock()
+	defer fake.getPromotionCodesMutex.RUnlock()
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
 	fake.getPromotionsMutex.RLock()
 	defer fake.getPromotionsMutex.RUnlock()
+	fake.getQualifiedReferralsMutex.RLock()
+	defer fake.getQualifiedReferralsMutex.RUnlock()
+	fake.getRedemptionsMutex.RLock()
+	defer fake.getRedemptionsMutex.RUnlock()
+	fake.getReferralReportMutex.RLock()
+	defer fake.getReferralReportMutex.RUnlock()
+	fake.getRunningTournamentsMutex.RLock()
+	defer fake.getRunningTournamentsMutex.RUnlock()
+	fake.getTierHistoryMutex.RLock()
+	defer fake.getTierHistoryMutex.RUnlock()
+	fake.getTiersMutex.RLock()
+	defer fake.getTiersMutex.RUnlock()
+	fake.getTournamentResultsMutex.RLock()
+	defer fake.getTournamentResultsMutex.RUnlock()
+	fake.getTournamentsMutex.RLock()
+	defer fake.getTournamentsMutex.RUnlock()
+	fake.getUnlockedWelcomeStepsMutex.RLock()
+	defer fake.getUnlockedWelcomeStepsMutex.RUnlock()
+	fake.getUnmatchedDepositMutex.RLock()
+	defer fake.getUnmatchedDepositMutex.RUnlock()
+	fake.getUnpaidTournamentResultsMutex.RLock()
+	defer fake.getUnpaidTournamentResultsMutex.RUnlock()
 	fake.getUserPromotionByIDMutex.RLock()
 	defer fake.getUserPromotionByIDMutex.RUnlock()
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
 	fake.getUserPromotionsMutex.RLock()
 	defer fake.getUserPromotionsMutex.RUnlock()
 	fake.getUsersMutex.RLock()
 	defer fake.getUsersMutex.RUnlock()
+	fake.getWelcomePackagesMutex.RLock()
+	defer fake.getWelcomePackagesMutex.RUnlock()
+	fake.idempotencyKeyCompleteMutex.RLock()
+	defer fake.idempotencyKeyCompleteMutex.RUnlock()
+	fake.idempotencyKeyCreateMutex.RLock()
+	defer fake.idempotencyKeyCreateMutex.RUnlock()
+	fake.idempotencyKeyDeleteMutex.RLock()
+	defer fake.idempotencyKeyDeleteMutex.RUnlock()
+	fake.idempotencyKeyGetMutex.RLock()
+	defer fake.idempotencyKeyGetMutex.RUnlock()
+	fake.ledgerEntriesGetMutex.RLock()
+	defer fake.ledgerEntriesGetMutex.RUnlock()
+	fake.pointsEntryCreateMutex.RLock()
+	defer fake.pointsEntryCreateMutex.RUnlock()
+	fake.pointsEntryGetMutex.RLock()
+	defer fake.pointsEntryGetMutex.RUnlock()
+	fake.pointsLotsConsumeMutex.RLock()
+	defer fake.pointsLotsConsumeMutex.RUnlock()
+	fake.pointsLotsExpireMutex.RLock()
+	defer fake.pointsLotsExpireMutex.RUnlock()
+	fake.pointsLotsWarnMutex.RLock()
+	defer fake.pointsLotsWarnMutex.RUnlock()
+	fake.pointsRateDeleteMutex.RLock()
+	defer fake.pointsRateDeleteMutex.RUnlock()
+	fake.pointsRateGetMutex.RLock()
+	defer fake.pointsRateGetMutex.RUnlock()
+	fake.pointsRateUpsertMutex.RLock()
+	defer fake.pointsRateUpsertMutex.RUnlock()
+	fake.promotionArchiveMutex.RLock()
+	defer fake.promotionArchiveMutex.RUnlock()
+	fake.promotionCodeGetByCodeMutex.RLock()
+	defer fake.promotionCodeGetByCodeMutex.RUnlock()
+	fake.promotionCodeRedeemMutex.RLock()
+	defer fake.promotionCodeRedeemMutex.RUnlock()
+	fake.promotionCodeRedemptionCreateMutex.RLock()
+	defer fake.promotionCodeRedemptionCreateMutex.RUnlock()
+	fake.promotionCodesCreateMutex.RLock()
+	defer fake.promotionCodesCreateMutex.RUnlock()
 	fake.promotionCreateMutex.RLock()
 	defer fake.promotionCreateMutex.RUnlock()
-	fake.promotionDeleteMutex.RLock()
-	defer fake.promotionDeleteMutex.RUnlock()
 	fake.promotionGetByIDMutex.RLock()
 	defer fake.promotionGetByIDMutex.RUnlock()
 	fake.promotionGetByTypeMutex.RLock()
 	defer fake.promotionGetByTypeMutex.RUnlock()
+	fake.promotionRestoreMutex.RLock()
+	defer fake.promotionRestoreMutex.RUnlock()
+	fake.promotionSpendMutex.RLock()
+	defer fake.promotionSpendMutex.RUnlock()
+	fake.promotionStateChangeCreateMutex.RLock()
+	defer fake.promotionStateChangeCreateMutex.RUnlock()
 	fake.promotionUpdateMutex.RLock()
 	defer fake.promotionUpdateMutex.RUnlock()
+	fake.promotionsApplyScheduleMutex.RLock()
+	defer fake.promotionsApplyScheduleMutex.RUnlock()
+	fake.redemptionCreateMutex.RLock()
+	defer fake.redemptionCreateMutex.RUnlock()
+	fake.redemptionFulfilMutex.RLock()
+	defer fake.redemptionFulfilMutex.RUnlock()
+	fake.referralRewardMutex.RLock()
+	defer fake.referralRewardMutex.RUnlock()
 	fake.rollbackTxMutex.RLock()
 	defer fake.rollbackTxMutex.RUnlock()
+	fake.tierCreateMutex.RLock()
+	defer fake.tierCreateMutex.RUnlock()
+	fake.tierDeleteMutex.RLock()
+	defer fake.tierDeleteMutex.RUnlock()
+	fake.tierUpdateMutex.RLock()
+	defer fake.tierUpdateMutex.RUnlock()
+	fake.tournamentCreateMutex.RLock()
+	defer fake.tournamentCreateMutex.RUnlock()
+	fake.tournamentDeleteMutex.RLock()
+	defer fake.tournamentDeleteMutex.RUnlock()
+	fake.tournamentFinishMutex.RLock()
+	defer fake.tournamentFinishMutex.RUnlock()
+	fake.tournamentGetByIDMutex.RLock()
+	defer fake.tournamentGetByIDMutex.RUnlock()
+	fake.tournamentResultCreateMutex.RLock()
+	defer fake.tournamentResultCreateMutex.RUnlock()
+	fake.tournamentResultLockMutex.RLock()
+	defer fake.tournamentResultLockMutex.RUnlock()
+	fake.tournamentResultPrizeMutex.RLock()
+	defer fake.tournamentResultPrizeMutex.RUnlock()
+	fake.tournamentResultPrizeFailMutex.RLock()
+	defer fake.tournamentResultPrizeFailMutex.RUnlock()
+	fake.tournamentUpdateMutex.RLock()
+	defer fake.tournamentUpdateMutex.RUnlock()
+	fake.userBalanceRebuildMutex.RLock()
+	defer fake.userBalanceRebuildMutex.RUnlock()
+	fake.userBalanceReconcileMutex.RLock()
+	defer fake.userBalanceReconcileMutex.RUnlock()
 	fake.userBalanceUpdateMutex.RLock()
 	defer fake.userBalanceUpdateMutex.RUnlock()
+	fake.userBalanceWithdrawMutex.RLock()
+	defer fake.userBalanceWithdrawMutex.RUnlock()
 	fake.userCreateMutex.RLock()
 	defer fake.userCreateMutex.RUnlock()
 	fake.userDeleteMutex.RLock()
 	defer fake.userDeleteMutex.RUnlock()
 	fake.userGetByMutex.RLock()
 	defer fake.userGetByMutex.RUnlock()
+	fake.userPromotionClaimCountMutex.RLock()
+	defer fake.userPromotionClaimCountMutex.RUnlock()
+	fake.userPromotionConvertMutex.RLock()
+	defer fake.userPromotionConvertMutex.RUnlock()
+	fake.userPromotionForfeitMutex.RLock()
+	defer fake.userPromotionForfeitMutex.RUnlock()
+	fake.userPromotionRevocationCreateMutex.RLock()
+	defer fake.userPromotionRevocationCreateMutex.RUnlock()
+	fake.userPromotionRevokeMutex.RLock()
+	defer fake.userPromotionRevokeMutex.RUnlock()
+	fake.userPromotionSettleMutex.RLock()
+	defer fake.userPromotionSettleMutex.RUnlock()
+	fake.userPromotionsExpireMutex.RLock()
+	defer fake.userPromotionsExpireMutex.RUnlock()
+	fake.userPromotionsRemindMutex.RLock()
+	defer fake.userPromotionsRemindMutex.RUnlock()
+	fake.userPromotionsUnwagerMutex.RLock()
+	defer fake.userPromotionsUnwagerMutex.RUnlock()
+	fake.userPromotionsWagerMutex.RLock()
+	defer fake.userPromotionsWagerMutex.RUnlock()
+	fake.userTiersDemoteMutex.RLock()
+	defer fake.userTiersDemoteMutex.RUnlock()
+	fake.userTiersQualifyMutex.RLock()
+	defer fake.userTiersQualifyMutex.RUnlock()
+	fake.userTiersWarnMutex.RLock()
+	defer fake.userTiersWarnMutex.RUnlock()
 	fake.userUpdateMutex.RLock()
 	defer fake.userUpdateMutex.RUnlock()
+	fake.userWelcomePackageCreateMutex.RLock()
+	defer fake.userWelcomePackageCreateMutex.RUnlock()
+	fake.userWelcomePackageGetMutex.RLock()
+	defer fake.userWelcomePackageGetMutex.RUnlock()
+	fake.userWelcomeStepCreateMutex.RLock()
+	defer fake.userWelcomeStepCreateMutex.RUnlock()
+	fake.welcomePackageCreateMutex.RLock()
+	defer fake.welcomePackageCreateMutex.RUnlock()
+	fake.welcomePackageGetCurrentMutex.RLock()
+	defer fake.welcomePackageGetCurrentMutex.RUnlock()
 	fake.withTxMutex.RLock()
 	defer fake.withTxMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,17 +4,34 @@ package fakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type FakePromotionManager struct {
-	GetPromotionsStub        func(context.Context) ([]types.Promotion, error)
+	GetPromotionStateChangesStub        func(context.Context, uuid.UUID) ([]types.PromotionStateChange, error)
+	getPromotionStateChangesMutex       sync.RWMutex
+	getPromotionStateChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPromotionStateChangesReturns struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	getPromotionStateChangesReturnsOnCall map[int]struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	GetPromotionsStub        func(context.Context, types.PromotionFilter) ([]types.Promotion, error)
 	getPromotionsMutex       sync.RWMutex
 	getPromotionsArgsForCall []struct {
 		arg1 context.Context
+		arg2 types.PromotionFilter
 	}
 	getPromotionsReturns struct {
 		result1 []types.Promotion
@@ -24,6 +41,18 @@ type FakePromotionManager struct {
 		result1 []types.Promotion
 		result2 error
 	}
+	PromotionArchiveStub        func(context.Context, uuid.UUID) error
+	promotionArchiveMutex       sync.RWMutex
+	promotionArchiveArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	promotionArchiveReturns struct {
+		result1 error
+	}
+	promotionArchiveReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PromotionCreateStub        func(context.Context, types.Promotion) (types.Promotion, error)
 	promotionCreateMutex       sync.RWMutex
 	promotionCreateArgsForCall []struct {
@@ -38,18 +67,6 @@ type FakePromotionManager struct {
 		result1 types.Promotion
 		result2 error
 	}
-	PromotionDeleteStub        func(context.Context, uuid.UUID) error
-	promotionDeleteMutex       sync.RWMutex
-	promotionDeleteArgsForCall []struct {
-		arg1 context.Context
-		arg2 uuid.UUID
-	}
-	promotionDeleteReturns struct {
-		result1 error
-	}
-	promotionDeleteReturnsOnCall map[int]struct {
-		result1 error
-	}
 	PromotionGetByIDStub        func(context.Context, uuid.UUID) (types.Promotion, error)
 	promotionGetByIDMutex       sync.RWMutex
 	promotionGetByIDArgsForCall []struct {
@@ -78,6 +95,47 @@ type FakePromotionManager struct {
 		result1 types.Promotion
 		result2 error
 	}
+	PromotionRestoreStub        func(context.Context, uuid.UUID) (types.Promotion, error)
+	promotionRestoreMutex       sync.RWMutex
+	promotionRestoreArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	promotionRestoreReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionRestoreReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionSpendStub        func(context.Context, uuid.UUID, decimal.Decimal) (types.Promotion, error)
+	promotionSpendMutex       sync.RWMutex
+	promotionSpendArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 decimal.Decimal
+	}
+	promotionSpendReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	promotionSpendReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
+	PromotionStateChangeCreateStub        func(context.Context, types.PromotionStateChange) error
+	promotionStateChangeCreateMutex       sync.RWMutex
+	promotionStateChangeCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.PromotionStateChange
+	}
+	promotionStateChangeCreateReturns struct {
+		result1 error
+	}
+	promotionStateChangeCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	PromotionUpdateStub        func(context.Context, types.Promotion) (types.Promotion, error)
 	promotionUpdateMutex       sync.RWMutex
 	promotionUpdateArgsForCall []struct {
@@ -92,22 +150,102 @@ type FakePromotionManager struct {
 		result1 types.Promotion
 		result2 error
 	}
+	PromotionsApplyScheduleStub        func(context.Context, time.Time) ([]types.PromotionStateChange, error)
+	promotionsApplyScheduleMutex       sync.RWMutex
+	promotionsApplyScheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	promotionsApplyScheduleReturns struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	promotionsApplyScheduleReturnsOnCall map[int]struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakePromotionManager) GetPromotions(arg1 context.Context) ([]types.Promotion, error) {
+func (fake *FakePromotionManager) GetPromotionStateChanges(arg1 context.Context, arg2 uuid.UUID) ([]types.PromotionStateChange, error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	ret, specificReturn := fake.getPromotionStateChangesReturnsOnCall[len(fake.getPromotionStateChangesArgsForCall)]
+	fake.getPromotionStateChangesArgsForCall = append(fake.getPromotionStateChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPromotionStateChangesStub
+	fakeReturns := fake.getPromotionStateChangesReturns
+	fake.recordInvocation("GetPromotionStateChanges", []interface{}{arg1, arg2})
+	fake.getPromotionStateChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionManager) GetPromotionStateChangesCallCount() int {
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
+	return len(fake.getPromotionStateChangesArgsForCall)
+}
+
+func (fake *FakePromotionManager) GetPromotionStateChangesCalls(stub func(context.Context, uuid.UUID) ([]types.PromotionStateChange, error)) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = stub
+}
+
+func (fake *FakePromotionManager) GetPromotionStateChangesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
+	argsForCall := fake.getPromotionStateChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionManager) GetPromotionStateChangesReturns(result1 []types.PromotionStateChange, result2 error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = nil
+	fake.getPromotionStateChangesReturns = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) GetPromotionStateChangesReturnsOnCall(i int, result1 []types.PromotionStateChange, result2 error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = nil
+	if fake.getPromotionStateChangesReturnsOnCall == nil {
+		fake.getPromotionStateChangesReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionStateChange
+			result2 error
+		})
+	}
+	fake.getPromotionStateChangesReturnsOnCall[i] = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) GetPromotions(arg1 context.Context, arg2 types.PromotionFilter) ([]types.Promotion, error) {
 	fake.getPromotionsMutex.Lock()
 	ret, specificReturn := fake.getPromotionsReturnsOnCall[len(fake.getPromotionsArgsForCall)]
 	fake.getPromotionsArgsForCall = append(fake.getPromotionsArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 types.PromotionFilter
+	}{arg1, arg2})
 	stub := fake.GetPromotionsStub
 	fakeReturns := fake.getPromotionsReturns
-	fake.recordInvocation("GetPromotions", []interface{}{arg1})
+	fake.recordInvocation("GetPromotions", []interface{}{arg1, arg2})
 	fake.getPromotionsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -121,17 +259,17 @@ func (fake *FakePromotionManager) GetPromotionsCallCount() int {
 	return len(fake.getPromotionsArgsForCall)
 }
 
-func (fake *FakePromotionManager) GetPromotionsCalls(stub func(context.Context) ([]types.Promotion, error)) {
+func (fake *FakePromotionManager) GetPromotionsCalls(stub func(context.Context, types.PromotionFilter) ([]types.Promotion, error)) {
 	fake.getPromotionsMutex.Lock()
 	defer fake.getPromotionsMutex.Unlock()
 	fake.GetPromotionsStub = stub
 }
 
-func (fake *FakePromotionManager) GetPromotionsArgsForCall(i int) context.Context {
+func (fake *FakePromotionManager) GetPromotionsArgsForCall(i int) (context.Context, types.PromotionFilter) {
 	fake.getPromotionsMutex.RLock()
 	defer fake.getPromotionsMutex.RUnlock()
 	argsForCall := fake.getPromotionsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakePromotionManager) GetPromotionsReturns(result1 []types.Promotion, result2 error) {
@@ -160,6 +298,68 @@ func (fake *FakePromotionManager) GetPromotionsReturnsOnCall(i int, result1 []ty
 	}{result1, result2}
 }
 
+func (fake *FakePromotionManager) PromotionArchive(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.promotionArchiveMutex.Lock()
+	ret, specificReturn := fake.promotionArchiveReturnsOnCall[len(fake.promotionArchiveArgsForCall)]
+	fake.promotionArchiveArgsForCall = append(fake.promotionArchiveArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PromotionArchiveStub
+	fakeReturns := fake.promotionArchiveReturns
+	fake.recordInvocation("PromotionArchive", []interface{}{arg1, arg2})
+	fake.promotionArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromotionManager) PromotionArchiveCallCount() int {
+	fake.promotionArchiveMutex.RLock()
+	defer fake.promotionArchiveMutex.RUnlock()
+	return len(fake.promotionArchiveArgsForCall)
+}
+
+func (fake *FakePromotionManager) PromotionArchiveCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.promotionArchiveMutex.Lock()
+	defer fake.promotionArchiveMutex.Unlock()
+	fake.PromotionArchiveStub = stub
+}
+
+func (fake *FakePromotionManager) PromotionArchiveArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.promotionArchiveMutex.RLock()
+	defer fake.promotionArchiveMutex.RUnlock()
+	argsForCall := fake.promotionArchiveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionManager) PromotionArchiveReturns(result1 error) {
+	fake.promotionArchiveMutex.Lock()
+	defer fake.promotionArchiveMutex.Unlock()
+	fake.PromotionArchiveStub = nil
+	fake.promotionArchiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePromotionManager) PromotionArchiveReturnsOnCall(i int, result1 error) {
+	fake.promotionArchiveMutex.Lock()
+	defer fake.promotionArchiveMutex.Unlock()
+	fake.PromotionArchiveStub = nil
+	if fake.promotionArchiveReturnsOnCall == nil {
+		fake.promotionArchiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promotionArchiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePromotionManager) PromotionCreate(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
 	fake.promotionCreateMutex.Lock()
 	ret, specificReturn := fake.promotionCreateReturnsOnCall[len(fake.promotionCreateArgsForCall)]
@@ -225,68 +425,6 @@ func (fake *FakePromotionManager) PromotionCreateReturnsOnCall(i int, result1 ty
 	}{result1, result2}
 }
 
-func (fake *FakePromotionManager) PromotionDelete(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.promotionDeleteMutex.Lock()
-	ret, specificReturn := fake.promotionDeleteReturnsOnCall[len(fake.promotionDeleteArgsForCall)]
-	fake.promotionDeleteArgsForCall = append(fake.promotionDeleteArgsForCall, struct {
-		arg1 context.Context
-		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.PromotionDeleteStub
-	fakeReturns := fake.promotionDeleteReturns
-	fake.recordInvocation("PromotionDelete", []interface{}{arg1, arg2})
-	fake.promotionDeleteMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakePromotionManager) PromotionDeleteCallCount() int {
-	fake.promotionDeleteMutex.RLock()
-	defer fake.promotionDeleteMutex.RUnlock()
-	return len(fake.promotionDeleteArgsForCall)
-}
-
-func (fake *FakePromotionManager) PromotionDeleteCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.promotionDeleteMutex.Lock()
-	defer fake.promotionDeleteMutex.Unlock()
-	fake.PromotionDeleteStub = stub
-}
-
-func (fake *FakePromotionManager) PromotionDeleteArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.promotionDeleteMutex.RLock()
-	defer fake.promotionDeleteMutex.RUnlock()
-	argsForCall := fake.promotionDeleteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakePromotionManager) PromotionDeleteReturns(result1 error) {
-	fake.promotionDeleteMutex.Lock()
-	defer fake.promotionDeleteMutex.Unlock()
-	fake.PromotionDeleteStub = nil
-	fake.promotionDeleteReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakePromotionManager) PromotionDeleteReturnsOnCall(i int, result1 error) {
-	fake.promotionDeleteMutex.Lock()
-	defer fake.promotionDeleteMutex.Unlock()
-	fake.PromotionDeleteStub = nil
-	if fake.promotionDeleteReturnsOnCall == nil {
-		fake.promotionDeleteReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.promotionDeleteReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakePromotionManager) PromotionGetByID(arg1 context.Context, arg2 uuid.UUID) (types.Promotion, error) {
 	fake.promotionGetByIDMutex.Lock()
 	ret, specificReturn := fake.promotionGetByIDReturnsOnCall[len(fake.promotionGetByIDArgsForCall)]
@@ -417,6 +555,199 @@ func (fake *FakePromotionManager) PromotionGetByTypeReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakePromotionManager) PromotionRestore(arg1 context.Context, arg2 uuid.UUID) (types.Promotion, error) {
+	fake.promotionRestoreMutex.Lock()
+	ret, specificReturn := fake.promotionRestoreReturnsOnCall[len(fake.promotionRestoreArgsForCall)]
+	fake.promotionRestoreArgsForCall = append(fake.promotionRestoreArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.PromotionRestoreStub
+	fakeReturns := fake.promotionRestoreReturns
+	fake.recordInvocation("PromotionRestore", []interface{}{arg1, arg2})
+	fake.promotionRestoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionManager) PromotionRestoreCallCount() int {
+	fake.promotionRestoreMutex.RLock()
+	defer fake.promotionRestoreMutex.RUnlock()
+	return len(fake.promotionRestoreArgsForCall)
+}
+
+func (fake *FakePromotionManager) PromotionRestoreCalls(stub func(context.Context, uuid.UUID) (types.Promotion, error)) {
+	fake.promotionRestoreMutex.Lock()
+	defer fake.promotionRestoreMutex.Unlock()
+	fake.PromotionRestoreStub = stub
+}
+
+func (fake *FakePromotionManager) PromotionRestoreArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.promotionRestoreMutex.RLock()
+	defer fake.promotionRestoreMutex.RUnlock()
+	argsForCall := fake.promotionRestoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionManager) PromotionRestoreReturns(result1 types.Promotion, result2 error) {
+	fake.promotionRestoreMutex.Lock()
+	defer fake.promotionRestoreMutex.Unlock()
+	fake.PromotionRestoreStub = nil
+	fake.promotionRestoreReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) PromotionRestoreReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionRestoreMutex.Lock()
+	defer fake.promotionRestoreMutex.Unlock()
+	fake.PromotionRestoreStub = nil
+	if fake.promotionRestoreReturnsOnCall == nil {
+		fake.promotionRestoreReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionRestoreReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) PromotionSpend(arg1 context.Context, arg2 uuid.UUID, arg3 decimal.Decimal) (types.Promotion, error) {
+	fake.promotionSpendMutex.Lock()
+	ret, specificReturn := fake.promotionSpendReturnsOnCall[len(fake.promotionSpendArgsForCall)]
+	fake.promotionSpendArgsForCall = append(fake.promotionSpendArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 decimal.Decimal
+	}{arg1, arg2, arg3})
+	stub := fake.PromotionSpendStub
+	fakeReturns := fake.promotionSpendReturns
+	fake.recordInvocation("PromotionSpend", []interface{}{arg1, arg2, arg3})
+	fake.promotionSpendMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionManager) PromotionSpendCallCount() int {
+	fake.promotionSpendMutex.RLock()
+	defer fake.promotionSpendMutex.RUnlock()
+	return len(fake.promotionSpendArgsForCall)
+}
+
+func (fake *FakePromotionManager) PromotionSpendCalls(stub func(context.Context, uuid.UUID, decimal.Decimal) (types.Promotion, error)) {
+	fake.promotionSpendMutex.Lock()
+	defer fake.promotionSpendMutex.Unlock()
+	fake.PromotionSpendStub = stub
+}
+
+func (fake *FakePromotionManager) PromotionSpendArgsForCall(i int) (context.Context, uuid.UUID, decimal.Decimal) {
+	fake.promotionSpendMutex.RLock()
+	defer fake.promotionSpendMutex.RUnlock()
+	argsForCall := fake.promotionSpendArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePromotionManager) PromotionSpendReturns(result1 types.Promotion, result2 error) {
+	fake.promotionSpendMutex.Lock()
+	defer fake.promotionSpendMutex.Unlock()
+	fake.PromotionSpendStub = nil
+	fake.promotionSpendReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) PromotionSpendReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.promotionSpendMutex.Lock()
+	defer fake.promotionSpendMutex.Unlock()
+	fake.PromotionSpendStub = nil
+	if fake.promotionSpendReturnsOnCall == nil {
+		fake.promotionSpendReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.promotionSpendReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) PromotionStateChangeCreate(arg1 context.Context, arg2 types.PromotionStateChange) error {
+	fake.promotionStateChangeCreateMutex.Lock()
+	ret, specificReturn := fake.promotionStateChangeCreateReturnsOnCall[len(fake.promotionStateChangeCreateArgsForCall)]
+	fake.promotionStateChangeCreateArgsForCall = append(fake.promotionStateChangeCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.PromotionStateChange
+	}{arg1, arg2})
+	stub := fake.PromotionStateChangeCreateStub
+	fakeReturns := fake.promotionStateChangeCreateReturns
+	fake.recordInvocation("PromotionStateChangeCreate", []interface{}{arg1, arg2})
+	fake.promotionStateChangeCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromotionManager) PromotionStateChangeCreateCallCount() int {
+	fake.promotionStateChangeCreateMutex.RLock()
+	defer fake.promotionStateChangeCreateMutex.RUnlock()
+	return len(fake.promotionStateChangeCreateArgsForCall)
+}
+
+func (fake *FakePromotionManager) PromotionStateChangeCreateCalls(stub func(context.Context, types.PromotionStateChange) error) {
+	fake.promotionStateChangeCreateMutex.Lock()
+	defer fake.promotionStateChangeCreateMutex.Unlock()
+	fake.PromotionStateChangeCreateStub = stub
+}
+
+func (fake *FakePromotionManager) PromotionStateChangeCreateArgsForCall(i int) (context.Context, types.PromotionStateChange) {
+	fake.promotionStateChangeCreateMutex.RLock()
+	defer fake.promotionStateChangeCreateMutex.RUnlock()
+	argsForCall := fake.promotionStateChangeCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionManager) PromotionStateChangeCreateReturns(result1 error) {
+	fake.promotionStateChangeCreateMutex.Lock()
+	defer fake.promotionStateChangeCreateMutex.Unlock()
+	fake.PromotionStateChangeCreateStub = nil
+	fake.promotionStateChangeCreateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePromotionManager) PromotionStateChangeCreateReturnsOnCall(i int, result1 error) {
+	fake.promotionStateChangeCreateMutex.Lock()
+	defer fake.promotionStateChangeCreateMutex.Unlock()
+	fake.PromotionStateChangeCreateStub = nil
+	if fake.promotionStateChangeCreateReturnsOnCall == nil {
+		fake.promotionStateChangeCreateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promotionStateChangeCreateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePromotionManager) PromotionUpdate(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
 	fake.promotionUpdateMutex.Lock()
 	ret, specificReturn := fake.promotionUpdateReturnsOnCall[len(fake.promotionUpdateArgsForCall)]
@@ -482,21 +813,96 @@ func (fake *FakePromotionManager) PromotionUpdateReturnsOnCall(i int, result1 ty
 	}{result1, result2}
 }
 
+func (fake *FakePromotionManager) PromotionsApplySchedule(arg1 context.Context, arg2 time.Time) ([]types.PromotionStateChange, error) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	ret, specificReturn := fake.promotionsApplyScheduleReturnsOnCall[len(fake.promotionsApplyScheduleArgsForCall)]
+	fake.promotionsApplyScheduleArgsForCall = append(fake.promotionsApplyScheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.PromotionsApplyScheduleStub
+	fakeReturns := fake.promotionsApplyScheduleReturns
+	fake.recordInvocation("PromotionsApplySchedule", []interface{}{arg1, arg2})
+	fake.promotionsApplyScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionManager) PromotionsApplyScheduleCallCount() int {
+	fake.promotionsApplyScheduleMutex.RLock()
+	defer fake.promotionsApplyScheduleMutex.RUnlock()
+	return len(fake.promotionsApplyScheduleArgsForCall)
+}
+
+func (fake *FakePromotionManager) PromotionsApplyScheduleCalls(stub func(context.Context, time.Time) ([]types.PromotionStateChange, error)) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	defer fake.promotionsApplyScheduleMutex.Unlock()
+	fake.PromotionsApplyScheduleStub = stub
+}
+
+func (fake *FakePromotionManager) PromotionsApplyScheduleArgsForCall(i int) (context.Context, time.Time) {
+	fake.promotionsApplyScheduleMutex.RLock()
+	defer fake.promotionsApplyScheduleMutex.RUnlock()
+	argsForCall := fake.promotionsApplyScheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionManager) PromotionsApplyScheduleReturns(result1 []types.PromotionStateChange, result2 error) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	defer fake.promotionsApplyScheduleMutex.Unlock()
+	fake.PromotionsApplyScheduleStub = nil
+	fake.promotionsApplyScheduleReturns = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionManager) PromotionsApplyScheduleReturnsOnCall(i int, result1 []types.PromotionStateChange, result2 error) {
+	fake.promotionsApplyScheduleMutex.Lock()
+	defer fake.promotionsApplyScheduleMutex.Unlock()
+	fake.PromotionsApplyScheduleStub = nil
+	if fake.promotionsApplyScheduleReturnsOnCall == nil {
+		fake.promotionsApplyScheduleReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionStateChange
+			result2 error
+		})
+	}
+	fake.promotionsApplyScheduleReturnsOnCall[i] = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePromotionManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
 	fake.getPromotionsMutex.RLock()
 	defer fake.getPromotionsMutex.RUnlock()
+	fake.promotionArchiveMutex.RLock()
+	defer fake.promotionArchiveMutex.RUnlock()
 	fake.promotionCreateMutex.RLock()
 	defer fake.promotionCreateMutex.RUnlock()
-	fake.promotionDeleteMutex.RLock()
-	defer fake.promotionDeleteMutex.RUnlock()
 	fake.promotionGetByIDMutex.RLock()
 	defer fake.promotionGetByIDMutex.RUnlock()
 	fake.promotionGetByTypeMutex.RLock()
 	defer fake.promotionGetByTypeMutex.RUnlock()
+	fake.promotionRestoreMutex.RLock()
+	defer fake.promotionRestoreMutex.RUnlock()
+	fake.promotionSpendMutex.RLock()
+	defer fake.promotionSpendMutex.RUnlock()
+	fake.promotionStateChangeCreateMutex.RLock()
+	defer fake.promotionStateChangeCreateMutex.RUnlock()
 	fake.promotionUpdateMutex.RLock()
 	defer fake.promotionUpdateMutex.RUnlock()
+	fake.promotionsApplyScheduleMutex.RLock()
+	defer fake.promotionsApplyScheduleMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
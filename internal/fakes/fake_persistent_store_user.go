@@ -11,6 +11,20 @@ import (
 )
 
 type FakeUserManager struct {
+	GetEligibilityProfileStub        func(context.Context, uuid.UUID) (types.EligibilityProfile, error)
+	getEligibilityProfileMutex       sync.RWMutex
+	getEligibilityProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getEligibilityProfileReturns struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}
+	getEligibilityProfileReturnsOnCall map[int]struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}
 	GetUsersStub        func(context.Context) ([]types.User, error)
 	getUsersMutex       sync.RWMutex
 	getUsersArgsForCall []struct {
@@ -24,12 +38,11 @@ type FakeUserManager struct {
 		result1 []types.User
 		result2 error
 	}
-	UserBalanceUpdateStub        func(context.Context, uuid.UUID, float64) (types.User, error)
+	UserBalanceUpdateStub        func(context.Context, types.LedgerEntry) (types.User, error)
 	userBalanceUpdateMutex       sync.RWMutex
 	userBalanceUpdateArgsForCall []struct {
 		arg1 context.Context
-		arg2 uuid.UUID
-		arg3 float64
+		arg2 types.LedgerEntry
 	}
 	userBalanceUpdateReturns struct {
 		result1 types.User
@@ -39,6 +52,20 @@ type FakeUserManager struct {
 		result1 types.User
 		result2 error
 	}
+	UserBalanceWithdrawStub        func(context.Context, types.LedgerEntry) (types.User, error)
+	userBalanceWithdrawMutex       sync.RWMutex
+	userBalanceWithdrawArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.LedgerEntry
+	}
+	userBalanceWithdrawReturns struct {
+		result1 types.User
+		result2 error
+	}
+	userBalanceWithdrawReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
 	UserCreateStub        func(context.Context, types.User) (types.User, error)
 	userCreateMutex       sync.RWMutex
 	userCreateArgsForCall []struct {
@@ -97,6 +124,71 @@ type FakeUserManager struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUserManager) GetEligibilityProfile(arg1 context.Context, arg2 uuid.UUID) (types.EligibilityProfile, error) {
+	fake.getEligibilityProfileMutex.Lock()
+	ret, specificReturn := fake.getEligibilityProfileReturnsOnCall[len(fake.getEligibilityProfileArgsForCall)]
+	fake.getEligibilityProfileArgsForCall = append(fake.getEligibilityProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetEligibilityProfileStub
+	fakeReturns := fake.getEligibilityProfileReturns
+	fake.recordInvocation("GetEligibilityProfile", []interface{}{arg1, arg2})
+	fake.getEligibilityProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserManager) GetEligibilityProfileCallCount() int {
+	fake.getEligibilityProfileMutex.RLock()
+	defer fake.getEligibilityProfileMutex.RUnlock()
+	return len(fake.getEligibilityProfileArgsForCall)
+}
+
+func (fake *FakeUserManager) GetEligibilityProfileCalls(stub func(context.Context, uuid.UUID) (types.EligibilityProfile, error)) {
+	fake.getEligibilityProfileMutex.Lock()
+	defer fake.getEligibilityProfileMutex.Unlock()
+	fake.GetEligibilityProfileStub = stub
+}
+
+func (fake *FakeUserManager) GetEligibilityProfileArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getEligibilityProfileMutex.RLock()
+	defer fake.getEligibilityProfileMutex.RUnlock()
+	argsForCall := fake.getEligibilityProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) GetEligibilityProfileReturns(result1 types.EligibilityProfile, result2 error) {
+	fake.getEligibilityProfileMutex.Lock()
+	defer fake.getEligibilityProfileMutex.Unlock()
+	fake.GetEligibilityProfileStub = nil
+	fake.getEligibilityProfileReturns = struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserManager) GetEligibilityProfileReturnsOnCall(i int, result1 types.EligibilityProfile, result2 error) {
+	fake.getEligibilityProfileMutex.Lock()
+	defer fake.getEligibilityProfileMutex.Unlock()
+	fake.GetEligibilityProfileStub = nil
+	if fake.getEligibilityProfileReturnsOnCall == nil {
+		fake.getEligibilityProfileReturnsOnCall = make(map[int]struct {
+			result1 types.EligibilityProfile
+			result2 error
+		})
+	}
+	fake.getEligibilityProfileReturnsOnCall[i] = struct {
+		result1 types.EligibilityProfile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserManager) GetUsers(arg1 context.Context) ([]types.User, error) {
 	fake.getUsersMutex.Lock()
 	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
@@ -161,20 +253,19 @@ func (fake *FakeUserManager) GetUsersReturnsOnCall(i int, result1 []types.User,
 	}{result1, result2}
 }
 
-func (fake *FakeUserManager) UserBalanceUpdate(arg1 context.Context, arg2 uuid.UUID, arg3 float64) (types.User, error) {
+func (fake *FakeUserManager) UserBalanceUpdate(arg1 context.Context, arg2 types.LedgerEntry) (types.User, error) {
 	fake.userBalanceUpdateMutex.Lock()
 	ret, specificReturn := fake.userBalanceUpdateReturnsOnCall[len(fake.userBalanceUpdateArgsForCall)]
 	fake.userBalanceUpdateArgsForCall = append(fake.userBalanceUpdateArgsForCall, struct {
 		arg1 context.Context
-		arg2 uuid.UUID
-		arg3 float64
-	}{arg1, arg2, arg3})
+		arg2 types.LedgerEntry
+	}{arg1, arg2})
 	stub := fake.UserBalanceUpdateStub
 	fakeReturns := fake.userBalanceUpdateReturns
-	fake.recordInvocation("UserBalanceUpdate", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("UserBalanceUpdate", []interface{}{arg1, arg2})
 	fake.userBalanceUpdateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -188,17 +279,17 @@ func (fake *FakeUserManager) UserBalanceUpdateCallCount() int {
 	return len(fake.userBalanceUpdateArgsForCall)
 }
 
-func (fake *FakeUserManager) UserBalanceUpdateCalls(stub func(context.Context, uuid.UUID, float64) (types.User, error)) {
+func (fake *FakeUserManager) UserBalanceUpdateCalls(stub func(context.Context, types.LedgerEntry) (types.User, error)) {
 	fake.userBalanceUpdateMutex.Lock()
 	defer fake.userBalanceUpdateMutex.Unlock()
 	fake.UserBalanceUpdateStub = stub
 }
 
-func (fake *FakeUserManager) UserBalanceUpdateArgsForCall(i int) (context.Context, uuid.UUID, float64) {
+func (fake *FakeUserManager) UserBalanceUpdateArgsForCall(i int) (context.Context, types.LedgerEntry) {
 	fake.userBalanceUpdateMutex.RLock()
 	defer fake.userBalanceUpdateMutex.RUnlock()
 	argsForCall := fake.userBalanceUpdateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeUserManager) UserBalanceUpdateReturns(result1 types.User, result2 error) {
@@ -227,6 +318,71 @@ func (fake *FakeUserManager) UserBalanceUpdateReturnsOnCall(i int, result1 types
 	}{result1, result2}
 }
 
+func (fake *FakeUserManager) UserBalanceWithdraw(arg1 context.Context, arg2 types.LedgerEntry) (types.User, error) {
+	fake.userBalanceWithdrawMutex.Lock()
+	ret, specificReturn := fake.userBalanceWithdrawReturnsOnCall[len(fake.userBalanceWithdrawArgsForCall)]
+	fake.userBalanceWithdrawArgsForCall = append(fake.userBalanceWithdrawArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.LedgerEntry
+	}{arg1, arg2})
+	stub := fake.UserBalanceWithdrawStub
+	fakeReturns := fake.userBalanceWithdrawReturns
+	fake.recordInvocation("UserBalanceWithdraw", []interface{}{arg1, arg2})
+	fake.userBalanceWithdrawMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserManager) UserBalanceWithdrawCallCount() int {
+	fake.userBalanceWithdrawMutex.RLock()
+	defer fake.userBalanceWithdrawMutex.RUnlock()
+	return len(fake.userBalanceWithdrawArgsForCall)
+}
+
+func (fake *FakeUserManager) UserBalanceWithdrawCalls(stub func(context.Context, types.LedgerEntry) (types.User, error)) {
+	fake.userBalanceWithdrawMutex.Lock()
+	defer fake.userBalanceWithdrawMutex.Unlock()
+	fake.UserBalanceWithdrawStub = stub
+}
+
+func (fake *FakeUserManager) UserBalanceWithdrawArgsForCall(i int) (context.Context, types.LedgerEntry) {
+	fake.userBalanceWithdrawMutex.RLock()
+	defer fake.userBalanceWithdrawMutex.RUnlock()
+	argsForCall := fake.userBalanceWithdrawArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserManager) UserBalanceWithdrawReturns(result1 types.User, result2 error) {
+	fake.userBalanceWithdrawMutex.Lock()
+	defer fake.userBalanceWithdrawMutex.Unlock()
+	fake.UserBalanceWithdrawStub = nil
+	fake.userBalanceWithdrawReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserManager) UserBalanceWithdrawReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.userBalanceWithdrawMutex.Lock()
+	defer fake.userBalanceWithdrawMutex.Unlock()
+	fake.UserBalanceWithdrawStub = nil
+	if fake.userBalanceWithdrawReturnsOnCall == nil {
+		fake.userBalanceWithdrawReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.userBalanceWithdrawReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserManager) UserCreate(arg1 context.Context, arg2 types.User) (types.User, error) {
 	fake.userCreateMutex.Lock()
 	ret, specificReturn := fake.userCreateReturnsOnCall[len(fake.userCreateArgsForCall)]
@@ -487,10 +643,14 @@ func (fake *FakeUserManager) UserUpdateReturnsOnCall(i int, result1 types.User,
 func (fake *FakeUserManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getEligibilityProfileMutex.RLock()
+	defer fake.getEligibilityProfileMutex.RUnlock()
 	fake.getUsersMutex.RLock()
 	defer fake.getUsersMutex.RUnlock()
 	fake.userBalanceUpdateMutex.RLock()
 	defer fake.userBalanceUpdateMutex.RUnlock()
+	fake.userBalanceWithdrawMutex.RLock()
+	defer fake.userBalanceWithdrawMutex.RUnlock()
 	fake.userCreateMutex.RLock()
 	defer fake.userCreateMutex.RUnlock()
 	fake.userDeleteMutex.RLock()
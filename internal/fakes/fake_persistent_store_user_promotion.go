@@ -4,6 +4,7 @@ package fakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
@@ -25,11 +26,11 @@ type FakeUserPromotionManager struct {
 		result1 types.UserPromotion
 		result2 error
 	}
-	ClaimPromotionStub        func(context.Context, uuid.UUID) error
+	ClaimPromotionStub        func(context.Context, types.UserPromotion) error
 	claimPromotionMutex       sync.RWMutex
 	claimPromotionArgsForCall []struct {
 		arg1 context.Context
-		arg2 uuid.UUID
+		arg2 types.UserPromotion
 	}
 	claimPromotionReturns struct {
 		result1 error
@@ -49,6 +50,21 @@ type FakeUserPromotionManager struct {
 	deleteUserPromotionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetExpiredUserPromotionBonusesStub        func(context.Context, time.Time, int) ([]types.UserPromotion, error)
+	getExpiredUserPromotionBonusesMutex       sync.RWMutex
+	getExpiredUserPromotionBonusesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	getExpiredUserPromotionBonusesReturns struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	getExpiredUserPromotionBonusesReturnsOnCall map[int]struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
 	GetUserPromotionByIDStub        func(context.Context, uuid.UUID) (types.UserPromotion, error)
 	getUserPromotionByIDMutex       sync.RWMutex
 	getUserPromotionByIDArgsForCall []struct {
@@ -63,11 +79,26 @@ type FakeUserPromotionManager struct {
 		result1 types.UserPromotion
 		result2 error
 	}
-	GetUserPromotionsStub        func(context.Context, uuid.UUID) ([]types.UserPromotion, error)
+	GetUserPromotionRevocationsStub        func(context.Context, uuid.UUID) ([]types.UserPromotionRevocation, error)
+	getUserPromotionRevocationsMutex       sync.RWMutex
+	getUserPromotionRevocationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserPromotionRevocationsReturns struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}
+	getUserPromotionRevocationsReturnsOnCall map[int]struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}
+	GetUserPromotionsStub        func(context.Context, uuid.UUID, types.UserPromotionFilter) ([]types.UserPromotion, error)
 	getUserPromotionsMutex       sync.RWMutex
 	getUserPromotionsArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 types.UserPromotionFilter
 	}
 	getUserPromotionsReturns struct {
 		result1 []types.UserPromotion
@@ -77,6 +108,144 @@ type FakeUserPromotionManager struct {
 		result1 []types.UserPromotion
 		result2 error
 	}
+	UserPromotionClaimCountStub        func(context.Context, uuid.UUID, uuid.UUID) (int, error)
+	userPromotionClaimCountMutex       sync.RWMutex
+	userPromotionClaimCountArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	userPromotionClaimCountReturns struct {
+		result1 int
+		result2 error
+	}
+	userPromotionClaimCountReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UserPromotionConvertStub        func(context.Context, uuid.UUID) error
+	userPromotionConvertMutex       sync.RWMutex
+	userPromotionConvertArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userPromotionConvertReturns struct {
+		result1 error
+	}
+	userPromotionConvertReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionForfeitStub        func(context.Context, uuid.UUID) error
+	userPromotionForfeitMutex       sync.RWMutex
+	userPromotionForfeitArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userPromotionForfeitReturns struct {
+		result1 error
+	}
+	userPromotionForfeitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionRevocationCreateStub        func(context.Context, types.UserPromotionRevocation) error
+	userPromotionRevocationCreateMutex       sync.RWMutex
+	userPromotionRevocationCreateArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.UserPromotionRevocation
+	}
+	userPromotionRevocationCreateReturns struct {
+		result1 error
+	}
+	userPromotionRevocationCreateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionRevokeStub        func(context.Context, uuid.UUID, types.UserPromotionStatus) (types.UserPromotion, error)
+	userPromotionRevokeMutex       sync.RWMutex
+	userPromotionRevokeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.UserPromotionStatus
+	}
+	userPromotionRevokeReturns struct {
+		result1 types.UserPromotion
+		result2 error
+	}
+	userPromotionRevokeReturnsOnCall map[int]struct {
+		result1 types.UserPromotion
+		result2 error
+	}
+	UserPromotionSettleStub        func(context.Context, types.UserPromotion) error
+	userPromotionSettleMutex       sync.RWMutex
+	userPromotionSettleArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.UserPromotion
+	}
+	userPromotionSettleReturns struct {
+		result1 error
+	}
+	userPromotionSettleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UserPromotionsExpireStub        func(context.Context, time.Time) (int, error)
+	userPromotionsExpireMutex       sync.RWMutex
+	userPromotionsExpireArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	userPromotionsExpireReturns struct {
+		result1 int
+		result2 error
+	}
+	userPromotionsExpireReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UserPromotionsRemindStub        func(context.Context, time.Time, time.Duration) ([]types.PromotionExpiryReminder, error)
+	userPromotionsRemindMutex       sync.RWMutex
+	userPromotionsRemindArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Duration
+	}
+	userPromotionsRemindReturns struct {
+		result1 []types.PromotionExpiryReminder
+		result2 error
+	}
+	userPromotionsRemindReturnsOnCall map[int]struct {
+		result1 []types.PromotionExpiryReminder
+		result2 error
+	}
+	UserPromotionsUnwagerStub        func(context.Context, uuid.UUID, uuid.UUID) (int, error)
+	userPromotionsUnwagerMutex       sync.RWMutex
+	userPromotionsUnwagerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	userPromotionsUnwagerReturns struct {
+		result1 int
+		result2 error
+	}
+	userPromotionsUnwagerReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	UserPromotionsWagerStub        func(context.Context, uuid.UUID, uuid.UUID, types.Money) ([]types.UserPromotion, error)
+	userPromotionsWagerMutex       sync.RWMutex
+	userPromotionsWagerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 types.Money
+	}
+	userPromotionsWagerReturns struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	userPromotionsWagerReturnsOnCall map[int]struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -146,12 +315,12 @@ func (fake *FakeUserPromotionManager) AddPromotionReturnsOnCall(i int, result1 t
 	}{result1, result2}
 }
 
-func (fake *FakeUserPromotionManager) ClaimPromotion(arg1 context.Context, arg2 uuid.UUID) error {
+func (fake *FakeUserPromotionManager) ClaimPromotion(arg1 context.Context, arg2 types.UserPromotion) error {
 	fake.claimPromotionMutex.Lock()
 	ret, specificReturn := fake.claimPromotionReturnsOnCall[len(fake.claimPromotionArgsForCall)]
 	fake.claimPromotionArgsForCall = append(fake.claimPromotionArgsForCall, struct {
 		arg1 context.Context
-		arg2 uuid.UUID
+		arg2 types.UserPromotion
 	}{arg1, arg2})
 	stub := fake.ClaimPromotionStub
 	fakeReturns := fake.claimPromotionReturns
@@ -172,13 +341,13 @@ func (fake *FakeUserPromotionManager) ClaimPromotionCallCount() int {
 	return len(fake.claimPromotionArgsForCall)
 }
 
-func (fake *FakeUserPromotionManager) ClaimPromotionCalls(stub func(context.Context, uuid.UUID) error) {
+func (fake *FakeUserPromotionManager) ClaimPromotionCalls(stub func(context.Context, types.UserPromotion) error) {
 	fake.claimPromotionMutex.Lock()
 	defer fake.claimPromotionMutex.Unlock()
 	fake.ClaimPromotionStub = stub
 }
 
-func (fake *FakeUserPromotionManager) ClaimPromotionArgsForCall(i int) (context.Context, uuid.UUID) {
+func (fake *FakeUserPromotionManager) ClaimPromotionArgsForCall(i int) (context.Context, types.UserPromotion) {
 	fake.claimPromotionMutex.RLock()
 	defer fake.claimPromotionMutex.RUnlock()
 	argsForCall := fake.claimPromotionArgsForCall[i]
@@ -270,6 +439,72 @@ func (fake *FakeUserPromotionManager) DeleteUserPromotionReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeUserPromotionManager) GetExpiredUserPromotionBonuses(arg1 context.Context, arg2 time.Time, arg3 int) ([]types.UserPromotion, error) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	ret, specificReturn := fake.getExpiredUserPromotionBonusesReturnsOnCall[len(fake.getExpiredUserPromotionBonusesArgsForCall)]
+	fake.getExpiredUserPromotionBonusesArgsForCall = append(fake.getExpiredUserPromotionBonusesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetExpiredUserPromotionBonusesStub
+	fakeReturns := fake.getExpiredUserPromotionBonusesReturns
+	fake.recordInvocation("GetExpiredUserPromotionBonuses", []interface{}{arg1, arg2, arg3})
+	fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) GetExpiredUserPromotionBonusesCallCount() int {
+	fake.getExpiredUserPromotionBonusesMutex.RLock()
+	defer fake.getExpiredUserPromotionBonusesMutex.RUnlock()
+	return len(fake.getExpiredUserPromotionBonusesArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) GetExpiredUserPromotionBonusesCalls(stub func(context.Context, time.Time, int) ([]types.UserPromotion, error)) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	defer fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	fake.GetExpiredUserPromotionBonusesStub = stub
+}
+
+func (fake *FakeUserPromotionManager) GetExpiredUserPromotionBonusesArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.getExpiredUserPromotionBonusesMutex.RLock()
+	defer fake.getExpiredUserPromotionBonusesMutex.RUnlock()
+	argsForCall := fake.getExpiredUserPromotionBonusesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserPromotionManager) GetExpiredUserPromotionBonusesReturns(result1 []types.UserPromotion, result2 error) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	defer fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	fake.GetExpiredUserPromotionBonusesStub = nil
+	fake.getExpiredUserPromotionBonusesReturns = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) GetExpiredUserPromotionBonusesReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
+	fake.getExpiredUserPromotionBonusesMutex.Lock()
+	defer fake.getExpiredUserPromotionBonusesMutex.Unlock()
+	fake.GetExpiredUserPromotionBonusesStub = nil
+	if fake.getExpiredUserPromotionBonusesReturnsOnCall == nil {
+		fake.getExpiredUserPromotionBonusesReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotion
+			result2 error
+		})
+	}
+	fake.getExpiredUserPromotionBonusesReturnsOnCall[i] = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserPromotionManager) GetUserPromotionByID(arg1 context.Context, arg2 uuid.UUID) (types.UserPromotion, error) {
 	fake.getUserPromotionByIDMutex.Lock()
 	ret, specificReturn := fake.getUserPromotionByIDReturnsOnCall[len(fake.getUserPromotionByIDArgsForCall)]
@@ -335,19 +570,85 @@ func (fake *FakeUserPromotionManager) GetUserPromotionByIDReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
-func (fake *FakeUserPromotionManager) GetUserPromotions(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotion, error) {
+func (fake *FakeUserPromotionManager) GetUserPromotionRevocations(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotionRevocation, error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	ret, specificReturn := fake.getUserPromotionRevocationsReturnsOnCall[len(fake.getUserPromotionRevocationsArgsForCall)]
+	fake.getUserPromotionRevocationsArgsForCall = append(fake.getUserPromotionRevocationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserPromotionRevocationsStub
+	fakeReturns := fake.getUserPromotionRevocationsReturns
+	fake.recordInvocation("GetUserPromotionRevocations", []interface{}{arg1, arg2})
+	fake.getUserPromotionRevocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) GetUserPromotionRevocationsCallCount() int {
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
+	return len(fake.getUserPromotionRevocationsArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) GetUserPromotionRevocationsCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotionRevocation, error)) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = stub
+}
+
+func (fake *FakeUserPromotionManager) GetUserPromotionRevocationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
+	argsForCall := fake.getUserPromotionRevocationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionManager) GetUserPromotionRevocationsReturns(result1 []types.UserPromotionRevocation, result2 error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = nil
+	fake.getUserPromotionRevocationsReturns = struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) GetUserPromotionRevocationsReturnsOnCall(i int, result1 []types.UserPromotionRevocation, result2 error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = nil
+	if fake.getUserPromotionRevocationsReturnsOnCall == nil {
+		fake.getUserPromotionRevocationsReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotionRevocation
+			result2 error
+		})
+	}
+	fake.getUserPromotionRevocationsReturnsOnCall[i] = struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) GetUserPromotions(arg1 context.Context, arg2 uuid.UUID, arg3 types.UserPromotionFilter) ([]types.UserPromotion, error) {
 	fake.getUserPromotionsMutex.Lock()
 	ret, specificReturn := fake.getUserPromotionsReturnsOnCall[len(fake.getUserPromotionsArgsForCall)]
 	fake.getUserPromotionsArgsForCall = append(fake.getUserPromotionsArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
+		arg3 types.UserPromotionFilter
+	}{arg1, arg2, arg3})
 	stub := fake.GetUserPromotionsStub
 	fakeReturns := fake.getUserPromotionsReturns
-	fake.recordInvocation("GetUserPromotions", []interface{}{arg1, arg2})
+	fake.recordInvocation("GetUserPromotions", []interface{}{arg1, arg2, arg3})
 	fake.getUserPromotionsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -361,17 +662,17 @@ func (fake *FakeUserPromotionManager) GetUserPromotionsCallCount() int {
 	return len(fake.getUserPromotionsArgsForCall)
 }
 
-func (fake *FakeUserPromotionManager) GetUserPromotionsCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotion, error)) {
+func (fake *FakeUserPromotionManager) GetUserPromotionsCalls(stub func(context.Context, uuid.UUID, types.UserPromotionFilter) ([]types.UserPromotion, error)) {
 	fake.getUserPromotionsMutex.Lock()
 	defer fake.getUserPromotionsMutex.Unlock()
 	fake.GetUserPromotionsStub = stub
 }
 
-func (fake *FakeUserPromotionManager) GetUserPromotionsArgsForCall(i int) (context.Context, uuid.UUID) {
+func (fake *FakeUserPromotionManager) GetUserPromotionsArgsForCall(i int) (context.Context, uuid.UUID, types.UserPromotionFilter) {
 	fake.getUserPromotionsMutex.RLock()
 	defer fake.getUserPromotionsMutex.RUnlock()
 	argsForCall := fake.getUserPromotionsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeUserPromotionManager) GetUserPromotionsReturns(result1 []types.UserPromotion, result2 error) {
@@ -400,6 +701,650 @@ func (fake *FakeUserPromotionManager) GetUserPromotionsReturnsOnCall(i int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeUserPromotionManager) UserPromotionClaimCount(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (int, error) {
+	fake.userPromotionClaimCountMutex.Lock()
+	ret, specificReturn := fake.userPromotionClaimCountReturnsOnCall[len(fake.userPromotionClaimCountArgsForCall)]
+	fake.userPromotionClaimCountArgsForCall = append(fake.userPromotionClaimCountArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionClaimCountStub
+	fakeReturns := fake.userPromotionClaimCountReturns
+	fake.recordInvocation("UserPromotionClaimCount", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionClaimCountMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionClaimCountCallCount() int {
+	fake.userPromotionClaimCountMutex.RLock()
+	defer fake.userPromotionClaimCountMutex.RUnlock()
+	return len(fake.userPromotionClaimCountArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionClaimCountCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (int, error)) {
+	fake.userPromotionClaimCountMutex.Lock()
+	defer fake.userPromotionClaimCountMutex.Unlock()
+	fake.UserPromotionClaimCountStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionClaimCountArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.userPromotionClaimCountMutex.RLock()
+	defer fake.userPromotionClaimCountMutex.RUnlock()
+	argsForCall := fake.userPromotionClaimCountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionClaimCountReturns(result1 int, result2 error) {
+	fake.userPromotionClaimCountMutex.Lock()
+	defer fake.userPromotionClaimCountMutex.Unlock()
+	fake.UserPromotionClaimCountStub = nil
+	fake.userPromotionClaimCountReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionClaimCountReturnsOnCall(i int, result1 int, result2 error) {
+	fake.userPromotionClaimCountMutex.Lock()
+	defer fake.userPromotionClaimCountMutex.Unlock()
+	fake.UserPromotionClaimCountStub = nil
+	if fake.userPromotionClaimCountReturnsOnCall == nil {
+		fake.userPromotionClaimCountReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.userPromotionClaimCountReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionConvert(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.userPromotionConvertMutex.Lock()
+	ret, specificReturn := fake.userPromotionConvertReturnsOnCall[len(fake.userPromotionConvertArgsForCall)]
+	fake.userPromotionConvertArgsForCall = append(fake.userPromotionConvertArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UserPromotionConvertStub
+	fakeReturns := fake.userPromotionConvertReturns
+	fake.recordInvocation("UserPromotionConvert", []interface{}{arg1, arg2})
+	fake.userPromotionConvertMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionConvertCallCount() int {
+	fake.userPromotionConvertMutex.RLock()
+	defer fake.userPromotionConvertMutex.RUnlock()
+	return len(fake.userPromotionConvertArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionConvertCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.userPromotionConvertMutex.Lock()
+	defer fake.userPromotionConvertMutex.Unlock()
+	fake.UserPromotionConvertStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionConvertArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userPromotionConvertMutex.RLock()
+	defer fake.userPromotionConvertMutex.RUnlock()
+	argsForCall := fake.userPromotionConvertArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionConvertReturns(result1 error) {
+	fake.userPromotionConvertMutex.Lock()
+	defer fake.userPromotionConvertMutex.Unlock()
+	fake.UserPromotionConvertStub = nil
+	fake.userPromotionConvertReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionConvertReturnsOnCall(i int, result1 error) {
+	fake.userPromotionConvertMutex.Lock()
+	defer fake.userPromotionConvertMutex.Unlock()
+	fake.UserPromotionConvertStub = nil
+	if fake.userPromotionConvertReturnsOnCall == nil {
+		fake.userPromotionConvertReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userPromotionConvertReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionForfeit(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.userPromotionForfeitMutex.Lock()
+	ret, specificReturn := fake.userPromotionForfeitReturnsOnCall[len(fake.userPromotionForfeitArgsForCall)]
+	fake.userPromotionForfeitArgsForCall = append(fake.userPromotionForfeitArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UserPromotionForfeitStub
+	fakeReturns := fake.userPromotionForfeitReturns
+	fake.recordInvocation("UserPromotionForfeit", []interface{}{arg1, arg2})
+	fake.userPromotionForfeitMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionForfeitCallCount() int {
+	fake.userPromotionForfeitMutex.RLock()
+	defer fake.userPromotionForfeitMutex.RUnlock()
+	return len(fake.userPromotionForfeitArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionForfeitCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.userPromotionForfeitMutex.Lock()
+	defer fake.userPromotionForfeitMutex.Unlock()
+	fake.UserPromotionForfeitStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionForfeitArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userPromotionForfeitMutex.RLock()
+	defer fake.userPromotionForfeitMutex.RUnlock()
+	argsForCall := fake.userPromotionForfeitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionForfeitReturns(result1 error) {
+	fake.userPromotionForfeitMutex.Lock()
+	defer fake.userPromotionForfeitMutex.Unlock()
+	fake.UserPromotionForfeitStub = nil
+	fake.userPromotionForfeitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionForfeitReturnsOnCall(i int, result1 error) {
+	fake.userPromotionForfeitMutex.Lock()
+	defer fake.userPromotionForfeitMutex.Unlock()
+	fake.UserPromotionForfeitStub = nil
+	if fake.userPromotionForfeitReturnsOnCall == nil {
+		fake.userPromotionForfeitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userPromotionForfeitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevocationCreate(arg1 context.Context, arg2 types.UserPromotionRevocation) error {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	ret, specificReturn := fake.userPromotionRevocationCreateReturnsOnCall[len(fake.userPromotionRevocationCreateArgsForCall)]
+	fake.userPromotionRevocationCreateArgsForCall = append(fake.userPromotionRevocationCreateArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.UserPromotionRevocation
+	}{arg1, arg2})
+	stub := fake.UserPromotionRevocationCreateStub
+	fakeReturns := fake.userPromotionRevocationCreateReturns
+	fake.recordInvocation("UserPromotionRevocationCreate", []interface{}{arg1, arg2})
+	fake.userPromotionRevocationCreateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevocationCreateCallCount() int {
+	fake.userPromotionRevocationCreateMutex.RLock()
+	defer fake.userPromotionRevocationCreateMutex.RUnlock()
+	return len(fake.userPromotionRevocationCreateArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevocationCreateCalls(stub func(context.Context, types.UserPromotionRevocation) error) {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	defer fake.userPromotionRevocationCreateMutex.Unlock()
+	fake.UserPromotionRevocationCreateStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevocationCreateArgsForCall(i int) (context.Context, types.UserPromotionRevocation) {
+	fake.userPromotionRevocationCreateMutex.RLock()
+	defer fake.userPromotionRevocationCreateMutex.RUnlock()
+	argsForCall := fake.userPromotionRevocationCreateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevocationCreateReturns(result1 error) {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	defer fake.userPromotionRevocationCreateMutex.Unlock()
+	fake.UserPromotionRevocationCreateStub = nil
+	fake.userPromotionRevocationCreateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevocationCreateReturnsOnCall(i int, result1 error) {
+	fake.userPromotionRevocationCreateMutex.Lock()
+	defer fake.userPromotionRevocationCreateMutex.Unlock()
+	fake.UserPromotionRevocationCreateStub = nil
+	if fake.userPromotionRevocationCreateReturnsOnCall == nil {
+		fake.userPromotionRevocationCreateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userPromotionRevocationCreateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevoke(arg1 context.Context, arg2 uuid.UUID, arg3 types.UserPromotionStatus) (types.UserPromotion, error) {
+	fake.userPromotionRevokeMutex.Lock()
+	ret, specificReturn := fake.userPromotionRevokeReturnsOnCall[len(fake.userPromotionRevokeArgsForCall)]
+	fake.userPromotionRevokeArgsForCall = append(fake.userPromotionRevokeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 types.UserPromotionStatus
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionRevokeStub
+	fakeReturns := fake.userPromotionRevokeReturns
+	fake.recordInvocation("UserPromotionRevoke", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionRevokeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevokeCallCount() int {
+	fake.userPromotionRevokeMutex.RLock()
+	defer fake.userPromotionRevokeMutex.RUnlock()
+	return len(fake.userPromotionRevokeArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevokeCalls(stub func(context.Context, uuid.UUID, types.UserPromotionStatus) (types.UserPromotion, error)) {
+	fake.userPromotionRevokeMutex.Lock()
+	defer fake.userPromotionRevokeMutex.Unlock()
+	fake.UserPromotionRevokeStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevokeArgsForCall(i int) (context.Context, uuid.UUID, types.UserPromotionStatus) {
+	fake.userPromotionRevokeMutex.RLock()
+	defer fake.userPromotionRevokeMutex.RUnlock()
+	argsForCall := fake.userPromotionRevokeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevokeReturns(result1 types.UserPromotion, result2 error) {
+	fake.userPromotionRevokeMutex.Lock()
+	defer fake.userPromotionRevokeMutex.Unlock()
+	fake.UserPromotionRevokeStub = nil
+	fake.userPromotionRevokeReturns = struct {
+		result1 types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionRevokeReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
+	fake.userPromotionRevokeMutex.Lock()
+	defer fake.userPromotionRevokeMutex.Unlock()
+	fake.UserPromotionRevokeStub = nil
+	if fake.userPromotionRevokeReturnsOnCall == nil {
+		fake.userPromotionRevokeReturnsOnCall = make(map[int]struct {
+			result1 types.UserPromotion
+			result2 error
+		})
+	}
+	fake.userPromotionRevokeReturnsOnCall[i] = struct {
+		result1 types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionSettle(arg1 context.Context, arg2 types.UserPromotion) error {
+	fake.userPromotionSettleMutex.Lock()
+	ret, specificReturn := fake.userPromotionSettleReturnsOnCall[len(fake.userPromotionSettleArgsForCall)]
+	fake.userPromotionSettleArgsForCall = append(fake.userPromotionSettleArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.UserPromotion
+	}{arg1, arg2})
+	stub := fake.UserPromotionSettleStub
+	fakeReturns := fake.userPromotionSettleReturns
+	fake.recordInvocation("UserPromotionSettle", []interface{}{arg1, arg2})
+	fake.userPromotionSettleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionSettleCallCount() int {
+	fake.userPromotionSettleMutex.RLock()
+	defer fake.userPromotionSettleMutex.RUnlock()
+	return len(fake.userPromotionSettleArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionSettleCalls(stub func(context.Context, types.UserPromotion) error) {
+	fake.userPromotionSettleMutex.Lock()
+	defer fake.userPromotionSettleMutex.Unlock()
+	fake.UserPromotionSettleStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionSettleArgsForCall(i int) (context.Context, types.UserPromotion) {
+	fake.userPromotionSettleMutex.RLock()
+	defer fake.userPromotionSettleMutex.RUnlock()
+	argsForCall := fake.userPromotionSettleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionSettleReturns(result1 error) {
+	fake.userPromotionSettleMutex.Lock()
+	defer fake.userPromotionSettleMutex.Unlock()
+	fake.UserPromotionSettleStub = nil
+	fake.userPromotionSettleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionSettleReturnsOnCall(i int, result1 error) {
+	fake.userPromotionSettleMutex.Lock()
+	defer fake.userPromotionSettleMutex.Unlock()
+	fake.UserPromotionSettleStub = nil
+	if fake.userPromotionSettleReturnsOnCall == nil {
+		fake.userPromotionSettleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.userPromotionSettleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsExpire(arg1 context.Context, arg2 time.Time) (int, error) {
+	fake.userPromotionsExpireMutex.Lock()
+	ret, specificReturn := fake.userPromotionsExpireReturnsOnCall[len(fake.userPromotionsExpireArgsForCall)]
+	fake.userPromotionsExpireArgsForCall = append(fake.userPromotionsExpireArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.UserPromotionsExpireStub
+	fakeReturns := fake.userPromotionsExpireReturns
+	fake.recordInvocation("UserPromotionsExpire", []interface{}{arg1, arg2})
+	fake.userPromotionsExpireMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsExpireCallCount() int {
+	fake.userPromotionsExpireMutex.RLock()
+	defer fake.userPromotionsExpireMutex.RUnlock()
+	return len(fake.userPromotionsExpireArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsExpireCalls(stub func(context.Context, time.Time) (int, error)) {
+	fake.userPromotionsExpireMutex.Lock()
+	defer fake.userPromotionsExpireMutex.Unlock()
+	fake.UserPromotionsExpireStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsExpireArgsForCall(i int) (context.Context, time.Time) {
+	fake.userPromotionsExpireMutex.RLock()
+	defer fake.userPromotionsExpireMutex.RUnlock()
+	argsForCall := fake.userPromotionsExpireArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsExpireReturns(result1 int, result2 error) {
+	fake.userPromotionsExpireMutex.Lock()
+	defer fake.userPromotionsExpireMutex.Unlock()
+	fake.UserPromotionsExpireStub = nil
+	fake.userPromotionsExpireReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsExpireReturnsOnCall(i int, result1 int, result2 error) {
+	fake.userPromotionsExpireMutex.Lock()
+	defer fake.userPromotionsExpireMutex.Unlock()
+	fake.UserPromotionsExpireStub = nil
+	if fake.userPromotionsExpireReturnsOnCall == nil {
+		fake.userPromotionsExpireReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.userPromotionsExpireReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsRemind(arg1 context.Context, arg2 time.Time, arg3 time.Duration) ([]types.PromotionExpiryReminder, error) {
+	fake.userPromotionsRemindMutex.Lock()
+	ret, specificReturn := fake.userPromotionsRemindReturnsOnCall[len(fake.userPromotionsRemindArgsForCall)]
+	fake.userPromotionsRemindArgsForCall = append(fake.userPromotionsRemindArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionsRemindStub
+	fakeReturns := fake.userPromotionsRemindReturns
+	fake.recordInvocation("UserPromotionsRemind", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionsRemindMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsRemindCallCount() int {
+	fake.userPromotionsRemindMutex.RLock()
+	defer fake.userPromotionsRemindMutex.RUnlock()
+	return len(fake.userPromotionsRemindArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsRemindCalls(stub func(context.Context, time.Time, time.Duration) ([]types.PromotionExpiryReminder, error)) {
+	fake.userPromotionsRemindMutex.Lock()
+	defer fake.userPromotionsRemindMutex.Unlock()
+	fake.UserPromotionsRemindStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsRemindArgsForCall(i int) (context.Context, time.Time, time.Duration) {
+	fake.userPromotionsRemindMutex.RLock()
+	defer fake.userPromotionsRemindMutex.RUnlock()
+	argsForCall := fake.userPromotionsRemindArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsRemindReturns(result1 []types.PromotionExpiryReminder, result2 error) {
+	fake.userPromotionsRemindMutex.Lock()
+	defer fake.userPromotionsRemindMutex.Unlock()
+	fake.UserPromotionsRemindStub = nil
+	fake.userPromotionsRemindReturns = struct {
+		result1 []types.PromotionExpiryReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsRemindReturnsOnCall(i int, result1 []types.PromotionExpiryReminder, result2 error) {
+	fake.userPromotionsRemindMutex.Lock()
+	defer fake.userPromotionsRemindMutex.Unlock()
+	fake.UserPromotionsRemindStub = nil
+	if fake.userPromotionsRemindReturnsOnCall == nil {
+		fake.userPromotionsRemindReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionExpiryReminder
+			result2 error
+		})
+	}
+	fake.userPromotionsRemindReturnsOnCall[i] = struct {
+		result1 []types.PromotionExpiryReminder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsUnwager(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (int, error) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	ret, specificReturn := fake.userPromotionsUnwagerReturnsOnCall[len(fake.userPromotionsUnwagerArgsForCall)]
+	fake.userPromotionsUnwagerArgsForCall = append(fake.userPromotionsUnwagerArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.UserPromotionsUnwagerStub
+	fakeReturns := fake.userPromotionsUnwagerReturns
+	fake.recordInvocation("UserPromotionsUnwager", []interface{}{arg1, arg2, arg3})
+	fake.userPromotionsUnwagerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsUnwagerCallCount() int {
+	fake.userPromotionsUnwagerMutex.RLock()
+	defer fake.userPromotionsUnwagerMutex.RUnlock()
+	return len(fake.userPromotionsUnwagerArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsUnwagerCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (int, error)) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	defer fake.userPromotionsUnwagerMutex.Unlock()
+	fake.UserPromotionsUnwagerStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsUnwagerArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.userPromotionsUnwagerMutex.RLock()
+	defer fake.userPromotionsUnwagerMutex.RUnlock()
+	argsForCall := fake.userPromotionsUnwagerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsUnwagerReturns(result1 int, result2 error) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	defer fake.userPromotionsUnwagerMutex.Unlock()
+	fake.UserPromotionsUnwagerStub = nil
+	fake.userPromotionsUnwagerReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsUnwagerReturnsOnCall(i int, result1 int, result2 error) {
+	fake.userPromotionsUnwagerMutex.Lock()
+	defer fake.userPromotionsUnwagerMutex.Unlock()
+	fake.UserPromotionsUnwagerStub = nil
+	if fake.userPromotionsUnwagerReturnsOnCall == nil {
+		fake.userPromotionsUnwagerReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.userPromotionsUnwagerReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsWager(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 types.Money) ([]types.UserPromotion, error) {
+	fake.userPromotionsWagerMutex.Lock()
+	ret, specificReturn := fake.userPromotionsWagerReturnsOnCall[len(fake.userPromotionsWagerArgsForCall)]
+	fake.userPromotionsWagerArgsForCall = append(fake.userPromotionsWagerArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 types.Money
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UserPromotionsWagerStub
+	fakeReturns := fake.userPromotionsWagerReturns
+	fake.recordInvocation("UserPromotionsWager", []interface{}{arg1, arg2, arg3, arg4})
+	fake.userPromotionsWagerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsWagerCallCount() int {
+	fake.userPromotionsWagerMutex.RLock()
+	defer fake.userPromotionsWagerMutex.RUnlock()
+	return len(fake.userPromotionsWagerArgsForCall)
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsWagerCalls(stub func(context.Context, uuid.UUID, uuid.UUID, types.Money) ([]types.UserPromotion, error)) {
+	fake.userPromotionsWagerMutex.Lock()
+	defer fake.userPromotionsWagerMutex.Unlock()
+	fake.UserPromotionsWagerStub = stub
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsWagerArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, types.Money) {
+	fake.userPromotionsWagerMutex.RLock()
+	defer fake.userPromotionsWagerMutex.RUnlock()
+	argsForCall := fake.userPromotionsWagerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsWagerReturns(result1 []types.UserPromotion, result2 error) {
+	fake.userPromotionsWagerMutex.Lock()
+	defer fake.userPromotionsWagerMutex.Unlock()
+	fake.UserPromotionsWagerStub = nil
+	fake.userPromotionsWagerReturns = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionManager) UserPromotionsWagerReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
+	fake.userPromotionsWagerMutex.Lock()
+	defer fake.userPromotionsWagerMutex.Unlock()
+	fake.UserPromotionsWagerStub = nil
+	if fake.userPromotionsWagerReturnsOnCall == nil {
+		fake.userPromotionsWagerReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotion
+			result2 error
+		})
+	}
+	fake.userPromotionsWagerReturnsOnCall[i] = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserPromotionManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -409,10 +1354,34 @@ func (fake *FakeUserPromotionManager) Invocations() map[string][][]interface{} {
 	defer fake.claimPromotionMutex.RUnlock()
 	fake.deleteUserPromotionMutex.RLock()
 	defer fake.deleteUserPromotionMutex.RUnlock()
+	fake.getExpiredUserPromotionBonusesMutex.RLock()
+	defer fake.getExpiredUserPromotionBonusesMutex.RUnlock()
 	fake.getUserPromotionByIDMutex.RLock()
 	defer fake.getUserPromotionByIDMutex.RUnlock()
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
 	fake.getUserPromotionsMutex.RLock()
 	defer fake.getUserPromotionsMutex.RUnlock()
+	fake.userPromotionClaimCountMutex.RLock()
+	defer fake.userPromotionClaimCountMutex.RUnlock()
+	fake.userPromotionConvertMutex.RLock()
+	defer fake.userPromotionConvertMutex.RUnlock()
+	fake.userPromotionForfeitMutex.RLock()
+	defer fake.userPromotionForfeitMutex.RUnlock()
+	fake.userPromotionRevocationCreateMutex.RLock()
+	defer fake.userPromotionRevocationCreateMutex.RUnlock()
+	fake.userPromotionRevokeMutex.RLock()
+	defer fake.userPromotionRevokeMutex.RUnlock()
+	fake.userPromotionSettleMutex.RLock()
+	defer fake.userPromotionSettleMutex.RUnlock()
+	fake.userPromotionsExpireMutex.RLock()
+	defer fake.userPromotionsExpireMutex.RUnlock()
+	fake.userPromotionsRemindMutex.RLock()
+	defer fake.userPromotionsRemindMutex.RUnlock()
+	fake.userPromotionsUnwagerMutex.RLock()
+	defer fake.userPromotionsUnwagerMutex.RUnlock()
+	fake.userPromotionsWagerMutex.RLock()
+	defer fake.userPromotionsWagerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
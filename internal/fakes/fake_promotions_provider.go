@@ -11,6 +11,19 @@ import (
 )
 
 type FakePromotionProvider struct {
+	ApplyScheduleStub        func(context.Context) (int, error)
+	applyScheduleMutex       sync.RWMutex
+	applyScheduleArgsForCall []struct {
+		arg1 context.Context
+	}
+	applyScheduleReturns struct {
+		result1 int
+		result2 error
+	}
+	applyScheduleReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	CreatePromotionsStub        func(context.Context, types.Promotion) (types.Promotion, error)
 	createPromotionsMutex       sync.RWMutex
 	createPromotionsArgsForCall []struct {
@@ -25,6 +38,20 @@ type FakePromotionProvider struct {
 		result1 types.Promotion
 		result2 error
 	}
+	CreateWelcomePackageStub        func(context.Context, types.WelcomePackage) (types.WelcomePackage, error)
+	createWelcomePackageMutex       sync.RWMutex
+	createWelcomePackageArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.WelcomePackage
+	}
+	createWelcomePackageReturns struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	createWelcomePackageReturnsOnCall map[int]struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
 	DeletePromotionStub        func(context.Context, uuid.UUID) error
 	deletePromotionMutex       sync.RWMutex
 	deletePromotionArgsForCall []struct {
@@ -51,10 +78,25 @@ type FakePromotionProvider struct {
 		result1 types.Promotion
 		result2 error
 	}
-	GetPromotionsStub        func(context.Context) ([]types.Promotion, error)
+	GetPromotionStateChangesStub        func(context.Context, uuid.UUID) ([]types.PromotionStateChange, error)
+	getPromotionStateChangesMutex       sync.RWMutex
+	getPromotionStateChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPromotionStateChangesReturns struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	getPromotionStateChangesReturnsOnCall map[int]struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}
+	GetPromotionsStub        func(context.Context, types.PromotionFilter) ([]types.Promotion, error)
 	getPromotionsMutex       sync.RWMutex
 	getPromotionsArgsForCall []struct {
 		arg1 context.Context
+		arg2 types.PromotionFilter
 	}
 	getPromotionsReturns struct {
 		result1 []types.Promotion
@@ -64,6 +106,46 @@ type FakePromotionProvider struct {
 		result1 []types.Promotion
 		result2 error
 	}
+	GetWelcomePackageStub        func(context.Context) (types.WelcomePackage, error)
+	getWelcomePackageMutex       sync.RWMutex
+	getWelcomePackageArgsForCall []struct {
+		arg1 context.Context
+	}
+	getWelcomePackageReturns struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	getWelcomePackageReturnsOnCall map[int]struct {
+		result1 types.WelcomePackage
+		result2 error
+	}
+	GetWelcomePackagesStub        func(context.Context) ([]types.WelcomePackage, error)
+	getWelcomePackagesMutex       sync.RWMutex
+	getWelcomePackagesArgsForCall []struct {
+		arg1 context.Context
+	}
+	getWelcomePackagesReturns struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}
+	getWelcomePackagesReturnsOnCall map[int]struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}
+	RestorePromotionStub        func(context.Context, uuid.UUID) (types.Promotion, error)
+	restorePromotionMutex       sync.RWMutex
+	restorePromotionArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	restorePromotionReturns struct {
+		result1 types.Promotion
+		result2 error
+	}
+	restorePromotionReturnsOnCall map[int]struct {
+		result1 types.Promotion
+		result2 error
+	}
 	UpdatePromotionStub        func(context.Context, types.Promotion) (types.Promotion, error)
 	updatePromotionMutex       sync.RWMutex
 	updatePromotionArgsForCall []struct {
@@ -82,6 +164,70 @@ type FakePromotionProvider struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePromotionProvider) ApplySchedule(arg1 context.Context) (int, error) {
+	fake.applyScheduleMutex.Lock()
+	ret, specificReturn := fake.applyScheduleReturnsOnCall[len(fake.applyScheduleArgsForCall)]
+	fake.applyScheduleArgsForCall = append(fake.applyScheduleArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ApplyScheduleStub
+	fakeReturns := fake.applyScheduleReturns
+	fake.recordInvocation("ApplySchedule", []interface{}{arg1})
+	fake.applyScheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionProvider) ApplyScheduleCallCount() int {
+	fake.applyScheduleMutex.RLock()
+	defer fake.applyScheduleMutex.RUnlock()
+	return len(fake.applyScheduleArgsForCall)
+}
+
+func (fake *FakePromotionProvider) ApplyScheduleCalls(stub func(context.Context) (int, error)) {
+	fake.applyScheduleMutex.Lock()
+	defer fake.applyScheduleMutex.Unlock()
+	fake.ApplyScheduleStub = stub
+}
+
+func (fake *FakePromotionProvider) ApplyScheduleArgsForCall(i int) context.Context {
+	fake.applyScheduleMutex.RLock()
+	defer fake.applyScheduleMutex.RUnlock()
+	argsForCall := fake.applyScheduleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromotionProvider) ApplyScheduleReturns(result1 int, result2 error) {
+	fake.applyScheduleMutex.Lock()
+	defer fake.applyScheduleMutex.Unlock()
+	fake.ApplyScheduleStub = nil
+	fake.applyScheduleReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) ApplyScheduleReturnsOnCall(i int, result1 int, result2 error) {
+	fake.applyScheduleMutex.Lock()
+	defer fake.applyScheduleMutex.Unlock()
+	fake.ApplyScheduleStub = nil
+	if fake.applyScheduleReturnsOnCall == nil {
+		fake.applyScheduleReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.applyScheduleReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePromotionProvider) CreatePromotions(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
 	fake.createPromotionsMutex.Lock()
 	ret, specificReturn := fake.createPromotionsReturnsOnCall[len(fake.createPromotionsArgsForCall)]
@@ -147,6 +293,71 @@ func (fake *FakePromotionProvider) CreatePromotionsReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakePromotionProvider) CreateWelcomePackage(arg1 context.Context, arg2 types.WelcomePackage) (types.WelcomePackage, error) {
+	fake.createWelcomePackageMutex.Lock()
+	ret, specificReturn := fake.createWelcomePackageReturnsOnCall[len(fake.createWelcomePackageArgsForCall)]
+	fake.createWelcomePackageArgsForCall = append(fake.createWelcomePackageArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.WelcomePackage
+	}{arg1, arg2})
+	stub := fake.CreateWelcomePackageStub
+	fakeReturns := fake.createWelcomePackageReturns
+	fake.recordInvocation("CreateWelcomePackage", []interface{}{arg1, arg2})
+	fake.createWelcomePackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionProvider) CreateWelcomePackageCallCount() int {
+	fake.createWelcomePackageMutex.RLock()
+	defer fake.createWelcomePackageMutex.RUnlock()
+	return len(fake.createWelcomePackageArgsForCall)
+}
+
+func (fake *FakePromotionProvider) CreateWelcomePackageCalls(stub func(context.Context, types.WelcomePackage) (types.WelcomePackage, error)) {
+	fake.createWelcomePackageMutex.Lock()
+	defer fake.createWelcomePackageMutex.Unlock()
+	fake.CreateWelcomePackageStub = stub
+}
+
+func (fake *FakePromotionProvider) CreateWelcomePackageArgsForCall(i int) (context.Context, types.WelcomePackage) {
+	fake.createWelcomePackageMutex.RLock()
+	defer fake.createWelcomePackageMutex.RUnlock()
+	argsForCall := fake.createWelcomePackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionProvider) CreateWelcomePackageReturns(result1 types.WelcomePackage, result2 error) {
+	fake.createWelcomePackageMutex.Lock()
+	defer fake.createWelcomePackageMutex.Unlock()
+	fake.CreateWelcomePackageStub = nil
+	fake.createWelcomePackageReturns = struct {
+		result1 types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) CreateWelcomePackageReturnsOnCall(i int, result1 types.WelcomePackage, result2 error) {
+	fake.createWelcomePackageMutex.Lock()
+	defer fake.createWelcomePackageMutex.Unlock()
+	fake.CreateWelcomePackageStub = nil
+	if fake.createWelcomePackageReturnsOnCall == nil {
+		fake.createWelcomePackageReturnsOnCall = make(map[int]struct {
+			result1 types.WelcomePackage
+			result2 error
+		})
+	}
+	fake.createWelcomePackageReturnsOnCall[i] = struct {
+		result1 types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePromotionProvider) DeletePromotion(arg1 context.Context, arg2 uuid.UUID) error {
 	fake.deletePromotionMutex.Lock()
 	ret, specificReturn := fake.deletePromotionReturnsOnCall[len(fake.deletePromotionArgsForCall)]
@@ -274,18 +485,84 @@ func (fake *FakePromotionProvider) GetPromotionByIDReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakePromotionProvider) GetPromotions(arg1 context.Context) ([]types.Promotion, error) {
+func (fake *FakePromotionProvider) GetPromotionStateChanges(arg1 context.Context, arg2 uuid.UUID) ([]types.PromotionStateChange, error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	ret, specificReturn := fake.getPromotionStateChangesReturnsOnCall[len(fake.getPromotionStateChangesArgsForCall)]
+	fake.getPromotionStateChangesArgsForCall = append(fake.getPromotionStateChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPromotionStateChangesStub
+	fakeReturns := fake.getPromotionStateChangesReturns
+	fake.recordInvocation("GetPromotionStateChanges", []interface{}{arg1, arg2})
+	fake.getPromotionStateChangesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionProvider) GetPromotionStateChangesCallCount() int {
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
+	return len(fake.getPromotionStateChangesArgsForCall)
+}
+
+func (fake *FakePromotionProvider) GetPromotionStateChangesCalls(stub func(context.Context, uuid.UUID) ([]types.PromotionStateChange, error)) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = stub
+}
+
+func (fake *FakePromotionProvider) GetPromotionStateChangesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
+	argsForCall := fake.getPromotionStateChangesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionProvider) GetPromotionStateChangesReturns(result1 []types.PromotionStateChange, result2 error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = nil
+	fake.getPromotionStateChangesReturns = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) GetPromotionStateChangesReturnsOnCall(i int, result1 []types.PromotionStateChange, result2 error) {
+	fake.getPromotionStateChangesMutex.Lock()
+	defer fake.getPromotionStateChangesMutex.Unlock()
+	fake.GetPromotionStateChangesStub = nil
+	if fake.getPromotionStateChangesReturnsOnCall == nil {
+		fake.getPromotionStateChangesReturnsOnCall = make(map[int]struct {
+			result1 []types.PromotionStateChange
+			result2 error
+		})
+	}
+	fake.getPromotionStateChangesReturnsOnCall[i] = struct {
+		result1 []types.PromotionStateChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) GetPromotions(arg1 context.Context, arg2 types.PromotionFilter) ([]types.Promotion, error) {
 	fake.getPromotionsMutex.Lock()
 	ret, specificReturn := fake.getPromotionsReturnsOnCall[len(fake.getPromotionsArgsForCall)]
 	fake.getPromotionsArgsForCall = append(fake.getPromotionsArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
+		arg2 types.PromotionFilter
+	}{arg1, arg2})
 	stub := fake.GetPromotionsStub
 	fakeReturns := fake.getPromotionsReturns
-	fake.recordInvocation("GetPromotions", []interface{}{arg1})
+	fake.recordInvocation("GetPromotions", []interface{}{arg1, arg2})
 	fake.getPromotionsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -299,17 +576,17 @@ func (fake *FakePromotionProvider) GetPromotionsCallCount() int {
 	return len(fake.getPromotionsArgsForCall)
 }
 
-func (fake *FakePromotionProvider) GetPromotionsCalls(stub func(context.Context) ([]types.Promotion, error)) {
+func (fake *FakePromotionProvider) GetPromotionsCalls(stub func(context.Context, types.PromotionFilter) ([]types.Promotion, error)) {
 	fake.getPromotionsMutex.Lock()
 	defer fake.getPromotionsMutex.Unlock()
 	fake.GetPromotionsStub = stub
 }
 
-func (fake *FakePromotionProvider) GetPromotionsArgsForCall(i int) context.Context {
+func (fake *FakePromotionProvider) GetPromotionsArgsForCall(i int) (context.Context, types.PromotionFilter) {
 	fake.getPromotionsMutex.RLock()
 	defer fake.getPromotionsMutex.RUnlock()
 	argsForCall := fake.getPromotionsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakePromotionProvider) GetPromotionsReturns(result1 []types.Promotion, result2 error) {
@@ -338,6 +615,199 @@ func (fake *FakePromotionProvider) GetPromotionsReturnsOnCall(i int, result1 []t
 	}{result1, result2}
 }
 
+func (fake *FakePromotionProvider) GetWelcomePackage(arg1 context.Context) (types.WelcomePackage, error) {
+	fake.getWelcomePackageMutex.Lock()
+	ret, specificReturn := fake.getWelcomePackageReturnsOnCall[len(fake.getWelcomePackageArgsForCall)]
+	fake.getWelcomePackageArgsForCall = append(fake.getWelcomePackageArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetWelcomePackageStub
+	fakeReturns := fake.getWelcomePackageReturns
+	fake.recordInvocation("GetWelcomePackage", []interface{}{arg1})
+	fake.getWelcomePackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackageCallCount() int {
+	fake.getWelcomePackageMutex.RLock()
+	defer fake.getWelcomePackageMutex.RUnlock()
+	return len(fake.getWelcomePackageArgsForCall)
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackageCalls(stub func(context.Context) (types.WelcomePackage, error)) {
+	fake.getWelcomePackageMutex.Lock()
+	defer fake.getWelcomePackageMutex.Unlock()
+	fake.GetWelcomePackageStub = stub
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackageArgsForCall(i int) context.Context {
+	fake.getWelcomePackageMutex.RLock()
+	defer fake.getWelcomePackageMutex.RUnlock()
+	argsForCall := fake.getWelcomePackageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackageReturns(result1 types.WelcomePackage, result2 error) {
+	fake.getWelcomePackageMutex.Lock()
+	defer fake.getWelcomePackageMutex.Unlock()
+	fake.GetWelcomePackageStub = nil
+	fake.getWelcomePackageReturns = struct {
+		result1 types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackageReturnsOnCall(i int, result1 types.WelcomePackage, result2 error) {
+	fake.getWelcomePackageMutex.Lock()
+	defer fake.getWelcomePackageMutex.Unlock()
+	fake.GetWelcomePackageStub = nil
+	if fake.getWelcomePackageReturnsOnCall == nil {
+		fake.getWelcomePackageReturnsOnCall = make(map[int]struct {
+			result1 types.WelcomePackage
+			result2 error
+		})
+	}
+	fake.getWelcomePackageReturnsOnCall[i] = struct {
+		result1 types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackages(arg1 context.Context) ([]types.WelcomePackage, error) {
+	fake.getWelcomePackagesMutex.Lock()
+	ret, specificReturn := fake.getWelcomePackagesReturnsOnCall[len(fake.getWelcomePackagesArgsForCall)]
+	fake.getWelcomePackagesArgsForCall = append(fake.getWelcomePackagesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetWelcomePackagesStub
+	fakeReturns := fake.getWelcomePackagesReturns
+	fake.recordInvocation("GetWelcomePackages", []interface{}{arg1})
+	fake.getWelcomePackagesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackagesCallCount() int {
+	fake.getWelcomePackagesMutex.RLock()
+	defer fake.getWelcomePackagesMutex.RUnlock()
+	return len(fake.getWelcomePackagesArgsForCall)
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackagesCalls(stub func(context.Context) ([]types.WelcomePackage, error)) {
+	fake.getWelcomePackagesMutex.Lock()
+	defer fake.getWelcomePackagesMutex.Unlock()
+	fake.GetWelcomePackagesStub = stub
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackagesArgsForCall(i int) context.Context {
+	fake.getWelcomePackagesMutex.RLock()
+	defer fake.getWelcomePackagesMutex.RUnlock()
+	argsForCall := fake.getWelcomePackagesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackagesReturns(result1 []types.WelcomePackage, result2 error) {
+	fake.getWelcomePackagesMutex.Lock()
+	defer fake.getWelcomePackagesMutex.Unlock()
+	fake.GetWelcomePackagesStub = nil
+	fake.getWelcomePackagesReturns = struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) GetWelcomePackagesReturnsOnCall(i int, result1 []types.WelcomePackage, result2 error) {
+	fake.getWelcomePackagesMutex.Lock()
+	defer fake.getWelcomePackagesMutex.Unlock()
+	fake.GetWelcomePackagesStub = nil
+	if fake.getWelcomePackagesReturnsOnCall == nil {
+		fake.getWelcomePackagesReturnsOnCall = make(map[int]struct {
+			result1 []types.WelcomePackage
+			result2 error
+		})
+	}
+	fake.getWelcomePackagesReturnsOnCall[i] = struct {
+		result1 []types.WelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) RestorePromotion(arg1 context.Context, arg2 uuid.UUID) (types.Promotion, error) {
+	fake.restorePromotionMutex.Lock()
+	ret, specificReturn := fake.restorePromotionReturnsOnCall[len(fake.restorePromotionArgsForCall)]
+	fake.restorePromotionArgsForCall = append(fake.restorePromotionArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RestorePromotionStub
+	fakeReturns := fake.restorePromotionReturns
+	fake.recordInvocation("RestorePromotion", []interface{}{arg1, arg2})
+	fake.restorePromotionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromotionProvider) RestorePromotionCallCount() int {
+	fake.restorePromotionMutex.RLock()
+	defer fake.restorePromotionMutex.RUnlock()
+	return len(fake.restorePromotionArgsForCall)
+}
+
+func (fake *FakePromotionProvider) RestorePromotionCalls(stub func(context.Context, uuid.UUID) (types.Promotion, error)) {
+	fake.restorePromotionMutex.Lock()
+	defer fake.restorePromotionMutex.Unlock()
+	fake.RestorePromotionStub = stub
+}
+
+func (fake *FakePromotionProvider) RestorePromotionArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.restorePromotionMutex.RLock()
+	defer fake.restorePromotionMutex.RUnlock()
+	argsForCall := fake.restorePromotionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromotionProvider) RestorePromotionReturns(result1 types.Promotion, result2 error) {
+	fake.restorePromotionMutex.Lock()
+	defer fake.restorePromotionMutex.Unlock()
+	fake.RestorePromotionStub = nil
+	fake.restorePromotionReturns = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromotionProvider) RestorePromotionReturnsOnCall(i int, result1 types.Promotion, result2 error) {
+	fake.restorePromotionMutex.Lock()
+	defer fake.restorePromotionMutex.Unlock()
+	fake.RestorePromotionStub = nil
+	if fake.restorePromotionReturnsOnCall == nil {
+		fake.restorePromotionReturnsOnCall = make(map[int]struct {
+			result1 types.Promotion
+			result2 error
+		})
+	}
+	fake.restorePromotionReturnsOnCall[i] = struct {
+		result1 types.Promotion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePromotionProvider) UpdatePromotion(arg1 context.Context, arg2 types.Promotion) (types.Promotion, error) {
 	fake.updatePromotionMutex.Lock()
 	ret, specificReturn := fake.updatePromotionReturnsOnCall[len(fake.updatePromotionArgsForCall)]
@@ -406,14 +876,26 @@ func (fake *FakePromotionProvider) UpdatePromotionReturnsOnCall(i int, result1 t
 func (fake *FakePromotionProvider) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.applyScheduleMutex.RLock()
+	defer fake.applyScheduleMutex.RUnlock()
 	fake.createPromotionsMutex.RLock()
 	defer fake.createPromotionsMutex.RUnlock()
+	fake.createWelcomePackageMutex.RLock()
+	defer fake.createWelcomePackageMutex.RUnlock()
 	fake.deletePromotionMutex.RLock()
 	defer fake.deletePromotionMutex.RUnlock()
 	fake.getPromotionByIDMutex.RLock()
 	defer fake.getPromotionByIDMutex.RUnlock()
+	fake.getPromotionStateChangesMutex.RLock()
+	defer fake.getPromotionStateChangesMutex.RUnlock()
 	fake.getPromotionsMutex.RLock()
 	defer fake.getPromotionsMutex.RUnlock()
+	fake.getWelcomePackageMutex.RLock()
+	defer fake.getWelcomePackageMutex.RUnlock()
+	fake.getWelcomePackagesMutex.RLock()
+	defer fake.getWelcomePackagesMutex.RUnlock()
+	fake.restorePromotionMutex.RLock()
+	defer fake.restorePromotionMutex.RUnlock()
 	fake.updatePromotionMutex.RLock()
 	defer fake.updatePromotionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
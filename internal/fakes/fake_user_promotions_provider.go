@@ -25,20 +25,6 @@ type FakeUserPromotionProvider struct {
 		result1 types.UserPromotion
 		result2 error
 	}
-	AddWelcomePromotionStub        func(context.Context, uuid.UUID) (types.UserPromotion, error)
-	addWelcomePromotionMutex       sync.RWMutex
-	addWelcomePromotionArgsForCall []struct {
-		arg1 context.Context
-		arg2 uuid.UUID
-	}
-	addWelcomePromotionReturns struct {
-		result1 types.UserPromotion
-		result2 error
-	}
-	addWelcomePromotionReturnsOnCall map[int]struct {
-		result1 types.UserPromotion
-		result2 error
-	}
 	ClaimPromotionStub        func(context.Context, uuid.UUID) error
 	claimPromotionMutex       sync.RWMutex
 	claimPromotionArgsForCall []struct {
@@ -63,6 +49,32 @@ type FakeUserPromotionProvider struct {
 	deleteUserPromotionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExpireUserPromotionsStub        func(context.Context) (int, error)
+	expireUserPromotionsMutex       sync.RWMutex
+	expireUserPromotionsArgsForCall []struct {
+		arg1 context.Context
+	}
+	expireUserPromotionsReturns struct {
+		result1 int
+		result2 error
+	}
+	expireUserPromotionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	ForfeitExpiredBonusesStub        func(context.Context) (int, error)
+	forfeitExpiredBonusesMutex       sync.RWMutex
+	forfeitExpiredBonusesArgsForCall []struct {
+		arg1 context.Context
+	}
+	forfeitExpiredBonusesReturns struct {
+		result1 int
+		result2 error
+	}
+	forfeitExpiredBonusesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	GetUserPromotionByIDStub        func(context.Context, uuid.UUID) (types.UserPromotion, error)
 	getUserPromotionByIDMutex       sync.RWMutex
 	getUserPromotionByIDArgsForCall []struct {
@@ -77,11 +89,26 @@ type FakeUserPromotionProvider struct {
 		result1 types.UserPromotion
 		result2 error
 	}
-	GetUserPromotionsStub        func(context.Context, uuid.UUID) ([]types.UserPromotion, error)
+	GetUserPromotionRevocationsStub        func(context.Context, uuid.UUID) ([]types.UserPromotionRevocation, error)
+	getUserPromotionRevocationsMutex       sync.RWMutex
+	getUserPromotionRevocationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserPromotionRevocationsReturns struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}
+	getUserPromotionRevocationsReturnsOnCall map[int]struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}
+	GetUserPromotionsStub        func(context.Context, uuid.UUID, types.UserPromotionFilter) ([]types.UserPromotion, error)
 	getUserPromotionsMutex       sync.RWMutex
 	getUserPromotionsArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 types.UserPromotionFilter
 	}
 	getUserPromotionsReturns struct {
 		result1 []types.UserPromotion
@@ -91,6 +118,20 @@ type FakeUserPromotionProvider struct {
 		result1 []types.UserPromotion
 		result2 error
 	}
+	GetUserWelcomePackageStub        func(context.Context, uuid.UUID) (types.UserWelcomePackage, error)
+	getUserWelcomePackageMutex       sync.RWMutex
+	getUserWelcomePackageArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserWelcomePackageReturns struct {
+		result1 types.UserWelcomePackage
+		result2 error
+	}
+	getUserWelcomePackageReturnsOnCall map[int]struct {
+		result1 types.UserWelcomePackage
+		result2 error
+	}
 	ListenToRegisterEventStub        func(context.Context) error
 	listenToRegisterEventMutex       sync.RWMutex
 	listenToRegisterEventArgsForCall []struct {
@@ -102,6 +143,87 @@ type FakeUserPromotionProvider struct {
 	listenToRegisterEventReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RecordWagerStub        func(context.Context, uuid.UUID, uuid.UUID, types.Money) error
+	recordWagerMutex       sync.RWMutex
+	recordWagerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 types.Money
+	}
+	recordWagerReturns struct {
+		result1 error
+	}
+	recordWagerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemindExpiringPromotionsStub        func(context.Context) (int, error)
+	remindExpiringPromotionsMutex       sync.RWMutex
+	remindExpiringPromotionsArgsForCall []struct {
+		arg1 context.Context
+	}
+	remindExpiringPromotionsReturns struct {
+		result1 int
+		result2 error
+	}
+	remindExpiringPromotionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RevertWagerStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	revertWagerMutex       sync.RWMutex
+	revertWagerArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	revertWagerReturns struct {
+		result1 error
+	}
+	revertWagerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokePromotionStub        func(context.Context, types.UserPromotionRevocation) (types.UserPromotionRevocation, error)
+	revokePromotionMutex       sync.RWMutex
+	revokePromotionArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.UserPromotionRevocation
+	}
+	revokePromotionReturns struct {
+		result1 types.UserPromotionRevocation
+		result2 error
+	}
+	revokePromotionReturnsOnCall map[int]struct {
+		result1 types.UserPromotionRevocation
+		result2 error
+	}
+	StartWelcomePackageStub        func(context.Context, uuid.UUID) ([]types.UserPromotion, error)
+	startWelcomePackageMutex       sync.RWMutex
+	startWelcomePackageArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	startWelcomePackageReturns struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	startWelcomePackageReturnsOnCall map[int]struct {
+		result1 []types.UserPromotion
+		result2 error
+	}
+	UnlockWelcomeStepsStub        func(context.Context) (int, error)
+	unlockWelcomeStepsMutex       sync.RWMutex
+	unlockWelcomeStepsArgsForCall []struct {
+		arg1 context.Context
+	}
+	unlockWelcomeStepsReturns struct {
+		result1 int
+		result2 error
+	}
+	unlockWelcomeStepsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -171,71 +293,6 @@ func (fake *FakeUserPromotionProvider) AddPromotionReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeUserPromotionProvider) AddWelcomePromotion(arg1 context.Context, arg2 uuid.UUID) (types.UserPromotion, error) {
-	fake.addWelcomePromotionMutex.Lock()
-	ret, specificReturn := fake.addWelcomePromotionReturnsOnCall[len(fake.addWelcomePromotionArgsForCall)]
-	fake.addWelcomePromotionArgsForCall = append(fake.addWelcomePromotionArgsForCall, struct {
-		arg1 context.Context
-		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.AddWelcomePromotionStub
-	fakeReturns := fake.addWelcomePromotionReturns
-	fake.recordInvocation("AddWelcomePromotion", []interface{}{arg1, arg2})
-	fake.addWelcomePromotionMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakeUserPromotionProvider) AddWelcomePromotionCallCount() int {
-	fake.addWelcomePromotionMutex.RLock()
-	defer fake.addWelcomePromotionMutex.RUnlock()
-	return len(fake.addWelcomePromotionArgsForCall)
-}
-
-func (fake *FakeUserPromotionProvider) AddWelcomePromotionCalls(stub func(context.Context, uuid.UUID) (types.UserPromotion, error)) {
-	fake.addWelcomePromotionMutex.Lock()
-	defer fake.addWelcomePromotionMutex.Unlock()
-	fake.AddWelcomePromotionStub = stub
-}
-
-func (fake *FakeUserPromotionProvider) AddWelcomePromotionArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.addWelcomePromotionMutex.RLock()
-	defer fake.addWelcomePromotionMutex.RUnlock()
-	argsForCall := fake.addWelcomePromotionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeUserPromotionProvider) AddWelcomePromotionReturns(result1 types.UserPromotion, result2 error) {
-	fake.addWelcomePromotionMutex.Lock()
-	defer fake.addWelcomePromotionMutex.Unlock()
-	fake.AddWelcomePromotionStub = nil
-	fake.addWelcomePromotionReturns = struct {
-		result1 types.UserPromotion
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeUserPromotionProvider) AddWelcomePromotionReturnsOnCall(i int, result1 types.UserPromotion, result2 error) {
-	fake.addWelcomePromotionMutex.Lock()
-	defer fake.addWelcomePromotionMutex.Unlock()
-	fake.AddWelcomePromotionStub = nil
-	if fake.addWelcomePromotionReturnsOnCall == nil {
-		fake.addWelcomePromotionReturnsOnCall = make(map[int]struct {
-			result1 types.UserPromotion
-			result2 error
-		})
-	}
-	fake.addWelcomePromotionReturnsOnCall[i] = struct {
-		result1 types.UserPromotion
-		result2 error
-	}{result1, result2}
-}
-
 func (fake *FakeUserPromotionProvider) ClaimPromotion(arg1 context.Context, arg2 uuid.UUID) error {
 	fake.claimPromotionMutex.Lock()
 	ret, specificReturn := fake.claimPromotionReturnsOnCall[len(fake.claimPromotionArgsForCall)]
@@ -360,6 +417,134 @@ func (fake *FakeUserPromotionProvider) DeleteUserPromotionReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeUserPromotionProvider) ExpireUserPromotions(arg1 context.Context) (int, error) {
+	fake.expireUserPromotionsMutex.Lock()
+	ret, specificReturn := fake.expireUserPromotionsReturnsOnCall[len(fake.expireUserPromotionsArgsForCall)]
+	fake.expireUserPromotionsArgsForCall = append(fake.expireUserPromotionsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExpireUserPromotionsStub
+	fakeReturns := fake.expireUserPromotionsReturns
+	fake.recordInvocation("ExpireUserPromotions", []interface{}{arg1})
+	fake.expireUserPromotionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) ExpireUserPromotionsCallCount() int {
+	fake.expireUserPromotionsMutex.RLock()
+	defer fake.expireUserPromotionsMutex.RUnlock()
+	return len(fake.expireUserPromotionsArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) ExpireUserPromotionsCalls(stub func(context.Context) (int, error)) {
+	fake.expireUserPromotionsMutex.Lock()
+	defer fake.expireUserPromotionsMutex.Unlock()
+	fake.ExpireUserPromotionsStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) ExpireUserPromotionsArgsForCall(i int) context.Context {
+	fake.expireUserPromotionsMutex.RLock()
+	defer fake.expireUserPromotionsMutex.RUnlock()
+	argsForCall := fake.expireUserPromotionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserPromotionProvider) ExpireUserPromotionsReturns(result1 int, result2 error) {
+	fake.expireUserPromotionsMutex.Lock()
+	defer fake.expireUserPromotionsMutex.Unlock()
+	fake.ExpireUserPromotionsStub = nil
+	fake.expireUserPromotionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) ExpireUserPromotionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.expireUserPromotionsMutex.Lock()
+	defer fake.expireUserPromotionsMutex.Unlock()
+	fake.ExpireUserPromotionsStub = nil
+	if fake.expireUserPromotionsReturnsOnCall == nil {
+		fake.expireUserPromotionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.expireUserPromotionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) ForfeitExpiredBonuses(arg1 context.Context) (int, error) {
+	fake.forfeitExpiredBonusesMutex.Lock()
+	ret, specificReturn := fake.forfeitExpiredBonusesReturnsOnCall[len(fake.forfeitExpiredBonusesArgsForCall)]
+	fake.forfeitExpiredBonusesArgsForCall = append(fake.forfeitExpiredBonusesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ForfeitExpiredBonusesStub
+	fakeReturns := fake.forfeitExpiredBonusesReturns
+	fake.recordInvocation("ForfeitExpiredBonuses", []interface{}{arg1})
+	fake.forfeitExpiredBonusesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) ForfeitExpiredBonusesCallCount() int {
+	fake.forfeitExpiredBonusesMutex.RLock()
+	defer fake.forfeitExpiredBonusesMutex.RUnlock()
+	return len(fake.forfeitExpiredBonusesArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) ForfeitExpiredBonusesCalls(stub func(context.Context) (int, error)) {
+	fake.forfeitExpiredBonusesMutex.Lock()
+	defer fake.forfeitExpiredBonusesMutex.Unlock()
+	fake.ForfeitExpiredBonusesStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) ForfeitExpiredBonusesArgsForCall(i int) context.Context {
+	fake.forfeitExpiredBonusesMutex.RLock()
+	defer fake.forfeitExpiredBonusesMutex.RUnlock()
+	argsForCall := fake.forfeitExpiredBonusesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserPromotionProvider) ForfeitExpiredBonusesReturns(result1 int, result2 error) {
+	fake.forfeitExpiredBonusesMutex.Lock()
+	defer fake.forfeitExpiredBonusesMutex.Unlock()
+	fake.ForfeitExpiredBonusesStub = nil
+	fake.forfeitExpiredBonusesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) ForfeitExpiredBonusesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.forfeitExpiredBonusesMutex.Lock()
+	defer fake.forfeitExpiredBonusesMutex.Unlock()
+	fake.ForfeitExpiredBonusesStub = nil
+	if fake.forfeitExpiredBonusesReturnsOnCall == nil {
+		fake.forfeitExpiredBonusesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.forfeitExpiredBonusesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserPromotionProvider) GetUserPromotionByID(arg1 context.Context, arg2 uuid.UUID) (types.UserPromotion, error) {
 	fake.getUserPromotionByIDMutex.Lock()
 	ret, specificReturn := fake.getUserPromotionByIDReturnsOnCall[len(fake.getUserPromotionByIDArgsForCall)]
@@ -425,19 +610,85 @@ func (fake *FakeUserPromotionProvider) GetUserPromotionByIDReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakeUserPromotionProvider) GetUserPromotions(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotion, error) {
+func (fake *FakeUserPromotionProvider) GetUserPromotionRevocations(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotionRevocation, error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	ret, specificReturn := fake.getUserPromotionRevocationsReturnsOnCall[len(fake.getUserPromotionRevocationsArgsForCall)]
+	fake.getUserPromotionRevocationsArgsForCall = append(fake.getUserPromotionRevocationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserPromotionRevocationsStub
+	fakeReturns := fake.getUserPromotionRevocationsReturns
+	fake.recordInvocation("GetUserPromotionRevocations", []interface{}{arg1, arg2})
+	fake.getUserPromotionRevocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) GetUserPromotionRevocationsCallCount() int {
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
+	return len(fake.getUserPromotionRevocationsArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) GetUserPromotionRevocationsCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotionRevocation, error)) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) GetUserPromotionRevocationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
+	argsForCall := fake.getUserPromotionRevocationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionProvider) GetUserPromotionRevocationsReturns(result1 []types.UserPromotionRevocation, result2 error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = nil
+	fake.getUserPromotionRevocationsReturns = struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) GetUserPromotionRevocationsReturnsOnCall(i int, result1 []types.UserPromotionRevocation, result2 error) {
+	fake.getUserPromotionRevocationsMutex.Lock()
+	defer fake.getUserPromotionRevocationsMutex.Unlock()
+	fake.GetUserPromotionRevocationsStub = nil
+	if fake.getUserPromotionRevocationsReturnsOnCall == nil {
+		fake.getUserPromotionRevocationsReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotionRevocation
+			result2 error
+		})
+	}
+	fake.getUserPromotionRevocationsReturnsOnCall[i] = struct {
+		result1 []types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) GetUserPromotions(arg1 context.Context, arg2 uuid.UUID, arg3 types.UserPromotionFilter) ([]types.UserPromotion, error) {
 	fake.getUserPromotionsMutex.Lock()
 	ret, specificReturn := fake.getUserPromotionsReturnsOnCall[len(fake.getUserPromotionsArgsForCall)]
 	fake.getUserPromotionsArgsForCall = append(fake.getUserPromotionsArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
+		arg3 types.UserPromotionFilter
+	}{arg1, arg2, arg3})
 	stub := fake.GetUserPromotionsStub
 	fakeReturns := fake.getUserPromotionsReturns
-	fake.recordInvocation("GetUserPromotions", []interface{}{arg1, arg2})
+	fake.recordInvocation("GetUserPromotions", []interface{}{arg1, arg2, arg3})
 	fake.getUserPromotionsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -451,17 +702,17 @@ func (fake *FakeUserPromotionProvider) GetUserPromotionsCallCount() int {
 	return len(fake.getUserPromotionsArgsForCall)
 }
 
-func (fake *FakeUserPromotionProvider) GetUserPromotionsCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotion, error)) {
+func (fake *FakeUserPromotionProvider) GetUserPromotionsCalls(stub func(context.Context, uuid.UUID, types.UserPromotionFilter) ([]types.UserPromotion, error)) {
 	fake.getUserPromotionsMutex.Lock()
 	defer fake.getUserPromotionsMutex.Unlock()
 	fake.GetUserPromotionsStub = stub
 }
 
-func (fake *FakeUserPromotionProvider) GetUserPromotionsArgsForCall(i int) (context.Context, uuid.UUID) {
+func (fake *FakeUserPromotionProvider) GetUserPromotionsArgsForCall(i int) (context.Context, uuid.UUID, types.UserPromotionFilter) {
 	fake.getUserPromotionsMutex.RLock()
 	defer fake.getUserPromotionsMutex.RUnlock()
 	argsForCall := fake.getUserPromotionsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeUserPromotionProvider) GetUserPromotionsReturns(result1 []types.UserPromotion, result2 error) {
@@ -490,6 +741,71 @@ func (fake *FakeUserPromotionProvider) GetUserPromotionsReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeUserPromotionProvider) GetUserWelcomePackage(arg1 context.Context, arg2 uuid.UUID) (types.UserWelcomePackage, error) {
+	fake.getUserWelcomePackageMutex.Lock()
+	ret, specificReturn := fake.getUserWelcomePackageReturnsOnCall[len(fake.getUserWelcomePackageArgsForCall)]
+	fake.getUserWelcomePackageArgsForCall = append(fake.getUserWelcomePackageArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserWelcomePackageStub
+	fakeReturns := fake.getUserWelcomePackageReturns
+	fake.recordInvocation("GetUserWelcomePackage", []interface{}{arg1, arg2})
+	fake.getUserWelcomePackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) GetUserWelcomePackageCallCount() int {
+	fake.getUserWelcomePackageMutex.RLock()
+	defer fake.getUserWelcomePackageMutex.RUnlock()
+	return len(fake.getUserWelcomePackageArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) GetUserWelcomePackageCalls(stub func(context.Context, uuid.UUID) (types.UserWelcomePackage, error)) {
+	fake.getUserWelcomePackageMutex.Lock()
+	defer fake.getUserWelcomePackageMutex.Unlock()
+	fake.GetUserWelcomePackageStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) GetUserWelcomePackageArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserWelcomePackageMutex.RLock()
+	defer fake.getUserWelcomePackageMutex.RUnlock()
+	argsForCall := fake.getUserWelcomePackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionProvider) GetUserWelcomePackageReturns(result1 types.UserWelcomePackage, result2 error) {
+	fake.getUserWelcomePackageMutex.Lock()
+	defer fake.getUserWelcomePackageMutex.Unlock()
+	fake.GetUserWelcomePackageStub = nil
+	fake.getUserWelcomePackageReturns = struct {
+		result1 types.UserWelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) GetUserWelcomePackageReturnsOnCall(i int, result1 types.UserWelcomePackage, result2 error) {
+	fake.getUserWelcomePackageMutex.Lock()
+	defer fake.getUserWelcomePackageMutex.Unlock()
+	fake.GetUserWelcomePackageStub = nil
+	if fake.getUserWelcomePackageReturnsOnCall == nil {
+		fake.getUserWelcomePackageReturnsOnCall = make(map[int]struct {
+			result1 types.UserWelcomePackage
+			result2 error
+		})
+	}
+	fake.getUserWelcomePackageReturnsOnCall[i] = struct {
+		result1 types.UserWelcomePackage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserPromotionProvider) ListenToRegisterEvent(arg1 context.Context) error {
 	fake.listenToRegisterEventMutex.Lock()
 	ret, specificReturn := fake.listenToRegisterEventReturnsOnCall[len(fake.listenToRegisterEventArgsForCall)]
@@ -551,23 +867,426 @@ func (fake *FakeUserPromotionProvider) ListenToRegisterEventReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeUserPromotionProvider) RecordWager(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 types.Money) error {
+	fake.recordWagerMutex.Lock()
+	ret, specificReturn := fake.recordWagerReturnsOnCall[len(fake.recordWagerArgsForCall)]
+	fake.recordWagerArgsForCall = append(fake.recordWagerArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 types.Money
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecordWagerStub
+	fakeReturns := fake.recordWagerReturns
+	fake.recordInvocation("RecordWager", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordWagerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserPromotionProvider) RecordWagerCallCount() int {
+	fake.recordWagerMutex.RLock()
+	defer fake.recordWagerMutex.RUnlock()
+	return len(fake.recordWagerArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) RecordWagerCalls(stub func(context.Context, uuid.UUID, uuid.UUID, types.Money) error) {
+	fake.recordWagerMutex.Lock()
+	defer fake.recordWagerMutex.Unlock()
+	fake.RecordWagerStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) RecordWagerArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, types.Money) {
+	fake.recordWagerMutex.RLock()
+	defer fake.recordWagerMutex.RUnlock()
+	argsForCall := fake.recordWagerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserPromotionProvider) RecordWagerReturns(result1 error) {
+	fake.recordWagerMutex.Lock()
+	defer fake.recordWagerMutex.Unlock()
+	fake.RecordWagerStub = nil
+	fake.recordWagerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionProvider) RecordWagerReturnsOnCall(i int, result1 error) {
+	fake.recordWagerMutex.Lock()
+	defer fake.recordWagerMutex.Unlock()
+	fake.RecordWagerStub = nil
+	if fake.recordWagerReturnsOnCall == nil {
+		fake.recordWagerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordWagerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionProvider) RemindExpiringPromotions(arg1 context.Context) (int, error) {
+	fake.remindExpiringPromotionsMutex.Lock()
+	ret, specificReturn := fake.remindExpiringPromotionsReturnsOnCall[len(fake.remindExpiringPromotionsArgsForCall)]
+	fake.remindExpiringPromotionsArgsForCall = append(fake.remindExpiringPromotionsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RemindExpiringPromotionsStub
+	fakeReturns := fake.remindExpiringPromotionsReturns
+	fake.recordInvocation("RemindExpiringPromotions", []interface{}{arg1})
+	fake.remindExpiringPromotionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) RemindExpiringPromotionsCallCount() int {
+	fake.remindExpiringPromotionsMutex.RLock()
+	defer fake.remindExpiringPromotionsMutex.RUnlock()
+	return len(fake.remindExpiringPromotionsArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) RemindExpiringPromotionsCalls(stub func(context.Context) (int, error)) {
+	fake.remindExpiringPromotionsMutex.Lock()
+	defer fake.remindExpiringPromotionsMutex.Unlock()
+	fake.RemindExpiringPromotionsStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) RemindExpiringPromotionsArgsForCall(i int) context.Context {
+	fake.remindExpiringPromotionsMutex.RLock()
+	defer fake.remindExpiringPromotionsMutex.RUnlock()
+	argsForCall := fake.remindExpiringPromotionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserPromotionProvider) RemindExpiringPromotionsReturns(result1 int, result2 error) {
+	fake.remindExpiringPromotionsMutex.Lock()
+	defer fake.remindExpiringPromotionsMutex.Unlock()
+	fake.RemindExpiringPromotionsStub = nil
+	fake.remindExpiringPromotionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) RemindExpiringPromotionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.remindExpiringPromotionsMutex.Lock()
+	defer fake.remindExpiringPromotionsMutex.Unlock()
+	fake.RemindExpiringPromotionsStub = nil
+	if fake.remindExpiringPromotionsReturnsOnCall == nil {
+		fake.remindExpiringPromotionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.remindExpiringPromotionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) RevertWager(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.revertWagerMutex.Lock()
+	ret, specificReturn := fake.revertWagerReturnsOnCall[len(fake.revertWagerArgsForCall)]
+	fake.revertWagerArgsForCall = append(fake.revertWagerArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RevertWagerStub
+	fakeReturns := fake.revertWagerReturns
+	fake.recordInvocation("RevertWager", []interface{}{arg1, arg2, arg3})
+	fake.revertWagerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserPromotionProvider) RevertWagerCallCount() int {
+	fake.revertWagerMutex.RLock()
+	defer fake.revertWagerMutex.RUnlock()
+	return len(fake.revertWagerArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) RevertWagerCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.revertWagerMutex.Lock()
+	defer fake.revertWagerMutex.Unlock()
+	fake.RevertWagerStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) RevertWagerArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.revertWagerMutex.RLock()
+	defer fake.revertWagerMutex.RUnlock()
+	argsForCall := fake.revertWagerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserPromotionProvider) RevertWagerReturns(result1 error) {
+	fake.revertWagerMutex.Lock()
+	defer fake.revertWagerMutex.Unlock()
+	fake.RevertWagerStub = nil
+	fake.revertWagerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionProvider) RevertWagerReturnsOnCall(i int, result1 error) {
+	fake.revertWagerMutex.Lock()
+	defer fake.revertWagerMutex.Unlock()
+	fake.RevertWagerStub = nil
+	if fake.revertWagerReturnsOnCall == nil {
+		fake.revertWagerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revertWagerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserPromotionProvider) RevokePromotion(arg1 context.Context, arg2 types.UserPromotionRevocation) (types.UserPromotionRevocation, error) {
+	fake.revokePromotionMutex.Lock()
+	ret, specificReturn := fake.revokePromotionReturnsOnCall[len(fake.revokePromotionArgsForCall)]
+	fake.revokePromotionArgsForCall = append(fake.revokePromotionArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.UserPromotionRevocation
+	}{arg1, arg2})
+	stub := fake.RevokePromotionStub
+	fakeReturns := fake.revokePromotionReturns
+	fake.recordInvocation("RevokePromotion", []interface{}{arg1, arg2})
+	fake.revokePromotionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) RevokePromotionCallCount() int {
+	fake.revokePromotionMutex.RLock()
+	defer fake.revokePromotionMutex.RUnlock()
+	return len(fake.revokePromotionArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) RevokePromotionCalls(stub func(context.Context, types.UserPromotionRevocation) (types.UserPromotionRevocation, error)) {
+	fake.revokePromotionMutex.Lock()
+	defer fake.revokePromotionMutex.Unlock()
+	fake.RevokePromotionStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) RevokePromotionArgsForCall(i int) (context.Context, types.UserPromotionRevocation) {
+	fake.revokePromotionMutex.RLock()
+	defer fake.revokePromotionMutex.RUnlock()
+	argsForCall := fake.revokePromotionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionProvider) RevokePromotionReturns(result1 types.UserPromotionRevocation, result2 error) {
+	fake.revokePromotionMutex.Lock()
+	defer fake.revokePromotionMutex.Unlock()
+	fake.RevokePromotionStub = nil
+	fake.revokePromotionReturns = struct {
+		result1 types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) RevokePromotionReturnsOnCall(i int, result1 types.UserPromotionRevocation, result2 error) {
+	fake.revokePromotionMutex.Lock()
+	defer fake.revokePromotionMutex.Unlock()
+	fake.RevokePromotionStub = nil
+	if fake.revokePromotionReturnsOnCall == nil {
+		fake.revokePromotionReturnsOnCall = make(map[int]struct {
+			result1 types.UserPromotionRevocation
+			result2 error
+		})
+	}
+	fake.revokePromotionReturnsOnCall[i] = struct {
+		result1 types.UserPromotionRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) StartWelcomePackage(arg1 context.Context, arg2 uuid.UUID) ([]types.UserPromotion, error) {
+	fake.startWelcomePackageMutex.Lock()
+	ret, specificReturn := fake.startWelcomePackageReturnsOnCall[len(fake.startWelcomePackageArgsForCall)]
+	fake.startWelcomePackageArgsForCall = append(fake.startWelcomePackageArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.StartWelcomePackageStub
+	fakeReturns := fake.startWelcomePackageReturns
+	fake.recordInvocation("StartWelcomePackage", []interface{}{arg1, arg2})
+	fake.startWelcomePackageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) StartWelcomePackageCallCount() int {
+	fake.startWelcomePackageMutex.RLock()
+	defer fake.startWelcomePackageMutex.RUnlock()
+	return len(fake.startWelcomePackageArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) StartWelcomePackageCalls(stub func(context.Context, uuid.UUID) ([]types.UserPromotion, error)) {
+	fake.startWelcomePackageMutex.Lock()
+	defer fake.startWelcomePackageMutex.Unlock()
+	fake.StartWelcomePackageStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) StartWelcomePackageArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.startWelcomePackageMutex.RLock()
+	defer fake.startWelcomePackageMutex.RUnlock()
+	argsForCall := fake.startWelcomePackageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserPromotionProvider) StartWelcomePackageReturns(result1 []types.UserPromotion, result2 error) {
+	fake.startWelcomePackageMutex.Lock()
+	defer fake.startWelcomePackageMutex.Unlock()
+	fake.StartWelcomePackageStub = nil
+	fake.startWelcomePackageReturns = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) StartWelcomePackageReturnsOnCall(i int, result1 []types.UserPromotion, result2 error) {
+	fake.startWelcomePackageMutex.Lock()
+	defer fake.startWelcomePackageMutex.Unlock()
+	fake.StartWelcomePackageStub = nil
+	if fake.startWelcomePackageReturnsOnCall == nil {
+		fake.startWelcomePackageReturnsOnCall = make(map[int]struct {
+			result1 []types.UserPromotion
+			result2 error
+		})
+	}
+	fake.startWelcomePackageReturnsOnCall[i] = struct {
+		result1 []types.UserPromotion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) UnlockWelcomeSteps(arg1 context.Context) (int, error) {
+	fake.unlockWelcomeStepsMutex.Lock()
+	ret, specificReturn := fake.unlockWelcomeStepsReturnsOnCall[len(fake.unlockWelcomeStepsArgsForCall)]
+	fake.unlockWelcomeStepsArgsForCall = append(fake.unlockWelcomeStepsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.UnlockWelcomeStepsStub
+	fakeReturns := fake.unlockWelcomeStepsReturns
+	fake.recordInvocation("UnlockWelcomeSteps", []interface{}{arg1})
+	fake.unlockWelcomeStepsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserPromotionProvider) UnlockWelcomeStepsCallCount() int {
+	fake.unlockWelcomeStepsMutex.RLock()
+	defer fake.unlockWelcomeStepsMutex.RUnlock()
+	return len(fake.unlockWelcomeStepsArgsForCall)
+}
+
+func (fake *FakeUserPromotionProvider) UnlockWelcomeStepsCalls(stub func(context.Context) (int, error)) {
+	fake.unlockWelcomeStepsMutex.Lock()
+	defer fake.unlockWelcomeStepsMutex.Unlock()
+	fake.UnlockWelcomeStepsStub = stub
+}
+
+func (fake *FakeUserPromotionProvider) UnlockWelcomeStepsArgsForCall(i int) context.Context {
+	fake.unlockWelcomeStepsMutex.RLock()
+	defer fake.unlockWelcomeStepsMutex.RUnlock()
+	argsForCall := fake.unlockWelcomeStepsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserPromotionProvider) UnlockWelcomeStepsReturns(result1 int, result2 error) {
+	fake.unlockWelcomeStepsMutex.Lock()
+	defer fake.unlockWelcomeStepsMutex.Unlock()
+	fake.UnlockWelcomeStepsStub = nil
+	fake.unlockWelcomeStepsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserPromotionProvider) UnlockWelcomeStepsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.unlockWelcomeStepsMutex.Lock()
+	defer fake.unlockWelcomeStepsMutex.Unlock()
+	fake.UnlockWelcomeStepsStub = nil
+	if fake.unlockWelcomeStepsReturnsOnCall == nil {
+		fake.unlockWelcomeStepsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.unlockWelcomeStepsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserPromotionProvider) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addPromotionMutex.RLock()
 	defer fake.addPromotionMutex.RUnlock()
-	fake.addWelcomePromotionMutex.RLock()
-	defer fake.addWelcomePromotionMutex.RUnlock()
 	fake.claimPromotionMutex.RLock()
 	defer fake.claimPromotionMutex.RUnlock()
 	fake.deleteUserPromotionMutex.RLock()
 	defer fake.deleteUserPromotionMutex.RUnlock()
+	fake.expireUserPromotionsMutex.RLock()
+	defer fake.expireUserPromotionsMutex.RUnlock()
+	fake.forfeitExpiredBonusesMutex.RLock()
+	defer fake.forfeitExpiredBonusesMutex.RUnlock()
 	fake.getUserPromotionByIDMutex.RLock()
 	defer fake.getUserPromotionByIDMutex.RUnlock()
+	fake.getUserPromotionRevocationsMutex.RLock()
+	defer fake.getUserPromotionRevocationsMutex.RUnlock()
 	fake.getUserPromotionsMutex.RLock()
 	defer fake.getUserPromotionsMutex.RUnlock()
+	fake.getUserWelcomePackageMutex.RLock()
+	defer fake.getUserWelcomePackageMutex.RUnlock()
 	fake.listenToRegisterEventMutex.RLock()
 	defer fake.listenToRegisterEventMutex.RUnlock()
+	fake.recordWagerMutex.RLock()
+	defer fake.recordWagerMutex.RUnlock()
+	fake.remindExpiringPromotionsMutex.RLock()
+	defer fake.remindExpiringPromotionsMutex.RUnlock()
+	fake.revertWagerMutex.RLock()
+	defer fake.revertWagerMutex.RUnlock()
+	fake.revokePromotionMutex.RLock()
+	defer fake.revokePromotionMutex.RUnlock()
+	fake.startWelcomePackageMutex.RLock()
+	defer fake.startWelcomePackageMutex.RUnlock()
+	fake.unlockWelcomeStepsMutex.RLock()
+	defer fake.unlockWelcomeStepsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -7,7 +7,6 @@ import (
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/users"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
-	"github.com/coder/websocket"
 	"github.com/google/uuid"
 )
 
@@ -54,6 +53,21 @@ type FakeUserProvider struct {
 		result1 types.User
 		result2 error
 	}
+	GetUserTransactionsStub        func(context.Context, types.LedgerFilter, string) (types.LedgerPage, error)
+	getUserTransactionsMutex       sync.RWMutex
+	getUserTransactionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.LedgerFilter
+		arg3 string
+	}
+	getUserTransactionsReturns struct {
+		result1 types.LedgerPage
+		result2 error
+	}
+	getUserTransactionsReturnsOnCall map[int]struct {
+		result1 types.LedgerPage
+		result2 error
+	}
 	GetUsersStub        func(context.Context) ([]types.User, error)
 	getUsersMutex       sync.RWMutex
 	getUsersArgsForCall []struct {
@@ -67,19 +81,6 @@ type FakeUserProvider struct {
 		result1 []types.User
 		result2 error
 	}
-	ListenToNotificationsStub        func(context.Context, *websocket.Conn, uuid.UUID) error
-	listenToNotificationsMutex       sync.RWMutex
-	listenToNotificationsArgsForCall []struct {
-		arg1 context.Context
-		arg2 *websocket.Conn
-		arg3 uuid.UUID
-	}
-	listenToNotificationsReturns struct {
-		result1 error
-	}
-	listenToNotificationsReturnsOnCall map[int]struct {
-		result1 error
-	}
 	LoginStub        func(context.Context, types.User) (types.User, string, error)
 	loginMutex       sync.RWMutex
 	loginArgsForCall []struct {
@@ -96,11 +97,40 @@ type FakeUserProvider struct {
 		result2 string
 		result3 error
 	}
-	RegisterStub        func(context.Context, types.User) (types.User, string, error)
+	RebuildUserBalanceStub        func(context.Context, uuid.UUID) (types.User, error)
+	rebuildUserBalanceMutex       sync.RWMutex
+	rebuildUserBalanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	rebuildUserBalanceReturns struct {
+		result1 types.User
+		result2 error
+	}
+	rebuildUserBalanceReturnsOnCall map[int]struct {
+		result1 types.User
+		result2 error
+	}
+	ReconcileUserBalanceStub        func(context.Context, uuid.UUID) (types.BalanceReconciliation, error)
+	reconcileUserBalanceMutex       sync.RWMutex
+	reconcileUserBalanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	reconcileUserBalanceReturns struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}
+	reconcileUserBalanceReturnsOnCall map[int]struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}
+	RegisterStub        func(context.Context, types.User, string) (types.User, string, error)
 	registerMutex       sync.RWMutex
 	registerArgsForCall []struct {
 		arg1 context.Context
 		arg2 types.User
+		arg3 string
 	}
 	registerReturns struct {
 		result1 types.User
@@ -126,13 +156,15 @@ type FakeUserProvider struct {
 		result1 types.User
 		result2 error
 	}
-	UpdateUserBalanceStub        func(context.Context, types.User, float64, types.TransactionType) (types.User, error)
+	UpdateUserBalanceStub        func(context.Context, uuid.UUID, types.Money, types.TransactionType, types.LedgerSource, uuid.NullUUID) (types.User, error)
 	updateUserBalanceMutex       sync.RWMutex
 	updateUserBalanceArgsForCall []struct {
 		arg1 context.Context
-		arg2 types.User
-		arg3 float64
+		arg2 uuid.UUID
+		arg3 types.Money
 		arg4 types.TransactionType
+		arg5 types.LedgerSource
+		arg6 uuid.NullUUID
 	}
 	updateUserBalanceReturns struct {
 		result1 types.User
@@ -340,6 +372,72 @@ func (fake *FakeUserProvider) GetUserReturnsOnCall(i int, result1 types.User, re
 	}{result1, result2}
 }
 
+func (fake *FakeUserProvider) GetUserTransactions(arg1 context.Context, arg2 types.LedgerFilter, arg3 string) (types.LedgerPage, error) {
+	fake.getUserTransactionsMutex.Lock()
+	ret, specificReturn := fake.getUserTransactionsReturnsOnCall[len(fake.getUserTransactionsArgsForCall)]
+	fake.getUserTransactionsArgsForCall = append(fake.getUserTransactionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.LedgerFilter
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetUserTransactionsStub
+	fakeReturns := fake.getUserTransactionsReturns
+	fake.recordInvocation("GetUserTransactions", []interface{}{arg1, arg2, arg3})
+	fake.getUserTransactionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserProvider) GetUserTransactionsCallCount() int {
+	fake.getUserTransactionsMutex.RLock()
+	defer fake.getUserTransactionsMutex.RUnlock()
+	return len(fake.getUserTransactionsArgsForCall)
+}
+
+func (fake *FakeUserProvider) GetUserTransactionsCalls(stub func(context.Context, types.LedgerFilter, string) (types.LedgerPage, error)) {
+	fake.getUserTransactionsMutex.Lock()
+	defer fake.getUserTransactionsMutex.Unlock()
+	fake.GetUserTransactionsStub = stub
+}
+
+func (fake *FakeUserProvider) GetUserTransactionsArgsForCall(i int) (context.Context, types.LedgerFilter, string) {
+	fake.getUserTransactionsMutex.RLock()
+	defer fake.getUserTransactionsMutex.RUnlock()
+	argsForCall := fake.getUserTransactionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserProvider) GetUserTransactionsReturns(result1 types.LedgerPage, result2 error) {
+	fake.getUserTransactionsMutex.Lock()
+	defer fake.getUserTransactionsMutex.Unlock()
+	fake.GetUserTransactionsStub = nil
+	fake.getUserTransactionsReturns = struct {
+		result1 types.LedgerPage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvider) GetUserTransactionsReturnsOnCall(i int, result1 types.LedgerPage, result2 error) {
+	fake.getUserTransactionsMutex.Lock()
+	defer fake.getUserTransactionsMutex.Unlock()
+	fake.GetUserTransactionsStub = nil
+	if fake.getUserTransactionsReturnsOnCall == nil {
+		fake.getUserTransactionsReturnsOnCall = make(map[int]struct {
+			result1 types.LedgerPage
+			result2 error
+		})
+	}
+	fake.getUserTransactionsReturnsOnCall[i] = struct {
+		result1 types.LedgerPage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserProvider) GetUsers(arg1 context.Context) ([]types.User, error) {
 	fake.getUsersMutex.Lock()
 	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
@@ -404,69 +502,6 @@ func (fake *FakeUserProvider) GetUsersReturnsOnCall(i int, result1 []types.User,
 	}{result1, result2}
 }
 
-func (fake *FakeUserProvider) ListenToNotifications(arg1 context.Context, arg2 *websocket.Conn, arg3 uuid.UUID) error {
-	fake.listenToNotificationsMutex.Lock()
-	ret, specificReturn := fake.listenToNotificationsReturnsOnCall[len(fake.listenToNotificationsArgsForCall)]
-	fake.listenToNotificationsArgsForCall = append(fake.listenToNotificationsArgsForCall, struct {
-		arg1 context.Context
-		arg2 *websocket.Conn
-		arg3 uuid.UUID
-	}{arg1, arg2, arg3})
-	stub := fake.ListenToNotificationsStub
-	fakeReturns := fake.listenToNotificationsReturns
-	fake.recordInvocation("ListenToNotifications", []interface{}{arg1, arg2, arg3})
-	fake.listenToNotificationsMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2, arg3)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeUserProvider) ListenToNotificationsCallCount() int {
-	fake.listenToNotificationsMutex.RLock()
-	defer fake.listenToNotificationsMutex.RUnlock()
-	return len(fake.listenToNotificationsArgsForCall)
-}
-
-func (fake *FakeUserProvider) ListenToNotificationsCalls(stub func(context.Context, *websocket.Conn, uuid.UUID) error) {
-	fake.listenToNotificationsMutex.Lock()
-	defer fake.listenToNotificationsMutex.Unlock()
-	fake.ListenToNotificationsStub = stub
-}
-
-func (fake *FakeUserProvider) ListenToNotificationsArgsForCall(i int) (context.Context, *websocket.Conn, uuid.UUID) {
-	fake.listenToNotificationsMutex.RLock()
-	defer fake.listenToNotificationsMutex.RUnlock()
-	argsForCall := fake.listenToNotificationsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
-}
-
-func (fake *FakeUserProvider) ListenToNotificationsReturns(result1 error) {
-	fake.listenToNotificationsMutex.Lock()
-	defer fake.listenToNotificationsMutex.Unlock()
-	fake.ListenToNotificationsStub = nil
-	fake.listenToNotificationsReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeUserProvider) ListenToNotificationsReturnsOnCall(i int, result1 error) {
-	fake.listenToNotificationsMutex.Lock()
-	defer fake.listenToNotificationsMutex.Unlock()
-	fake.ListenToNotificationsStub = nil
-	if fake.listenToNotificationsReturnsOnCall == nil {
-		fake.listenToNotificationsReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.listenToNotificationsReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakeUserProvider) Login(arg1 context.Context, arg2 types.User) (types.User, string, error) {
 	fake.loginMutex.Lock()
 	ret, specificReturn := fake.loginReturnsOnCall[len(fake.loginArgsForCall)]
@@ -535,19 +570,150 @@ func (fake *FakeUserProvider) LoginReturnsOnCall(i int, result1 types.User, resu
 	}{result1, result2, result3}
 }
 
-func (fake *FakeUserProvider) Register(arg1 context.Context, arg2 types.User) (types.User, string, error) {
+func (fake *FakeUserProvider) RebuildUserBalance(arg1 context.Context, arg2 uuid.UUID) (types.User, error) {
+	fake.rebuildUserBalanceMutex.Lock()
+	ret, specificReturn := fake.rebuildUserBalanceReturnsOnCall[len(fake.rebuildUserBalanceArgsForCall)]
+	fake.rebuildUserBalanceArgsForCall = append(fake.rebuildUserBalanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RebuildUserBalanceStub
+	fakeReturns := fake.rebuildUserBalanceReturns
+	fake.recordInvocation("RebuildUserBalance", []interface{}{arg1, arg2})
+	fake.rebuildUserBalanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserProvider) RebuildUserBalanceCallCount() int {
+	fake.rebuildUserBalanceMutex.RLock()
+	defer fake.rebuildUserBalanceMutex.RUnlock()
+	return len(fake.rebuildUserBalanceArgsForCall)
+}
+
+func (fake *FakeUserProvider) RebuildUserBalanceCalls(stub func(context.Context, uuid.UUID) (types.User, error)) {
+	fake.rebuildUserBalanceMutex.Lock()
+	defer fake.rebuildUserBalanceMutex.Unlock()
+	fake.RebuildUserBalanceStub = stub
+}
+
+func (fake *FakeUserProvider) RebuildUserBalanceArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.rebuildUserBalanceMutex.RLock()
+	defer fake.rebuildUserBalanceMutex.RUnlock()
+	argsForCall := fake.rebuildUserBalanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserProvider) RebuildUserBalanceReturns(result1 types.User, result2 error) {
+	fake.rebuildUserBalanceMutex.Lock()
+	defer fake.rebuildUserBalanceMutex.Unlock()
+	fake.RebuildUserBalanceStub = nil
+	fake.rebuildUserBalanceReturns = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvider) RebuildUserBalanceReturnsOnCall(i int, result1 types.User, result2 error) {
+	fake.rebuildUserBalanceMutex.Lock()
+	defer fake.rebuildUserBalanceMutex.Unlock()
+	fake.RebuildUserBalanceStub = nil
+	if fake.rebuildUserBalanceReturnsOnCall == nil {
+		fake.rebuildUserBalanceReturnsOnCall = make(map[int]struct {
+			result1 types.User
+			result2 error
+		})
+	}
+	fake.rebuildUserBalanceReturnsOnCall[i] = struct {
+		result1 types.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvider) ReconcileUserBalance(arg1 context.Context, arg2 uuid.UUID) (types.BalanceReconciliation, error) {
+	fake.reconcileUserBalanceMutex.Lock()
+	ret, specificReturn := fake.reconcileUserBalanceReturnsOnCall[len(fake.reconcileUserBalanceArgsForCall)]
+	fake.reconcileUserBalanceArgsForCall = append(fake.reconcileUserBalanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ReconcileUserBalanceStub
+	fakeReturns := fake.reconcileUserBalanceReturns
+	fake.recordInvocation("ReconcileUserBalance", []interface{}{arg1, arg2})
+	fake.reconcileUserBalanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserProvider) ReconcileUserBalanceCallCount() int {
+	fake.reconcileUserBalanceMutex.RLock()
+	defer fake.reconcileUserBalanceMutex.RUnlock()
+	return len(fake.reconcileUserBalanceArgsForCall)
+}
+
+func (fake *FakeUserProvider) ReconcileUserBalanceCalls(stub func(context.Context, uuid.UUID) (types.BalanceReconciliation, error)) {
+	fake.reconcileUserBalanceMutex.Lock()
+	defer fake.reconcileUserBalanceMutex.Unlock()
+	fake.ReconcileUserBalanceStub = stub
+}
+
+func (fake *FakeUserProvider) ReconcileUserBalanceArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.reconcileUserBalanceMutex.RLock()
+	defer fake.reconcileUserBalanceMutex.RUnlock()
+	argsForCall := fake.reconcileUserBalanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserProvider) ReconcileUserBalanceReturns(result1 types.BalanceReconciliation, result2 error) {
+	fake.reconcileUserBalanceMutex.Lock()
+	defer fake.reconcileUserBalanceMutex.Unlock()
+	fake.ReconcileUserBalanceStub = nil
+	fake.reconcileUserBalanceReturns = struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvider) ReconcileUserBalanceReturnsOnCall(i int, result1 types.BalanceReconciliation, result2 error) {
+	fake.reconcileUserBalanceMutex.Lock()
+	defer fake.reconcileUserBalanceMutex.Unlock()
+	fake.ReconcileUserBalanceStub = nil
+	if fake.reconcileUserBalanceReturnsOnCall == nil {
+		fake.reconcileUserBalanceReturnsOnCall = make(map[int]struct {
+			result1 types.BalanceReconciliation
+			result2 error
+		})
+	}
+	fake.reconcileUserBalanceReturnsOnCall[i] = struct {
+		result1 types.BalanceReconciliation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvider) Register(arg1 context.Context, arg2 types.User, arg3 string) (types.User, string, error) {
 	fake.registerMutex.Lock()
 	ret, specificReturn := fake.registerReturnsOnCall[len(fake.registerArgsForCall)]
 	fake.registerArgsForCall = append(fake.registerArgsForCall, struct {
 		arg1 context.Context
 		arg2 types.User
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.RegisterStub
 	fakeReturns := fake.registerReturns
-	fake.recordInvocation("Register", []interface{}{arg1, arg2})
+	fake.recordInvocation("Register", []interface{}{arg1, arg2, arg3})
 	fake.registerMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -561,17 +727,17 @@ func (fake *FakeUserProvider) RegisterCallCount() int {
 	return len(fake.registerArgsForCall)
 }
 
-func (fake *FakeUserProvider) RegisterCalls(stub func(context.Context, types.User) (types.User, string, error)) {
+func (fake *FakeUserProvider) RegisterCalls(stub func(context.Context, types.User, string) (types.User, string, error)) {
 	fake.registerMutex.Lock()
 	defer fake.registerMutex.Unlock()
 	fake.RegisterStub = stub
 }
 
-func (fake *FakeUserProvider) RegisterArgsForCall(i int) (context.Context, types.User) {
+func (fake *FakeUserProvider) RegisterArgsForCall(i int) (context.Context, types.User, string) {
 	fake.registerMutex.RLock()
 	defer fake.registerMutex.RUnlock()
 	argsForCall := fake.registerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeUserProvider) RegisterReturns(result1 types.User, result2 string, result3 error) {
@@ -668,21 +834,23 @@ func (fake *FakeUserProvider) UpdateUserReturnsOnCall(i int, result1 types.User,
 	}{result1, result2}
 }
 
-func (fake *FakeUserProvider) UpdateUserBalance(arg1 context.Context, arg2 types.User, arg3 float64, arg4 types.TransactionType) (types.User, error) {
+func (fake *FakeUserProvider) UpdateUserBalance(arg1 context.Context, arg2 uuid.UUID, arg3 types.Money, arg4 types.TransactionType, arg5 types.LedgerSource, arg6 uuid.NullUUID) (types.User, error) {
 	fake.updateUserBalanceMutex.Lock()
 	ret, specificReturn := fake.updateUserBalanceReturnsOnCall[len(fake.updateUserBalanceArgsForCall)]
 	fake.updateUserBalanceArgsForCall = append(fake.updateUserBalanceArgsForCall, struct {
 		arg1 context.Context
-		arg2 types.User
-		arg3 float64
+		arg2 uuid.UUID
+		arg3 types.Money
 		arg4 types.TransactionType
-	}{arg1, arg2, arg3, arg4})
+		arg5 types.LedgerSource
+		arg6 uuid.NullUUID
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.UpdateUserBalanceStub
 	fakeReturns := fake.updateUserBalanceReturns
-	fake.recordInvocation("UpdateUserBalance", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateUserBalance", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.updateUserBalanceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -696,17 +864,17 @@ func (fake *FakeUserProvider) UpdateUserBalanceCallCount() int {
 	return len(fake.updateUserBalanceArgsForCall)
 }
 
-func (fake *FakeUserProvider) UpdateUserBalanceCalls(stub func(context.Context, types.User, float64, types.TransactionType) (types.User, error)) {
+func (fake *FakeUserProvider) UpdateUserBalanceCalls(stub func(context.Context, uuid.UUID, types.Money, types.TransactionType, types.LedgerSource, uuid.NullUUID) (types.User, error)) {
 	fake.updateUserBalanceMutex.Lock()
 	defer fake.updateUserBalanceMutex.Unlock()
 	fake.UpdateUserBalanceStub = stub
 }
 
-func (fake *FakeUserProvider) UpdateUserBalanceArgsForCall(i int) (context.Context, types.User, float64, types.TransactionType) {
+func (fake *FakeUserProvider) UpdateUserBalanceArgsForCall(i int) (context.Context, uuid.UUID, types.Money, types.TransactionType, types.LedgerSource, uuid.NullUUID) {
 	fake.updateUserBalanceMutex.RLock()
 	defer fake.updateUserBalanceMutex.RUnlock()
 	argsForCall := fake.updateUserBalanceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeUserProvider) UpdateUserBalanceReturns(result1 types.User, result2 error) {
@@ -744,12 +912,16 @@ func (fake *FakeUserProvider) Invocations() map[string][][]interface{} {
 	defer fake.deleteUserMutex.RUnlock()
 	fake.getUserMutex.RLock()
 	defer fake.getUserMutex.RUnlock()
+	fake.getUserTransactionsMutex.RLock()
+	defer fake.getUserTransactionsMutex.RUnlock()
 	fake.getUsersMutex.RLock()
 	defer fake.getUsersMutex.RUnlock()
-	fake.listenToNotificationsMutex.RLock()
-	defer fake.listenToNotificationsMutex.RUnlock()
 	fake.loginMutex.RLock()
 	defer fake.loginMutex.RUnlock()
+	fake.rebuildUserBalanceMutex.RLock()
+	defer fake.rebuildUserBalanceMutex.RUnlock()
+	fake.reconcileUserBalanceMutex.RLock()
+	defer fake.reconcileUserBalanceMutex.RUnlock()
 	fake.registerMutex.RLock()
 	defer fake.registerMutex.RUnlock()
 	fake.updateUserMutex.RLock()
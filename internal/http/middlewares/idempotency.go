@@ -0,0 +1,128 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/idempotency"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+)
+
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
+// IdempotencyMiddleware replays the stored response of requests sent again
+// with the same Idempotency-Key header. It has to run after AuthMiddleware,
+// keys are scoped per user. Requests without the header are not affected.
+func IdempotencyMiddleware(component idempotency.IdempotencyProvider) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			log := types.GetLoggerFromContext(ctx).With("handler", "middleware.idempotency")
+
+			value := r.Header.Get(types.IdempotencyKeyHeader)
+			if value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			account, err := types.GetAccountFromContext(ctx)
+			if err != nil {
+				log.Errorf("failed to get account from context: %s", err)
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := sha256.New()
+			hash.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+			hash.Write(body)
+
+			key, err := component.Begin(ctx, types.IdempotencyKey{
+				UserID:      account.ID,
+				Key:         value,
+				RequestHash: hex.EncodeToString(hash.Sum(nil)),
+			})
+			if err != nil {
+				switch {
+				case errors.Is(err, types.ErrIdempotencyKeyInvalid):
+					utils.WriteError(log, w, http.StatusBadRequest, err)
+				case errors.Is(err, types.ErrIdempotencyKeyInUse):
+					utils.WriteError(log, w, http.StatusConflict, err)
+				case errors.Is(err, types.ErrIdempotencyKeyReused):
+					utils.WriteError(log, w, http.StatusUnprocessableEntity, err)
+				default:
+					log.Errorf("failed to begin idempotent request: %s", err)
+					utils.WriteError(log, w, http.StatusInternalServerError, err)
+				}
+				return
+			}
+
+			if key.IsCompleted() {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set(idempotentReplayedHeader, "true")
+				w.WriteHeader(key.StatusCode)
+				w.Write(key.Response)
+				return
+			}
+
+			// the response is already sent, finish the key even if the client went away
+			finishCtx := context.WithoutCancel(ctx)
+			release := func() {
+				if err := component.Release(finishCtx, key.UserID, key.Key); err != nil {
+					log.Errorf("failed to release idempotency key: %s", err)
+				}
+			}
+
+			// a panicking handler leaves no response to replay, the key is
+			// released so the request can be retried
+			defer func() {
+				if v := recover(); v != nil {
+					release()
+					panic(v)
+				}
+			}()
+
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= http.StatusInternalServerError {
+				release()
+				return
+			}
+
+			key.StatusCode = recorder.statusCode
+			key.Response = recorder.body.Bytes()
+			if err := component.Complete(finishCtx, key); err != nil {
+				log.Errorf("failed to complete idempotency key: %s", err)
+			}
+		})
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
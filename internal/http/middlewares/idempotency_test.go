@@ -0,0 +1,187 @@
+package middlewares_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/idempotency"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/fakes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/http/middlewares"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// keyStore keeps idempotency keys in memory the way the database does.
+type keyStore struct {
+	mu   sync.Mutex
+	keys map[string]types.IdempotencyKey
+}
+
+func newKeyStore() *keyStore {
+	return &keyStore{keys: map[string]types.IdempotencyKey{}}
+}
+
+func (s *keyStore) persistent() *fakes.FakePersistent {
+	return &fakes.FakePersistent{
+		IdempotencyKeyCreateStub: func(ctx context.Context, key types.IdempotencyKey) (bool, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if _, ok := s.keys[key.Key]; ok {
+				return false, nil
+			}
+			s.keys[key.Key] = key
+			return true, nil
+		},
+		IdempotencyKeyGetStub: func(ctx context.Context, userID uuid.UUID, key string) (types.IdempotencyKey, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.keys[key], nil
+		},
+		IdempotencyKeyCompleteStub: func(ctx context.Context, key types.IdempotencyKey) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.keys[key.Key] = key
+			return nil
+		},
+		IdempotencyKeyDeleteStub: func(ctx context.Context, userID uuid.UUID, key string) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.keys, key)
+			return nil
+		},
+	}
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	userID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), types.CtxKeyAccount, types.User{ID: userID})
+
+	request := func(key string, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPut, "/api/v1/users/balance", strings.NewReader(body))
+		if key != "" {
+			r.Header.Set(types.IdempotencyKeyHeader, key)
+		}
+		return r.WithContext(ctx)
+	}
+
+	serve := func(handler http.Handler, r *http.Request) (*http.Response, string) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		resp := w.Result()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp, string(body)
+	}
+
+	t.Run("it should replay the stored response", func(t *testing.T) {
+		calls := 0
+		handler := middlewares.IdempotencyMiddleware(idempotency.New(newKeyStore().persistent()))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"calls":1}`))
+		}))
+
+		resp, body := serve(handler, request("retry-1", `{"amount":"10"}`))
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get("Idempotent-Replayed"))
+
+		resp, replayed := serve(handler, request("retry-1", `{"amount":"10"}`))
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Equal(t, "true", resp.Header.Get("Idempotent-Replayed"))
+		require.Equal(t, body, replayed)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("it should conflict while the request is in progress", func(t *testing.T) {
+		var (
+			started  = make(chan struct{})
+			finished = make(chan struct{})
+		)
+		handler := middlewares.IdempotencyMiddleware(idempotency.New(newKeyStore().persistent()))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-finished
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			handler.ServeHTTP(httptest.NewRecorder(), request("retry-1", `{"amount":"10"}`))
+		}()
+		<-started
+
+		resp, body := serve(handler, request("retry-1", `{"amount":"10"}`))
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		require.Contains(t, body, types.ErrIdempotencyKeyInUse.Error())
+
+		close(finished)
+		<-done
+	})
+
+	t.Run("it should reject a key reused with a different body", func(t *testing.T) {
+		handler := middlewares.IdempotencyMiddleware(idempotency.New(newKeyStore().persistent()))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		resp, _ := serve(handler, request("retry-1", `{"amount":"10"}`))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, body := serve(handler, request("retry-1", `{"amount":"20"}`))
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		require.Contains(t, body, types.ErrIdempotencyKeyReused.Error())
+	})
+
+	t.Run("it should release the key when the handler panics", func(t *testing.T) {
+		calls := 0
+		handler := middlewares.IdempotencyMiddleware(idempotency.New(newKeyStore().persistent()))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				panic("handler failed")
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		require.PanicsWithValue(t, "handler failed", func() {
+			handler.ServeHTTP(httptest.NewRecorder(), request("retry-1", `{"amount":"10"}`))
+		})
+
+		resp, _ := serve(handler, request("retry-1", `{"amount":"10"}`))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("it should release the key on a server error", func(t *testing.T) {
+		calls := 0
+		handler := middlewares.IdempotencyMiddleware(idempotency.New(newKeyStore().persistent()))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+
+		serve(handler, request("retry-1", `{"amount":"10"}`))
+		resp, _ := serve(handler, request("retry-1", `{"amount":"10"}`))
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("it should pass requests without a key through", func(t *testing.T) {
+		calls := 0
+		handler := middlewares.IdempotencyMiddleware(&fakes.FakeIdempotencyProvider{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		serve(handler, request("", `{"amount":"10"}`))
+		serve(handler, request("", `{"amount":"10"}`))
+		require.Equal(t, 2, calls)
+	})
+}
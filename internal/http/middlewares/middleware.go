@@ -2,6 +2,7 @@ package middlewares
 
 import (
 	"context"
+	"crypto/subtle"
 	"net/http"
 	"strings"
 
@@ -85,3 +86,23 @@ func RequiredRole(requiredRole types.UserType) func(next http.Handler) http.Hand
 		})
 	}
 }
+
+// APIKeyMiddleware authenticates service clients such as game servers by the
+// X-API-Key header.
+func APIKeyMiddleware(keys []string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			log := types.GetLoggerFromContext(r.Context()).With("handler", "middleware.api_key")
+
+			key := []byte(r.Header.Get("X-API-Key"))
+			for _, k := range keys {
+				if len(key) > 0 && subtle.ConstantTimeCompare(key, []byte(k)) == 1 {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			utils.WriteError(log, w, http.StatusUnauthorized, types.ErrInvalidAPIKey)
+		})
+	}
+}
@@ -19,7 +19,7 @@ func NewNotificationsRouter(component notifications.NotificationProvider) *notif
 
 // ListenToNotifications establishes a WebSocket connection for real-time notifications.
 // @Summary Listen to notifications
-// @Description Establishes a WebSocket connection to receive real-time notifications for the authenticated user.
+// @Description Establishes a WebSocket connection to receive real-time notifications for the authenticated user. Staff also receive staff notifications such as promotion budget alerts.
 // @Tags Notifications
 // @Produce json
 // @Success 101 {string} string "Switching Protocols - WebSocket connection established"
@@ -46,7 +46,7 @@ func (nr *notificationsRouter) ListenToNotifications(w http.ResponseWriter, r *h
 
 	defer conn.CloseNow()
 
-	err = nr.component.ListenToNotifications(r.Context(), conn, user.ID)
+	err = nr.component.ListenToNotifications(r.Context(), conn, user.ID, user.Role)
 	if err != nil {
 		log.Errorf("failed to listen to notificaitons: %s", err)
 	}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
 	"github.com/kelseyhightower/envconfig"
+	"github.com/shopspring/decimal"
 )
 
 type Config struct {
@@ -16,6 +19,35 @@ type Config struct {
 	RedisURI    string        `envconfig:"REDIS_URI" default:"redis://localhost:6379"`
 	JWTKey      string        `envconfig:"JWT_KEY" default:"true"`
 	JWTDuration time.Duration `envconfig:"JWT_DURATION" default:"24h"`
+
+	BonusForfeitInterval      time.Duration   `envconfig:"BONUS_FORFEIT_INTERVAL" default:"5m"`
+	PromotionExpiryInterval   time.Duration   `envconfig:"PROMOTION_EXPIRY_INTERVAL" default:"5m"`
+	PromotionReminderInterval time.Duration   `envconfig:"PROMOTION_REMINDER_INTERVAL" default:"5m"`
+	PromotionReminders        []time.Duration `envconfig:"PROMOTION_REMINDER_LEAD_TIMES" default:"12h,1h"`
+	PromotionScheduleInterval time.Duration   `envconfig:"PROMOTION_SCHEDULE_INTERVAL" default:"1m"`
+	WelcomeStepInterval       time.Duration   `envconfig:"WELCOME_STEP_INTERVAL" default:"1m"`
+	TierRecalculationInterval time.Duration   `envconfig:"TIER_RECALCULATION_INTERVAL" default:"1h"`
+	TierQualificationPeriod   string          `envconfig:"TIER_QUALIFICATION_PERIOD" default:"rolling"`
+	TierQualificationDays     int             `envconfig:"TIER_QUALIFICATION_DAYS" default:"90"`
+	TierGracePeriod           time.Duration   `envconfig:"TIER_GRACE_PERIOD" default:"336h"`
+	PointsValidity            time.Duration   `envconfig:"POINTS_VALIDITY" default:"8760h"`
+	PointsExpiryWarning       time.Duration   `envconfig:"POINTS_EXPIRY_WARNING" default:"168h"`
+	PointsExpiryInterval      time.Duration   `envconfig:"POINTS_EXPIRY_INTERVAL" default:"1h"`
+	TournamentPrizeInterval   time.Duration   `envconfig:"TOURNAMENT_PRIZE_INTERVAL" default:"1m"`
+	CashbackInterval          time.Duration   `envconfig:"CASHBACK_INTERVAL" default:"1h"`
+	CashbackValidity          time.Duration   `envconfig:"CASHBACK_VALIDITY" default:"168h"`
+	FreeSpinsSettleInterval   time.Duration   `envconfig:"FREE_SPINS_SETTLE_INTERVAL" default:"5m"`
+	CodeRedemptionAttempts    int             `envconfig:"CODE_REDEMPTION_ATTEMPTS" default:"10"`
+	CodeRedemptionWindow      time.Duration   `envconfig:"CODE_REDEMPTION_WINDOW" default:"1h"`
+	ReferralCondition         string          `envconfig:"REFERRAL_CONDITION" default:"first_deposit"`
+	ReferralThreshold         decimal.Decimal `envconfig:"REFERRAL_THRESHOLD" default:"20"`
+	ReferrerReward            decimal.Decimal `envconfig:"REFERRER_REWARD" default:"10"`
+	RefereeReward             decimal.Decimal `envconfig:"REFEREE_REWARD" default:"10"`
+	ReferralValidity          time.Duration   `envconfig:"REFERRAL_VALIDITY" default:"168h"`
+	ReferralRewardInterval    time.Duration   `envconfig:"REFERRAL_REWARD_INTERVAL" default:"5m"`
+	BudgetAlertThreshold      decimal.Decimal `envconfig:"BUDGET_ALERT_THRESHOLD" default:"0.8"`
+	ClawbackPolicy            string          `envconfig:"CLAWBACK_POLICY" default:"cap_at_zero"`
+	GameServerAPIKeys         []string        `envconfig:"GAME_SERVER_API_KEYS"`
 }
 
 func newConfig(ctx context.Context) (*Config, error) {
@@ -26,5 +58,33 @@ func newConfig(ctx context.Context) (*Config, error) {
 		return nil, fmt.Errorf("failed to fetch environment variables: %w", err)
 	}
 
+	switch types.TierQualificationPeriod(config.TierQualificationPeriod) {
+	case types.TierQualificationLifetime, types.TierQualificationRolling, types.TierQualificationQuarter:
+	default:
+		return nil, fmt.Errorf("invalid tier qualification period %q, use lifetime, rolling or quarter", config.TierQualificationPeriod)
+	}
+
+	switch types.ReferralCondition(config.ReferralCondition) {
+	case types.ReferralConditionDeposit, types.ReferralConditionWagered:
+	default:
+		return nil, fmt.Errorf("invalid referral condition %q, use first_deposit or wagered", config.ReferralCondition)
+	}
+
+	switch types.ClawbackPolicy(config.ClawbackPolicy) {
+	case types.ClawbackAllowNegative, types.ClawbackCapAtZero:
+	default:
+		return nil, fmt.Errorf("invalid clawback policy %q, use allow_negative or cap_at_zero", config.ClawbackPolicy)
+	}
+
+	if !config.BudgetAlertThreshold.IsPositive() || config.BudgetAlertThreshold.GreaterThan(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("invalid budget alert threshold %s, use a share of the budget above 0 and up to 1", config.BudgetAlertThreshold)
+	}
+
+	for _, leadTime := range config.PromotionReminders {
+		if leadTime <= 0 {
+			return nil, fmt.Errorf("invalid promotion reminder %s, use a lead time before the end date above 0", leadTime)
+		}
+	}
+
 	return &config, nil
 }
@@ -9,7 +9,9 @@ import (
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/postgresdb"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_leaderboard"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_pub_sub"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/redis_rate_limiter"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -17,12 +19,14 @@ import (
 )
 
 type Resource struct {
-	Config     *Config
-	Log        *zap.SugaredLogger
-	HTTPClient *http.Client
-	DB         store.Persistent
-	PubSub     store.PubSub
-	Close      func() error
+	Config      *Config
+	Log         *zap.SugaredLogger
+	HTTPClient  *http.Client
+	DB          store.Persistent
+	PubSub      store.PubSub
+	Leaderboard store.Leaderboard
+	RateLimiter store.RateLimiter
+	Close       func() error
 }
 
 func InitResource(ctx context.Context) (*Resource, error) {
@@ -77,6 +81,8 @@ func InitResource(ctx context.Context) (*Resource, error) {
 	}
 
 	r.PubSub = redis_pub_sub.New(redisClient, r.Log)
+	r.Leaderboard = redis_leaderboard.New(redisClient)
+	r.RateLimiter = redis_rate_limiter.New(redisClient)
 
 	r.Close = func() error {
 		return errors.Join(
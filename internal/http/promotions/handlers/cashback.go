@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/cashback"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type cashbackRouter struct {
+	component cashback.CashbackProvider
+}
+
+func NewCashbackRouter(component cashback.CashbackProvider) *cashbackRouter {
+	return &cashbackRouter{component: component}
+}
+
+// GetCashbackCalculations retrieves the cashback calculations of a user.
+// @Summary Get the cashback calculations of a user
+// @Description Retrieve the net losses, rules and amounts each cashback granted to the user was calculated from, latest period first
+// @Tags Cashback
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {array} types.CashbackCalculation "Cashback calculations"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/cashback/{user_id} [get]
+func (cr *cashbackRouter) GetCashbackCalculations() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		calculations, err := cr.component.GetCashbackCalculations(r.Context(), userID)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, calculations)
+	}
+}
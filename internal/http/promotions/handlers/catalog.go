@@ -0,0 +1,384 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/catalog"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type catalogRouter struct {
+	component catalog.CatalogProvider
+}
+
+func NewCatalogRouter(component catalog.CatalogProvider) *catalogRouter {
+	return &catalogRouter{component: component}
+}
+
+// GetItems retrieves the catalog items.
+// @Summary Get catalog items
+// @Description Retrieve the rewards players can buy with loyalty points. Players only see active items
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.CatalogItem "List of catalog items"
+// @Failure 400 {object} types.ErrorResponse "Missing requestor account"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog [get]
+func (cr *catalogRouter) GetItems() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		items, err := cr.component.GetItems(r.Context(), us.Role < types.Staff)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, items)
+	}
+}
+
+// GetItemByID retrieves a catalog item by its ID.
+// @Summary Get a catalog item by ID
+// @Description Retrieve a catalog item using its unique ID
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param id path string true "Catalog item ID"
+// @Success 200 {object} types.CatalogItem "Retrieved catalog item"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Catalog item not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog/{id} [get]
+func (cr *catalogRouter) GetItemByID() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get catalog item id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		item, err := cr.component.GetItemByID(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, item)
+	}
+}
+
+// CreateItem handles the creation of a new catalog item.
+// @Summary Create a catalog item
+// @Description Create a reward with its points price, stock and the bonus amount or promotion it grants. Bonus items are wagered under the multiplier of their promotion within the validity days
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param item body types.CatalogItem true "Catalog item details"
+// @Success 200 {object} types.CatalogItem "Created catalog item"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog [post]
+func (cr *catalogRouter) CreateItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CatalogItem
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		item, err := cr.component.CreateItem(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidCatalogItem) || store.IsErrForeignKeyViolation(err) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, item)
+	}
+}
+
+// UpdateItem updates an existing catalog item.
+// @Summary Update a catalog item
+// @Description Update the price, stock, reward or availability of a catalog item
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param id path string true "Catalog item ID"
+// @Param item body types.CatalogItem true "Updated catalog item details"
+// @Success 200 {object} types.CatalogItem "Updated catalog item"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 404 {object} types.ErrorResponse "Catalog item not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog/{id} [put]
+func (cr *catalogRouter) UpdateItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CatalogItem
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get catalog item id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		req.ID = id
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		item, err := cr.component.UpdateItem(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidCatalogItem) || store.IsErrForeignKeyViolation(err) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, item)
+	}
+}
+
+// DeleteItem deletes a catalog item by its ID.
+// @Summary Delete a catalog item
+// @Description Delete a catalog item that was never redeemed. Redeemed items can only be deactivated
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param id path string true "Catalog item ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Catalog item not found"
+// @Failure 409 {object} types.ErrorResponse "Catalog item was already redeemed"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog/{id} [delete]
+func (cr *catalogRouter) DeleteItem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get catalog item id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = cr.component.DeleteItem(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("catalog item with id: %s was not found to be deleted: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, types.ErrCatalogItemRedeemed) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, "OK")
+	}
+}
+
+// Redeem buys a catalog item with the requestor's loyalty points.
+// @Summary Redeem a catalog item
+// @Description Debit the points price from the requestor and hand out the reward. Physical items stay pending until staff fulfil them
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param id path string true "Catalog item ID"
+// @Param Idempotency-Key header string false "Replays the original response when the request is sent again with the same key"
+// @Success 200 {object} types.Redemption "Redemption"
+// @Failure 400 {object} types.ErrorResponse "Invalid input or business rule violation"
+// @Failure 404 {object} types.ErrorResponse "Catalog item not found"
+// @Failure 409 {object} types.ErrorResponse "Out of stock or request with the same idempotency key is in progress"
+// @Failure 422 {object} types.ErrorResponse "Idempotency key was used for a different request"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog/{id}/redeem [post]
+func (cr *catalogRouter) Redeem() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get catalog item id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		redemption, err := cr.component.Redeem(r.Context(), us.ID, id)
+		if err != nil {
+			log.Errorf("failed to redeem catalog item %s: %s", id.String(), err)
+			if errors.Is(err, types.ErrInsufficientPoints) ||
+				errors.Is(err, types.ErrCatalogItemUnavailable) ||
+				errors.Is(err, types.ErrPromotionArchived) ||
+				errors.Is(err, types.ErrPromotionNoLongerActive) ||
+				errors.Is(err, types.ErrPromotionNotAssignable) ||
+				errors.Is(err, types.ErrNotEligible) ||
+				errors.Is(err, types.ErrCurrencyMismatch) {
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+				return
+			}
+			if errors.Is(err, types.ErrCatalogItemOutOfStock) {
+				utils.WriteError(log, w, http.StatusConflict, err)
+				return
+			}
+			if errors.Is(err, pgx.ErrNoRows) {
+				utils.WriteError(log, w, http.StatusNotFound, err)
+				return
+			}
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, redemption)
+	}
+}
+
+// GetRedemptions retrieves catalog redemptions.
+// @Summary Get redemptions
+// @Description Retrieve redemptions newest first. Players only see their own, staff can filter by user and status
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param user_id query string false "User ID"
+// @Param status query string false "Redemption status" Enums(pending, fulfilled)
+// @Success 200 {array} types.Redemption "List of redemptions"
+// @Failure 400 {object} types.ErrorResponse "Invalid filter"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog/redemptions [get]
+func (cr *catalogRouter) GetRedemptions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var filter types.RedemptionFilter
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if value := r.URL.Query().Get("user_id"); value != "" {
+			userID, err := uuid.Parse(value)
+			if err != nil {
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+				return
+			}
+			filter.UserID = uuid.NullUUID{UUID: userID, Valid: true}
+		}
+
+		if us.Role < types.Staff {
+			filter.UserID = uuid.NullUUID{UUID: us.ID, Valid: true}
+		}
+
+		if value := r.URL.Query().Get("status"); value != "" {
+			status := types.RedemptionStatus(value)
+			filter.Status = &status
+		}
+
+		redemptions, err := cr.component.GetRedemptions(r.Context(), filter)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, redemptions)
+	}
+}
+
+// FulfilRedemption marks a pending redemption as handed out.
+// @Summary Fulfil a redemption
+// @Description Mark a pending physical item redemption as handed out to the player
+// @Tags Catalog
+// @Accept json
+// @Produce json
+// @Param id path string true "Redemption ID"
+// @Success 200 {object} types.Redemption "Fulfilled redemption"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Pending redemption not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/catalog/redemptions/{id}/fulfil [put]
+func (cr *catalogRouter) FulfilRedemption() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get redemption id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		redemption, err := cr.component.FulfilRedemption(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("pending redemption with id: %s was not found: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, redemption)
+	}
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	freespins "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/free_spins"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type freeSpinsRouter struct {
+	component freespins.FreeSpinsProvider
+}
+
+func NewFreeSpinsRouter(component freespins.FreeSpinsProvider) *freeSpinsRouter {
+	return &freeSpinsRouter{component: component}
+}
+
+// GetFreeSpins retrieves the free spins a player can play.
+// @Summary Get the free spins of a player
+// @Description Retrieve the free spins the player claimed that are not used up, expired or settled, soonest expiry first. Game servers can narrow them down to a game.
+// @Tags Free spins
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "Game server API key"
+// @Param user_id path string true "User ID"
+// @Param game_id query string false "Game ID"
+// @Success 200 {array} types.FreeSpinsEntitlement "Free spins"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 401 {object} types.ErrorResponse "Invalid API key"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/free_spins/{user_id} [get]
+func (fr *freeSpinsRouter) GetFreeSpins() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		freeSpins, err := fr.component.GetFreeSpins(r.Context(), userID, r.URL.Query().Get("game_id"))
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, freeSpins)
+	}
+}
+
+// PlayFreeSpin uses one of the free spins of a player.
+// @Summary Play a free spin
+// @Description Uses one spin for a game round and adds its win to the winnings. With the last spin the winnings are credited to the player, as bonus under the wagering multiplier of the promotion or as cash without one. Rounds are deduplicated, a repeated round returns the free spins and uses nothing.
+// @Tags Free spins
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "Game server API key"
+// @Param user_id path string true "User ID"
+// @Param free_spins_id path string true "Free spins ID"
+// @Param spin body types.FreeSpin true "Played round"
+// @Success 201 {object} types.FreeSpinsEntitlement "Spin used"
+// @Success 200 {object} types.FreeSpinsEntitlement "Round was already played"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format, invalid spin or currency mismatch"
+// @Failure 401 {object} types.ErrorResponse "Invalid API key"
+// @Failure 404 {object} types.ErrorResponse "Free spins not found"
+// @Failure 409 {object} types.ErrorResponse "Free spins are used up, expired or settled"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/free_spins/{user_id}/{free_spins_id}/spins [post]
+func (fr *freeSpinsRouter) PlayFreeSpin() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.FreeSpin
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		freeSpinsID, err := uuid.Parse(chi.URLParam(r, "free_spins_id"))
+		if err != nil {
+			log.Errorf("failed to get free spins id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		freeSpins, created, err := fr.component.PlayFreeSpin(r.Context(), userID, freeSpinsID, req)
+		if err != nil {
+			switch {
+			case errors.Is(err, types.ErrInvalidAmount),
+				errors.Is(err, types.ErrCurrencyMismatch):
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+			case errors.Is(err, types.ErrFreeSpinsUnavailable):
+				utils.WriteError(log, w, http.StatusConflict, err)
+			case store.IsErrNotFound(err):
+				utils.WriteError(log, w, http.StatusNotFound, err)
+			default:
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		if !created {
+			utils.WriteJSON(log, w, http.StatusOK, freeSpins)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusCreated, freeSpins)
+	}
+}
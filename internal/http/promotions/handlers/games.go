@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/games"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+)
+
+type gamesRouter struct {
+	component games.GameProvider
+}
+
+func NewGamesRouter(component games.GameProvider) *gamesRouter {
+	return &gamesRouter{component: component}
+}
+
+// IngestEvent applies a game event reported by a game server.
+// @Summary Ingest a game event
+// @Description Applies a bet, win or rollback of a game round to the player's balances. Events are deduplicated by game ID and event ID, a repeated event returns the stored one and applies nothing.
+// @Tags Games
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "Game server API key"
+// @Param event body types.GameEvent true "Game event"
+// @Success 201 {object} types.GameEvent "Event applied"
+// @Success 200 {object} types.GameEvent "Event was already applied"
+// @Failure 400 {object} types.ErrorResponse "Invalid event, currency mismatch or insufficient balance"
+// @Failure 401 {object} types.ErrorResponse "Invalid API key"
+// @Failure 404 {object} types.ErrorResponse "User or bet of the round not found"
+// @Failure 409 {object} types.ErrorResponse "Round already has a win, or a bet or rollback with another event ID"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/game_events [post]
+func (gr *gamesRouter) IngestEvent() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GameEvent
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		event, created, err := gr.component.IngestEvent(r.Context(), req)
+		if err != nil {
+			switch {
+			case errors.Is(err, types.ErrInvalidGameEvent),
+				errors.Is(err, types.ErrInvalidAmount),
+				errors.Is(err, types.ErrCurrencyMismatch),
+				errors.Is(err, types.ErrInsufficientBalance):
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+			case errors.Is(err, types.ErrGameRoundSettled),
+				errors.Is(err, types.ErrGameRoundDuplicate):
+				utils.WriteError(log, w, http.StatusConflict, err)
+			case store.IsErrNotFound(err):
+				utils.WriteError(log, w, http.StatusNotFound, err)
+			default:
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		if !created {
+			utils.WriteJSON(log, w, http.StatusOK, event)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusCreated, event)
+	}
+}
@@ -0,0 +1,396 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/loyalty"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// defaultLiabilityPeriod is how far ahead the points liability report looks
+// when no end is given.
+const defaultLiabilityPeriod = 30 * 24 * time.Hour
+
+type loyaltyRouter struct {
+	component loyalty.LoyaltyProvider
+}
+
+func NewLoyaltyRouter(component loyalty.LoyaltyProvider) *loyaltyRouter {
+	return &loyaltyRouter{component: component}
+}
+
+// GetPointsRates retrieves the loyalty points rates of all game categories.
+// @Summary Get all points rates
+// @Description Retrieve the loyalty points earned per unit wagered for each game category
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.PointsRate "List of points rates"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/points_rates [get]
+func (lr *loyaltyRouter) GetPointsRates() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		rates, err := lr.component.GetPointsRates(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, rates)
+	}
+}
+
+// SetPointsRate creates or replaces the points rate of a game category.
+// @Summary Set a points rate
+// @Description Set the loyalty points earned per unit wagered on games of the category. The "default" category applies to games without a rate of their own
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param category path string true "Game category"
+// @Param rate body types.PointsRate true "Points rate"
+// @Success 200 {object} types.PointsRate "Stored points rate"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/points_rates/{category} [put]
+func (lr *loyaltyRouter) SetPointsRate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PointsRate
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		req.Category = chi.URLParam(r, "category")
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		rate, err := lr.component.SetPointsRate(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidPointsRate) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, rate)
+	}
+}
+
+// DeletePointsRate deletes the points rate of a game category.
+// @Summary Delete a points rate
+// @Description Delete the points rate of a game category, its games fall back to the default rate
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param category path string true "Game category"
+// @Success 200 {string} string "OK"
+// @Failure 404 {object} types.ErrorResponse "Points rate not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/points_rates/{category} [delete]
+func (lr *loyaltyRouter) DeletePointsRate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		category := chi.URLParam(r, "category")
+
+		err := lr.component.DeletePointsRate(r.Context(), category)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("points rate of category %s was not found to be deleted: %s", category, err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, "OK")
+	}
+}
+
+// GetTiers retrieves all VIP tiers.
+// @Summary Get all tiers
+// @Description Retrieve all VIP tiers with their points thresholds and benefits, ordered by threshold
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.Tier "List of tiers"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tiers [get]
+func (lr *loyaltyRouter) GetTiers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		tiers, err := lr.component.GetTiers(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tiers)
+	}
+}
+
+// CreateTier handles the creation of a new tier.
+// @Summary Create a new tier
+// @Description Create a VIP tier reached by players who earned at least its points threshold
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param tier body types.Tier true "Tier details"
+// @Success 200 {object} types.Tier "Created tier"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 409 {object} types.ErrorResponse "Tier name or threshold already used"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tiers [post]
+func (lr *loyaltyRouter) CreateTier() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.Tier
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		tier, err := lr.component.CreateTier(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidTierThreshold) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, types.ErrTierExists) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tier)
+	}
+}
+
+// UpdateTier updates an existing tier.
+// @Summary Update a tier
+// @Description Update the name, points threshold and benefits of a tier. Players are moved between tiers on the next recalculation
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param id path string true "Tier ID"
+// @Param tier body types.Tier true "Updated tier details"
+// @Success 200 {object} types.Tier "Updated tier"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 404 {object} types.ErrorResponse "Tier not found"
+// @Failure 409 {object} types.ErrorResponse "Tier name or threshold already used"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tiers/{id} [put]
+func (lr *loyaltyRouter) UpdateTier() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.Tier
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get tier id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		req.ID = id
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		tier, err := lr.component.UpdateTier(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidTierThreshold) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, types.ErrTierExists) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tier)
+	}
+}
+
+// DeleteTier deletes a tier by its ID.
+// @Summary Delete a tier
+// @Description Delete a tier, its players are moved to another tier on the next recalculation
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param id path string true "Tier ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Tier not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tiers/{id} [delete]
+func (lr *loyaltyRouter) DeleteTier() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get tier id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = lr.component.DeleteTier(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("tier with id: %s was not found to be deleted: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, "OK")
+	}
+}
+
+// GetTierHistory retrieves the tier changes of a user.
+// @Summary Get the tier history of a user
+// @Description Retrieve every tier change of a user with its reason and the points it was based on, newest first
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {array} types.TierHistoryEntry "List of tier changes"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 403 {object} types.ErrorResponse "Forbidden - Requestor ID does not match"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tiers/history/{user_id} [get]
+func (lr *loyaltyRouter) GetTierHistory() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if us.ID != userID && us.Role < types.Staff {
+			utils.WriteError(log, w, http.StatusForbidden, types.ErrRequestorIDNotMatching)
+			return
+		}
+
+		history, err := lr.component.GetTierHistory(r.Context(), userID)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, history)
+	}
+}
+
+// GetPointsLiability reports the unredeemed points that expire in a period.
+// @Summary Get expiring points liability
+// @Description Sum the unredeemed loyalty points per day of expiry. The period defaults to the next 30 days
+// @Tags Loyalty
+// @Accept json
+// @Produce json
+// @Param from query string false "Start of the period, RFC 3339"
+// @Param to query string false "End of the period, RFC 3339"
+// @Success 200 {array} types.PointsLiability "Expiring points per day"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/points/liability [get]
+func (lr *loyaltyRouter) GetPointsLiability() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		var (
+			query = r.URL.Query()
+			from  = time.Now()
+			err   error
+		)
+
+		if value := query.Get("from"); value != "" {
+			from, err = time.Parse(time.RFC3339, value)
+			if err != nil {
+				utils.WriteError(log, w, http.StatusBadRequest, fmt.Errorf("invalid from: %w", err))
+				return
+			}
+		}
+
+		to := from.Add(defaultLiabilityPeriod)
+		if value := query.Get("to"); value != "" {
+			to, err = time.Parse(time.RFC3339, value)
+			if err != nil {
+				utils.WriteError(log, w, http.StatusBadRequest, fmt.Errorf("invalid to: %w", err))
+				return
+			}
+		}
+
+		liabilities, err := lr.component.GetPointsLiability(r.Context(), from, to)
+		if errors.Is(err, types.ErrStartAfterEndDate) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, liabilities)
+	}
+}
@@ -19,6 +19,10 @@ type promotionsRouter struct {
 	component promotions.PromotionProvider
 }
 
+type CreateWelcomePackageRequest struct {
+	Steps []types.WelcomeStep `json:"steps" validate:"required,min=1,dive"`
+}
+
 func NewPromotionsRouter(component promotions.PromotionProvider) *promotionsRouter {
 	return &promotionsRouter{component: component}
 }
@@ -52,6 +56,16 @@ func (pr *promotionsRouter) CreatePromotion() http.HandlerFunc {
 		}
 
 		promotion, err := pr.component.CreatePromotions(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidWagering) ||
+			errors.Is(err, types.ErrInvalidCashback) ||
+			errors.Is(err, types.ErrInvalidMatchBonus) ||
+			errors.Is(err, types.ErrInvalidFreeSpins) ||
+			errors.Is(err, types.ErrInvalidEligibility) ||
+			errors.Is(err, types.ErrInvalidAvailability) ||
+			errors.Is(err, types.ErrInvalidBudget) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
 		if err != nil {
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -102,18 +116,33 @@ func (pr *promotionsRouter) GetPromotionByID() http.HandlerFunc {
 
 // GetPromotions retrieves all promotions.
 // @Summary Get all promotions
-// @Description Retrieve a list of all promotions
+// @Description Retrieve a list of all promotions, optionally only the ones that are live, upcoming or ended
 // @Tags Promotions
 // @Accept json
 // @Produce json
+// @Param availability query string false "Availability" Enums(live, upcoming, ended)
 // @Success 200 {array} types.Promotion "List of promotions"
+// @Failure 400 {object} types.ErrorResponse "Invalid filter"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/promotions [get]
 func (pr *promotionsRouter) GetPromotions() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		var filter types.PromotionFilter
+
 		log := types.GetLoggerFromContext(r.Context())
 
-		users, err := pr.component.GetPromotions(r.Context())
+		if value := r.URL.Query().Get("availability"); value != "" {
+			availability := types.PromotionAvailability(value)
+			switch availability {
+			case types.PromotionLive, types.PromotionUpcoming, types.PromotionEnded:
+				filter.Availability = &availability
+			default:
+				utils.WriteError(log, w, http.StatusBadRequest, types.ErrInvalidPromotionFilter)
+				return
+			}
+		}
+
+		users, err := pr.component.GetPromotions(r.Context(), filter)
 		if err != nil {
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -125,13 +154,14 @@ func (pr *promotionsRouter) GetPromotions() http.HandlerFunc {
 
 // UpdatePromotion updates an existing promotion.
 // @Summary Update a promotion
-// @Description Update an existing promotion with the provided details
+// @Description Update an existing promotion with the provided details. Switching a scheduled promotion on or off by hand overrides its availability window until the next edge of it
 // @Tags Promotions
 // @Accept json
 // @Produce json
 // @Param promotion body types.Promotion true "Updated promotion details"
 // @Success 200 {object} types.Promotion "Updated promotion"
 // @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 409 {object} types.ErrorResponse "Promotion is archived"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/promotions/{id} [put]
 func (pr *promotionsRouter) UpdatePromotion() http.HandlerFunc {
@@ -152,6 +182,20 @@ func (pr *promotionsRouter) UpdatePromotion() http.HandlerFunc {
 		}
 
 		promotion, err := pr.component.UpdatePromotion(r.Context(), req)
+		if errors.Is(err, types.ErrInvalidWagering) ||
+			errors.Is(err, types.ErrInvalidCashback) ||
+			errors.Is(err, types.ErrInvalidMatchBonus) ||
+			errors.Is(err, types.ErrInvalidFreeSpins) ||
+			errors.Is(err, types.ErrInvalidEligibility) ||
+			errors.Is(err, types.ErrInvalidAvailability) ||
+			errors.Is(err, types.ErrInvalidBudget) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, types.ErrPromotionArchived) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
 		if err != nil {
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -162,9 +206,9 @@ func (pr *promotionsRouter) UpdatePromotion() http.HandlerFunc {
 	}
 }
 
-// DeletePromotion deletes a promotion by its ID.
-// @Summary Delete a promotion
-// @Description Delete a promotion using its unique ID
+// DeletePromotion archives a promotion by its ID.
+// @Summary Archive a promotion
+// @Description Archive a promotion using its unique ID. Archived promotions are deactivated and can no longer be assigned, players keep their promotions of it
 // @Tags Promotions
 // @Accept json
 // @Produce json
@@ -187,7 +231,7 @@ func (pr *promotionsRouter) DeletePromotion() http.HandlerFunc {
 
 		err = pr.component.DeletePromotion(r.Context(), id)
 		if errors.Is(err, pgx.ErrNoRows) {
-			log.Errorf("promotion with id: %s was not found to be deleted: %s", id.String(), err)
+			log.Errorf("promotion with id: %s was not found to be archived: %s", id.String(), err)
 			utils.WriteError(log, w, http.StatusNotFound, fmt.Errorf("promotion with %s id was not found", id.String()))
 			return
 		}
@@ -199,3 +243,207 @@ func (pr *promotionsRouter) DeletePromotion() http.HandlerFunc {
 		utils.WriteJSON(log, w, http.StatusOK, "OK")
 	}
 }
+
+// GetArchivedPromotions retrieves the archived promotions.
+// @Summary Get archived promotions
+// @Description Retrieve the archived promotions, latest archived first
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.Promotion "Archived promotions"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/archived [get]
+func (pr *promotionsRouter) GetArchivedPromotions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		archived, err := pr.component.GetPromotions(r.Context(), types.PromotionFilter{Archived: true})
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, archived)
+	}
+}
+
+// RestorePromotion restores an archived promotion.
+// @Summary Restore an archived promotion
+// @Description Restore an archived promotion. It stays inactive until it is activated or, when it is scheduled, its availability starts
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID"
+// @Success 200 {object} types.Promotion "Restored promotion"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Promotion not found"
+// @Failure 409 {object} types.ErrorResponse "Promotion is not archived"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/{id}/restore [put]
+func (pr *promotionsRouter) RestorePromotion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get promotion id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		promotion, err := pr.component.RestorePromotion(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("promotion with id: %s was not found to be restored: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, fmt.Errorf("promotion with %s id was not found", id.String()))
+			return
+		}
+		if errors.Is(err, types.ErrPromotionNotArchived) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, promotion)
+	}
+}
+
+// GetPromotionStateChanges retrieves the switches of a promotion.
+// @Summary Get promotion state changes
+// @Description Retrieve the audit trail of the promotion being switched on and off, by the scheduler or by hand, newest first
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID"
+// @Success 200 {array} types.PromotionStateChange "State changes"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/{id}/state_changes [get]
+func (pr *promotionsRouter) GetPromotionStateChanges() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get promotion id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		changes, err := pr.component.GetPromotionStateChanges(r.Context(), id)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, changes)
+	}
+}
+
+// CreateWelcomePackage creates the next version of the welcome package.
+// @Summary Create a welcome package version
+// @Description Create the next version of the welcome package new players get. Steps are numbered in the order given and unlock at registration or with the player's nth deposit. Players keep the version that was current when they registered
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Param request body CreateWelcomePackageRequest true "Steps of the welcome package"
+// @Success 201 {object} types.WelcomePackage "Created welcome package version"
+// @Failure 400 {object} types.ErrorResponse "Invalid steps"
+// @Failure 409 {object} types.ErrorResponse "Another version was created at the same time"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/welcome_package [post]
+func (pr *promotionsRouter) CreateWelcomePackage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateWelcomePackageRequest
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		welcomePackage, err := pr.component.CreateWelcomePackage(r.Context(), types.WelcomePackage{
+			Steps:     req.Steps,
+			CreatedBy: us.ID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, types.ErrInvalidWelcomePackage):
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+			case errors.Is(err, types.ErrWelcomePackageConflict):
+				utils.WriteError(log, w, http.StatusConflict, err)
+			default:
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusCreated, welcomePackage)
+	}
+}
+
+// GetWelcomePackage retrieves the current version of the welcome package.
+// @Summary Get the welcome package
+// @Description Retrieve the version of the welcome package players who register now get
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.WelcomePackage "Current welcome package version"
+// @Failure 404 {object} types.ErrorResponse "No welcome package was created"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/welcome_package [get]
+func (pr *promotionsRouter) GetWelcomePackage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		welcomePackage, err := pr.component.GetWelcomePackage(r.Context())
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, welcomePackage)
+	}
+}
+
+// GetWelcomePackages retrieves every version of the welcome package.
+// @Summary Get welcome package versions
+// @Description Retrieve every version of the welcome package, latest first
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.WelcomePackage "Welcome package versions"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/welcome_package/versions [get]
+func (pr *promotionsRouter) GetWelcomePackages() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		welcomePackages, err := pr.component.GetWelcomePackages(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, welcomePackages)
+	}
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	promotioncodes "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotion_codes"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type promotionCodesRouter struct {
+	component promotioncodes.PromotionCodeProvider
+}
+
+type RedeemPromotionCodeRequest struct {
+	Code string `json:"code" validate:"required,max=32" example:"SPRING25"`
+}
+
+func NewPromotionCodesRouter(component promotioncodes.PromotionCodeProvider) *promotionCodesRouter {
+	return &promotionCodesRouter{component: component}
+}
+
+// CreatePromotionCodes creates codes players redeem for a promotion.
+// @Summary Create promotion codes
+// @Description Generate a batch of single use codes, or create a shared code that every player can redeem once up to its redemption limit
+// @Tags Promotion codes
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID"
+// @Param batch body types.PromotionCodeBatch true "Codes to create"
+// @Success 201 {array} types.PromotionCode "Created codes"
+// @Failure 400 {object} types.ErrorResponse "Invalid input, promotion cannot be assigned or is archived"
+// @Failure 404 {object} types.ErrorResponse "Promotion not found"
+// @Failure 409 {object} types.ErrorResponse "Code already exists"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/{id}/codes [post]
+func (pcr *promotionCodesRouter) CreatePromotionCodes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PromotionCodeBatch
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get promotion id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		codes, err := pcr.component.CreatePromotionCodes(r.Context(), id, req)
+		if err != nil {
+			switch {
+			case errors.Is(err, types.ErrInvalidPromotionCodes),
+				errors.Is(err, types.ErrPromotionNotAssignable),
+				errors.Is(err, types.ErrPromotionArchived):
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+			case errors.Is(err, types.ErrPromotionCodeExists):
+				utils.WriteError(log, w, http.StatusConflict, err)
+			case store.IsErrNotFound(err):
+				utils.WriteError(log, w, http.StatusNotFound, err)
+			default:
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusCreated, codes)
+	}
+}
+
+// GetPromotionCodes retrieves the codes of a promotion.
+// @Summary Get promotion codes
+// @Description Retrieve the codes of a promotion with how often they were redeemed, oldest first
+// @Tags Promotion codes
+// @Accept json
+// @Produce json
+// @Param id path string true "Promotion ID"
+// @Success 200 {array} types.PromotionCode "Promotion codes"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotions/{id}/codes [get]
+func (pcr *promotionCodesRouter) GetPromotionCodes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get promotion id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		codes, err := pcr.component.GetPromotionCodes(r.Context(), id)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, codes)
+	}
+}
+
+// RedeemPromotionCode assigns the promotion of a code to the requestor.
+// @Summary Redeem a promotion code
+// @Description Assign the promotion of the code to the requestor, who can then claim it. Redemption attempts are rate limited per player
+// @Tags Promotion codes
+// @Accept json
+// @Produce json
+// @Param request body RedeemPromotionCodeRequest true "Code to redeem"
+// @Param Idempotency-Key header string false "Replays the original response when the request is sent again with the same key"
+// @Success 201 {object} types.UserPromotion "Assigned user promotion"
+// @Failure 400 {object} types.ErrorResponse "Invalid, expired or used up code, promotion no longer active or player not eligible"
+// @Failure 409 {object} types.ErrorResponse "Code already redeemed or request with the same idempotency key is in progress"
+// @Failure 422 {object} types.ErrorResponse "Idempotency key was used for a different request"
+// @Failure 429 {object} types.ErrorResponse "Too many redemption attempts"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/promotion_codes/redeem [post]
+func (pcr *promotionCodesRouter) RedeemPromotionCode() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedeemPromotionCodeRequest
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		userPromotion, err := pcr.component.RedeemPromotionCode(r.Context(), us.ID, req.Code)
+		if err != nil {
+			log.Errorf("failed to redeem promotion code: %s", err)
+			switch {
+			case errors.Is(err, types.ErrInvalidPromotionCode),
+				errors.Is(err, types.ErrPromotionCodeUsedUp),
+				errors.Is(err, types.ErrPromotionNoLongerActive),
+				errors.Is(err, types.ErrPromotionNotAssignable),
+				errors.Is(err, types.ErrNotEligible):
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+			case errors.Is(err, types.ErrPromotionCodeRedeemed):
+				utils.WriteError(log, w, http.StatusConflict, err)
+			case errors.Is(err, types.ErrTooManyAttempts):
+				utils.WriteError(log, w, http.StatusTooManyRequests, err)
+			default:
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusCreated, userPromotion)
+	}
+}
@@ -14,9 +14,14 @@ import (
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
 func TestCreatePromotion(t *testing.T) {
 	type fields struct {
 		promotionsProvider *fakes.FakePromotionProvider
@@ -43,16 +48,16 @@ func TestCreatePromotion(t *testing.T) {
 							Description: "Description",
 							Type:        types.Regular,
 							IsActive:    true,
-							Amount:      10,
+							Amount:      eur(10),
 						}, nil
 					},
 				},
 			},
 			req: test.TestRequest{
-				Body: `{"title":"Title","description":"Description","type":"regular","is_active":true,"amount":10}`,
+				Body: `{"title":"Title","description":"Description","type":"regular","wagering_multiplier":"0","is_active":true,"amount":{"amount":"10","currency":"EUR"}}`,
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":10,"is_active":true,"type":"regular","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":{"amount":"10","currency":"EUR"},"is_active":true,"type":"regular","wagering_multiplier":"0","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
 		},
 	}
 
@@ -101,7 +106,7 @@ func TestGetPromotionByID(t *testing.T) {
 							Description: "Description",
 							Type:        types.Regular,
 							IsActive:    true,
-							Amount:      10,
+							Amount:      eur(10),
 						}, nil
 					},
 				},
@@ -112,7 +117,7 @@ func TestGetPromotionByID(t *testing.T) {
 				},
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":10,"is_active":true,"type":"regular","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":{"amount":"10","currency":"EUR"},"is_active":true,"type":"regular","wagering_multiplier":"0","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
 		},
 		{
 			name: "it should fail to get promotion by id not found",
@@ -171,7 +176,7 @@ func TestGetPromotions(t *testing.T) {
 			name: "it should get promotion by id",
 			fields: fields{
 				promotionsProvider: &fakes.FakePromotionProvider{
-					GetPromotionsStub: func(ctx context.Context) ([]types.Promotion, error) {
+					GetPromotionsStub: func(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
 						return []types.Promotion{
 							{
 								ID:          ID,
@@ -179,7 +184,7 @@ func TestGetPromotions(t *testing.T) {
 								Description: "Description",
 								Type:        types.Regular,
 								IsActive:    true,
-								Amount:      10,
+								Amount:      eur(10),
 							},
 						}, nil
 					},
@@ -187,7 +192,30 @@ func TestGetPromotions(t *testing.T) {
 			},
 			req:            test.TestRequest{},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `[{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":10,"is_active":true,"type":"regular","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}]`,
+			expectedOutput: `[{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":{"amount":"10","currency":"EUR"},"is_active":true,"type":"regular","wagering_multiplier":"0","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}]`,
+		},
+		{
+			name: "it should get live promotions",
+			fields: fields{
+				promotionsProvider: &fakes.FakePromotionProvider{
+					GetPromotionsStub: func(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
+						require.Equal(t, types.PromotionLive, *filter.Availability)
+						return []types.Promotion{}, nil
+					},
+				},
+			},
+			req:            test.TestRequest{UrlParams: map[string]string{"availability": "live"}},
+			expectedCode:   http.StatusOK,
+			expectedOutput: `[]`,
+		},
+		{
+			name: "it should fail on an unknown availability",
+			fields: fields{
+				promotionsProvider: &fakes.FakePromotionProvider{},
+			},
+			req:            test.TestRequest{UrlParams: map[string]string{"availability": "soon"}},
+			expectedCode:   http.StatusBadRequest,
+			expectedOutput: types.ErrInvalidPromotionFilter.Error(),
 		},
 	}
 
@@ -236,7 +264,7 @@ func TestUpdatePromotion(t *testing.T) {
 							Description: "Description",
 							Type:        types.Regular,
 							IsActive:    true,
-							Amount:      10,
+							Amount:      eur(10),
 						}, nil
 					},
 				},
@@ -245,10 +273,10 @@ func TestUpdatePromotion(t *testing.T) {
 				Vars: map[string]string{
 					"id": "460aec7e-7d58-42fd-93b8-bca05a77bbf5",
 				},
-				Body: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":10,"is_active":true,"type":"regular","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
+				Body: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":{"amount":"10","currency":"EUR"},"is_active":true,"type":"regular","wagering_multiplier":"0","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":10,"is_active":true,"type":"regular","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","title":"Title","description":"Description","amount":{"amount":"10","currency":"EUR"},"is_active":true,"type":"regular","wagering_multiplier":"0","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}`,
 		},
 	}
 
@@ -337,3 +365,87 @@ func TestDeletePromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestRestorePromotion(t *testing.T) {
+	type fields struct {
+		promotionsProvider *fakes.FakePromotionProvider
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		req            test.TestRequest
+		expectedCode   int
+		expectedOutput string
+	}{
+		{
+			name: "it should restore promotion",
+			fields: fields{
+				promotionsProvider: &fakes.FakePromotionProvider{
+					RestorePromotionStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{ID: id}, nil
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars: map[string]string{
+					"id": "460aec7e-7d58-42fd-93b8-bca05a77bbf5",
+				},
+			},
+			expectedCode:   http.StatusOK,
+			expectedOutput: `"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5"`,
+		},
+		{
+			name: "it should fail to restore a promotion that is not archived",
+			fields: fields{
+				promotionsProvider: &fakes.FakePromotionProvider{
+					RestorePromotionStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{}, types.ErrPromotionNotArchived
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars: map[string]string{
+					"id": "460aec7e-7d58-42fd-93b8-bca05a77bbf5",
+				},
+			},
+			expectedCode:   http.StatusConflict,
+			expectedOutput: types.ErrPromotionNotArchived.Error(),
+		},
+		{
+			name: "it should fail to restore a promotion that was not found",
+			fields: fields{
+				promotionsProvider: &fakes.FakePromotionProvider{
+					RestorePromotionStub: func(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+						return types.Promotion{}, pgx.ErrNoRows
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars: map[string]string{
+					"id": "460aec7e-7d58-42fd-93b8-bca05a77bbf5",
+				},
+			},
+			expectedCode:   http.StatusNotFound,
+			expectedOutput: `"promotion with 460aec7e-7d58-42fd-93b8-bca05a77bbf5 id was not found"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := handlers.NewPromotionsRouter(tt.fields.promotionsProvider)
+			w := httptest.NewRecorder()
+			r, err := tt.req.GetRequest(http.MethodPut)
+			require.NoError(t, err)
+			router.RestorePromotion().ServeHTTP(w, r)
+
+			resp := w.Result()
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedCode, resp.StatusCode)
+			require.Regexp(t, regexp.MustCompile(regexp.QuoteMeta(tt.expectedOutput)), string(respBody))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/referrals"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+)
+
+type referralsRouter struct {
+	component referrals.ReferralProvider
+}
+
+func NewReferralsRouter(component referrals.ReferralProvider) *referralsRouter {
+	return &referralsRouter{component: component}
+}
+
+// GetReferralReport retrieves the referral report.
+// @Summary Get the referral report
+// @Description Retrieve the number of referrals per referrer and how many of them were rewarded, most referrals first
+// @Tags Referrals
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.ReferralReport "Referrals per referrer"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/referrals/report [get]
+func (rr *referralsRouter) GetReferralReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		report, err := rr.component.GetReferralReport(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, report)
+	}
+}
@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/tournaments"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+type tournamentsRouter struct {
+	component tournaments.TournamentProvider
+}
+
+func NewTournamentsRouter(component tournaments.TournamentProvider) *tournamentsRouter {
+	return &tournamentsRouter{component: component}
+}
+
+// GetTournaments retrieves all tournaments.
+// @Summary Get all tournaments
+// @Description Retrieve the scheduled, running and finished tournaments, latest first
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Success 200 {array} types.Tournament "List of tournaments"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tournaments [get]
+func (tr *tournamentsRouter) GetTournaments() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		tournaments, err := tr.component.GetTournaments(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tournaments)
+	}
+}
+
+// GetTournamentByID retrieves a tournament by its ID.
+// @Summary Get a tournament by ID
+// @Description Retrieve a tournament using its unique ID
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {object} types.Tournament "Retrieved tournament"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Tournament not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tournaments/{id} [get]
+func (tr *tournamentsRouter) GetTournamentByID() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get tournament id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		tournament, err := tr.component.GetTournamentByID(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tournament)
+	}
+}
+
+// GetLeaderboard retrieves the leaderboard of a tournament.
+// @Summary Get a tournament leaderboard
+// @Description Retrieve the leading players of a tournament and the standing of the requestor. Running tournaments are ranked live, finished ones by their final results
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Param limit query int false "Number of leading players, 10 by default and at most 100"
+// @Success 200 {object} types.Leaderboard "Tournament leaderboard"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 404 {object} types.ErrorResponse "Tournament not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tournaments/{id}/leaderboard [get]
+func (tr *tournamentsRouter) GetLeaderboard() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get tournament id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		limit := defaultLeaderboardLimit
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit < 1 || limit > maxLeaderboardLimit {
+				utils.WriteError(log, w, http.StatusBadRequest, fmt.Errorf("invalid limit: %s", value))
+				return
+			}
+		}
+
+		leaderboard, err := tr.component.GetLeaderboard(r.Context(), id, us.ID, limit)
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, leaderboard)
+	}
+}
+
+// CreateTournament handles the creation of a new tournament.
+// @Summary Create a tournament
+// @Description Create a tournament with its window, scoring rule and the promotions granted as prizes
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param tournament body types.Tournament true "Tournament details"
+// @Success 200 {object} types.Tournament "Created tournament"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tournaments [post]
+func (tr *tournamentsRouter) CreateTournament() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.Tournament
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		tournament, err := tr.component.CreateTournament(r.Context(), req)
+		if errors.Is(err, types.ErrStartAfterEndDate) || errors.Is(err, types.ErrInvalidTournamentPrizes) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tournament)
+	}
+}
+
+// UpdateTournament updates an existing tournament.
+// @Summary Update a tournament
+// @Description Update a tournament that is not finished. Once it started its scoring, game category and start date cannot change
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Param tournament body types.Tournament true "Updated tournament details"
+// @Success 200 {object} types.Tournament "Updated tournament"
+// @Failure 400 {object} types.ErrorResponse "Invalid input"
+// @Failure 404 {object} types.ErrorResponse "Tournament not found"
+// @Failure 409 {object} types.ErrorResponse "Tournament already started or finished"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tournaments/{id} [put]
+func (tr *tournamentsRouter) UpdateTournament() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.Tournament
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get tournament id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		req.ID = id
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		tournament, err := tr.component.UpdateTournament(r.Context(), req)
+		if errors.Is(err, types.ErrStartAfterEndDate) || errors.Is(err, types.ErrInvalidTournamentPrizes) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if errors.Is(err, types.ErrTournamentStarted) || errors.Is(err, types.ErrTournamentFinished) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, tournament)
+	}
+}
+
+// DeleteTournament deletes a tournament by its ID.
+// @Summary Delete a tournament
+// @Description Delete a tournament that did not start yet
+// @Tags Tournaments
+// @Accept json
+// @Produce json
+// @Param id path string true "Tournament ID"
+// @Success 200 {string} string "OK"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "Tournament not found"
+// @Failure 409 {object} types.ErrorResponse "Tournament already started"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/tournaments/{id} [delete]
+func (tr *tournamentsRouter) DeleteTournament() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get tournament id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = tr.component.DeleteTournament(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("tournament with id: %s was not found to be deleted: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if errors.Is(err, types.ErrTournamentStarted) {
+			utils.WriteError(log, w, http.StatusConflict, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, "OK")
+	}
+}
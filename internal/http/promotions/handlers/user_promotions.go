@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	userpromotion "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/user_promotion"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	utils "github.com/Jozzo6/casino_loyalty_reward_system/internal/util"
 
@@ -17,6 +18,11 @@ type userPromotionsRouter struct {
 	component userpromotion.UserPromotionProvider
 }
 
+type RevokePromotionRequest struct {
+	Reason types.RevocationReason `json:"reason" validate:"required,oneof=bonus_abuse fraud duplicate_account not_eligible operator_error player_request" example:"bonus_abuse"`
+	Note   string                 `json:"note" validate:"max=1000" example:"Same device as an existing account"`
+}
+
 func NewUserPromotionsRouter(component userpromotion.UserPromotionProvider) *userPromotionsRouter {
 	return &userPromotionsRouter{component: component}
 }
@@ -53,8 +59,12 @@ func (upr *userPromotionsRouter) AddPromotion() http.HandlerFunc {
 		if err != nil {
 			log.Errorf("failed to add promotion to user: %s", err)
 			if errors.Is(err, types.ErrStartAfterEndDate) ||
-				errors.Is(err, types.ErrPromotionNoLongerActive) {
+				errors.Is(err, types.ErrPromotionNoLongerActive) ||
+				errors.Is(err, types.ErrPromotionArchived) ||
+				errors.Is(err, types.ErrPromotionNotAssignable) ||
+				errors.Is(err, types.ErrNotEligible) {
 				utils.WriteError(log, w, http.StatusBadRequest, err)
+				return
 			}
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -99,13 +109,14 @@ func (upr *userPromotionsRouter) GetUserPromotionByID() http.HandlerFunc {
 
 // GetUserPromotions retrieves all promotions for a specific user.
 // @Summary Get all promotions for a user
-// @Description Retrieve a list of all promotions assigned to a specific user
+// @Description Retrieve a list of all promotions assigned to a specific user, optionally only those in a status
 // @Tags User Promotions
 // @Accept json
 // @Produce json
 // @Param user_id path string true "User ID"
+// @Param status query string false "Status" Enums(assigned, claimed, expired, revoked, forfeited)
 // @Success 200 {array} types.UserPromotion "List of user promotions"
-// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format or status"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/user-promotions/{user_id} [get]
 func (upr *userPromotionsRouter) GetUserPromotions() http.HandlerFunc {
@@ -119,7 +130,20 @@ func (upr *userPromotionsRouter) GetUserPromotions() http.HandlerFunc {
 			return
 		}
 
-		userPromotion, err := upr.component.GetUserPromotions(r.Context(), userID)
+		var filter types.UserPromotionFilter
+		if value := r.URL.Query().Get("status"); value != "" {
+			status := types.UserPromotionStatus(value)
+			switch status {
+			case types.UserPromotionAssigned, types.UserPromotionClaimed, types.UserPromotionExpired,
+				types.UserPromotionRevoked, types.UserPromotionForfeited:
+				filter.Status = &status
+			default:
+				utils.WriteError(log, w, http.StatusBadRequest, types.ErrInvalidStatusFilter)
+				return
+			}
+		}
+
+		userPromotion, err := upr.component.GetUserPromotions(r.Context(), userID, filter)
 		if err != nil {
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -138,6 +162,7 @@ func (upr *userPromotionsRouter) GetUserPromotions() http.HandlerFunc {
 // @Param user_prom_id path string true "User Promotion ID"
 // @Success 200 {string} string "OK"
 // @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 404 {object} types.ErrorResponse "User promotion not found"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/user-promotions/{user_id}/promotions/{user_prom_id} [delete]
 func (upr *userPromotionsRouter) DeleteUserPromotion() http.HandlerFunc {
@@ -152,6 +177,10 @@ func (upr *userPromotionsRouter) DeleteUserPromotion() http.HandlerFunc {
 		}
 
 		err = upr.component.DeleteUserPromotion(r.Context(), userPromotionID)
+		if store.IsErrNotFound(err) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
 		if err != nil {
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -161,17 +190,180 @@ func (upr *userPromotionsRouter) DeleteUserPromotion() http.HandlerFunc {
 	}
 }
 
+// RevokePromotion revokes a promotion of a user.
+// @Summary Revoke a user promotion
+// @Description Revoke an assigned or claimed promotion for a reason. What a claim credited is taken back under the clawback policy, which either allows a negative balance or stops at zero. The player is notified and the revocation is recorded
+// @Tags User Promotions
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Param user_prom_id path string true "User Promotion ID"
+// @Param request body RevokePromotionRequest true "Reason of the revocation"
+// @Success 200 {object} types.UserPromotionRevocation "Recorded revocation"
+// @Failure 400 {object} types.ErrorResponse "Invalid input or promotion expired or forfeited"
+// @Failure 404 {object} types.ErrorResponse "User promotion not found"
+// @Failure 409 {object} types.ErrorResponse "Promotion already revoked or changed while it was revoked"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/user-promotions/{user_id}/promotions/{user_prom_id}/revoke [post]
+func (upr *userPromotionsRouter) RevokePromotion() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokePromotionRequest
+
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		userPromotionID, err := uuid.Parse(chi.URLParam(r, "user_prom_id"))
+		if err != nil {
+			log.Errorf("failed to get user promotion id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if errs := utils.Validator.Struct(req); errs != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, errs)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		revocation, err := upr.component.RevokePromotion(r.Context(), types.UserPromotionRevocation{
+			UserPromotionID: userPromotionID,
+			UserID:          userID,
+			RevokedBy:       us.ID,
+			Reason:          req.Reason,
+			Note:            req.Note,
+		})
+		if err != nil {
+			log.Errorf("failed to revoke user promotion: %s", err)
+			switch {
+			case errors.Is(err, types.ErrPromotionNotRevocable):
+				utils.WriteError(log, w, http.StatusBadRequest, err)
+			case errors.Is(err, types.ErrPromotionRevoked),
+				errors.Is(err, types.ErrUserPromotionChanged):
+				utils.WriteError(log, w, http.StatusConflict, err)
+			case store.IsErrNotFound(err):
+				utils.WriteError(log, w, http.StatusNotFound, err)
+			default:
+				utils.WriteError(log, w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, revocation)
+	}
+}
+
+// GetUserPromotionRevocations retrieves the revocations of a user's promotions.
+// @Summary Get user promotion revocations
+// @Description Retrieve the recorded revocations of the promotions of a user, latest first
+// @Tags User Promotions
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {array} types.UserPromotionRevocation "Revocations"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/user-promotions/{user_id}/revocations [get]
+func (upr *userPromotionsRouter) GetUserPromotionRevocations() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		revocations, err := upr.component.GetUserPromotionRevocations(r.Context(), userID)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, revocations)
+	}
+}
+
+// GetUserWelcomePackage retrieves the welcome package of a user.
+// @Summary Get the welcome package of a user
+// @Description Retrieve the welcome package version a user registered under with the steps unlocked so far and the promotions they were assigned
+// @Tags User Promotions
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {object} types.UserWelcomePackage "Welcome package of the user"
+// @Failure 400 {object} types.ErrorResponse "Invalid ID format"
+// @Failure 403 {object} types.ErrorResponse "Forbidden - Requestor ID does not match"
+// @Failure 404 {object} types.ErrorResponse "User has no welcome package"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/user-promotions/{user_id}/welcome_package [get]
+func (upr *userPromotionsRouter) GetUserWelcomePackage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if us.ID != userID && us.Role < types.Staff {
+			utils.WriteError(log, w, http.StatusForbidden, types.ErrRequestorIDNotMatching)
+			return
+		}
+
+		welcomePackage, err := upr.component.GetUserWelcomePackage(r.Context(), userID)
+		if store.IsErrNotFound(err) {
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, welcomePackage)
+	}
+}
+
 // ClaimPromotion allows a user to claim a promotion.
 // @Summary Claim a promotion
-// @Description Allows a user to claim a promotion if eligible
+// @Description Allows a user to claim a promotion if eligible. A match bonus matches the latest deposit made since the promotion was assigned, free spins grant their spins to be played on the game servers
 // @Tags User Promotions
 // @Accept json
 // @Produce json
 // @Param user_id path string true "User ID"
 // @Param user_prom_id path string true "User Promotion ID"
+// @Param Idempotency-Key header string false "Replays the original response when the request is sent again with the same key"
 // @Success 200 {string} string "OK"
 // @Failure 400 {object} types.ErrorResponse "Invalid input or business rule violation"
 // @Failure 403 {object} types.ErrorResponse "Forbidden - Requestor ID does not match"
+// @Failure 409 {object} types.ErrorResponse "Promotion budget or claim limit reached, or request with the same idempotency key is in progress"
+// @Failure 422 {object} types.ErrorResponse "Idempotency key was used for a different request"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/user-promotions/{user_id}/promotions/{user_prom_id}/claim [post]
 func (upr *userPromotionsRouter) ClaimPromotion() http.HandlerFunc {
@@ -211,10 +403,19 @@ func (upr *userPromotionsRouter) ClaimPromotion() http.HandlerFunc {
 			if errors.Is(err, types.ErrPromotionNoLongerActive) ||
 				errors.Is(err, types.ErrPromotionExpired) ||
 				errors.Is(err, types.ErrPromotionNotStarted) ||
-				errors.Is(err, types.ErrPromotionClaimed) {
+				errors.Is(err, types.ErrPromotionClaimed) ||
+				errors.Is(err, types.ErrPromotionRevoked) ||
+				errors.Is(err, types.ErrCurrencyMismatch) ||
+				errors.Is(err, types.ErrNoQualifyingDeposit) {
 				utils.WriteError(log, w, http.StatusBadRequest, err)
 				return
 			}
+			if errors.Is(err, types.ErrBudgetExhausted) ||
+				errors.Is(err, types.ErrClaimLimitReached) ||
+				errors.Is(err, types.ErrUserClaimLimitReached) {
+				utils.WriteError(log, w, http.StatusConflict, err)
+				return
+			}
 			if errors.Is(err, types.ErrRequestorIDNotMatching) {
 				utils.WriteError(log, w, http.StatusForbidden, err)
 				return
@@ -0,0 +1,129 @@
+package promotions
+
+import (
+	"context"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/cashback"
+	freespins "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/free_spins"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/loyalty"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/referrals"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/tournaments"
+	userpromotion "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/user_promotion"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/scheduler"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+)
+
+func (s *server) jobs(promotionsComponent promotions.PromotionProvider, userPromotionComponent userpromotion.UserPromotionProvider, loyaltyComponent loyalty.LoyaltyProvider, tournamentsComponent tournaments.TournamentProvider, referralsComponent referrals.ReferralProvider, cashbackComponent cashback.CashbackProvider, freeSpinsComponent freespins.FreeSpinsProvider) []scheduler.Job {
+	return []scheduler.Job{
+		{
+			Name:     "apply_promotion_schedule",
+			Interval: s.Resource.Config.PromotionScheduleInterval,
+			Run: func(ctx context.Context) error {
+				switched, err := promotionsComponent.ApplySchedule(ctx)
+				if switched > 0 {
+					types.GetLoggerFromContext(ctx).Infof("switched %d scheduled promotions", switched)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "forfeit_expired_bonuses",
+			Interval: s.Resource.Config.BonusForfeitInterval,
+			Run: func(ctx context.Context) error {
+				forfeited, err := userPromotionComponent.ForfeitExpiredBonuses(ctx)
+				if forfeited > 0 {
+					types.GetLoggerFromContext(ctx).Infof("forfeited %d expired bonuses", forfeited)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "expire_user_promotions",
+			Interval: s.Resource.Config.PromotionExpiryInterval,
+			Run: func(ctx context.Context) error {
+				expired, err := userPromotionComponent.ExpireUserPromotions(ctx)
+				if expired > 0 {
+					types.GetLoggerFromContext(ctx).Infof("expired %d unclaimed user promotions", expired)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "remind_expiring_promotions",
+			Interval: s.Resource.Config.PromotionReminderInterval,
+			Run: func(ctx context.Context) error {
+				reminded, err := userPromotionComponent.RemindExpiringPromotions(ctx)
+				if reminded > 0 {
+					types.GetLoggerFromContext(ctx).Infof("sent %d promotion expiry reminders", reminded)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "unlock_welcome_steps",
+			Interval: s.Resource.Config.WelcomeStepInterval,
+			Run: func(ctx context.Context) error {
+				unlocked, err := userPromotionComponent.UnlockWelcomeSteps(ctx)
+				if unlocked > 0 {
+					types.GetLoggerFromContext(ctx).Infof("unlocked %d welcome package steps", unlocked)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "evaluate_tiers",
+			Interval: s.Resource.Config.TierRecalculationInterval,
+			Run:      loyaltyComponent.EvaluateTiers,
+		},
+		{
+			Name:     "expire_points",
+			Interval: s.Resource.Config.PointsExpiryInterval,
+			Run:      loyaltyComponent.ExpirePoints,
+		},
+		{
+			Name:     "distribute_tournament_prizes",
+			Interval: s.Resource.Config.TournamentPrizeInterval,
+			Run: func(ctx context.Context) error {
+				granted, err := tournamentsComponent.DistributePrizes(ctx)
+				if granted > 0 {
+					types.GetLoggerFromContext(ctx).Infof("granted %d tournament prizes", granted)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "reward_referrals",
+			Interval: s.Resource.Config.ReferralRewardInterval,
+			Run: func(ctx context.Context) error {
+				rewarded, err := referralsComponent.RewardReferrals(ctx)
+				if rewarded > 0 {
+					types.GetLoggerFromContext(ctx).Infof("rewarded %d referrals", rewarded)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "calculate_cashback",
+			Interval: s.Resource.Config.CashbackInterval,
+			Run: func(ctx context.Context) error {
+				granted, err := cashbackComponent.CalculateCashback(ctx)
+				if granted > 0 {
+					types.GetLoggerFromContext(ctx).Infof("granted %d cashbacks", granted)
+				}
+				return err
+			},
+		},
+		{
+			Name:     "settle_expired_free_spins",
+			Interval: s.Resource.Config.FreeSpinsSettleInterval,
+			Run: func(ctx context.Context) error {
+				settled, err := freeSpinsComponent.SettleExpiredFreeSpins(ctx)
+				if settled > 0 {
+					types.GetLoggerFromContext(ctx).Infof("settled %d expired free spins", settled)
+				}
+				return err
+			},
+		},
+	}
+}
@@ -1,13 +1,24 @@
 package promotions
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/cashback"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/catalog"
+	freespins "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/free_spins"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/games"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/idempotency"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/loyalty"
+	promotioncodes "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotion_codes"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/promotions"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/referrals"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/tournaments"
 	userpromotion "github.com/Jozzo6/casino_loyalty_reward_system/internal/component/user_promotion"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/users"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/http/middlewares"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/http/promotions/handlers"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/scheduler"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 
 	"github.com/go-chi/chi/v5"
@@ -33,35 +44,145 @@ func (s *server) routes() http.Handler {
 
 	usersComponent := users.New(s.Resource.DB, s.Resource.PubSub, []byte(s.Resource.Config.JWTKey), s.Resource.Config.JWTDuration)
 	promotionsComponent := promotions.New(s.Resource.DB)
-	userPromotionComponent := userpromotion.New(s.Resource.DB, s.Resource.PubSub)
+	userPromotionComponent := userpromotion.New(s.Resource.DB, s.Resource.PubSub, s.Resource.Config.BudgetAlertThreshold, types.ClawbackPolicy(s.Resource.Config.ClawbackPolicy), s.Resource.Config.PromotionReminders)
+	idempotencyComponent := idempotency.New(s.Resource.DB)
+	loyaltyComponent := loyalty.New(s.Resource.DB, s.Resource.PubSub, types.TierQualification{
+		Period:      types.TierQualificationPeriod(s.Resource.Config.TierQualificationPeriod),
+		Days:        s.Resource.Config.TierQualificationDays,
+		GracePeriod: s.Resource.Config.TierGracePeriod,
+	}, types.PointsExpiry{
+		Validity: s.Resource.Config.PointsValidity,
+		Warning:  s.Resource.Config.PointsExpiryWarning,
+	})
+	catalogComponent := catalog.New(s.Resource.DB, s.Resource.PubSub)
+	tournamentsComponent := tournaments.New(s.Resource.DB, s.Resource.Leaderboard, s.Resource.PubSub)
+	cashbackComponent := cashback.New(s.Resource.DB, s.Resource.PubSub, s.Resource.Config.CashbackValidity)
+	freeSpinsComponent := freespins.New(s.Resource.DB, s.Resource.PubSub)
+	promotionCodesComponent := promotioncodes.New(s.Resource.DB, s.Resource.PubSub, s.Resource.RateLimiter, types.RateLimit{
+		Attempts: s.Resource.Config.CodeRedemptionAttempts,
+		Window:   s.Resource.Config.CodeRedemptionWindow,
+	})
+	referralsComponent := referrals.New(s.Resource.DB, s.Resource.PubSub, types.ReferralProgram{
+		Condition:      types.ReferralCondition(s.Resource.Config.ReferralCondition),
+		Threshold:      types.NewMoney(s.Resource.Config.ReferralThreshold, types.DefaultCurrency),
+		ReferrerReward: types.NewMoney(s.Resource.Config.ReferrerReward, types.DefaultCurrency),
+		RefereeReward:  types.NewMoney(s.Resource.Config.RefereeReward, types.DefaultCurrency),
+		Validity:       s.Resource.Config.ReferralValidity,
+	})
+	gamesComponent := games.New(s.Resource.DB, s.Resource.PubSub, userPromotionComponent, loyaltyComponent, tournamentsComponent)
+
+	go func() {
+		err := gamesComponent.ListenToGameEvents(context.Background())
+		if err != nil {
+			s.Resource.Log.Errorf("error in ListenToGameEvents: %s", err)
+		}
+	}()
+
+	s.scheduler = scheduler.New(s.Resource.Log, s.jobs(promotionsComponent, userPromotionComponent, loyaltyComponent, tournamentsComponent, referralsComponent, cashbackComponent, freeSpinsComponent)...)
 
 	authMiddleware := middlewares.AuthMiddleware(usersComponent)
+	idempotencyMiddleware := middlewares.IdempotencyMiddleware(idempotencyComponent)
+	apiKeyMiddleware := middlewares.APIKeyMiddleware(s.Resource.Config.GameServerAPIKeys)
 
 	promotionsRouter := handlers.NewPromotionsRouter(promotionsComponent)
 	userPromotionsRouter := handlers.NewUserPromotionsRouter(userPromotionComponent)
+	gamesRouter := handlers.NewGamesRouter(gamesComponent)
+	loyaltyRouter := handlers.NewLoyaltyRouter(loyaltyComponent)
+	catalogRouter := handlers.NewCatalogRouter(catalogComponent)
+	tournamentsRouter := handlers.NewTournamentsRouter(tournamentsComponent)
+	referralsRouter := handlers.NewReferralsRouter(referralsComponent)
+	cashbackRouter := handlers.NewCashbackRouter(cashbackComponent)
+	freeSpinsRouter := handlers.NewFreeSpinsRouter(freeSpinsComponent)
+	promotionCodesRouter := handlers.NewPromotionCodesRouter(promotionCodesComponent)
 
 	r.Route("/api/v1", func(r chi.Router) {
+		r.With(apiKeyMiddleware).Post("/game_events", gamesRouter.IngestEvent())
+		r.With(apiKeyMiddleware).Route("/free_spins/{user_id}", func(r chi.Router) {
+			r.Get("/", freeSpinsRouter.GetFreeSpins())
+			r.Post("/{free_spins_id}/spins", freeSpinsRouter.PlayFreeSpin())
+		})
+
 		r.With(authMiddleware).Group(func(r chi.Router) {
 			r.Route("/user_promotions", func(r chi.Router) {
 				r.Get("/{user_id}", userPromotionsRouter.GetUserPromotions())
 				r.Get("/{user_id}/promotion/{user_prom_id}", userPromotionsRouter.GetUserPromotionByID())
-				r.Put("/{user_id}/promotions/{user_prom_id}/claim", userPromotionsRouter.ClaimPromotion())
-				
+				r.Get("/{user_id}/welcome_package", userPromotionsRouter.GetUserWelcomePackage())
+				r.With(idempotencyMiddleware).Put("/{user_id}/promotions/{user_prom_id}/claim", userPromotionsRouter.ClaimPromotion())
+
 				r.With(middlewares.RequiredRole(types.Staff)).Group(func(r chi.Router) {
 					r.Post("/{user_id}", userPromotionsRouter.AddPromotion())
-					r.Delete("/{user_id}/promotions/{user_prom_id}", userPromotionsRouter.GetUserPromotionByID())
+					r.Delete("/{user_id}/promotions/{user_prom_id}", userPromotionsRouter.DeleteUserPromotion())
+					r.Post("/{user_id}/promotions/{user_prom_id}/revoke", userPromotionsRouter.RevokePromotion())
+					r.Get("/{user_id}/revocations", userPromotionsRouter.GetUserPromotionRevocations())
 				})
 			})
 
 			r.Route("/promotions", func(r chi.Router) {
 				r.Get("/", promotionsRouter.GetPromotions())
+				r.Get("/welcome_package", promotionsRouter.GetWelcomePackage())
 				r.Get("/{id}", promotionsRouter.GetPromotionByID())
 				r.With(middlewares.RequiredRole(types.Staff)).Group(func(r chi.Router) {
 					r.Post("/", promotionsRouter.CreatePromotion())
 					r.Put("/{id}", promotionsRouter.UpdatePromotion())
 					r.Delete("/{id}", promotionsRouter.DeletePromotion())
+					r.Get("/archived", promotionsRouter.GetArchivedPromotions())
+					r.Put("/{id}/restore", promotionsRouter.RestorePromotion())
+					r.Get("/{id}/state_changes", promotionsRouter.GetPromotionStateChanges())
+					r.Get("/{id}/codes", promotionCodesRouter.GetPromotionCodes())
+					r.Post("/welcome_package", promotionsRouter.CreateWelcomePackage())
+					r.Get("/welcome_package/versions", promotionsRouter.GetWelcomePackages())
+					r.Post("/{id}/codes", promotionCodesRouter.CreatePromotionCodes())
 				})
 			})
+
+			r.With(idempotencyMiddleware).Post("/promotion_codes/redeem", promotionCodesRouter.RedeemPromotionCode())
+
+			r.With(middlewares.RequiredRole(types.Staff)).Route("/points_rates", func(r chi.Router) {
+				r.Get("/", loyaltyRouter.GetPointsRates())
+				r.Put("/{category}", loyaltyRouter.SetPointsRate())
+				r.Delete("/{category}", loyaltyRouter.DeletePointsRate())
+			})
+
+			r.With(middlewares.RequiredRole(types.Staff)).Get("/points/liability", loyaltyRouter.GetPointsLiability())
+
+			r.Route("/tiers", func(r chi.Router) {
+				r.Get("/", loyaltyRouter.GetTiers())
+				r.Get("/history/{user_id}", loyaltyRouter.GetTierHistory())
+				r.With(middlewares.RequiredRole(types.Staff)).Group(func(r chi.Router) {
+					r.Post("/", loyaltyRouter.CreateTier())
+					r.Put("/{id}", loyaltyRouter.UpdateTier())
+					r.Delete("/{id}", loyaltyRouter.DeleteTier())
+				})
+			})
+
+			r.Route("/catalog", func(r chi.Router) {
+				r.Get("/", catalogRouter.GetItems())
+				r.Get("/redemptions", catalogRouter.GetRedemptions())
+				r.Get("/{id}", catalogRouter.GetItemByID())
+				r.With(idempotencyMiddleware).Post("/{id}/redeem", catalogRouter.Redeem())
+
+				r.With(middlewares.RequiredRole(types.Staff)).Group(func(r chi.Router) {
+					r.Post("/", catalogRouter.CreateItem())
+					r.Put("/{id}", catalogRouter.UpdateItem())
+					r.Delete("/{id}", catalogRouter.DeleteItem())
+					r.Put("/redemptions/{id}/fulfil", catalogRouter.FulfilRedemption())
+				})
+			})
+
+			r.Route("/tournaments", func(r chi.Router) {
+				r.Get("/", tournamentsRouter.GetTournaments())
+				r.Get("/{id}", tournamentsRouter.GetTournamentByID())
+				r.Get("/{id}/leaderboard", tournamentsRouter.GetLeaderboard())
+
+				r.With(middlewares.RequiredRole(types.Staff)).Group(func(r chi.Router) {
+					r.Post("/", tournamentsRouter.CreateTournament())
+					r.Put("/{id}", tournamentsRouter.UpdateTournament())
+					r.Delete("/{id}", tournamentsRouter.DeleteTournament())
+				})
+			})
+
+			r.With(middlewares.RequiredRole(types.Staff)).Get("/referrals/report", referralsRouter.GetReferralReport())
+			r.With(middlewares.RequiredRole(types.Staff)).Get("/cashback/{user_id}", cashbackRouter.GetCashbackCalculations())
 		})
 	})
 
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/http/promotions/config"
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/scheduler"
 )
 
 type server struct {
 	Resource   *config.Resource
 	httpServer *http.Server
+	scheduler  *scheduler.Scheduler
 }
 
 func NewServer(ctx context.Context) (*server, error) {
@@ -39,6 +41,8 @@ func (s *server) ListenAndServe() error {
 		Handler: s.routes(),
 	}
 
+	s.scheduler.Start(context.Background())
+
 	s.Resource.Log.Debugf("starting server on port: %s", s.Resource.Config.Port)
 	return s.httpServer.ListenAndServe()
 }
@@ -47,6 +51,8 @@ func (s *server) Close(ctx context.Context, timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	s.scheduler.Stop()
+
 	return errors.Join(
 		s.httpServer.Shutdown(ctx),
 		s.Resource.Close(),
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/users"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store"
@@ -25,27 +28,30 @@ func NewAccountsRouter(component users.UserProvider) *usersRouter {
 }
 
 type RegisterRequest struct {
-	Name     string `json:"name" validate:"required,min=3"`
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
+	Name         string `json:"name" validate:"required,min=3"`
+	Email        string `json:"email" validate:"required,email"`
+	Password     string `json:"password" validate:"required,min=6"`
+	ReferralCode string `json:"referral_code" validate:"max=32"`
+	Country      string `json:"country" validate:"omitempty,iso3166_1_alpha2" example:"DE"`
 }
 
 type RegisterResponse struct {
-	ID    uuid.UUID `json:"id"`
-	Name  string    `json:"name"`
-	Email string    `json:"email"`
-	Token string    `json:"token"`
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	ReferralCode string    `json:"referral_code"`
+	Token        string    `json:"token"`
 }
 
 // Register handles user registration.
 // @Summary Register a new user
-// @Description Creates a new user account and returns the user details along with a token.
+// @Description Creates a new user account and returns the user details along with a token. A referral code of another player can be given to be rewarded together once the new player qualifies.
 // @Tags Users
 // @Accept json
 // @Produce json
 // @Param request body RegisterRequest true "User registration details"
 // @Success 200 {object} RegisterResponse "User registered successfully"
-// @Failure 400 {object} types.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} types.ErrorResponse "Invalid request payload or referral code"
 // @Failure 409 {object} types.ErrorResponse "User already exists"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/register [post]
@@ -70,9 +76,13 @@ func (ur *usersRouter) Register() http.HandlerFunc {
 			Email:    req.Email,
 			Name:     req.Name,
 			Password: req.Password,
-		})
+			Country:  req.Country,
+		}, req.ReferralCode)
 
-		if store.IsErrConflict(err) {
+		if errors.Is(err, types.ErrInvalidReferralCode) || errors.Is(err, types.ErrSelfReferral) || errors.Is(err, types.ErrDuplicateReferral) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		} else if store.IsErrConflict(err) {
 			utils.WriteError(log, w, http.StatusConflict, err)
 			return
 		} else if err != nil {
@@ -81,10 +91,11 @@ func (ur *usersRouter) Register() http.HandlerFunc {
 		}
 
 		utils.WriteJSON(log, w, http.StatusOK, RegisterResponse{
-			ID:    createdUser.ID,
-			Name:  createdUser.Name,
-			Email: createdUser.Email,
-			Token: token,
+			ID:           createdUser.ID,
+			Name:         createdUser.Name,
+			Email:        createdUser.Email,
+			ReferralCode: createdUser.ReferralCode,
+			Token:        token,
 		})
 	}
 }
@@ -262,6 +273,7 @@ func (ur *usersRouter) UpdateUser() http.HandlerFunc {
 // @Success 200 {string} string "User deleted successfully"
 // @Failure 400 {object} types.ErrorResponse "Invalid user ID"
 // @Failure 404 {object} types.ErrorResponse "User not found"
+// @Failure 409 {object} types.ErrorResponse "User has balance history"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/users/{id} [delete]
 func (ur *usersRouter) DeleteUser() http.HandlerFunc {
@@ -281,6 +293,10 @@ func (ur *usersRouter) DeleteUser() http.HandlerFunc {
 			utils.WriteError(log, w, http.StatusNotFound, fmt.Errorf("user with %s id was not found", id.String()))
 			return
 		}
+		if store.IsErrForeignKeyViolation(err) {
+			utils.WriteError(log, w, http.StatusConflict, fmt.Errorf("user with %s id has balance history and cannot be deleted", id.String()))
+			return
+		}
 		if err != nil {
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
@@ -291,19 +307,27 @@ func (ur *usersRouter) DeleteUser() http.HandlerFunc {
 }
 
 type UpdateBalanceRequest struct {
-	Value           float64               `json:"value" validate:"required"`
+	Value           types.Money           `json:"value"`
 	TransactionType types.TransactionType `json:"transaction_type" validate:"required"`
+	Source          types.LedgerSource    `json:"source" validate:"omitempty,oneof=manual adjustment"`
+	ReferenceID     uuid.NullUUID         `json:"reference_id" swaggertype:"string"`
 }
 
 // UpdateBalance updates a user's balance.
 // @Summary Update user balance
-// @Description Updates the balance of a user based on the transaction type and value.
+// @Description Updates the balance of a user based on the transaction type and value. Every update is recorded as a ledger entry, adjustments and references are restricted to staff.
 // @Tags Users
 // @Accept json
 // @Produce json
+// @Param id path string true "User ID"
 // @Param request body UpdateBalanceRequest true "Balance update details"
+// @Param Idempotency-Key header string false "Replays the original response when the request is sent again with the same key"
 // @Success 200 {object} types.User "User balance updated successfully"
-// @Failure 400 {object} types.ErrorResponse "Invalid request payload or insufficient balance"
+// @Failure 400 {object} types.ErrorResponse "Invalid request payload, currency mismatch or insufficient balance"
+// @Failure 403 {object} types.ErrorResponse "Forbidden - Requestor ID does not match or adjustment or reference without staff role"
+// @Failure 404 {object} types.ErrorResponse "User not found"
+// @Failure 409 {object} types.ErrorResponse "Request with the same idempotency key is in progress"
+// @Failure 422 {object} types.ErrorResponse "Idempotency key was used for a different request"
 // @Failure 500 {object} types.ErrorResponse "Internal server error"
 // @Router /api/v1/users/{id}/balance [put]
 func (ur *usersRouter) UpdateBalance() http.HandlerFunc {
@@ -312,7 +336,14 @@ func (ur *usersRouter) UpdateBalance() http.HandlerFunc {
 
 		log := types.GetLoggerFromContext(r.Context())
 
-		err := json.NewDecoder(r.Body).Decode(&req)
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		err = json.NewDecoder(r.Body).Decode(&req)
 		if err != nil {
 			utils.WriteError(log, w, http.StatusBadRequest, err)
 			return
@@ -329,12 +360,37 @@ func (ur *usersRouter) UpdateBalance() http.HandlerFunc {
 			return
 		}
 
-		user, err := ur.component.UpdateUserBalance(r.Context(), us, req.Value, req.TransactionType)
+		if us.ID != id && us.Role < types.Staff {
+			utils.WriteError(log, w, http.StatusForbidden, types.ErrRequestorIDNotMatching)
+			return
+		}
+
+		if req.Source == "" {
+			req.Source = types.LedgerSourceManual
+		}
+
+		if req.Source == types.LedgerSourceAdjustment && us.Role < types.Staff {
+			utils.WriteError(log, w, http.StatusForbidden, types.ErrAdjustmentNotAllowed)
+			return
+		}
+
+		if req.ReferenceID.Valid && us.Role < types.Staff {
+			utils.WriteError(log, w, http.StatusForbidden, types.ErrReferenceNotAllowed)
+			return
+		}
+
+		user, err := ur.component.UpdateUserBalance(r.Context(), id, req.Value, req.TransactionType, req.Source, req.ReferenceID)
 		if err != nil {
-			if errors.Is(err, types.ErrInsufficientBalance) {
+			if errors.Is(err, types.ErrInsufficientBalance) ||
+				errors.Is(err, types.ErrInvalidAmount) ||
+				errors.Is(err, types.ErrCurrencyMismatch) {
 				utils.WriteError(log, w, http.StatusBadRequest, err)
 				return
 			}
+			if errors.Is(err, pgx.ErrNoRows) {
+				utils.WriteError(log, w, http.StatusNotFound, err)
+				return
+			}
 			utils.WriteError(log, w, http.StatusInternalServerError, err)
 			return
 		}
@@ -343,3 +399,186 @@ func (ur *usersRouter) UpdateBalance() http.HandlerFunc {
 
 	}
 }
+
+// ReconcileBalance compares a user's balance with their ledger.
+// @Summary Reconcile user balance
+// @Description Compares the stored balance of a user with the balance computed from their ledger entries.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} types.BalanceReconciliation "Balance reconciliation"
+// @Failure 400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure 404 {object} types.ErrorResponse "User not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/balance/reconciliation [get]
+func (ur *usersRouter) ReconcileBalance() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		reconciliation, err := ur.component.ReconcileUserBalance(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("user with id: %s was not found: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, reconciliation)
+	}
+}
+
+// RebuildBalance recomputes a user's balance from their ledger.
+// @Summary Rebuild user balance
+// @Description Overwrites the stored balance of a user with the balance computed from their ledger entries.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} types.User "User balance rebuilt successfully"
+// @Failure 400 {object} types.ErrorResponse "Invalid user ID"
+// @Failure 404 {object} types.ErrorResponse "User not found"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/balance/rebuild [post]
+func (ur *usersRouter) RebuildBalance() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		user, err := ur.component.RebuildUserBalance(r.Context(), id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			log.Errorf("user with id: %s was not found: %s", id.String(), err)
+			utils.WriteError(log, w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, user)
+	}
+}
+
+// GetTransactions returns a user's balance history.
+// @Summary Get user transactions
+// @Description Retrieves the ledger entries of a user, newest first, with cursor pagination. Entries caused by a promotion claim include the claimed user promotion.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param from query string false "Only entries created at or after this time (RFC3339)"
+// @Param to query string false "Only entries created before this time (RFC3339)"
+// @Param type query []string false "Transaction types" collectionFormat(csv) Enums(manual, promotion_claim, adjustment, bonus_conversion, bonus_forfeit, game_bet, game_win, game_rollback, points_redemption, referral_reward, free_spins_win, promotion_clawback)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param limit query int false "Page size, defaults to 50, maximum 100"
+// @Success 200 {object} types.LedgerPage "Page of transactions"
+// @Failure 400 {object} types.ErrorResponse "Invalid user ID or query parameters"
+// @Failure 403 {object} types.ErrorResponse "Forbidden - Requestor ID does not match"
+// @Failure 500 {object} types.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/transactions [get]
+func (ur *usersRouter) GetTransactions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := types.GetLoggerFromContext(r.Context())
+
+		id, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			log.Errorf("failed to get user id: %s", err)
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		us, err := types.GetAccountFromContext(r.Context())
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+
+		if us.ID != id && us.Role < types.Staff {
+			utils.WriteError(log, w, http.StatusForbidden, types.ErrRequestorIDNotMatching)
+			return
+		}
+
+		filter, err := parseLedgerFilter(r)
+		if err != nil {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		filter.UserID = id
+
+		page, err := ur.component.GetUserTransactions(r.Context(), filter, r.URL.Query().Get("cursor"))
+		if errors.Is(err, types.ErrInvalidCursor) {
+			utils.WriteError(log, w, http.StatusBadRequest, err)
+			return
+		}
+		if err != nil {
+			utils.WriteError(log, w, http.StatusInternalServerError, err)
+			return
+		}
+
+		utils.WriteJSON(log, w, http.StatusOK, page)
+	}
+}
+
+func parseLedgerFilter(r *http.Request) (types.LedgerFilter, error) {
+	var (
+		filter types.LedgerFilter
+		query  = r.URL.Query()
+	)
+
+	if value := query.Get("from"); value != "" {
+		from, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return types.LedgerFilter{}, fmt.Errorf("invalid from: %w", err)
+		}
+		filter.From = &from
+	}
+
+	if value := query.Get("to"); value != "" {
+		to, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return types.LedgerFilter{}, fmt.Errorf("invalid to: %w", err)
+		}
+		filter.To = &to
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return types.LedgerFilter{}, types.ErrStartAfterEndDate
+	}
+
+	if value := query.Get("type"); value != "" {
+		for _, source := range strings.Split(value, ",") {
+			source := types.LedgerSource(strings.TrimSpace(source))
+			if !source.IsValid() {
+				return types.LedgerFilter{}, fmt.Errorf("invalid type: %s", source)
+			}
+			filter.Sources = append(filter.Sources, source)
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return types.LedgerFilter{}, fmt.Errorf("invalid limit: %s", value)
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
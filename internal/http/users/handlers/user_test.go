@@ -14,9 +14,14 @@ import (
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
+func eur(amount int64) types.Money {
+	return types.NewMoney(decimal.NewFromInt(amount), types.EUR)
+}
+
 func TestRegister(t *testing.T) {
 	type fields struct {
 		userProvider *fakes.FakeUserProvider
@@ -36,14 +41,14 @@ func TestRegister(t *testing.T) {
 			name: "it should register the user",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					RegisterStub: func(ctx context.Context, u types.User) (types.User, string, error) {
+					RegisterStub: func(ctx context.Context, u types.User, referralCode string) (types.User, string, error) {
 						return types.User{
 							ID:       ID,
 							Name:     "John",
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     1,
-							Balance:  0,
+							Balance:  eur(0),
 						}, "token", nil
 					},
 				},
@@ -52,13 +57,13 @@ func TestRegister(t *testing.T) {
 				Body: `{"name":"John","password":"password","email":"john@example.com"}`,
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","token":"token"}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","referral_code":"","token":"token"}`,
 		},
 		{
 			name: "it should fail register because of email validation",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					RegisterStub: func(ctx context.Context, u types.User) (types.User, string, error) {
+					RegisterStub: func(ctx context.Context, u types.User, referralCode string) (types.User, string, error) {
 						return types.User{}, "", nil
 					},
 				},
@@ -73,7 +78,7 @@ func TestRegister(t *testing.T) {
 			name: "it should fail register because of password validation",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					RegisterStub: func(ctx context.Context, u types.User) (types.User, string, error) {
+					RegisterStub: func(ctx context.Context, u types.User, referralCode string) (types.User, string, error) {
 						return types.User{}, "", nil
 					},
 				},
@@ -88,7 +93,7 @@ func TestRegister(t *testing.T) {
 			name: "it should fail register because of name validation",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					RegisterStub: func(ctx context.Context, u types.User) (types.User, string, error) {
+					RegisterStub: func(ctx context.Context, u types.User, referralCode string) (types.User, string, error) {
 						return types.User{}, "", nil
 					},
 				},
@@ -146,7 +151,7 @@ func TestLogin(t *testing.T) {
 							Email:    "john@example.com",
 							Password: "password",
 							Role:     1,
-							Balance:  0,
+							Balance:  eur(0),
 						}, "token", nil
 					},
 				},
@@ -155,7 +160,7 @@ func TestLogin(t *testing.T) {
 				Body: `{"password":"password","email":"john@example.com"}`,
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","token":"token"}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","referral_code":"","token":"token"}`,
 		},
 		{
 			name: "it should fail login because of email validation",
@@ -229,11 +234,12 @@ func TestGetUser(t *testing.T) {
 				userProvider: &fakes.FakeUserProvider{
 					GetUserStub: func(ctx context.Context, u uuid.UUID) (types.User, error) {
 						return types.User{
-							ID:      ID,
-							Name:    "John",
-							Email:   "john@example.com",
-							Role:    1,
-							Balance: 0,
+							ID:           ID,
+							Name:         "John",
+							Email:        "john@example.com",
+							Role:         1,
+							Balance:      eur(0),
+							BonusBalance: eur(5),
 						}, nil
 					},
 				},
@@ -244,7 +250,7 @@ func TestGetUser(t *testing.T) {
 				},
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","role":1,"balance":0,"created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z","Password":""}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","role":1,"balance":{"amount":"0","currency":"EUR"},"bonus_balance":{"amount":"5","currency":"EUR"},"loyalty_points":"0","referral_code":"","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z","Password":""}`,
 		},
 		{
 			name: "it should invalid uuid format",
@@ -310,7 +316,7 @@ func TestGetUsers(t *testing.T) {
 								Name:    "John",
 								Email:   "john@example.com",
 								Role:    1,
-								Balance: 0,
+								Balance: eur(0),
 							},
 						}, nil
 					},
@@ -379,13 +385,13 @@ func TestUpdateUser(t *testing.T) {
 							Name:    "John",
 							Email:   "john@example.com",
 							Role:    2,
-							Balance: 0,
+							Balance: eur(0),
 						}, nil
 					},
 				},
 			},
 			req: test.TestRequest{
-				Body: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","role":2,"balance": 0}`,
+				Body: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","role":2,"balance":{"amount":"0","currency":"EUR"}}`,
 			},
 			expectedCode:   http.StatusOK,
 			expectedOutput: ``,
@@ -497,6 +503,8 @@ func TestUpdateUserBalance(t *testing.T) {
 
 	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
 	require.NoError(t, err)
+	otherID, err := uuid.Parse("8c3524e5-a297-42aa-85d3-faca261cbfb8")
+	require.NoError(t, err)
 
 	tests := []struct {
 		name           string
@@ -509,13 +517,13 @@ func TestUpdateUserBalance(t *testing.T) {
 			name: "it should update the user balance add",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, user types.User, value float64, transactionType types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, userID uuid.UUID, value types.Money, transactionType types.TransactionType, source types.LedgerSource, referenceID uuid.NullUUID) (types.User, error) {
 						return types.User{
 							ID:      ID,
 							Name:    "John",
 							Email:   "john@example.com",
 							Role:    2,
-							Balance: 10,
+							Balance: eur(10),
 						}, nil
 					},
 				},
@@ -526,9 +534,10 @@ func TestUpdateUserBalance(t *testing.T) {
 					Name:    "John",
 					Email:   "john@example.com",
 					Role:    2,
-					Balance: 0,
+					Balance: eur(0),
 				}),
-				Body: `{"value": 10,"transaction_type":"add"}`,
+				Vars: map[string]string{"id": ID.String()},
+				Body: `{"value": {"amount": "10", "currency": "EUR"},"transaction_type":"add"}`,
 			},
 			expectedCode:   http.StatusOK,
 			expectedOutput: ``,
@@ -537,13 +546,14 @@ func TestUpdateUserBalance(t *testing.T) {
 			name: "it should update the user balance remove",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, user types.User, value float64, transactionType types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, userID uuid.UUID, value types.Money, transactionType types.TransactionType, source types.LedgerSource, referenceID uuid.NullUUID) (types.User, error) {
 						return types.User{
-							ID:      ID,
-							Name:    "John",
-							Email:   "john@example.com",
-							Role:    2,
-							Balance: 90,
+							ID:           ID,
+							Name:         "John",
+							Email:        "john@example.com",
+							Role:         2,
+							Balance:      eur(90),
+							BonusBalance: eur(0),
 						}, nil
 					},
 				},
@@ -554,18 +564,19 @@ func TestUpdateUserBalance(t *testing.T) {
 					Name:    "John",
 					Email:   "john@example.com",
 					Role:    2,
-					Balance: 100,
+					Balance: eur(100),
 				}),
-				Body: `{"value": 10,"transaction_type":"remove"}`,
+				Vars: map[string]string{"id": ID.String()},
+				Body: `{"value": {"amount": "10", "currency": "EUR"},"transaction_type":"remove"}`,
 			},
 			expectedCode:   http.StatusOK,
-			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","role":2,"balance":90,"created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z","Password":""}`,
+			expectedOutput: `{"id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","name":"John","email":"john@example.com","role":2,"balance":{"amount":"90","currency":"EUR"},"bonus_balance":{"amount":"0","currency":"EUR"},"loyalty_points":"0","referral_code":"","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z","Password":""}`,
 		},
 		{
 			name: "it should fail update the user balance",
 			fields: fields{
 				userProvider: &fakes.FakeUserProvider{
-					UpdateUserBalanceStub: func(ctx context.Context, user types.User, value float64, transactionType types.TransactionType) (types.User, error) {
+					UpdateUserBalanceStub: func(ctx context.Context, userID uuid.UUID, value types.Money, transactionType types.TransactionType, source types.LedgerSource, referenceID uuid.NullUUID) (types.User, error) {
 						return types.User{}, types.ErrInsufficientBalance
 					},
 				},
@@ -576,13 +587,62 @@ func TestUpdateUserBalance(t *testing.T) {
 					Name:    "John",
 					Email:   "john@example.com",
 					Role:    2,
-					Balance: 0,
+					Balance: eur(0),
 				}),
-				Body: `{"value": 10,"transaction_type":"remove"}`,
+				Vars: map[string]string{"id": ID.String()},
+				Body: `{"value": {"amount": "10", "currency": "EUR"},"transaction_type":"remove"}`,
 			},
 			expectedCode:   http.StatusBadRequest,
 			expectedOutput: `"Insufficient balance"`,
 		},
+		{
+			name: "it should fail update other user balance",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{},
+			},
+			req: test.TestRequest{
+				Context: context.WithValue(context.Background(), types.CtxKeyAccount, types.User{
+					ID:   otherID,
+					Role: types.Player,
+				}),
+				Vars: map[string]string{"id": ID.String()},
+				Body: `{"value": {"amount": "10", "currency": "EUR"},"transaction_type":"add"}`,
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedOutput: `"Requestor ID is not matching path ID"`,
+		},
+		{
+			name: "it should fail adjustment without staff role",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{},
+			},
+			req: test.TestRequest{
+				Context: context.WithValue(context.Background(), types.CtxKeyAccount, types.User{
+					ID:   ID,
+					Role: types.Player,
+				}),
+				Vars: map[string]string{"id": ID.String()},
+				Body: `{"value": {"amount": "10", "currency": "EUR"},"transaction_type":"add","source":"adjustment"}`,
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedOutput: `"Balance adjustments require staff role"`,
+		},
+		{
+			name: "it should fail reference without staff role",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{},
+			},
+			req: test.TestRequest{
+				Context: context.WithValue(context.Background(), types.CtxKeyAccount, types.User{
+					ID:   ID,
+					Role: types.Player,
+				}),
+				Vars: map[string]string{"id": ID.String()},
+				Body: `{"value": {"amount": "10", "currency": "EUR"},"transaction_type":"add","reference_id":"8c3524e5-a297-42aa-85d3-faca261cbfb8"}`,
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedOutput: `"Ledger references require staff role"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -603,3 +663,204 @@ func TestUpdateUserBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileBalance(t *testing.T) {
+	type fields struct {
+		userProvider *fakes.FakeUserProvider
+	}
+
+	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		fields         fields
+		req            test.TestRequest
+		expectedCode   int
+		expectedOutput string
+	}{
+		{
+			name: "it should reconcile the user balance",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{
+					ReconcileUserBalanceStub: func(ctx context.Context, u uuid.UUID) (types.BalanceReconciliation, error) {
+						return types.BalanceReconciliation{
+							UserID:             ID,
+							Balance:            eur(20),
+							LedgerBalance:      eur(10),
+							Difference:         eur(10),
+							BonusBalance:       eur(5),
+							LedgerBonusBalance: eur(5),
+							BonusDifference:    eur(0),
+						}, nil
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars: map[string]string{"id": ID.String()},
+			},
+			expectedCode:   http.StatusOK,
+			expectedOutput: `{"user_id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","balance":{"amount":"20","currency":"EUR"},"ledger_balance":{"amount":"10","currency":"EUR"},"difference":{"amount":"10","currency":"EUR"},"bonus_balance":{"amount":"5","currency":"EUR"},"ledger_bonus_balance":{"amount":"5","currency":"EUR"},"bonus_difference":{"amount":"0","currency":"EUR"}}`,
+		},
+		{
+			name: "it should fail not found",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{
+					ReconcileUserBalanceStub: func(ctx context.Context, u uuid.UUID) (types.BalanceReconciliation, error) {
+						return types.BalanceReconciliation{}, pgx.ErrNoRows
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars: map[string]string{"id": ID.String()},
+			},
+			expectedCode:   http.StatusNotFound,
+			expectedOutput: `"no rows in result set"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := handlers.NewAccountsRouter(tt.fields.userProvider)
+			w := httptest.NewRecorder()
+			r, err := tt.req.GetRequest(http.MethodGet)
+			require.NoError(t, err)
+			router.ReconcileBalance().ServeHTTP(w, r)
+
+			resp := w.Result()
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedCode, resp.StatusCode)
+			require.Regexp(t, regexp.MustCompile(tt.expectedOutput), string(respBody))
+		})
+	}
+}
+
+func TestGetTransactions(t *testing.T) {
+	type fields struct {
+		userProvider *fakes.FakeUserProvider
+	}
+
+	ID, err := uuid.Parse("460aec7e-7d58-42fd-93b8-bca05a77bbf5")
+	require.NoError(t, err)
+
+	entryID, err := uuid.Parse("0b7e7d4c-6f0e-4d57-9a3a-3f1f4f0c1a01")
+	require.NoError(t, err)
+
+	owner := context.WithValue(context.Background(), types.CtxKeyAccount, types.User{
+		ID:   ID,
+		Name: "John",
+		Role: types.Player,
+	})
+
+	tests := []struct {
+		name           string
+		fields         fields
+		req            test.TestRequest
+		expectedCode   int
+		expectedOutput string
+	}{
+		{
+			name: "it should return user transactions",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{
+					GetUserTransactionsStub: func(ctx context.Context, f types.LedgerFilter, c string) (types.LedgerPage, error) {
+						require.Equal(t, ID, f.UserID)
+						require.Equal(t, []types.LedgerSource{types.LedgerSourcePromotionClaim, types.LedgerSourceManual}, f.Sources)
+						require.Equal(t, 10, f.Limit)
+						require.NotNil(t, f.From)
+						require.Nil(t, f.To)
+						require.Equal(t, "abc", c)
+						return types.LedgerPage{
+							Entries: []types.LedgerEntry{
+								{
+									ID:            entryID,
+									UserID:        ID,
+									DebitAccount:  types.LedgerAccountCashier,
+									CreditAccount: types.LedgerAccountPlayerCash,
+									Amount:        eur(10),
+									Source:        types.LedgerSourceManual,
+								},
+							},
+							NextCursor: "next",
+						}, nil
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars:    map[string]string{"id": ID.String()},
+				Context: owner,
+				UrlParams: map[string]string{
+					"from":   "2025-01-01T00:00:00Z",
+					"type":   "promotion_claim,manual",
+					"limit":  "10",
+					"cursor": "abc",
+				},
+			},
+			expectedCode:   http.StatusOK,
+			expectedOutput: `{"entries":\[{"id":"0b7e7d4c-6f0e-4d57-9a3a-3f1f4f0c1a01","user_id":"460aec7e-7d58-42fd-93b8-bca05a77bbf5","debit_account":"cashier","credit_account":"player_cash","amount":{"amount":"10","currency":"EUR"},"source":"manual",.*}\],"next_cursor":"next"}`,
+		},
+		{
+			name: "it should fail forbidden for another user",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{},
+			},
+			req: test.TestRequest{
+				Vars:    map[string]string{"id": uuid.NewString()},
+				Context: owner,
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedOutput: `"Requestor ID is not matching path ID"`,
+		},
+		{
+			name: "it should fail invalid type",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{},
+			},
+			req: test.TestRequest{
+				Vars:      map[string]string{"id": ID.String()},
+				Context:   owner,
+				UrlParams: map[string]string{"type": "bonus"},
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedOutput: `"invalid type: bonus"`,
+		},
+		{
+			name: "it should fail invalid cursor",
+			fields: fields{
+				userProvider: &fakes.FakeUserProvider{
+					GetUserTransactionsStub: func(ctx context.Context, f types.LedgerFilter, c string) (types.LedgerPage, error) {
+						return types.LedgerPage{}, types.ErrInvalidCursor
+					},
+				},
+			},
+			req: test.TestRequest{
+				Vars:      map[string]string{"id": ID.String()},
+				Context:   owner,
+				UrlParams: map[string]string{"cursor": "abc"},
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedOutput: `"Invalid cursor"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := handlers.NewAccountsRouter(tt.fields.userProvider)
+			w := httptest.NewRecorder()
+			r, err := tt.req.GetRequest(http.MethodGet)
+			require.NoError(t, err)
+			router.GetTransactions().ServeHTTP(w, r)
+
+			resp := w.Result()
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.expectedCode, resp.StatusCode)
+			require.Regexp(t, regexp.MustCompile(tt.expectedOutput), string(respBody))
+		})
+	}
+}
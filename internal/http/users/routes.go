@@ -3,6 +3,7 @@ package users
 import (
 	"net/http"
 
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/idempotency"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/component/users"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/http/middlewares"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/http/users/handlers"
@@ -31,7 +32,10 @@ func (s *server) routes() http.Handler {
 
 	usersComponent := users.New(s.Resource.DB, s.Resource.PubSub, []byte(s.Resource.Config.JWTKey), s.Resource.Config.JWTDuration)
 
+	idempotencyComponent := idempotency.New(s.Resource.DB)
+
 	authMiddleware := middlewares.AuthMiddleware(usersComponent)
+	idempotencyMiddleware := middlewares.IdempotencyMiddleware(idempotencyComponent)
 
 	usersRouter := handlers.NewAccountsRouter(usersComponent)
 
@@ -44,8 +48,13 @@ func (s *server) routes() http.Handler {
 				r.Get("/", usersRouter.GetUsers())
 				r.Get("/{id}", usersRouter.GetUser())
 				r.Put("/{id}", usersRouter.UpdateUser())
-				r.Put("/{id}/balance", usersRouter.UpdateBalance())
-				r.With(middlewares.RequiredRole(types.Staff)).Delete("/{id}", usersRouter.DeleteUser())
+				r.With(idempotencyMiddleware).Put("/{id}/balance", usersRouter.UpdateBalance())
+				r.Get("/{id}/transactions", usersRouter.GetTransactions())
+				r.With(middlewares.RequiredRole(types.Staff)).Group(func(r chi.Router) {
+					r.Delete("/{id}", usersRouter.DeleteUser())
+					r.Get("/{id}/balance/reconciliation", usersRouter.ReconcileBalance())
+					r.Post("/{id}/balance/rebuild", usersRouter.RebuildBalance())
+				})
 			})
 		})
 	})
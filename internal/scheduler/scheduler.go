@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"go.uber.org/zap"
+)
+
+// Job is run every Interval until the scheduler is stopped. Every service
+// replica runs its own scheduler, so Run has to be safe to execute
+// concurrently with itself.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	log    *zap.SugaredLogger
+	jobs   []Job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(log *zap.SugaredLogger, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		log:  log,
+		jobs: jobs,
+	}
+}
+
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			s.log.Infof("job %s is disabled", job.Name)
+			continue
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.run(ctx, job)
+		}()
+	}
+}
+
+// Stop cancels running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	if s == nil || s.cancel == nil {
+		return
+	}
+
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	log := s.log.With("job", job.Name)
+	ctx = context.WithValue(ctx, types.CtxKeyLogger, log)
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := job.Run(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Errorf("job failed: %s", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package scheduler_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/scheduler"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestScheduler(t *testing.T) {
+	var runs atomic.Int32
+
+	s := scheduler.New(zap.NewNop().Sugar(),
+		scheduler.Job{
+			Name:     "counter",
+			Interval: time.Millisecond,
+			Run: func(ctx context.Context) error {
+				runs.Add(1)
+				return nil
+			},
+		},
+		scheduler.Job{
+			Name: "disabled",
+			Run: func(ctx context.Context) error {
+				t.Fatal("disabled job should not run")
+				return nil
+			},
+		},
+	)
+
+	s.Start(context.Background())
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+	s.Stop()
+
+	stopped := runs.Load()
+	time.Sleep(5 * time.Millisecond)
+	require.Equal(t, stopped, runs.Load())
+}
@@ -0,0 +1,165 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+)
+
+// GetNetLosses returns the players who lost cash in currency between from
+// and to, with what they wagered and what bets returned as wins or
+// rollbacks. Bonus funds are not counted.
+func (q *Queries) GetNetLosses(ctx context.Context, currency types.Currency, from time.Time, to time.Time) ([]types.CashbackCalculation, error) {
+	var (
+		losses []types.CashbackCalculation
+		query  = `
+		SELECT
+			user_id,
+			COALESCE(SUM(cash_amount) FILTER (WHERE type = $4), 0) AS wagered,
+			COALESCE(SUM(cash_amount) FILTER (WHERE type <> $4), 0) AS returned
+		FROM game_events
+		WHERE currency = $1 AND created >= $2 AND created < $3
+		GROUP BY user_id
+		HAVING COALESCE(SUM(cash_amount) FILTER (WHERE type = $4), 0) >
+			COALESCE(SUM(cash_amount) FILTER (WHERE type <> $4), 0)
+		ORDER BY user_id`
+	)
+
+	rows, err := q.db.Query(ctx, query, currency, from, to, types.GameEventBet)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		loss := types.CashbackCalculation{
+			PeriodStart: from,
+			PeriodEnd:   to,
+		}
+		err := rows.Scan(
+			&loss.UserID,
+			&loss.Wagered.Amount,
+			&loss.Returned.Amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		loss.Wagered.Currency = currency
+		loss.Returned.Currency = currency
+		loss.NetLoss = types.NewMoney(loss.Wagered.Amount.Sub(loss.Returned.Amount), currency)
+
+		losses = append(losses, loss)
+	}
+
+	return losses, rows.Err()
+}
+
+// CashbackCalculationCreate stores the calculation and reports whether it was
+// stored by this call. A player gets the cashback of a promotion once per
+// period.
+func (q *Queries) CashbackCalculationCreate(ctx context.Context, calculation types.CashbackCalculation) (bool, error) {
+	query := `
+		INSERT INTO cashback_calculations (
+			id,
+			promotion_id,
+			user_id,
+			user_promotion_id,
+			period_start,
+			period_end,
+			wagered,
+			returned,
+			net_loss,
+			percentage,
+			max_amount,
+			amount,
+			currency,
+			created
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (promotion_id, user_id, period_start) DO NOTHING`
+
+	tag, err := q.db.Exec(ctx, query,
+		calculation.ID,
+		calculation.PromotionID,
+		calculation.UserID,
+		calculation.UserPromotionID,
+		calculation.PeriodStart,
+		calculation.PeriodEnd,
+		calculation.Wagered.Amount,
+		calculation.Returned.Amount,
+		calculation.NetLoss.Amount,
+		calculation.Percentage,
+		calculation.MaxAmount,
+		calculation.Amount.Amount,
+		calculation.Amount.Currency,
+		calculation.Created,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (q *Queries) GetCashbackCalculations(ctx context.Context, userID uuid.UUID) ([]types.CashbackCalculation, error) {
+	var (
+		calculations []types.CashbackCalculation
+		query        = `
+		SELECT
+			id,
+			promotion_id,
+			user_id,
+			user_promotion_id,
+			period_start,
+			period_end,
+			wagered,
+			returned,
+			net_loss,
+			percentage,
+			max_amount,
+			amount,
+			currency,
+			created
+		FROM cashback_calculations
+		WHERE user_id = $1
+		ORDER BY period_start DESC, created DESC`
+	)
+
+	rows, err := q.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var calculation types.CashbackCalculation
+		err := rows.Scan(
+			&calculation.ID,
+			&calculation.PromotionID,
+			&calculation.UserID,
+			&calculation.UserPromotionID,
+			&calculation.PeriodStart,
+			&calculation.PeriodEnd,
+			&calculation.Wagered.Amount,
+			&calculation.Returned.Amount,
+			&calculation.NetLoss.Amount,
+			&calculation.Percentage,
+			&calculation.MaxAmount,
+			&calculation.Amount.Amount,
+			&calculation.Amount.Currency,
+			&calculation.Created,
+		)
+		if err != nil {
+			return nil, err
+		}
+		calculation.Wagered.Currency = calculation.Amount.Currency
+		calculation.Returned.Currency = calculation.Amount.Currency
+		calculation.NetLoss.Currency = calculation.Amount.Currency
+
+		calculations = append(calculations, calculation)
+	}
+
+	return calculations, rows.Err()
+}
@@ -0,0 +1,343 @@
+package postgresdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (q *Queries) CatalogItemCreate(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error) {
+	query := `
+		INSERT INTO catalog_items (
+			id,
+			title,
+			description,
+			type,
+			points_price,
+			stock,
+			bonus_amount,
+			currency,
+			promotion_id,
+			validity_days,
+			is_active
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING created, updated`
+
+	err := q.db.QueryRow(ctx, query,
+		item.ID,
+		item.Title,
+		item.Description,
+		item.Type,
+		item.PointsPrice,
+		item.Stock,
+		item.BonusAmount.Amount,
+		item.BonusAmount.Currency,
+		item.PromotionID,
+		item.ValidityDays,
+		item.IsActive,
+	).Scan(
+		&item.Created,
+		&item.Updated,
+	)
+
+	return item, err
+}
+
+func (q *Queries) CatalogItemGetByID(ctx context.Context, id uuid.UUID) (types.CatalogItem, error) {
+	var (
+		item  types.CatalogItem
+		query = `
+		SELECT
+			id,
+			title,
+			COALESCE(description, ''),
+			type,
+			points_price,
+			stock,
+			bonus_amount,
+			currency,
+			promotion_id,
+			validity_days,
+			is_active,
+			created,
+			updated
+		FROM catalog_items
+		WHERE id = $1`
+	)
+
+	err := q.db.QueryRow(ctx, query, id).Scan(
+		&item.ID,
+		&item.Title,
+		&item.Description,
+		&item.Type,
+		&item.PointsPrice,
+		&item.Stock,
+		&item.BonusAmount.Amount,
+		&item.BonusAmount.Currency,
+		&item.PromotionID,
+		&item.ValidityDays,
+		&item.IsActive,
+		&item.Created,
+		&item.Updated,
+	)
+
+	return item, err
+}
+
+func (q *Queries) GetCatalogItems(ctx context.Context, activeOnly bool) ([]types.CatalogItem, error) {
+	var (
+		items []types.CatalogItem
+		query = `
+		SELECT
+			id,
+			title,
+			COALESCE(description, ''),
+			type,
+			points_price,
+			stock,
+			bonus_amount,
+			currency,
+			promotion_id,
+			validity_days,
+			is_active,
+			created,
+			updated
+		FROM catalog_items
+		WHERE $1 = FALSE OR is_active
+		ORDER BY points_price`
+	)
+
+	rows, err := q.db.Query(ctx, query, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item types.CatalogItem
+		err := rows.Scan(
+			&item.ID,
+			&item.Title,
+			&item.Description,
+			&item.Type,
+			&item.PointsPrice,
+			&item.Stock,
+			&item.BonusAmount.Amount,
+			&item.BonusAmount.Currency,
+			&item.PromotionID,
+			&item.ValidityDays,
+			&item.IsActive,
+			&item.Created,
+			&item.Updated,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+func (q *Queries) CatalogItemUpdate(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error) {
+	query := `
+		UPDATE catalog_items SET
+			title = $1,
+			description = $2,
+			type = $3,
+			points_price = $4,
+			stock = $5,
+			bonus_amount = $6,
+			currency = $7,
+			promotion_id = $8,
+			validity_days = $9,
+			is_active = $10
+		WHERE id = $11
+		RETURNING created, updated`
+
+	err := q.db.QueryRow(ctx, query,
+		item.Title,
+		item.Description,
+		item.Type,
+		item.PointsPrice,
+		item.Stock,
+		item.BonusAmount.Amount,
+		item.BonusAmount.Currency,
+		item.PromotionID,
+		item.ValidityDays,
+		item.IsActive,
+		item.ID,
+	).Scan(
+		&item.Created,
+		&item.Updated,
+	)
+
+	return item, err
+}
+
+func (q *Queries) CatalogItemDelete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM catalog_items WHERE id = $1`
+
+	res, err := q.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// CatalogItemReserve takes one unit of the item from stock. It returns
+// pgx.ErrNoRows when the item has no stock left.
+func (q *Queries) CatalogItemReserve(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE catalog_items
+			SET stock = stock - 1
+			WHERE id = $1 AND (stock IS NULL OR stock > 0)`
+
+	res, err := q.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (q *Queries) RedemptionCreate(ctx context.Context, redemption types.Redemption) (types.Redemption, error) {
+	query := `
+		INSERT INTO redemptions (
+			id,
+			user_id,
+			catalog_item_id,
+			points,
+			status,
+			user_promotion_id,
+			created,
+			fulfilled
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := q.db.Exec(ctx, query,
+		redemption.ID,
+		redemption.UserID,
+		redemption.CatalogItemID,
+		redemption.Points,
+		redemption.Status,
+		redemption.UserPromotionID,
+		redemption.Created,
+		redemption.Fulfilled,
+	)
+
+	return redemption, err
+}
+
+func (q *Queries) GetRedemptions(ctx context.Context, filter types.RedemptionFilter) ([]types.Redemption, error) {
+	var (
+		redemptions []types.Redemption
+		whereClause = []string{"TRUE"}
+		args        []any
+
+		query = `
+		SELECT
+			id,
+			user_id,
+			catalog_item_id,
+			points,
+			status,
+			user_promotion_id,
+			created,
+			fulfilled
+		FROM redemptions
+		WHERE
+			%s
+		ORDER BY created DESC`
+	)
+
+	if filter.UserID.Valid {
+		whereClause = append(whereClause, fmt.Sprintf("user_id = $%d", len(args)+1))
+		args = append(args, filter.UserID)
+	}
+
+	if filter.Status != nil {
+		whereClause = append(whereClause, fmt.Sprintf("status = $%d", len(args)+1))
+		args = append(args, *filter.Status)
+	}
+
+	query = fmt.Sprintf(query, strings.Join(whereClause, " AND "))
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var redemption types.Redemption
+		err := rows.Scan(
+			&redemption.ID,
+			&redemption.UserID,
+			&redemption.CatalogItemID,
+			&redemption.Points,
+			&redemption.Status,
+			&redemption.UserPromotionID,
+			&redemption.Created,
+			&redemption.Fulfilled,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		redemptions = append(redemptions, redemption)
+	}
+
+	return redemptions, rows.Err()
+}
+
+// RedemptionFulfil marks a pending redemption as fulfilled. It returns
+// pgx.ErrNoRows when there is no pending redemption with the id.
+func (q *Queries) RedemptionFulfil(ctx context.Context, id uuid.UUID) (types.Redemption, error) {
+	var (
+		redemption types.Redemption
+		query      = `
+		UPDATE redemptions
+			SET status = $2,
+				fulfilled = NOW()
+			WHERE id = $1 AND status = $3
+			RETURNING
+				id,
+				user_id,
+				catalog_item_id,
+				points,
+				status,
+				user_promotion_id,
+				created,
+				fulfilled`
+	)
+
+	err := q.db.QueryRow(ctx, query, id, types.RedemptionFulfilled, types.RedemptionPending).Scan(
+		&redemption.ID,
+		&redemption.UserID,
+		&redemption.CatalogItemID,
+		&redemption.Points,
+		&redemption.Status,
+		&redemption.UserPromotionID,
+		&redemption.Created,
+		&redemption.Fulfilled,
+	)
+
+	return redemption, err
+}
@@ -0,0 +1,192 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const freeSpinsColumns = `
+			id,
+			user_promotion_id,
+			user_id,
+			game_id,
+			spins,
+			remaining,
+			stake,
+			winnings,
+			currency,
+			expires,
+			settled,
+			created,
+			updated`
+
+func (q *Queries) FreeSpinsCreate(ctx context.Context, freeSpins types.FreeSpinsEntitlement) error {
+	query := `
+		INSERT INTO free_spins (
+			id,
+			user_promotion_id,
+			user_id,
+			game_id,
+			spins,
+			remaining,
+			stake,
+			currency,
+			expires
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := q.db.Exec(ctx, query,
+		freeSpins.ID,
+		freeSpins.UserPromotionID,
+		freeSpins.UserID,
+		freeSpins.GameID,
+		freeSpins.Spins,
+		freeSpins.Remaining,
+		freeSpins.Stake.Amount,
+		freeSpins.Stake.Currency,
+		freeSpins.Expires,
+	)
+
+	return err
+}
+
+func (q *Queries) FreeSpinsGetByID(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error) {
+	query := `SELECT ` + freeSpinsColumns + `
+		FROM free_spins
+		WHERE id = $1`
+
+	return scanFreeSpins(q.db.QueryRow(ctx, query, id))
+}
+
+// GetActiveFreeSpins returns the user's free spins that can still be played,
+// of gameID when it is set.
+func (q *Queries) GetActiveFreeSpins(ctx context.Context, userID uuid.UUID, gameID string) ([]types.FreeSpinsEntitlement, error) {
+	query := `SELECT ` + freeSpinsColumns + `
+		FROM free_spins
+		WHERE user_id = $1
+			AND ($2 = '' OR game_id = $2)
+			AND settled IS NULL
+			AND remaining > 0
+			AND expires > now()
+		ORDER BY expires`
+
+	return q.queryFreeSpins(ctx, query, userID, gameID)
+}
+
+// GetExpiredFreeSpins returns free spins that expired before they were used
+// up and still have to be settled.
+func (q *Queries) GetExpiredFreeSpins(ctx context.Context, before time.Time, limit int) ([]types.FreeSpinsEntitlement, error) {
+	query := `SELECT ` + freeSpinsColumns + `
+		FROM free_spins
+		WHERE settled IS NULL
+			AND expires <= $1
+		ORDER BY expires
+		LIMIT $2`
+
+	return q.queryFreeSpins(ctx, query, before, limit)
+}
+
+func (q *Queries) queryFreeSpins(ctx context.Context, query string, args ...any) ([]types.FreeSpinsEntitlement, error) {
+	var freeSpins []types.FreeSpinsEntitlement
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		fs, err := scanFreeSpins(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		freeSpins = append(freeSpins, fs)
+	}
+
+	return freeSpins, rows.Err()
+}
+
+// FreeSpinRoundCreate records a round played with the free spins and reports
+// whether it was recorded by this call.
+func (q *Queries) FreeSpinRoundCreate(ctx context.Context, freeSpinsID uuid.UUID, spin types.FreeSpin) (bool, error) {
+	query := `
+		INSERT INTO free_spin_rounds (
+			free_spins_id,
+			round_id,
+			win
+		) VALUES ($1, $2, $3)
+		ON CONFLICT (free_spins_id, round_id) DO NOTHING`
+
+	tag, err := q.db.Exec(ctx, query, freeSpinsID, spin.RoundID, spin.Win.Amount)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// FreeSpinsConsume uses one spin and adds win to the winnings. Free spins
+// that are used up, expired or settled are not changed.
+func (q *Queries) FreeSpinsConsume(ctx context.Context, id uuid.UUID, win types.Money) (types.FreeSpinsEntitlement, error) {
+	query := `
+		UPDATE free_spins SET
+			remaining = remaining - 1,
+			winnings = winnings + $2
+		WHERE id = $1
+			AND currency = $3
+			AND settled IS NULL
+			AND remaining > 0
+			AND expires > now()
+		RETURNING ` + freeSpinsColumns
+
+	return scanFreeSpins(q.db.QueryRow(ctx, query, id, win.Amount, win.Currency))
+}
+
+// FreeSpinsSettle closes the free spins and returns their final winnings.
+func (q *Queries) FreeSpinsSettle(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error) {
+	query := `
+		UPDATE free_spins SET settled = now()
+		WHERE id = $1 AND settled IS NULL
+		RETURNING ` + freeSpinsColumns
+
+	return scanFreeSpins(q.db.QueryRow(ctx, query, id))
+}
+
+// FreeSpinsVoid closes the unsettled free spins of the user promotion
+// without paying out their winnings.
+func (q *Queries) FreeSpinsVoid(ctx context.Context, userPromotionID uuid.UUID) error {
+	query := `
+		UPDATE free_spins SET settled = now()
+		WHERE user_promotion_id = $1 AND settled IS NULL`
+
+	_, err := q.db.Exec(ctx, query, userPromotionID)
+
+	return err
+}
+
+func scanFreeSpins(row pgx.Row) (types.FreeSpinsEntitlement, error) {
+	var freeSpins types.FreeSpinsEntitlement
+	err := row.Scan(
+		&freeSpins.ID,
+		&freeSpins.UserPromotionID,
+		&freeSpins.UserID,
+		&freeSpins.GameID,
+		&freeSpins.Spins,
+		&freeSpins.Remaining,
+		&freeSpins.Stake.Amount,
+		&freeSpins.Winnings.Amount,
+		&freeSpins.Stake.Currency,
+		&freeSpins.Expires,
+		&freeSpins.Settled,
+		&freeSpins.Created,
+		&freeSpins.Updated,
+	)
+	freeSpins.Winnings.Currency = freeSpins.Stake.Currency
+
+	return freeSpins, err
+}
@@ -0,0 +1,107 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+)
+
+// GameEventCreate stores the event and reports whether it was stored. An
+// event repeating the event ID of a stored event of the same game is not
+// stored. A second bet or rollback of a round fails with a unique violation.
+func (q *Queries) GameEventCreate(ctx context.Context, event types.GameEvent) (bool, error) {
+	query := `
+		INSERT INTO game_events (
+			id,
+			event_id,
+			user_id,
+			game_id,
+			game_category,
+			round_id,
+			type,
+			amount,
+			currency,
+			cash_amount,
+			bonus_amount,
+			created
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (game_id, event_id) DO NOTHING`
+
+	res, err := q.db.Exec(ctx, query,
+		event.ID,
+		event.EventID,
+		event.UserID,
+		event.GameID,
+		event.GameCategory,
+		event.RoundID,
+		event.Type,
+		event.Amount.Amount,
+		event.Amount.Currency,
+		event.CashAmount,
+		event.BonusAmount,
+		event.Created,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() == 1, nil
+}
+
+// GameEventGet returns the first event of the given type in the round of
+// the game.
+func (q *Queries) GameEventGet(ctx context.Context, gameID string, roundID string, eventType types.GameEventType) (types.GameEvent, error) {
+	query := `
+		WHERE game_id = $1 AND round_id = $2 AND type = $3
+		ORDER BY created, id
+		LIMIT 1`
+
+	return q.gameEventGet(ctx, query, gameID, roundID, eventType)
+}
+
+// GameEventGetByEventID returns the event the game server reported with
+// eventID.
+func (q *Queries) GameEventGetByEventID(ctx context.Context, gameID string, eventID string) (types.GameEvent, error) {
+	query := `
+		WHERE game_id = $1 AND event_id = $2`
+
+	return q.gameEventGet(ctx, query, gameID, eventID)
+}
+
+func (q *Queries) gameEventGet(ctx context.Context, where string, args ...any) (types.GameEvent, error) {
+	var (
+		event types.GameEvent
+		query = `
+		SELECT
+			id,
+			event_id,
+			user_id,
+			game_id,
+			game_category,
+			round_id,
+			type,
+			amount,
+			currency,
+			cash_amount,
+			bonus_amount,
+			created
+		FROM game_events` + where
+	)
+
+	err := q.db.QueryRow(ctx, query, args...).Scan(
+		&event.ID,
+		&event.EventID,
+		&event.UserID,
+		&event.GameID,
+		&event.GameCategory,
+		&event.RoundID,
+		&event.Type,
+		&event.Amount.Amount,
+		&event.Amount.Currency,
+		&event.CashAmount,
+		&event.BonusAmount,
+		&event.Created,
+	)
+
+	return event, err
+}
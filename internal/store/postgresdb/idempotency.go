@@ -0,0 +1,93 @@
+package postgresdb
+
+import (
+	"context"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyCreate reserves the key and reports whether it was reserved
+// by this call. Keys older than a day are released and can be reserved again,
+// as are keys whose request is still in progress after a minute, which was
+// abandoned by a process that stopped while handling it.
+func (q *Queries) IdempotencyKeyCreate(ctx context.Context, key types.IdempotencyKey) (bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (
+			user_id,
+			key,
+			request_hash,
+			created
+		) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			response = NULL,
+			created = EXCLUDED.created
+		WHERE idempotency_keys.created < EXCLUDED.created - INTERVAL '24 hours'
+			OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created < EXCLUDED.created - INTERVAL '1 minute')`
+
+	tag, err := q.db.Exec(ctx, query,
+		key.UserID,
+		key.Key,
+		key.RequestHash,
+		key.Created,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (q *Queries) IdempotencyKeyGet(ctx context.Context, userID uuid.UUID, key string) (types.IdempotencyKey, error) {
+	var (
+		idempotencyKey types.IdempotencyKey
+		query          = `
+		SELECT
+			user_id,
+			key,
+			request_hash,
+			COALESCE(status_code, 0),
+			response,
+			created
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2`
+	)
+
+	err := q.db.QueryRow(ctx, query, userID, key).Scan(
+		&idempotencyKey.UserID,
+		&idempotencyKey.Key,
+		&idempotencyKey.RequestHash,
+		&idempotencyKey.StatusCode,
+		&idempotencyKey.Response,
+		&idempotencyKey.Created,
+	)
+
+	return idempotencyKey, err
+}
+
+func (q *Queries) IdempotencyKeyComplete(ctx context.Context, key types.IdempotencyKey) error {
+	query := `
+		UPDATE idempotency_keys SET
+			status_code = $3,
+			response = $4
+		WHERE user_id = $1 AND key = $2`
+
+	_, err := q.db.Exec(ctx, query,
+		key.UserID,
+		key.Key,
+		key.StatusCode,
+		key.Response,
+	)
+
+	return err
+}
+
+func (q *Queries) IdempotencyKeyDelete(ctx context.Context, userID uuid.UUID, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2`
+
+	_, err := q.db.Exec(ctx, query, userID, key)
+
+	return err
+}
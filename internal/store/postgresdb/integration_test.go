@@ -141,9 +141,7 @@ func initDB() {
 
 func truncate() {
 	q := `
-		DELETE FROM users;
-		DELETE FROM promotions;
-		DELETE FROM users_promotions;
+		TRUNCATE users, promotions, users_promotions, ledger_entries, idempotency_keys, game_events, points_rates, points_entries, points_lots, tiers, tier_history, catalog_items, redemptions, tournaments, tournament_results, referrals, cashback_calculations, free_spins, free_spin_rounds, promotion_codes, promotion_code_redemptions, promotion_state_changes, user_promotion_revocations, user_promotion_reminders, welcome_packages, user_welcome_packages, user_welcome_steps;
 	`
 	_, err := testDB.Exec(context.Background(), q)
 	if err != nil {
@@ -0,0 +1,263 @@
+package postgresdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+)
+
+func (q *Queries) UserBalanceReconcile(ctx context.Context, userID uuid.UUID) (types.BalanceReconciliation, error) {
+	var (
+		reconciliation types.BalanceReconciliation
+		query          = `
+		SELECT
+			id,
+			balance,
+			ledger_balance(id, $2),
+			bonus_balance,
+			ledger_balance(id, $3),
+			currency
+		FROM users
+		WHERE id = $1`
+	)
+
+	err := q.db.QueryRow(ctx, query, userID, types.LedgerAccountPlayerCash, types.LedgerAccountPlayerBonus).Scan(
+		&reconciliation.UserID,
+		&reconciliation.Balance.Amount,
+		&reconciliation.LedgerBalance.Amount,
+		&reconciliation.BonusBalance.Amount,
+		&reconciliation.LedgerBonusBalance.Amount,
+		&reconciliation.Balance.Currency,
+	)
+	if err != nil {
+		return reconciliation, err
+	}
+
+	reconciliation.LedgerBalance.Currency = reconciliation.Balance.Currency
+	reconciliation.BonusBalance.Currency = reconciliation.Balance.Currency
+	reconciliation.LedgerBonusBalance.Currency = reconciliation.Balance.Currency
+
+	reconciliation.Difference, err = reconciliation.Balance.Sub(reconciliation.LedgerBalance)
+	if err != nil {
+		return reconciliation, err
+	}
+
+	reconciliation.BonusDifference, err = reconciliation.BonusBalance.Sub(reconciliation.LedgerBonusBalance)
+
+	return reconciliation, err
+}
+
+func (q *Queries) UserBalanceRebuild(ctx context.Context, userID uuid.UUID) (types.User, error) {
+	var (
+		user  types.User
+		query = `
+		UPDATE users
+			SET balance = ledger_balance(id, $2),
+				bonus_balance = ledger_balance(id, $3)
+			WHERE id = $1
+			RETURNING
+				id,
+				email,
+				name,
+				role,
+				balance,
+				bonus_balance,
+				loyalty_points,
+				referral_code,
+				currency,
+				created,
+				updated`
+	)
+
+	err := q.db.QueryRow(ctx, query, userID, types.LedgerAccountPlayerCash, types.LedgerAccountPlayerBonus).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Name,
+		&user.Role,
+		&user.Balance.Amount,
+		&user.BonusBalance.Amount,
+		&user.LoyaltyPoints,
+		&user.ReferralCode,
+		&user.Balance.Currency,
+		&user.Created,
+		&user.Updated,
+	)
+	user.BonusBalance.Currency = user.Balance.Currency
+
+	return user, err
+}
+
+func (q *Queries) LedgerEntriesGet(ctx context.Context, filter types.LedgerFilter) ([]types.LedgerEntry, error) {
+	var (
+		entries     []types.LedgerEntry
+		whereClause = []string{"l.user_id = $1"}
+		args        = []any{filter.UserID, types.UserPromotionLedgerSources()}
+
+		query = `
+		SELECT
+			l.id,
+			l.user_id,
+			l.debit_account,
+			l.credit_account,
+			l.amount,
+			l.currency,
+			l.source,
+			l.reference_id,
+			l.created,
+			CASE WHEN up.id IS NULL THEN NULL ELSE json_build_object(
+				'id', up.id,
+				'user_id', up.user_id,
+				'promotion_id', up.promotion_id,
+				'status', up.status,
+				'claimed', up.claimed,
+				'bonus_amount', json_build_object('amount', up.bonus_amount, 'currency', p.currency),
+				'wagering_required', json_build_object('amount', up.wagering_required, 'currency', p.currency),
+				'wagered', json_build_object('amount', up.wagered, 'currency', p.currency),
+				'converted', up.converted,
+				'forfeited', up.forfeited,
+				'deposit_id', up.deposit_id,
+				'start_date', up.start_date,
+				'end_date', up.end_date,
+				'created', up.created,
+				'updated', up.updated,
+				'promotion', json_build_object(
+					'id', p.id,
+					'title', p.title,
+					'description', p.description,
+					'amount', json_build_object('amount', p.amount, 'currency', p.currency),
+					'is_active', p.is_active,
+					'type', p.type,
+					'wagering_multiplier', p.wagering_multiplier,
+					'cashback', p.cashback,
+					'match_bonus', p.match_bonus,
+					'free_spins', p.free_spins,
+					'eligibility', p.eligibility,
+					'available_from', p.available_from,
+					'available_until', p.available_until,
+					'archived', p.archived,
+					'created', p.created,
+					'updated', p.updated
+				)
+			) END AS user_promotion
+		FROM ledger_entries l
+		LEFT JOIN users_promotions up ON up.id = l.reference_id
+			AND up.user_id = l.user_id
+			AND l.source = ANY($2)
+		LEFT JOIN promotions p ON p.id = up.promotion_id
+		WHERE
+			%s
+		ORDER BY l.created DESC, l.id DESC
+		LIMIT %d`
+	)
+
+	if filter.From != nil {
+		whereClause = append(whereClause, fmt.Sprintf("l.created >= $%d", len(args)+1))
+		args = append(args, filter.From)
+	}
+
+	if filter.To != nil {
+		whereClause = append(whereClause, fmt.Sprintf("l.created < $%d", len(args)+1))
+		args = append(args, filter.To)
+	}
+
+	if len(filter.Sources) > 0 {
+		sources := make([]string, 0, len(filter.Sources))
+		for _, source := range filter.Sources {
+			sources = append(sources, string(source))
+		}
+		whereClause = append(whereClause, fmt.Sprintf("l.source = ANY($%d)", len(args)+1))
+		args = append(args, sources)
+	}
+
+	if filter.After != nil {
+		whereClause = append(whereClause, fmt.Sprintf("(l.created, l.id) < ($%d, $%d)", len(args)+1, len(args)+2))
+		args = append(args, filter.After.Created, filter.After.ID)
+	}
+
+	query = fmt.Sprintf(query, strings.Join(whereClause, " AND "), filter.Limit)
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry types.LedgerEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.UserID,
+			&entry.DebitAccount,
+			&entry.CreditAccount,
+			&entry.Amount.Amount,
+			&entry.Amount.Currency,
+			&entry.Source,
+			&entry.ReferenceID,
+			&entry.Created,
+			&entry.UserPromotion,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// GetUnmatchedDeposit returns the user's latest deposit since since of at
+// least minimum that no match bonus was claimed with yet.
+func (q *Queries) GetUnmatchedDeposit(ctx context.Context, userID uuid.UUID, since time.Time, minimum types.Money) (types.LedgerEntry, error) {
+	var (
+		entry types.LedgerEntry
+		query = `
+		SELECT
+			l.id,
+			l.user_id,
+			l.debit_account,
+			l.credit_account,
+			l.amount,
+			l.currency,
+			l.source,
+			l.reference_id,
+			l.created
+		FROM ledger_entries l
+		WHERE l.user_id = $1
+			AND l.source = $2
+			AND l.credit_account = $3
+			AND l.created >= $4
+			AND l.currency = $5
+			AND l.amount >= $6
+			AND NOT EXISTS (SELECT 1 FROM users_promotions up WHERE up.deposit_id = l.id)
+		ORDER BY l.created DESC, l.id DESC
+		LIMIT 1`
+	)
+
+	err := q.db.QueryRow(ctx, query,
+		userID,
+		types.LedgerSourceManual,
+		types.LedgerAccountPlayerCash,
+		since,
+		minimum.Currency,
+		minimum.Amount,
+	).Scan(
+		&entry.ID,
+		&entry.UserID,
+		&entry.DebitAccount,
+		&entry.CreditAccount,
+		&entry.Amount.Amount,
+		&entry.Amount.Currency,
+		&entry.Source,
+		&entry.ReferenceID,
+		&entry.Created,
+	)
+
+	return entry, err
+}
@@ -0,0 +1,782 @@
+package postgresdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+func (q *Queries) PointsRateUpsert(ctx context.Context, rate types.PointsRate) (types.PointsRate, error) {
+	query := `
+		INSERT INTO points_rates (
+			category,
+			rate
+		) VALUES ($1, $2)
+		ON CONFLICT (category) DO UPDATE SET rate = EXCLUDED.rate
+		RETURNING
+			category,
+			rate,
+			created,
+			updated`
+
+	err := q.db.QueryRow(ctx, query, rate.Category, rate.Rate).Scan(
+		&rate.Category,
+		&rate.Rate,
+		&rate.Created,
+		&rate.Updated,
+	)
+
+	return rate, err
+}
+
+func (q *Queries) PointsRateGet(ctx context.Context, category string) (types.PointsRate, error) {
+	var (
+		rate  types.PointsRate
+		query = `
+		SELECT
+			category,
+			rate,
+			created,
+			updated
+		FROM points_rates
+		WHERE category = $1`
+	)
+
+	err := q.db.QueryRow(ctx, query, category).Scan(
+		&rate.Category,
+		&rate.Rate,
+		&rate.Created,
+		&rate.Updated,
+	)
+
+	return rate, err
+}
+
+func (q *Queries) GetPointsRates(ctx context.Context) ([]types.PointsRate, error) {
+	var (
+		rates []types.PointsRate
+		query = `
+		SELECT
+			category,
+			rate,
+			created,
+			updated
+		FROM points_rates
+		ORDER BY category`
+	)
+
+	rows, err := q.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rate types.PointsRate
+		err := rows.Scan(
+			&rate.Category,
+			&rate.Rate,
+			&rate.Created,
+			&rate.Updated,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
+
+func (q *Queries) PointsRateDelete(ctx context.Context, category string) error {
+	query := `DELETE FROM points_rates WHERE category = $1`
+
+	res, err := q.db.Exec(ctx, query, category)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// PointsEntryCreate books the entry and applies it to the user's points
+// balance. Earned points are kept as a lot that is consumed by redemptions
+// and expires at the expiry of the entry. It reports false when the source
+// and reference of the entry were already booked, in which case nothing is
+// applied.
+func (q *Queries) PointsEntryCreate(ctx context.Context, entry types.PointsEntry) (bool, error) {
+	query := `WITH entry AS (
+			INSERT INTO points_entries (
+				id,
+				user_id,
+				points,
+				source,
+				reference_id,
+				created
+			) VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (source, reference_id) DO NOTHING
+			RETURNING id, user_id, points, created
+		),
+		lot AS (
+			INSERT INTO points_lots (
+				entry_id,
+				user_id,
+				remaining,
+				expires,
+				created
+			)
+			SELECT id, user_id, points, $7, created
+			FROM entry
+			WHERE points > 0
+		)
+		UPDATE users
+			SET loyalty_points = loyalty_points + entry.points
+			FROM entry
+			WHERE users.id = entry.user_id`
+
+	res, err := q.db.Exec(ctx, query,
+		entry.ID,
+		entry.UserID,
+		entry.Points,
+		entry.Source,
+		entry.ReferenceID,
+		entry.Created,
+		entry.Expires,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() == 1, nil
+}
+
+func (q *Queries) PointsEntryGet(ctx context.Context, source types.PointsSource, referenceID uuid.UUID) (types.PointsEntry, error) {
+	var (
+		entry types.PointsEntry
+		query = `
+		SELECT
+			id,
+			user_id,
+			points,
+			source,
+			reference_id,
+			created
+		FROM points_entries
+		WHERE source = $1 AND reference_id = $2`
+	)
+
+	err := q.db.QueryRow(ctx, query, source, referenceID).Scan(
+		&entry.ID,
+		&entry.UserID,
+		&entry.Points,
+		&entry.Source,
+		&entry.ReferenceID,
+		&entry.Created,
+	)
+
+	return entry, err
+}
+
+// PointsLotsConsume takes points from the user's lots, the ones expiring
+// first before the others. The lots are locked before they are read, so
+// concurrent redemptions of the same user consume them one after another.
+func (q *Queries) PointsLotsConsume(ctx context.Context, userID uuid.UUID, points decimal.Decimal) error {
+	var (
+		entryIDs []uuid.UUID
+		consumed []decimal.Decimal
+		query    = `
+		SELECT
+			entry_id,
+			remaining
+		FROM points_lots
+		WHERE user_id = $1 AND remaining > 0
+		ORDER BY expires NULLS LAST, created, entry_id
+		FOR UPDATE`
+	)
+
+	rows, err := q.db.Query(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() && points.IsPositive() {
+		var (
+			entryID   uuid.UUID
+			remaining decimal.Decimal
+		)
+		err := rows.Scan(&entryID, &remaining)
+		if err != nil {
+			return err
+		}
+
+		take := decimal.Min(remaining, points)
+		entryIDs = append(entryIDs, entryID)
+		consumed = append(consumed, take)
+		points = points.Sub(take)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if len(entryIDs) == 0 {
+		return nil
+	}
+
+	query = `
+		UPDATE points_lots pl
+			SET remaining = pl.remaining - c.points
+			FROM unnest($1::UUID[], $2::DECIMAL[]) AS c(entry_id, points)
+			WHERE pl.entry_id = c.entry_id`
+
+	_, err = q.db.Exec(ctx, query, entryIDs, consumed)
+	return err
+}
+
+// PointsLotsWarn marks the lots expiring between now and before as warned
+// and returns their points per user. Each lot is returned once.
+func (q *Queries) PointsLotsWarn(ctx context.Context, now time.Time, before time.Time) ([]types.PointsExpiryNotice, error) {
+	query := `
+		WITH warned AS (
+			UPDATE points_lots
+				SET warned = $1
+				WHERE remaining > 0
+					AND warned IS NULL
+					AND expires > $1
+					AND expires <= $2
+				RETURNING user_id, remaining, expires
+		)
+		SELECT
+			user_id,
+			SUM(remaining),
+			MIN(expires)
+		FROM warned
+		GROUP BY user_id`
+
+	return q.pointsExpiryNotices(ctx, query, now, before)
+}
+
+// PointsLotsExpire expires up to limit lots that expired at now. The
+// remaining points are booked out of the user's balance with an expiry
+// entry referencing the lot and the expired points are returned per user.
+func (q *Queries) PointsLotsExpire(ctx context.Context, now time.Time, limit int) ([]types.PointsExpiryNotice, error) {
+	query := `
+		WITH expired AS (
+			UPDATE points_lots pl
+				SET remaining = 0
+				FROM (
+					SELECT entry_id, remaining
+					FROM points_lots
+					WHERE remaining > 0 AND expires <= $1
+					ORDER BY expires
+					LIMIT $2
+					FOR UPDATE SKIP LOCKED
+				) old
+				WHERE pl.entry_id = old.entry_id
+				RETURNING pl.entry_id, pl.user_id, old.remaining, pl.expires
+		),
+		entries AS (
+			INSERT INTO points_entries (
+				id,
+				user_id,
+				points,
+				source,
+				reference_id,
+				created
+			)
+			SELECT gen_random_uuid(), user_id, -remaining, $3, entry_id, $1
+			FROM expired
+			ON CONFLICT (source, reference_id) DO NOTHING
+			RETURNING user_id, points, reference_id
+		),
+		totals AS (
+			SELECT
+				e.user_id,
+				-SUM(e.points) AS points,
+				MAX(x.expires) AS expires
+			FROM entries e
+			JOIN expired x ON x.entry_id = e.reference_id
+			GROUP BY e.user_id
+		)
+		UPDATE users
+			SET loyalty_points = loyalty_points - totals.points
+			FROM totals
+			WHERE users.id = totals.user_id
+			RETURNING users.id, totals.points, totals.expires`
+
+	notices, err := q.pointsExpiryNotices(ctx, query, now, limit, types.PointsSourceExpiry)
+	for i := range notices {
+		notices[i].Expired = true
+	}
+
+	return notices, err
+}
+
+func (q *Queries) pointsExpiryNotices(ctx context.Context, query string, args ...any) ([]types.PointsExpiryNotice, error) {
+	var notices []types.PointsExpiryNotice
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var notice types.PointsExpiryNotice
+		err := rows.Scan(
+			&notice.UserID,
+			&notice.Points,
+			&notice.Expires,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notices = append(notices, notice)
+	}
+
+	return notices, rows.Err()
+}
+
+// GetPointsLiability sums the unredeemed points per day of expiry between
+// from and to.
+func (q *Queries) GetPointsLiability(ctx context.Context, from time.Time, to time.Time) ([]types.PointsLiability, error) {
+	var (
+		liabilities []types.PointsLiability
+		query       = `
+		SELECT
+			date_trunc('day', expires) AS date,
+			COUNT(DISTINCT user_id),
+			SUM(remaining)
+		FROM points_lots
+		WHERE remaining > 0 AND expires >= $1 AND expires < $2
+		GROUP BY date
+		ORDER BY date`
+	)
+
+	rows, err := q.db.Query(ctx, query, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var liability types.PointsLiability
+		err := rows.Scan(
+			&liability.Date,
+			&liability.Users,
+			&liability.Points,
+		)
+		if err != nil {
+			return nil, err
+		}
+		liabilities = append(liabilities, liability)
+	}
+
+	return liabilities, rows.Err()
+}
+
+func (q *Queries) TierCreate(ctx context.Context, tier types.Tier) (types.Tier, error) {
+	query := `
+		INSERT INTO tiers (
+			id,
+			name,
+			min_points,
+			benefits
+		) VALUES ($1, $2, $3, $4)
+		RETURNING
+			id,
+			name,
+			min_points,
+			benefits,
+			created,
+			updated`
+
+	err := q.db.QueryRow(ctx, query, tier.ID, tier.Name, tier.MinPoints, tier.Benefits).Scan(
+		&tier.ID,
+		&tier.Name,
+		&tier.MinPoints,
+		&tier.Benefits,
+		&tier.Created,
+		&tier.Updated,
+	)
+
+	return tier, err
+}
+
+func (q *Queries) GetTiers(ctx context.Context) ([]types.Tier, error) {
+	var (
+		tiers []types.Tier
+		query = `
+		SELECT
+			id,
+			name,
+			min_points,
+			benefits,
+			created,
+			updated
+		FROM tiers
+		ORDER BY min_points`
+	)
+
+	rows, err := q.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tier types.Tier
+		err := rows.Scan(
+			&tier.ID,
+			&tier.Name,
+			&tier.MinPoints,
+			&tier.Benefits,
+			&tier.Created,
+			&tier.Updated,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, rows.Err()
+}
+
+func (q *Queries) TierUpdate(ctx context.Context, tier types.Tier) (types.Tier, error) {
+	query := `
+		UPDATE tiers SET
+			name = $1,
+			min_points = $2,
+			benefits = $3
+		WHERE id = $4
+		RETURNING
+			id,
+			name,
+			min_points,
+			benefits,
+			created,
+			updated`
+
+	err := q.db.QueryRow(ctx, query, tier.Name, tier.MinPoints, tier.Benefits, tier.ID).Scan(
+		&tier.ID,
+		&tier.Name,
+		&tier.MinPoints,
+		&tier.Benefits,
+		&tier.Created,
+		&tier.Updated,
+	)
+
+	return tier, err
+}
+
+func (q *Queries) TierDelete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM tiers WHERE id = $1`
+
+	res, err := q.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// qualifiedTiersQuery selects the users matching %s with the tier the
+// points they earned since $1 qualify for. Points of bets that were rolled
+// back do not count. Not having a tier ranks below every tier.
+const qualifiedTiersQuery = `
+	qualified AS (
+		SELECT
+			u.id AS user_id,
+			u.tier_id AS previous_tier_id,
+			pt.name AS previous_tier_name,
+			COALESCE(pt.min_points, -1) AS previous_rank,
+			e.points,
+			nt.id AS tier_id,
+			nt.name AS tier_name,
+			COALESCE(nt.min_points, -1) AS rank
+		FROM users u
+		LEFT JOIN tiers pt ON pt.id = u.tier_id
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(pe.points), 0) AS points
+			FROM points_entries pe
+			WHERE pe.user_id = u.id AND pe.points > 0 AND pe.created >= $1
+				AND NOT EXISTS (
+					SELECT 1
+					FROM points_entries r
+					WHERE r.source = '` + string(types.PointsSourceRollback) + `'
+						AND r.reference_id = pe.reference_id
+				)
+		) e
+		LEFT JOIN LATERAL (
+			SELECT t.id, t.name, t.min_points
+			FROM tiers t
+			WHERE t.min_points <= e.points
+			ORDER BY t.min_points DESC
+			LIMIT 1
+		) nt ON true
+		WHERE %s
+	)`
+
+// tierJSON builds the tier with the given id as JSON.
+const tierJSON = `(
+	SELECT json_build_object(
+		'id', t.id,
+		'name', t.name,
+		'min_points', t.min_points,
+		'benefits', t.benefits,
+		'created', t.created,
+		'updated', t.updated
+	)
+	FROM tiers t
+	WHERE t.id = %s
+)`
+
+// tierChangesQuery applies the tier update in %s to the qualified users,
+// records the changed tiers in the history with reason $2 and returns them.
+const tierChangesQuery = `
+	WITH %s,
+	changed AS (
+		%s
+		RETURNING qualified.*
+	),
+	history AS (
+		INSERT INTO tier_history (
+			id,
+			user_id,
+			previous_tier_id,
+			previous_tier_name,
+			tier_id,
+			tier_name,
+			reason,
+			qualifying_points
+		)
+		SELECT gen_random_uuid(), user_id, previous_tier_id, previous_tier_name, tier_id, tier_name, $2, points
+		FROM changed
+		WHERE previous_tier_id IS DISTINCT FROM tier_id
+	)
+	SELECT
+		c.user_id,
+		c.previous_tier_id,
+		%s,
+		c.points
+	FROM changed c
+	WHERE c.previous_tier_id IS DISTINCT FROM c.tier_id`
+
+// UserTiersQualify moves users up to the tier the points they earned since
+// the given time qualify for, and ends the grace period of users who
+// requalified for their tier. Only the given user is evaluated when userID
+// is set, otherwise all users are. It returns the users whose tier changed.
+func (q *Queries) UserTiersQualify(ctx context.Context, userID uuid.NullUUID, since time.Time) ([]types.TierChange, error) {
+	var (
+		whereClause = "TRUE"
+		args        = []any{since, types.TierChangeQualified}
+		update      = `
+		UPDATE users
+			SET tier_id = qualified.tier_id,
+				tier_grace_until = NULL
+			FROM qualified
+			WHERE users.id = qualified.user_id
+				AND qualified.rank >= qualified.previous_rank
+				AND (users.tier_id IS DISTINCT FROM qualified.tier_id OR users.tier_grace_until IS NOT NULL)`
+	)
+
+	if userID.Valid {
+		whereClause = fmt.Sprintf("u.id = $%d", len(args)+1)
+		args = append(args, userID)
+	}
+
+	query := fmt.Sprintf(tierChangesQuery, fmt.Sprintf(qualifiedTiersQuery, whereClause), update, fmt.Sprintf(tierJSON, "c.tier_id"))
+
+	return q.tierChanges(ctx, types.TierChangeQualified, query, args...)
+}
+
+// UserTiersDemote moves users whose grace period ended by now down to the
+// tier the points they earned since the given time qualify for. Only the
+// given user is evaluated when userID is set, otherwise all users are. It
+// returns the users whose tier changed.
+func (q *Queries) UserTiersDemote(ctx context.Context, userID uuid.NullUUID, since time.Time, now time.Time) ([]types.TierChange, error) {
+	var (
+		whereClause = "u.tier_grace_until IS NOT NULL"
+		args        = []any{since, types.TierChangeDemoted, now}
+		update      = `
+		UPDATE users
+			SET tier_id = qualified.tier_id,
+				tier_grace_until = NULL
+			FROM qualified
+			WHERE users.id = qualified.user_id
+				AND qualified.rank < qualified.previous_rank
+				AND users.tier_grace_until <= $3`
+	)
+
+	if userID.Valid {
+		whereClause += fmt.Sprintf(" AND u.id = $%d", len(args)+1)
+		args = append(args, userID)
+	}
+
+	query := fmt.Sprintf(tierChangesQuery, fmt.Sprintf(qualifiedTiersQuery, whereClause), update, fmt.Sprintf(tierJSON, "c.tier_id"))
+
+	return q.tierChanges(ctx, types.TierChangeDemoted, query, args...)
+}
+
+func (q *Queries) tierChanges(ctx context.Context, reason types.TierChangeReason, query string, args ...any) ([]types.TierChange, error) {
+	var changes []types.TierChange
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change := types.TierChange{Reason: reason}
+		err := rows.Scan(
+			&change.UserID,
+			&change.PreviousTierID,
+			&change.Tier,
+			&change.QualifyingPoints,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+// UserTiersWarn starts the grace period of users who no longer earned
+// enough points since the given time for their tier and returns them. A
+// user is warned once per grace period. Only the given user is evaluated
+// when userID is set, otherwise all users are.
+func (q *Queries) UserTiersWarn(ctx context.Context, userID uuid.NullUUID, since time.Time, demotionDate time.Time) ([]types.TierWarning, error) {
+	var (
+		warnings    []types.TierWarning
+		whereClause = "u.tier_id IS NOT NULL AND u.tier_grace_until IS NULL"
+		args        = []any{since, demotionDate}
+	)
+
+	if userID.Valid {
+		whereClause += fmt.Sprintf(" AND u.id = $%d", len(args)+1)
+		args = append(args, userID)
+	}
+
+	query := fmt.Sprintf(`
+		WITH %s,
+		warned AS (
+			UPDATE users
+				SET tier_grace_until = $2
+				FROM qualified
+				WHERE users.id = qualified.user_id
+					AND qualified.rank < qualified.previous_rank
+					AND users.tier_grace_until IS NULL
+				RETURNING qualified.*, users.tier_grace_until
+		)
+		SELECT
+			w.user_id,
+			%s,
+			%s,
+			w.points,
+			w.tier_grace_until
+		FROM warned w`,
+		fmt.Sprintf(qualifiedTiersQuery, whereClause),
+		fmt.Sprintf(tierJSON, "w.previous_tier_id"),
+		fmt.Sprintf(tierJSON, "w.tier_id"),
+	)
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var warning types.TierWarning
+		err := rows.Scan(
+			&warning.UserID,
+			&warning.Tier,
+			&warning.QualifiedTier,
+			&warning.QualifyingPoints,
+			&warning.DemotionDate,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		warnings = append(warnings, warning)
+	}
+
+	return warnings, rows.Err()
+}
+
+func (q *Queries) GetTierHistory(ctx context.Context, userID uuid.UUID) ([]types.TierHistoryEntry, error) {
+	var (
+		entries []types.TierHistoryEntry
+		query   = `
+		SELECT
+			id,
+			user_id,
+			previous_tier_id,
+			previous_tier_name,
+			tier_id,
+			tier_name,
+			reason,
+			qualifying_points,
+			created
+		FROM tier_history
+		WHERE user_id = $1
+		ORDER BY created DESC`
+	)
+
+	rows, err := q.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry types.TierHistoryEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.UserID,
+			&entry.PreviousTierID,
+			&entry.PreviousTierName,
+			&entry.TierID,
+			&entry.TierName,
+			&entry.Reason,
+			&entry.QualifyingPoints,
+			&entry.Created,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
@@ -2,13 +2,42 @@ package postgresdb
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 )
 
+const promotionColumns = `
+			id,
+			title,
+			description,
+			amount,
+			currency,
+			is_active,
+			type,
+			wagering_multiplier,
+			cashback,
+			match_bonus,
+			free_spins,
+			eligibility,
+			available_from,
+			available_until,
+			manual_state_at,
+			budget,
+			max_claims,
+			max_claims_per_user,
+			spent,
+			claims,
+			archived,
+			created,
+			updated`
+
 func (q *Queries) PromotionCreate(ctx context.Context, promotion types.Promotion) (types.Promotion, error) {
 	query := `
 		INSERT INTO promotions (
@@ -16,96 +45,109 @@ func (q *Queries) PromotionCreate(ctx context.Context, promotion types.Promotion
 			title,
 			description,
 			amount,
-			is_active
-		) VALUES ($1, $2, $3, $4, $5)`
+			currency,
+			is_active,
+			type,
+			wagering_multiplier,
+			cashback,
+			match_bonus,
+			free_spins,
+			eligibility,
+			available_from,
+			available_until,
+			budget,
+			max_claims,
+			max_claims_per_user
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+
+	budget, maxClaims, maxClaimsPerUser := budgetLimits(promotion.Budget)
 
 	_, err := q.db.Exec(ctx, query,
 		promotion.ID,
 		promotion.Title,
 		promotion.Description,
-		promotion.Amount,
+		promotion.Amount.Amount,
+		promotion.Amount.Currency,
 		promotion.IsActive,
+		promotion.Type,
+		promotion.WageringMultiplier,
+		promotion.Cashback,
+		promotion.MatchBonus,
+		promotion.FreeSpins,
+		promotion.Eligibility,
+		promotion.AvailableFrom,
+		promotion.AvailableUntil,
+		budget,
+		maxClaims,
+		maxClaimsPerUser,
 	)
 
 	return promotion, err
 }
 
 func (q *Queries) PromotionGetByID(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
-	var (
-		promotion types.Promotion
-		query     = `
-		SELECT 
-			id,
-			title,
-			description,
-			amount,
-			is_active,
-			created,
-			updated
-		FROM promotions 
+	query := `SELECT ` + promotionColumns + `
+		FROM promotions
 		WHERE id = $1`
-	)
-
-	err := q.db.QueryRow(ctx, query, id).Scan(
-		&promotion.ID,
-		&promotion.Title,
-		&promotion.Description,
-		&promotion.Amount,
-		&promotion.IsActive,
-		&promotion.Created,
-		&promotion.Updated,
-	)
 
-	return promotion, err
+	return scanPromotion(q.db.QueryRow(ctx, query, id))
 }
 
 func (q *Queries) PromotionGetByType(ctx context.Context, promotionType types.PromotionType) (types.Promotion, error) {
-	var (
-		promotion types.Promotion
-		query     = `
-		SELECT 
-			id,
-			title,
-			description,
-			amount,
-			is_active,
-			created,
-			updated
-		FROM promotions 
-		WHERE type = $1
+	query := `SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE type = $1 AND archived IS NULL
 		LIMIT 1`
-	)
-
-	err := q.db.QueryRow(ctx, query, promotionType).Scan(
-		&promotion.ID,
-		&promotion.Title,
-		&promotion.Description,
-		&promotion.Amount,
-		&promotion.IsActive,
-		&promotion.Created,
-		&promotion.Updated,
-	)
 
-	return promotion, err
+	return scanPromotion(q.db.QueryRow(ctx, query, promotionType))
 }
 
-func (q *Queries) GetPromotions(ctx context.Context) ([]types.Promotion, error) {
+func (q *Queries) GetPromotions(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error) {
 	var (
-		promotions []types.Promotion
-		args       []any
+		promotions  []types.Promotion
+		whereClause []string
+		args        []any
 
-		query = `
-		SELECT 
-			id,
-			title,
-			description,
-			amount,
-			is_active,
-			created,
-			updated
+		query = `SELECT ` + promotionColumns + `
 		FROM promotions`
 	)
 
+	if filter.Archived {
+		whereClause = append(whereClause, "archived IS NOT NULL")
+	} else {
+		whereClause = append(whereClause, "archived IS NULL")
+	}
+
+	if filter.ByType != nil {
+		whereClause = append(whereClause, fmt.Sprintf("type = $%d", len(args)+1))
+		args = append(args, *filter.ByType)
+	}
+
+	if filter.IsActive != nil {
+		whereClause = append(whereClause, fmt.Sprintf("is_active = $%d", len(args)+1))
+		args = append(args, *filter.IsActive)
+	}
+
+	if filter.Availability != nil {
+		switch *filter.Availability {
+		case types.PromotionLive:
+			whereClause = append(whereClause, fmt.Sprintf(
+				"is_active AND (available_from IS NULL OR available_from <= $%[1]d) AND (available_until IS NULL OR available_until > $%[1]d)",
+				len(args)+1))
+		case types.PromotionUpcoming:
+			whereClause = append(whereClause, fmt.Sprintf("available_from > $%d", len(args)+1))
+		case types.PromotionEnded:
+			whereClause = append(whereClause, fmt.Sprintf("available_until <= $%d", len(args)+1))
+		}
+		args = append(args, filter.At)
+	}
+
+	query += " WHERE " + strings.Join(whereClause, " AND ")
+
+	if filter.Archived {
+		query += " ORDER BY archived DESC"
+	}
+
 	rows, err := q.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -113,17 +155,7 @@ func (q *Queries) GetPromotions(ctx context.Context) ([]types.Promotion, error)
 	defer rows.Close()
 
 	for rows.Next() {
-		var promotion types.Promotion
-		err := rows.Scan(
-			&promotion.ID,
-			&promotion.Title,
-			&promotion.Description,
-			&promotion.Amount,
-			&promotion.IsActive,
-			&promotion.Created,
-			&promotion.Updated,
-		)
-
+		promotion, err := scanPromotion(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -134,22 +166,98 @@ func (q *Queries) GetPromotions(ctx context.Context) ([]types.Promotion, error)
 	return promotions, rows.Err()
 }
 
+func scanPromotion(row pgx.Row) (types.Promotion, error) {
+	var (
+		promotion types.Promotion
+		budget    types.PromotionBudget
+	)
+	err := row.Scan(
+		&promotion.ID,
+		&promotion.Title,
+		&promotion.Description,
+		&promotion.Amount.Amount,
+		&promotion.Amount.Currency,
+		&promotion.IsActive,
+		&promotion.Type,
+		&promotion.WageringMultiplier,
+		&promotion.Cashback,
+		&promotion.MatchBonus,
+		&promotion.FreeSpins,
+		&promotion.Eligibility,
+		&promotion.AvailableFrom,
+		&promotion.AvailableUntil,
+		&promotion.ManualStateAt,
+		&budget.Amount,
+		&budget.MaxClaims,
+		&budget.MaxClaimsPerUser,
+		&budget.Spent,
+		&budget.Claims,
+		&promotion.Archived,
+		&promotion.Created,
+		&promotion.Updated,
+	)
+
+	if budget.Amount != nil || budget.MaxClaims != nil || budget.MaxClaimsPerUser != nil {
+		promotion.Budget = &budget
+	}
+
+	return promotion, err
+}
+
+// budgetLimits returns the limits of budget, which are all unset without a
+// budget.
+func budgetLimits(budget *types.PromotionBudget) (*decimal.Decimal, *int, *int) {
+	if budget == nil {
+		return nil, nil, nil
+	}
+
+	return budget.Amount, budget.MaxClaims, budget.MaxClaimsPerUser
+}
+
 func (q *Queries) PromotionUpdate(ctx context.Context, promotion types.Promotion) (types.Promotion, error) {
 	query := `
 		UPDATE promotions SET
 			title = $1,
 			description = $2,
 			amount = $3,
-			is_active = $4
-		WHERE id = $5`
+			currency = $4,
+			is_active = $5,
+			type = $6,
+			wagering_multiplier = $7,
+			cashback = $8,
+			match_bonus = $9,
+			free_spins = $10,
+			eligibility = $11,
+			available_from = $12,
+			available_until = $13,
+			budget = $14,
+			max_claims = $15,
+			max_claims_per_user = $16,
+			manual_state_at = $17
+		WHERE id = $18 AND archived IS NULL`
+
+	budget, maxClaims, maxClaimsPerUser := budgetLimits(promotion.Budget)
 
 	res, err := q.db.Exec(
 		ctx,
 		query,
 		&promotion.Title,
 		&promotion.Description,
-		&promotion.Amount,
+		&promotion.Amount.Amount,
+		&promotion.Amount.Currency,
 		&promotion.IsActive,
+		&promotion.Type,
+		&promotion.WageringMultiplier,
+		promotion.Cashback,
+		promotion.MatchBonus,
+		promotion.FreeSpins,
+		promotion.Eligibility,
+		promotion.AvailableFrom,
+		promotion.AvailableUntil,
+		budget,
+		maxClaims,
+		maxClaimsPerUser,
+		promotion.ManualStateAt,
 		&promotion.ID,
 	)
 
@@ -164,15 +272,17 @@ func (q *Queries) PromotionUpdate(ctx context.Context, promotion types.Promotion
 	return promotion, err
 }
 
-func (q *Queries) PromotionDelete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM promotions WHERE id = $1`
-
-	res, err := q.db.Exec(
-		ctx,
-		query,
-		id,
-	)
+// PromotionArchive archives the promotion and deactivates it, so it can no
+// longer be assigned while the user promotions of it are kept. Archiving an
+// archived promotion keeps when it was first archived.
+func (q *Queries) PromotionArchive(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE promotions SET
+			archived = COALESCE(archived, NOW()),
+			is_active = FALSE
+		WHERE id = $1`
 
+	res, err := q.db.Exec(ctx, query, id)
 	if err != nil {
 		return err
 	}
@@ -183,3 +293,161 @@ func (q *Queries) PromotionDelete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// PromotionRestore restores the archived promotion. It stays inactive until
+// it is activated. It returns pgx.ErrNoRows when the promotion is not
+// archived.
+func (q *Queries) PromotionRestore(ctx context.Context, id uuid.UUID) (types.Promotion, error) {
+	query := `
+		UPDATE promotions SET archived = NULL
+		WHERE id = $1 AND archived IS NOT NULL
+		RETURNING ` + promotionColumns
+
+	return scanPromotion(q.db.QueryRow(ctx, query, id))
+}
+
+// PromotionSpend counts a claim spending amount of the promotion and returns
+// the promotion with its limits. It locks the promotion until the transaction
+// ends and returns pgx.ErrNoRows when the claim exceeds the budget or the
+// maximum number of claims.
+func (q *Queries) PromotionSpend(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (types.Promotion, error) {
+	query := `
+		UPDATE promotions SET
+			spent = spent + $2,
+			claims = claims + 1
+		WHERE id = $1
+			AND (budget IS NULL OR spent + $2 <= budget)
+			AND (max_claims IS NULL OR claims < max_claims)
+		RETURNING ` + promotionColumns
+
+	return scanPromotion(q.db.QueryRow(ctx, query, id, amount))
+}
+
+// PromotionsApplySchedule switches the promotions whose availability window
+// disagrees with their active flag at now and records each switch. A
+// promotion switched by hand is left until an edge of its window passes
+// after the switch, which clears the override. A promotion is switched once
+// even when replicas run it concurrently.
+func (q *Queries) PromotionsApplySchedule(ctx context.Context, now time.Time) ([]types.PromotionStateChange, error) {
+	var (
+		changes []types.PromotionStateChange
+		query   = `
+		WITH switched AS (
+			UPDATE promotions SET
+				is_active = NOT COALESCE(is_active, FALSE),
+				manual_state_at = NULL
+			WHERE (available_from IS NOT NULL OR available_until IS NOT NULL)
+				AND archived IS NULL
+				AND COALESCE(is_active, FALSE) <> (
+					(available_from IS NULL OR available_from <= $1)
+					AND (available_until IS NULL OR available_until > $1)
+				)
+				AND (
+					manual_state_at IS NULL
+					OR available_from > manual_state_at AND available_from <= $1
+					OR available_until > manual_state_at AND available_until <= $1
+				)
+			RETURNING id, is_active
+		)
+		INSERT INTO promotion_state_changes (
+			id,
+			promotion_id,
+			is_active,
+			reason,
+			created
+		)
+		SELECT
+			gen_random_uuid(),
+			id,
+			is_active,
+			CASE WHEN is_active THEN $2 ELSE $3 END,
+			$1
+		FROM switched
+		RETURNING id, promotion_id, is_active, reason, created`
+	)
+
+	rows, err := q.db.Query(ctx, query, now, types.PromotionScheduledStart, types.PromotionScheduledEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := scanPromotionStateChange(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+// PromotionStateChangeCreate records the promotion being switched by hand.
+func (q *Queries) PromotionStateChangeCreate(ctx context.Context, change types.PromotionStateChange) error {
+	query := `
+		INSERT INTO promotion_state_changes (
+			id,
+			promotion_id,
+			is_active,
+			reason,
+			created
+		) VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := q.db.Exec(ctx, query,
+		change.ID,
+		change.PromotionID,
+		change.IsActive,
+		change.Reason,
+		change.Created,
+	)
+
+	return err
+}
+
+func (q *Queries) GetPromotionStateChanges(ctx context.Context, promotionID uuid.UUID) ([]types.PromotionStateChange, error) {
+	var (
+		changes []types.PromotionStateChange
+		query   = `
+		SELECT
+			id,
+			promotion_id,
+			is_active,
+			reason,
+			created
+		FROM promotion_state_changes
+		WHERE promotion_id = $1
+		ORDER BY created DESC`
+	)
+
+	rows, err := q.db.Query(ctx, query, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := scanPromotionStateChange(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+func scanPromotionStateChange(row pgx.Row) (types.PromotionStateChange, error) {
+	var change types.PromotionStateChange
+	err := row.Scan(
+		&change.ID,
+		&change.PromotionID,
+		&change.IsActive,
+		&change.Reason,
+		&change.Created,
+	)
+
+	return change, err
+}
@@ -0,0 +1,161 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const promotionCodeColumns = `
+			id,
+			promotion_id,
+			code,
+			kind,
+			max_redemptions,
+			redemptions,
+			validity_days,
+			expires,
+			created`
+
+// PromotionCodesCreate stores the codes in one statement. It fails with a
+// unique violation when any of the codes exists.
+func (q *Queries) PromotionCodesCreate(ctx context.Context, codes []types.PromotionCode) error {
+	var (
+		ids            = make([]uuid.UUID, len(codes))
+		promotionIDs   = make([]uuid.UUID, len(codes))
+		values         = make([]string, len(codes))
+		kinds          = make([]string, len(codes))
+		maxRedemptions = make([]*int, len(codes))
+		validityDays   = make([]int, len(codes))
+		expires        = make([]*time.Time, len(codes))
+
+		query = `
+		INSERT INTO promotion_codes (
+			id,
+			promotion_id,
+			code,
+			kind,
+			max_redemptions,
+			validity_days,
+			expires
+		)
+		SELECT * FROM unnest(
+			$1::uuid[],
+			$2::uuid[],
+			$3::text[],
+			$4::text[],
+			$5::integer[],
+			$6::integer[],
+			$7::timestamptz[]
+		)`
+	)
+
+	for i, code := range codes {
+		ids[i] = code.ID
+		promotionIDs[i] = code.PromotionID
+		values[i] = code.Code
+		kinds[i] = string(code.Kind)
+		maxRedemptions[i] = code.MaxRedemptions
+		validityDays[i] = code.ValidityDays
+		expires[i] = code.Expires
+	}
+
+	_, err := q.db.Exec(ctx, query, ids, promotionIDs, values, kinds, maxRedemptions, validityDays, expires)
+
+	return err
+}
+
+func (q *Queries) GetPromotionCodes(ctx context.Context, promotionID uuid.UUID) ([]types.PromotionCode, error) {
+	var (
+		codes []types.PromotionCode
+		query = `SELECT ` + promotionCodeColumns + `
+		FROM promotion_codes
+		WHERE promotion_id = $1
+		ORDER BY created, code`
+	)
+
+	rows, err := q.db.Query(ctx, query, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		code, err := scanPromotionCode(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, rows.Err()
+}
+
+func (q *Queries) PromotionCodeGetByCode(ctx context.Context, code string) (types.PromotionCode, error) {
+	query := `SELECT ` + promotionCodeColumns + `
+		FROM promotion_codes
+		WHERE code = $1`
+
+	return scanPromotionCode(q.db.QueryRow(ctx, query, code))
+}
+
+// PromotionCodeRedeem counts a redemption of the code. A code that reached
+// its redemption limit is not changed.
+func (q *Queries) PromotionCodeRedeem(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE promotion_codes SET redemptions = redemptions + 1
+		WHERE id = $1
+			AND (max_redemptions IS NULL OR redemptions < max_redemptions)`
+
+	res, err := q.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// PromotionCodeRedemptionCreate records that the user redeemed the code and
+// reports whether it was recorded by this call. Players redeem a code once.
+func (q *Queries) PromotionCodeRedemptionCreate(ctx context.Context, promotionCodeID uuid.UUID, userID uuid.UUID, userPromotionID uuid.UUID) (bool, error) {
+	query := `
+		INSERT INTO promotion_code_redemptions (
+			promotion_code_id,
+			user_id,
+			user_promotion_id
+		) VALUES ($1, $2, $3)
+		ON CONFLICT (promotion_code_id, user_id) DO NOTHING`
+
+	tag, err := q.db.Exec(ctx, query, promotionCodeID, userID, userPromotionID)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanPromotionCode(row pgx.Row) (types.PromotionCode, error) {
+	var code types.PromotionCode
+	err := row.Scan(
+		&code.ID,
+		&code.PromotionID,
+		&code.Code,
+		&code.Kind,
+		&code.MaxRedemptions,
+		&code.Redemptions,
+		&code.ValidityDays,
+		&code.Expires,
+		&code.Created,
+	)
+
+	return code, err
+}
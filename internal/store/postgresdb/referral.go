@@ -0,0 +1,155 @@
+package postgresdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// GetQualifiedReferrals returns up to limit pending referrals whose referee
+// meets the condition. With first_deposit their first deposit must be at
+// least threshold, with wagered their bets net of rollbacks.
+func (q *Queries) GetQualifiedReferrals(ctx context.Context, condition types.ReferralCondition, threshold decimal.Decimal, limit int) ([]types.Referral, error) {
+	var (
+		referrals []types.Referral
+		query     string
+		args      []any
+	)
+
+	switch condition {
+	case types.ReferralConditionDeposit:
+		query = `
+		SELECT
+			r.id,
+			r.referrer_id,
+			r.referee_id,
+			r.status,
+			r.rewarded,
+			r.created
+		FROM referrals r
+		WHERE r.status = $1 AND (
+			SELECT l.amount
+			FROM ledger_entries l
+			WHERE l.user_id = r.referee_id AND l.source = $2 AND l.credit_account = $3
+			ORDER BY l.created, l.id
+			LIMIT 1
+		) >= $4
+		ORDER BY r.created
+		LIMIT $5`
+		args = []any{types.ReferralPending, types.LedgerSourceManual, types.LedgerAccountPlayerCash, threshold, limit}
+	case types.ReferralConditionWagered:
+		query = `
+		SELECT
+			r.id,
+			r.referrer_id,
+			r.referee_id,
+			r.status,
+			r.rewarded,
+			r.created
+		FROM referrals r
+		WHERE r.status = $1 AND (
+			SELECT COALESCE(SUM(CASE WHEN g.type = $2 THEN g.amount ELSE -g.amount END), 0)
+			FROM game_events g
+			WHERE g.user_id = r.referee_id AND g.type IN ($2, $3)
+		) >= $4
+		ORDER BY r.created
+		LIMIT $5`
+		args = []any{types.ReferralPending, types.GameEventBet, types.GameEventRollback, threshold, limit}
+	default:
+		return nil, fmt.Errorf("unknown referral condition: %s", condition)
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var referral types.Referral
+		err := rows.Scan(
+			&referral.ID,
+			&referral.ReferrerID,
+			&referral.RefereeID,
+			&referral.Status,
+			&referral.Rewarded,
+			&referral.Created,
+		)
+		if err != nil {
+			return nil, err
+		}
+		referrals = append(referrals, referral)
+	}
+
+	return referrals, rows.Err()
+}
+
+// ReferralReward marks a pending referral rewarded. It returns pgx.ErrNoRows
+// when the referral was already rewarded, so both players are rewarded once.
+func (q *Queries) ReferralReward(ctx context.Context, id uuid.UUID, rewarded time.Time) error {
+	query := `
+		UPDATE referrals
+			SET status = $2, rewarded = $3
+			WHERE id = $1 AND status = $4`
+
+	res, err := q.db.Exec(ctx, query, id, types.ReferralRewarded, rewarded, types.ReferralPending)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (q *Queries) GetReferralReport(ctx context.Context) ([]types.ReferralReport, error) {
+	var (
+		reports []types.ReferralReport
+		query   = `
+		SELECT
+			u.id,
+			u.name,
+			u.email,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE r.status = $1),
+			COUNT(*) FILTER (WHERE r.status = $2),
+			MAX(r.created)
+		FROM referrals r
+		JOIN users u ON u.id = r.referrer_id
+		GROUP BY u.id
+		ORDER BY COUNT(*) DESC, MAX(r.created) DESC`
+	)
+
+	rows, err := q.db.Query(ctx, query, types.ReferralRewarded, types.ReferralPending)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var report types.ReferralReport
+		err := rows.Scan(
+			&report.ReferrerID,
+			&report.Name,
+			&report.Email,
+			&report.Referrals,
+			&report.Rewarded,
+			&report.Pending,
+			&report.LastReferral,
+		)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
@@ -0,0 +1,360 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const tournamentColumns = `
+			id,
+			name,
+			COALESCE(description, ''),
+			game_category,
+			scoring,
+			start_date,
+			end_date,
+			prizes,
+			finished,
+			created,
+			updated`
+
+func (q *Queries) TournamentCreate(ctx context.Context, tournament types.Tournament) (types.Tournament, error) {
+	query := `
+		INSERT INTO tournaments (
+			id,
+			name,
+			description,
+			game_category,
+			scoring,
+			start_date,
+			end_date,
+			prizes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created, updated`
+
+	err := q.db.QueryRow(ctx, query,
+		tournament.ID,
+		tournament.Name,
+		tournament.Description,
+		tournament.GameCategory,
+		tournament.Scoring,
+		tournament.StartDate,
+		tournament.EndDate,
+		tournament.Prizes,
+	).Scan(
+		&tournament.Created,
+		&tournament.Updated,
+	)
+
+	return tournament, err
+}
+
+func (q *Queries) TournamentGetByID(ctx context.Context, id uuid.UUID) (types.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + `
+		FROM tournaments
+		WHERE id = $1`
+
+	return scanTournament(q.db.QueryRow(ctx, query, id))
+}
+
+func (q *Queries) GetTournaments(ctx context.Context) ([]types.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + `
+		FROM tournaments
+		ORDER BY start_date DESC`
+
+	return q.tournaments(ctx, query)
+}
+
+// GetRunningTournaments returns the tournaments events at at count towards.
+func (q *Queries) GetRunningTournaments(ctx context.Context, at time.Time) ([]types.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + `
+		FROM tournaments
+		WHERE finished IS NULL AND start_date <= $1 AND end_date > $1`
+
+	return q.tournaments(ctx, query, at)
+}
+
+// GetEndedTournaments returns the tournaments that ended before at and whose
+// prizes were not distributed yet.
+func (q *Queries) GetEndedTournaments(ctx context.Context, at time.Time) ([]types.Tournament, error) {
+	query := `SELECT ` + tournamentColumns + `
+		FROM tournaments
+		WHERE finished IS NULL AND end_date <= $1
+		ORDER BY end_date`
+
+	return q.tournaments(ctx, query, at)
+}
+
+func (q *Queries) tournaments(ctx context.Context, query string, args ...any) ([]types.Tournament, error) {
+	var tournaments []types.Tournament
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		tournament, err := scanTournament(rows)
+		if err != nil {
+			return nil, err
+		}
+		tournaments = append(tournaments, tournament)
+	}
+
+	return tournaments, rows.Err()
+}
+
+func scanTournament(row pgx.Row) (types.Tournament, error) {
+	var tournament types.Tournament
+	err := row.Scan(
+		&tournament.ID,
+		&tournament.Name,
+		&tournament.Description,
+		&tournament.GameCategory,
+		&tournament.Scoring,
+		&tournament.StartDate,
+		&tournament.EndDate,
+		&tournament.Prizes,
+		&tournament.Finished,
+		&tournament.Created,
+		&tournament.Updated,
+	)
+
+	return tournament, err
+}
+
+// TournamentUpdate updates a tournament that is not finished yet.
+func (q *Queries) TournamentUpdate(ctx context.Context, tournament types.Tournament) (types.Tournament, error) {
+	query := `
+		UPDATE tournaments SET
+			name = $2,
+			description = $3,
+			game_category = $4,
+			scoring = $5,
+			start_date = $6,
+			end_date = $7,
+			prizes = $8
+		WHERE id = $1 AND finished IS NULL
+		RETURNING ` + tournamentColumns
+
+	return scanTournament(q.db.QueryRow(ctx, query,
+		tournament.ID,
+		tournament.Name,
+		tournament.Description,
+		tournament.GameCategory,
+		tournament.Scoring,
+		tournament.StartDate,
+		tournament.EndDate,
+		tournament.Prizes,
+	))
+}
+
+func (q *Queries) TournamentDelete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM tournaments WHERE id = $1`
+
+	res, err := q.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// TournamentFinish marks the tournament finished at finished. It returns
+// pgx.ErrNoRows when the tournament is already finished, so one caller
+// distributes its prizes.
+func (q *Queries) TournamentFinish(ctx context.Context, id uuid.UUID, finished time.Time) error {
+	query := `
+		UPDATE tournaments
+			SET finished = $2
+			WHERE id = $1 AND finished IS NULL`
+
+	res, err := q.db.Exec(ctx, query, id, finished)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (q *Queries) TournamentResultCreate(ctx context.Context, result types.TournamentResult) error {
+	query := `
+		INSERT INTO tournament_results (
+			tournament_id,
+			user_id,
+			rank,
+			score,
+			created
+		) VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := q.db.Exec(ctx, query,
+		result.TournamentID,
+		result.UserID,
+		result.Rank,
+		result.Score,
+		result.Created,
+	)
+
+	return err
+}
+
+// TournamentResultPrize records the user promotion granted for the result.
+func (q *Queries) TournamentResultPrize(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID, userPromotionID uuid.UUID) error {
+	query := `
+		UPDATE tournament_results
+			SET user_promotion_id = $3
+			WHERE tournament_id = $1 AND user_id = $2 AND user_promotion_id IS NULL`
+
+	res, err := q.db.Exec(ctx, query, tournamentID, userID, userPromotionID)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// TournamentResultPrizeFail records why the prize of the user in the
+// tournament can never be granted, so it is not tried again.
+func (q *Queries) TournamentResultPrizeFail(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID, reason string) error {
+	query := `
+		UPDATE tournament_results
+			SET prize_error = $3
+			WHERE tournament_id = $1 AND user_id = $2 AND user_promotion_id IS NULL`
+
+	res, err := q.db.Exec(ctx, query, tournamentID, userID, reason)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// TournamentResultLock locks the result of the user in the tournament while
+// its prize is granted. A result that already has its prize, failed it for
+// good or is being granted by another replica is not found.
+func (q *Queries) TournamentResultLock(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID) (types.TournamentResult, error) {
+	var (
+		result types.TournamentResult
+		query  = `
+		SELECT
+			tournament_id,
+			user_id,
+			rank,
+			score,
+			user_promotion_id,
+			prize_error,
+			created
+		FROM tournament_results
+		WHERE tournament_id = $1 AND user_id = $2
+			AND user_promotion_id IS NULL
+			AND prize_error IS NULL
+		FOR UPDATE SKIP LOCKED`
+	)
+
+	err := q.db.QueryRow(ctx, query, tournamentID, userID).Scan(
+		&result.TournamentID,
+		&result.UserID,
+		&result.Rank,
+		&result.Score,
+		&result.UserPromotionID,
+		&result.PrizeError,
+		&result.Created,
+	)
+
+	return result, err
+}
+
+func (q *Queries) GetTournamentResults(ctx context.Context, tournamentID uuid.UUID) ([]types.TournamentResult, error) {
+	query := `
+		SELECT
+			tournament_id,
+			user_id,
+			rank,
+			score,
+			user_promotion_id,
+			prize_error,
+			created
+		FROM tournament_results
+		WHERE tournament_id = $1
+		ORDER BY rank`
+
+	return q.tournamentResults(ctx, query, tournamentID)
+}
+
+// GetUnpaidTournamentResults returns the results of finished tournaments
+// that rank for a prize the player did not get yet and can still get,
+// oldest first.
+func (q *Queries) GetUnpaidTournamentResults(ctx context.Context) ([]types.TournamentResult, error) {
+	query := `
+		SELECT
+			r.tournament_id,
+			r.user_id,
+			r.rank,
+			r.score,
+			r.user_promotion_id,
+			r.prize_error,
+			r.created
+		FROM tournament_results r
+		JOIN tournaments t ON t.id = r.tournament_id
+		WHERE t.finished IS NOT NULL
+			AND r.user_promotion_id IS NULL
+			AND r.prize_error IS NULL
+			AND EXISTS (
+				SELECT 1 FROM jsonb_array_elements(t.prizes) p
+				WHERE r.rank BETWEEN (p->>'from_rank')::INTEGER AND (p->>'to_rank')::INTEGER
+			)
+		ORDER BY r.created, r.tournament_id, r.rank`
+
+	return q.tournamentResults(ctx, query)
+}
+
+func (q *Queries) tournamentResults(ctx context.Context, query string, args ...any) ([]types.TournamentResult, error) {
+	var results []types.TournamentResult
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result types.TournamentResult
+		err := rows.Scan(
+			&result.TournamentID,
+			&result.UserID,
+			&result.Rank,
+			&result.Score,
+			&result.UserPromotionID,
+			&result.PrizeError,
+			&result.Created,
+		)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
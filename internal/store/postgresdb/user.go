@@ -11,17 +11,34 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// UserCreate creates the user and, when the user was referred, their
+// referral.
 func (q *Queries) UserCreate(ctx context.Context, user types.User) (types.User, error) {
 	query := `
-INSERT INTO users (
+WITH created AS (
+	INSERT INTO users (
+		id,
+		name,
+		email,
+		password,
+		role,
+		currency,
+		country,
+		referral_code,
+		created,
+		updated
+	) VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9, $10)
+	RETURNING id, created
+)
+INSERT INTO referrals (
 	id,
-	name,
-	email,
-	password,
-	role,
-	created,
-	updated
-) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	referrer_id,
+	referee_id,
+	created
+)
+SELECT gen_random_uuid(), $11, id, created
+FROM created
+WHERE $11::UUID IS NOT NULL`
 
 	_, err := q.db.Exec(ctx, query,
 		user.ID,
@@ -29,8 +46,12 @@ INSERT INTO users (
 		user.Email,
 		user.Password,
 		user.Role,
+		user.Balance.Currency,
+		user.Country,
+		user.ReferralCode,
 		user.Created,
 		user.Updated,
+		user.ReferrerID,
 	)
 
 	return user, err
@@ -44,34 +65,65 @@ func (q *Queries) UserGetBy(ctx context.Context, filter types.UserFilter) (types
 
 		query = `
 		SELECT
-			id,
-			email,
-			name,
-			password,
-			role,
-			created,
-			updated
-		FROM users
+			u.id,
+			u.email,
+			u.name,
+			u.password,
+			u.role,
+			u.balance,
+			u.bonus_balance,
+			u.loyalty_points,
+			u.referral_code,
+			u.currency,
+			COALESCE(u.country, ''),
+			u.created,
+			u.updated,
+			CASE WHEN t.id IS NULL THEN NULL ELSE json_build_object(
+				'id', t.id,
+				'name', t.name,
+				'min_points', t.min_points,
+				'benefits', t.benefits,
+				'created', t.created,
+				'updated', t.updated
+			) END AS tier
+		FROM users u
+		LEFT JOIN tiers t ON t.id = u.tier_id
 		WHERE
 			%s
-		LIMIT 1`
+		LIMIT 1
+		%s`
 	)
 
 	if filter.ByID.Valid {
-		whereClause = append(whereClause, fmt.Sprintf("id = $%d", len(args)+1))
+		whereClause = append(whereClause, fmt.Sprintf("u.id = $%d", len(args)+1))
 		args = append(args, filter.ByID)
 	}
 
 	if filter.ByEmail != nil {
-		whereClause = append(whereClause, fmt.Sprintf("email = $%d", len(args)+1))
+		whereClause = append(whereClause, fmt.Sprintf("u.email = $%d", len(args)+1))
 		args = append(args, filter.ByEmail)
 	}
 
+	if filter.ByMailbox != nil {
+		whereClause = append(whereClause, fmt.Sprintf(`lower(regexp_replace(u.email::text, '\+[^@]*@', '@')) = $%d`, len(args)+1))
+		args = append(args, filter.ByMailbox)
+	}
+
+	if filter.ByReferralCode != nil {
+		whereClause = append(whereClause, fmt.Sprintf("u.referral_code = $%d", len(args)+1))
+		args = append(args, strings.ToUpper(*filter.ByReferralCode))
+	}
+
 	if len(args) == 0 {
 		return types.User{}, ErrorNoFiltersProvided
 	}
 
-	query = fmt.Sprintf(query, strings.Join(whereClause, " AND "))
+	lock := ""
+	if filter.ForUpdate {
+		lock = "FOR UPDATE OF u"
+	}
+
+	query = fmt.Sprintf(query, strings.Join(whereClause, " AND "), lock)
 
 	err := q.db.QueryRow(ctx, query, args...).Scan(
 		&user.ID,
@@ -79,9 +131,17 @@ func (q *Queries) UserGetBy(ctx context.Context, filter types.UserFilter) (types
 		&user.Name,
 		&user.Password,
 		&user.Role,
+		&user.Balance.Amount,
+		&user.BonusBalance.Amount,
+		&user.LoyaltyPoints,
+		&user.ReferralCode,
+		&user.Balance.Currency,
+		&user.Country,
 		&user.Created,
 		&user.Updated,
+		&user.Tier,
 	)
+	user.BonusBalance.Currency = user.Balance.Currency
 
 	return user, err
 }
@@ -97,6 +157,11 @@ func (q *Queries) GetUsers(ctx context.Context) ([]types.User, error) {
 			email,
 			name,
 			role,
+			balance,
+			bonus_balance,
+			loyalty_points,
+			referral_code,
+			currency,
 			created,
 			updated
 		FROM users`
@@ -115,6 +180,11 @@ func (q *Queries) GetUsers(ctx context.Context) ([]types.User, error) {
 			&user.Email,
 			&user.Name,
 			&user.Role,
+			&user.Balance.Amount,
+			&user.BonusBalance.Amount,
+			&user.LoyaltyPoints,
+			&user.ReferralCode,
+			&user.Balance.Currency,
 			&user.Created,
 			&user.Updated,
 		)
@@ -122,6 +192,7 @@ func (q *Queries) GetUsers(ctx context.Context) ([]types.User, error) {
 		if err != nil {
 			return nil, err
 		}
+		user.BonusBalance.Currency = user.Balance.Currency
 
 		users = append(users, user)
 	}
@@ -177,31 +248,93 @@ func (q *Queries) UserDelete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (q *Queries) UserBalanceUpdate(ctx context.Context, id uuid.UUID, newBalance float64) (types.User, error) {
-	query := `UPDATE users
-			SET balance = balance + $1
-			WHERE id = $2 
-			RETURNING 
-				id, 
-				email, 
-				name, 
-				role, 
-				balance, 
-				created, 
-				updated`
+func (q *Queries) UserBalanceUpdate(ctx context.Context, entry types.LedgerEntry) (types.User, error) {
+	return q.userBalanceUpdate(ctx, entry, "")
+}
+
+// UserBalanceWithdraw books the entry only when it leaves neither balance of
+// the user negative. The balances are checked by the update itself, so
+// concurrent withdrawals cannot overdraw them. It returns pgx.ErrNoRows when
+// the user does not exist or cannot cover the entry.
+func (q *Queries) UserBalanceWithdraw(ctx context.Context, entry types.LedgerEntry) (types.User, error) {
+	return q.userBalanceUpdate(ctx, entry, "AND balance + $10 >= 0 AND bonus_balance + $11 >= 0")
+}
+
+// userBalanceUpdate applies the entry to the balances of the user matching
+// condition and records it in the ledger.
+func (q *Queries) userBalanceUpdate(ctx context.Context, entry types.LedgerEntry, condition string) (types.User, error) {
+	query := fmt.Sprintf(`WITH updated AS (
+			UPDATE users
+				SET balance = balance + $10,
+					bonus_balance = bonus_balance + $11
+				WHERE id = $2 %s
+				RETURNING
+					id,
+					email,
+					name,
+					role,
+					balance,
+					bonus_balance,
+					loyalty_points,
+					referral_code,
+					currency,
+					created,
+					updated
+		),
+		entry AS (
+			INSERT INTO ledger_entries (
+				id,
+				user_id,
+				debit_account,
+				credit_account,
+				amount,
+				currency,
+				source,
+				reference_id,
+				created
+			)
+			SELECT $1::UUID, id, $3::TEXT, $4::TEXT, $5::DECIMAL, $6::CHAR(3), $7::TEXT, $8::UUID, $9::TIMESTAMPTZ
+			FROM updated
+		)
+		SELECT
+			id,
+			email,
+			name,
+			role,
+			balance,
+			bonus_balance,
+			loyalty_points,
+			referral_code,
+			currency,
+			created,
+			updated
+		FROM updated`, condition)
 
 	var user types.User
 	err := q.db.QueryRow(
 		ctx,
 		query,
-		newBalance,
-		id,
+		entry.ID,
+		entry.UserID,
+		entry.DebitAccount,
+		entry.CreditAccount,
+		entry.Amount.Amount,
+		entry.Amount.Currency,
+		entry.Source,
+		entry.ReferenceID,
+		entry.Created,
+		entry.Delta(types.LedgerAccountPlayerCash).Amount,
+		entry.Delta(types.LedgerAccountPlayerBonus).Amount,
 	).Scan(
 		&user.ID,
 		&user.Email,
 		&user.Name,
 		&user.Role,
-		&user.Balance,
+		&user.Balance.Amount,
+		&user.BonusBalance.Amount,
+		&user.LoyaltyPoints,
+		&user.ReferralCode,
+		&user.Balance.Currency,
 		&user.Created,
 		&user.Updated,
 	)
@@ -212,6 +345,53 @@ func (q *Queries) UserBalanceUpdate(ctx context.Context, id uuid.UUID, newBalanc
 		}
 		return user, err
 	}
+	user.BonusBalance.Currency = user.Balance.Currency
 
 	return user, nil
 }
+
+// GetEligibilityProfile returns what the eligibility rules of promotions are
+// checked against. Deposits are the manual credits to the player's cash.
+func (q *Queries) GetEligibilityProfile(ctx context.Context, userID uuid.UUID) (types.EligibilityProfile, error) {
+	var (
+		profile types.EligibilityProfile
+		query   = `
+		SELECT
+			u.id,
+			u.created,
+			u.tier_id,
+			COALESCE(u.country, ''),
+			COALESCE((
+				SELECT SUM(l.amount)
+				FROM ledger_entries l
+				WHERE l.user_id = u.id
+					AND l.source = $2
+					AND l.credit_account = $3
+					AND l.currency = u.currency
+			), 0),
+			u.currency,
+			ARRAY(
+				SELECT DISTINCT up.promotion_id
+				FROM users_promotions up
+				WHERE up.user_id = u.id
+			)
+		FROM users u
+		WHERE u.id = $1`
+	)
+
+	err := q.db.QueryRow(ctx, query,
+		userID,
+		types.LedgerSourceManual,
+		types.LedgerAccountPlayerCash,
+	).Scan(
+		&profile.UserID,
+		&profile.Created,
+		&profile.TierID,
+		&profile.Country,
+		&profile.TotalDeposits.Amount,
+		&profile.TotalDeposits.Currency,
+		&profile.PromotionIDs,
+	)
+
+	return profile, err
+}
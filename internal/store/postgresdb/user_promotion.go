@@ -2,9 +2,11 @@ package postgresdb
 
 import (
 	"context"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 func (q *Queries) AddPromotion(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error) {
@@ -14,38 +16,203 @@ func (q *Queries) AddPromotion(ctx context.Context, userPromotion types.UserProm
 			user_id,
 			promotion_id,
 			claimed,
+			bonus_amount,
 			start_date,
 			end_date
-		) VALUES ($1, $2, $3, $4, $5, $6)`
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)`
 
 	_, err := q.db.Exec(ctx, query,
 		&userPromotion.ID,
 		&userPromotion.UserID,
 		&userPromotion.PromotionID,
 		&userPromotion.Claimed,
+		&userPromotion.BonusAmount.Amount,
 		&userPromotion.StartDate,
 		&userPromotion.EndDate,
 	)
+	userPromotion.Status = types.UserPromotionAssigned
 
 	return userPromotion, err
 }
 
-func (q *Queries) ClaimPromotion(ctx context.Context, userPromotionID uuid.UUID) error {
-	query := `UPDATE users_promotions SET claimed = now() WHERE id = $1`
+func (q *Queries) ClaimPromotion(ctx context.Context, userPromotion types.UserPromotion) error {
+	query := `
+		UPDATE users_promotions SET
+			status = 'claimed',
+			claimed = now(),
+			bonus_amount = $2,
+			wagering_required = $3,
+			converted = $4,
+			deposit_id = $5
+		WHERE id = $1 AND status = 'assigned'`
 
-	_, err := q.db.Exec(ctx, query, userPromotionID)
+	res, err := q.db.Exec(ctx, query,
+		userPromotion.ID,
+		userPromotion.BonusAmount.Amount,
+		userPromotion.WageringRequired.Amount,
+		userPromotion.Converted,
+		userPromotion.DepositID,
+	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if res.RowsAffected() == 0 {
+		return types.ErrPromotionClaimed
+	}
+
+	return nil
 }
 
 func (q *Queries) DeleteUserPromotion(ctx context.Context, userPromotionID uuid.UUID) error {
-	query := `DELETE FROM users_promotions WHERE id = $1 LIMIT 1`
+	query := `DELETE FROM users_promotions WHERE id = $1`
+
+	res, err := q.db.Exec(ctx, query, &userPromotionID)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
 
-	_, err := q.db.Exec(ctx, query, &userPromotionID)
+	return nil
+}
+
+// UserPromotionRevoke revokes the user promotion when it is still in status
+// and returns what it credited at the time it is revoked. It returns
+// pgx.ErrNoRows when the status changed.
+func (q *Queries) UserPromotionRevoke(ctx context.Context, userPromotionID uuid.UUID, status types.UserPromotionStatus) (types.UserPromotion, error) {
+	var (
+		userPromotion types.UserPromotion
+		query         = `
+		UPDATE users_promotions up SET status = 'revoked'
+		FROM promotions p
+		WHERE p.id = up.promotion_id
+			AND up.id = $1
+			AND up.status = $2
+		RETURNING
+			up.id,
+			up.user_id,
+			up.promotion_id,
+			up.bonus_amount,
+			p.currency,
+			up.converted`
+	)
+
+	err := q.db.QueryRow(ctx, query, userPromotionID, status).Scan(
+		&userPromotion.ID,
+		&userPromotion.UserID,
+		&userPromotion.PromotionID,
+		&userPromotion.BonusAmount.Amount,
+		&userPromotion.BonusAmount.Currency,
+		&userPromotion.Converted,
+	)
+	userPromotion.Status = types.UserPromotionRevoked
+
+	return userPromotion, err
+}
+
+func (q *Queries) UserPromotionRevocationCreate(ctx context.Context, revocation types.UserPromotionRevocation) error {
+	query := `
+		INSERT INTO user_promotion_revocations (
+			id,
+			user_promotion_id,
+			user_id,
+			promotion_id,
+			revoked_by,
+			reason,
+			note,
+			previous_status,
+			credited,
+			clawed_back,
+			currency,
+			account,
+			policy,
+			created
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, ''), $13, $14)`
+
+	_, err := q.db.Exec(ctx, query,
+		revocation.ID,
+		revocation.UserPromotionID,
+		revocation.UserID,
+		revocation.PromotionID,
+		revocation.RevokedBy,
+		revocation.Reason,
+		revocation.Note,
+		revocation.PreviousStatus,
+		revocation.Credited.Amount,
+		revocation.ClawedBack.Amount,
+		revocation.Credited.Currency,
+		revocation.Account,
+		revocation.Policy,
+		revocation.Created,
+	)
 
 	return err
 }
 
+// GetUserPromotionRevocations returns the revocations of the user's
+// promotions, latest first.
+func (q *Queries) GetUserPromotionRevocations(ctx context.Context, userID uuid.UUID) ([]types.UserPromotionRevocation, error) {
+	var (
+		revocations []types.UserPromotionRevocation
+		query       = `
+		SELECT
+			id,
+			user_promotion_id,
+			user_id,
+			promotion_id,
+			revoked_by,
+			reason,
+			note,
+			previous_status,
+			credited,
+			clawed_back,
+			currency,
+			COALESCE(account, ''),
+			policy,
+			created
+		FROM user_promotion_revocations
+		WHERE user_id = $1
+		ORDER BY created DESC`
+	)
+
+	rows, err := q.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var revocation types.UserPromotionRevocation
+		err := rows.Scan(
+			&revocation.ID,
+			&revocation.UserPromotionID,
+			&revocation.UserID,
+			&revocation.PromotionID,
+			&revocation.RevokedBy,
+			&revocation.Reason,
+			&revocation.Note,
+			&revocation.PreviousStatus,
+			&revocation.Credited.Amount,
+			&revocation.ClawedBack.Amount,
+			&revocation.Credited.Currency,
+			&revocation.Account,
+			&revocation.Policy,
+			&revocation.Created,
+		)
+		if err != nil {
+			return nil, err
+		}
+		revocation.ClawedBack.Currency = revocation.Credited.Currency
+
+		revocations = append(revocations, revocation)
+	}
+
+	return revocations, rows.Err()
+}
+
 func (q *Queries) GetUserPromotionByID(ctx context.Context, userPromotionID uuid.UUID) (types.UserPromotion, error) {
 	var (
 		userPromotion types.UserPromotion
@@ -53,15 +220,32 @@ func (q *Queries) GetUserPromotionByID(ctx context.Context, userPromotionID uuid
 			up.id,
 			up.user_id,
 			up.promotion_id,
+			up.status,
 			up.claimed,
+			up.bonus_amount,
+			up.wagering_required,
+			up.wagered,
+			p.currency,
+			up.converted,
+			up.forfeited,
+			up.deposit_id,
 			up.start_date,
 			up.end_date,
 			json_build_object(
 				'id', p.id,
 				'title', p.title,
 				'description', p.description,
-				'amount', p.amount,
+				'amount', json_build_object('amount', p.amount, 'currency', p.currency),
 				'is_active', p.is_active,
+				'type', p.type,
+				'wagering_multiplier', p.wagering_multiplier,
+				'cashback', p.cashback,
+				'match_bonus', p.match_bonus,
+				'free_spins', p.free_spins,
+				'eligibility', p.eligibility,
+				'available_from', p.available_from,
+				'available_until', p.available_until,
+				'archived', p.archived,
 				'created', p.created,
 				'updated', p.updated
 			) as promotion
@@ -75,40 +259,75 @@ func (q *Queries) GetUserPromotionByID(ctx context.Context, userPromotionID uuid
 		&userPromotion.ID,
 		&userPromotion.UserID,
 		&userPromotion.PromotionID,
+		&userPromotion.Status,
 		&userPromotion.Claimed,
+		&userPromotion.BonusAmount.Amount,
+		&userPromotion.WageringRequired.Amount,
+		&userPromotion.Wagered.Amount,
+		&userPromotion.BonusAmount.Currency,
+		&userPromotion.Converted,
+		&userPromotion.Forfeited,
+		&userPromotion.DepositID,
 		&userPromotion.StartDate,
 		&userPromotion.EndDate,
 		&userPromotion.Promotion,
 	)
+	userPromotion.WageringRequired.Currency = userPromotion.BonusAmount.Currency
+	userPromotion.Wagered.Currency = userPromotion.BonusAmount.Currency
 
 	return userPromotion, err
 }
 
-func (q *Queries) GetUserPromotions(ctx context.Context, userPromotionID uuid.UUID) ([]types.UserPromotion, error) {
+// GetUserPromotions returns the promotions of the user, only those in the
+// status of the filter when it is set.
+func (q *Queries) GetUserPromotions(ctx context.Context, userID uuid.UUID, filter types.UserPromotionFilter) ([]types.UserPromotion, error) {
 	var (
 		userPromotions []types.UserPromotion
 		query          = `SELECT 
 			up.id,
 			up.user_id,
 			up.promotion_id,
+			up.status,
 			up.claimed,
+			up.bonus_amount,
+			up.wagering_required,
+			up.wagered,
+			p.currency,
+			up.converted,
+			up.forfeited,
+			up.deposit_id,
 			up.start_date,
 			up.end_date,
 			json_build_object(
 				'id', p.id,
 				'title', p.title,
 				'description', p.description,
-				'amount', p.amount,
+				'amount', json_build_object('amount', p.amount, 'currency', p.currency),
 				'is_active', p.is_active,
+				'type', p.type,
+				'wagering_multiplier', p.wagering_multiplier,
+				'cashback', p.cashback,
+				'match_bonus', p.match_bonus,
+				'free_spins', p.free_spins,
+				'eligibility', p.eligibility,
+				'available_from', p.available_from,
+				'available_until', p.available_until,
+				'archived', p.archived,
 				'created', p.created,
 				'updated', p.updated
 			) as promotion
 			FROM users_promotions up
 			INNER JOIN promotions p on p.id = up.promotion_id
 			WHERE up.user_id = $1`
+		args = []any{userID}
 	)
 
-	rows, err := q.db.Query(ctx, query, userPromotionID)
+	if filter.Status != nil {
+		query += " AND up.status = $2"
+		args = append(args, *filter.Status)
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
 	if err != nil {
 		return []types.UserPromotion{}, err
 	}
@@ -120,12 +339,322 @@ func (q *Queries) GetUserPromotions(ctx context.Context, userPromotionID uuid.UU
 			&userPromotion.ID,
 			&userPromotion.UserID,
 			&userPromotion.PromotionID,
+			&userPromotion.Status,
 			&userPromotion.Claimed,
+			&userPromotion.BonusAmount.Amount,
+			&userPromotion.WageringRequired.Amount,
+			&userPromotion.Wagered.Amount,
+			&userPromotion.BonusAmount.Currency,
+			&userPromotion.Converted,
+			&userPromotion.Forfeited,
+			&userPromotion.DepositID,
 			&userPromotion.StartDate,
 			&userPromotion.EndDate,
 			&userPromotion.Promotion,
 		)
 
+		if err != nil {
+			return nil, err
+		}
+		userPromotion.WageringRequired.Currency = userPromotion.BonusAmount.Currency
+		userPromotion.Wagered.Currency = userPromotion.BonusAmount.Currency
+
+		userPromotions = append(userPromotions, userPromotion)
+	}
+
+	return userPromotions, rows.Err()
+}
+
+// UserPromotionsWager adds amount to the wagered total of the user's claimed
+// bonuses in the same currency that are still being wagered and records what
+// the bet betID added to each of them. Free spins have no requirement until
+// their winnings are settled. A bet is counted once.
+func (q *Queries) UserPromotionsWager(ctx context.Context, userID uuid.UUID, betID uuid.UUID, amount types.Money) ([]types.UserPromotion, error) {
+	var (
+		userPromotions []types.UserPromotion
+		query          = `
+		WITH eligible AS (
+			SELECT
+				up.id,
+				LEAST($2, up.wagering_required - up.wagered) AS amount
+			FROM users_promotions up
+			JOIN promotions p ON p.id = up.promotion_id
+			WHERE up.user_id = $1
+				AND p.currency = $3
+				AND up.status = 'claimed'
+				AND up.converted IS NULL
+				AND up.wagering_required > 0
+				AND up.end_date > now()
+			FOR UPDATE OF up
+		), wagers AS (
+			INSERT INTO user_promotion_wagers (user_promotion_id, game_event_id, amount)
+			SELECT id, $4, amount FROM eligible WHERE amount > 0
+			ON CONFLICT DO NOTHING
+			RETURNING user_promotion_id, amount
+		)
+		UPDATE users_promotions up SET
+			wagered = up.wagered + w.amount
+		FROM wagers w, promotions p
+		WHERE up.id = w.user_promotion_id
+			AND p.id = up.promotion_id
+		RETURNING
+			up.id,
+			up.user_id,
+			up.promotion_id,
+			up.bonus_amount,
+			up.wagering_required,
+			up.wagered,
+			p.currency`
+	)
+
+	rows, err := q.db.Query(ctx, query, userID, amount.Amount, amount.Currency, betID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		userPromotion, err := scanUserPromotionBonus(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		userPromotions = append(userPromotions, userPromotion)
+	}
+
+	return userPromotions, rows.Err()
+}
+
+// UserPromotionsUnwager takes what the bet betID added off the wagered total
+// of the user's bonuses that are still being wagered and returns how many
+// bonuses changed. Converted or settled bonuses keep their total, and a bet
+// is taken off once.
+func (q *Queries) UserPromotionsUnwager(ctx context.Context, userID uuid.UUID, betID uuid.UUID) (int, error) {
+	query := `
+		WITH reversed AS (
+			UPDATE user_promotion_wagers w SET
+				reversed = now()
+			FROM users_promotions up
+			WHERE up.id = w.user_promotion_id
+				AND w.game_event_id = $2
+				AND w.reversed IS NULL
+				AND up.user_id = $1
+				AND up.status = 'claimed'
+				AND up.converted IS NULL
+			RETURNING w.user_promotion_id, w.amount
+		)
+		UPDATE users_promotions up SET
+			wagered = GREATEST(up.wagered - r.amount, 0)
+		FROM reversed r
+		WHERE up.id = r.user_promotion_id`
+
+	res, err := q.db.Exec(ctx, query, userID, betID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.RowsAffected()), nil
+}
+
+func (q *Queries) UserPromotionConvert(ctx context.Context, userPromotionID uuid.UUID) error {
+	query := `
+		UPDATE users_promotions SET converted = now()
+		WHERE id = $1
+			AND status = 'claimed'
+			AND converted IS NULL
+			AND wagered >= wagering_required`
+
+	res, err := q.db.Exec(ctx, query, userPromotionID)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// UserPromotionSettle sets the bonus of claimed free spins once their
+// winnings are known, along with its wagering requirement and the end of the
+// wagering.
+func (q *Queries) UserPromotionSettle(ctx context.Context, userPromotion types.UserPromotion) error {
+	query := `
+		UPDATE users_promotions SET
+			bonus_amount = $2,
+			wagering_required = $3,
+			converted = $4,
+			end_date = $5
+		WHERE id = $1
+			AND status = 'claimed'
+			AND converted IS NULL
+			AND wagering_required = 0`
+
+	res, err := q.db.Exec(ctx, query,
+		userPromotion.ID,
+		userPromotion.BonusAmount.Amount,
+		userPromotion.WageringRequired.Amount,
+		userPromotion.Converted,
+		userPromotion.EndDate,
+	)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+func (q *Queries) UserPromotionForfeit(ctx context.Context, userPromotionID uuid.UUID) error {
+	query := `
+		UPDATE users_promotions SET
+			status = 'forfeited',
+			forfeited = now()
+		WHERE id = $1
+			AND status = 'claimed'
+			AND converted IS NULL`
+
+	res, err := q.db.Exec(ctx, query, userPromotionID)
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// UserPromotionsExpire marks the assigned promotions that were not claimed
+// before their end date as expired and returns how many expired.
+func (q *Queries) UserPromotionsExpire(ctx context.Context, now time.Time) (int, error) {
+	query := `
+		UPDATE users_promotions SET status = 'expired'
+		WHERE status = 'assigned'
+			AND end_date <= $1`
+
+	res, err := q.db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.RowsAffected()), nil
+}
+
+// UserPromotionsRemind records a reminder for the assigned promotions that
+// started and end within leadTime of now, and returns them. A promotion is
+// reminded once per lead time, and not at all when a reminder for a shorter
+// lead time was already sent.
+func (q *Queries) UserPromotionsRemind(ctx context.Context, now time.Time, leadTime time.Duration) ([]types.PromotionExpiryReminder, error) {
+	var (
+		reminders []types.PromotionExpiryReminder
+		query     = `
+		WITH reminded AS (
+			INSERT INTO user_promotion_reminders (user_promotion_id, lead_seconds)
+			SELECT up.id, $3
+			FROM users_promotions up
+			WHERE up.status = 'assigned'
+				AND up.start_date <= $1
+				AND up.end_date > $1
+				AND up.end_date <= $2
+				AND NOT EXISTS (
+					SELECT 1
+					FROM user_promotion_reminders r
+					WHERE r.user_promotion_id = up.id AND r.lead_seconds <= $3
+				)
+			ON CONFLICT DO NOTHING
+			RETURNING user_promotion_id
+		)
+		SELECT
+			up.user_id,
+			up.id,
+			up.promotion_id,
+			p.title,
+			up.end_date
+		FROM reminded
+		INNER JOIN users_promotions up ON up.id = reminded.user_promotion_id
+		INNER JOIN promotions p ON p.id = up.promotion_id`
+	)
+
+	rows, err := q.db.Query(ctx, query, now, now.Add(leadTime), int64(leadTime.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reminder types.PromotionExpiryReminder
+		err := rows.Scan(
+			&reminder.UserID,
+			&reminder.UserPromotionID,
+			&reminder.PromotionID,
+			&reminder.Title,
+			&reminder.EndDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		reminders = append(reminders, reminder)
+	}
+
+	return reminders, rows.Err()
+}
+
+// UserPromotionClaimCount returns how often the user claimed the promotion.
+func (q *Queries) UserPromotionClaimCount(ctx context.Context, userID uuid.UUID, promotionID uuid.UUID) (int, error) {
+	var (
+		count int
+		query = `
+		SELECT COUNT(*)
+		FROM users_promotions
+		WHERE user_id = $1
+			AND promotion_id = $2
+			AND claimed IS NOT NULL`
+	)
+
+	err := q.db.QueryRow(ctx, query, userID, promotionID).Scan(&count)
+
+	return count, err
+}
+
+// GetExpiredUserPromotionBonuses returns claimed bonuses whose wagering was not
+// completed before the user promotion ended.
+func (q *Queries) GetExpiredUserPromotionBonuses(ctx context.Context, before time.Time, limit int) ([]types.UserPromotion, error) {
+	var (
+		userPromotions []types.UserPromotion
+		query          = `
+		SELECT
+			up.id,
+			up.user_id,
+			up.promotion_id,
+			up.bonus_amount,
+			up.wagering_required,
+			up.wagered,
+			p.currency
+		FROM users_promotions up
+		INNER JOIN promotions p ON p.id = up.promotion_id
+		WHERE up.status = 'claimed'
+			AND up.converted IS NULL
+			AND up.wagering_required > 0
+			AND up.end_date <= $1
+		ORDER BY up.end_date
+		LIMIT $2`
+	)
+
+	rows, err := q.db.Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		userPromotion, err := scanUserPromotionBonus(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -135,3 +664,20 @@ func (q *Queries) GetUserPromotions(ctx context.Context, userPromotionID uuid.UU
 
 	return userPromotions, rows.Err()
 }
+
+func scanUserPromotionBonus(rows pgx.Rows) (types.UserPromotion, error) {
+	var userPromotion types.UserPromotion
+	err := rows.Scan(
+		&userPromotion.ID,
+		&userPromotion.UserID,
+		&userPromotion.PromotionID,
+		&userPromotion.BonusAmount.Amount,
+		&userPromotion.WageringRequired.Amount,
+		&userPromotion.Wagered.Amount,
+		&userPromotion.BonusAmount.Currency,
+	)
+	userPromotion.WageringRequired.Currency = userPromotion.BonusAmount.Currency
+	userPromotion.Wagered.Currency = userPromotion.BonusAmount.Currency
+
+	return userPromotion, err
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/store/postgresdb"
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -35,24 +37,26 @@ func TestGetUserBy(t *testing.T) {
 	tm := time.Now()
 
 	usr1 := types.User{
-		ID:       ID,
-		Name:     "John1",
-		Email:    "john1@example.com",
-		Password: "password",
-		Role:     1,
-		Balance:  0,
-		Created:  tm,
-		Updated:  tm,
+		ID:           ID,
+		Name:         "John1",
+		Email:        "john1@example.com",
+		Password:     "password",
+		Role:         1,
+		Balance:      types.NewMoney(decimal.Zero, types.EUR),
+		BonusBalance: types.NewMoney(decimal.Zero, types.EUR),
+		Created:      tm,
+		Updated:      tm,
 	}
 	usr2 := types.User{
-		ID:       ID2,
-		Name:     "John2",
-		Email:    "john2@example.com",
-		Password: "password",
-		Role:     1,
-		Balance:  0,
-		Created:  tm,
-		Updated:  tm,
+		ID:           ID2,
+		Name:         "John2",
+		Email:        "john2@example.com",
+		Password:     "password",
+		Role:         1,
+		Balance:      types.NewMoney(decimal.Zero, types.EUR),
+		BonusBalance: types.NewMoney(decimal.Zero, types.EUR),
+		Created:      tm,
+		Updated:      tm,
 	}
 
 	_, err = testDB.Exec(ctx, `
@@ -110,3 +114,45 @@ func TestGetUserBy(t *testing.T) {
 		})
 	}
 }
+
+func TestUserBalanceWithdraw(t *testing.T) {
+	defer truncate()
+
+	log, err := zap.NewDevelopment()
+	require.NoError(t, err)
+
+	var (
+		ctx = context.Background()
+
+		databaseManager = postgresdb.New(log.Sugar(), testDB)
+	)
+
+	ID, err := uuid.Parse("c94e17df-ce34-4196-a8ca-5497e478d95d")
+	require.NoError(t, err)
+
+	_, err = testDB.Exec(ctx, `
+		INSERT INTO users (
+		id,
+		name,
+		email,
+		password,
+		balance
+	) VALUES ($1, 'John1', 'john1@example.com', 'password', 10)`, ID)
+	require.NoError(t, err)
+
+	withdrawal := func() types.LedgerEntry {
+		return types.NewPlayerCashEntry(ID, types.LedgerSourceManual, uuid.NullUUID{}, types.NewMoney(decimal.NewFromInt(-6), types.EUR))
+	}
+
+	user, err := databaseManager.UserBalanceWithdraw(ctx, withdrawal())
+	require.NoError(t, err)
+	require.True(t, user.Balance.Amount.Equal(decimal.NewFromInt(4)))
+
+	_, err = databaseManager.UserBalanceWithdraw(ctx, withdrawal())
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+
+	var entries int
+	err = testDB.QueryRow(ctx, `SELECT COUNT(*) FROM ledger_entries WHERE user_id = $1`, ID).Scan(&entries)
+	require.NoError(t, err)
+	require.Equal(t, 1, entries)
+}
@@ -0,0 +1,290 @@
+package postgresdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// WelcomePackageCreate stores the package as the version after the current
+// one. Versions created concurrently conflict on their number.
+func (q *Queries) WelcomePackageCreate(ctx context.Context, welcomePackage types.WelcomePackage) (types.WelcomePackage, error) {
+	query := `
+		INSERT INTO welcome_packages (
+			id,
+			version,
+			steps,
+			created_by
+		) VALUES ($1, (SELECT COALESCE(MAX(version), 0) + 1 FROM welcome_packages), $2, $3)
+		RETURNING version, created`
+
+	err := q.db.QueryRow(ctx, query,
+		welcomePackage.ID,
+		welcomePackage.Steps,
+		welcomePackage.CreatedBy,
+	).Scan(
+		&welcomePackage.Version,
+		&welcomePackage.Created,
+	)
+
+	return welcomePackage, err
+}
+
+// WelcomePackageGetCurrent returns the latest version of the welcome
+// package.
+func (q *Queries) WelcomePackageGetCurrent(ctx context.Context) (types.WelcomePackage, error) {
+	query := `
+		SELECT
+			id,
+			version,
+			steps,
+			created_by,
+			created
+		FROM welcome_packages
+		ORDER BY version DESC
+		LIMIT 1`
+
+	return scanWelcomePackage(q.db.QueryRow(ctx, query))
+}
+
+// GetWelcomePackages returns every version of the welcome package, latest
+// first.
+func (q *Queries) GetWelcomePackages(ctx context.Context) ([]types.WelcomePackage, error) {
+	var (
+		welcomePackages []types.WelcomePackage
+		query           = `
+		SELECT
+			id,
+			version,
+			steps,
+			created_by,
+			created
+		FROM welcome_packages
+		ORDER BY version DESC`
+	)
+
+	rows, err := q.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		welcomePackage, err := scanWelcomePackage(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		welcomePackages = append(welcomePackages, welcomePackage)
+	}
+
+	return welcomePackages, rows.Err()
+}
+
+// UserWelcomePackageCreate starts the user on the welcome package. It
+// returns false when the user already started one.
+func (q *Queries) UserWelcomePackageCreate(ctx context.Context, userID uuid.UUID, packageID uuid.UUID) (bool, error) {
+	query := `
+		INSERT INTO user_welcome_packages (
+			user_id,
+			package_id
+		) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO NOTHING`
+
+	tag, err := q.db.Exec(ctx, query, userID, packageID)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UserWelcomePackageGet returns the welcome package the user started with
+// the progress of each of its steps.
+func (q *Queries) UserWelcomePackageGet(ctx context.Context, userID uuid.UUID) (types.UserWelcomePackage, error) {
+	var (
+		userPackage types.UserWelcomePackage
+		query       = `
+		SELECT
+			uwp.user_id,
+			uwp.package_id,
+			wp.version,
+			uwp.created
+		FROM user_welcome_packages uwp
+		INNER JOIN welcome_packages wp ON wp.id = uwp.package_id
+		WHERE uwp.user_id = $1`
+		stepsQuery = `
+		SELECT
+			s.step,
+			s.promotion_id,
+			s.unlock,
+			COALESCE(s.deposits, 0),
+			s.validity_hours,
+			ws.user_promotion_id,
+			ws.unlocked
+		FROM user_welcome_packages uwp
+		INNER JOIN welcome_packages wp ON wp.id = uwp.package_id
+		CROSS JOIN LATERAL jsonb_to_recordset(wp.steps) AS s(
+			step INTEGER,
+			promotion_id UUID,
+			unlock TEXT,
+			deposits INTEGER,
+			validity_hours INTEGER
+		)
+		LEFT JOIN user_welcome_steps ws ON ws.user_id = uwp.user_id AND ws.step = s.step
+		WHERE uwp.user_id = $1
+		ORDER BY s.step`
+	)
+
+	err := q.db.QueryRow(ctx, query, userID).Scan(
+		&userPackage.UserID,
+		&userPackage.PackageID,
+		&userPackage.Version,
+		&userPackage.Created,
+	)
+	if err != nil {
+		return types.UserWelcomePackage{}, err
+	}
+
+	rows, err := q.db.Query(ctx, stepsQuery, userID)
+	if err != nil {
+		return types.UserWelcomePackage{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var step types.UserWelcomeStep
+		err := rows.Scan(
+			&step.Step,
+			&step.PromotionID,
+			&step.Unlock,
+			&step.Deposits,
+			&step.ValidityHours,
+			&step.UserPromotionID,
+			&step.Unlocked,
+		)
+		if err != nil {
+			return types.UserWelcomePackage{}, err
+		}
+
+		userPackage.Steps = append(userPackage.Steps, step)
+	}
+
+	return userPackage, rows.Err()
+}
+
+// GetUnlockedWelcomeSteps returns up to limit deposit steps of the players'
+// welcome packages that were not unlocked yet although the player made the
+// deposit that unlocks them, earliest deposit first. Deposits are the manual
+// credits to the player's cash since they started the package.
+func (q *Queries) GetUnlockedWelcomeSteps(ctx context.Context, limit int) ([]types.UnlockedWelcomeStep, error) {
+	var (
+		steps []types.UnlockedWelcomeStep
+		query = `
+		SELECT
+			uwp.user_id,
+			s.step,
+			s.promotion_id,
+			s.unlock,
+			s.deposits,
+			s.validity_hours,
+			d.created
+		FROM user_welcome_packages uwp
+		INNER JOIN welcome_packages wp ON wp.id = uwp.package_id
+		CROSS JOIN LATERAL jsonb_to_recordset(wp.steps) AS s(
+			step INTEGER,
+			promotion_id UUID,
+			unlock TEXT,
+			deposits INTEGER,
+			validity_hours INTEGER
+		)
+		INNER JOIN LATERAL (
+			SELECT l.created
+			FROM ledger_entries l
+			WHERE l.user_id = uwp.user_id
+				AND l.source = $2
+				AND l.credit_account = $3
+				AND l.created >= uwp.created
+			ORDER BY l.created, l.id
+			OFFSET s.deposits - 1
+			LIMIT 1
+		) d ON TRUE
+		WHERE s.unlock = $1
+			AND NOT EXISTS (
+				SELECT 1
+				FROM user_welcome_steps ws
+				WHERE ws.user_id = uwp.user_id AND ws.step = s.step
+			)
+		ORDER BY d.created
+		LIMIT $4`
+	)
+
+	rows, err := q.db.Query(ctx, query,
+		types.WelcomeUnlockDeposit,
+		types.LedgerSourceManual,
+		types.LedgerAccountPlayerCash,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var step types.UnlockedWelcomeStep
+		err := rows.Scan(
+			&step.UserID,
+			&step.Step,
+			&step.PromotionID,
+			&step.Unlock,
+			&step.Deposits,
+			&step.ValidityHours,
+			&step.Unlocked,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, rows.Err()
+}
+
+// UserWelcomeStepCreate records the step of the user's welcome package as
+// unlocked with the promotion that was assigned, if any. It returns false
+// when the step was already unlocked.
+func (q *Queries) UserWelcomeStepCreate(ctx context.Context, userID uuid.UUID, step int, userPromotionID uuid.NullUUID, unlocked time.Time) (bool, error) {
+	query := `
+		INSERT INTO user_welcome_steps (
+			user_id,
+			step,
+			user_promotion_id,
+			unlocked
+		) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, step) DO NOTHING`
+
+	tag, err := q.db.Exec(ctx, query, userID, step, userPromotionID, unlocked)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanWelcomePackage(row pgx.Row) (types.WelcomePackage, error) {
+	var welcomePackage types.WelcomePackage
+	err := row.Scan(
+		&welcomePackage.ID,
+		&welcomePackage.Version,
+		&welcomePackage.Steps,
+		&welcomePackage.CreatedBy,
+		&welcomePackage.Created,
+	)
+
+	return welcomePackage, err
+}
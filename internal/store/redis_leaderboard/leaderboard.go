@@ -0,0 +1,70 @@
+package redis_leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func New(client *redis.Client) *Leaderboard {
+	return &Leaderboard{client: client}
+}
+
+type Leaderboard struct {
+	client *redis.Client
+}
+
+func (l *Leaderboard) LeaderboardIncrement(ctx context.Context, key string, userID uuid.UUID, score float64) error {
+	return l.client.ZIncrBy(ctx, key, score, userID.String()).Err()
+}
+
+// LeaderboardMax sets the score of the user when it is higher than the
+// current one.
+func (l *Leaderboard) LeaderboardMax(ctx context.Context, key string, userID uuid.UUID, score float64) error {
+	return l.client.ZAddGT(ctx, key, redis.Z{Score: score, Member: userID.String()}).Err()
+}
+
+func (l *Leaderboard) LeaderboardTop(ctx context.Context, key string, limit int) ([]types.LeaderboardEntry, error) {
+	members, err := l.client.ZRevRangeWithScores(ctx, key, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]types.LeaderboardEntry, 0, len(members))
+	for i, member := range members {
+		userID, err := uuid.Parse(member.Member.(string))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, types.LeaderboardEntry{
+			Rank:   i + 1,
+			UserID: userID,
+			Score:  member.Score,
+		})
+	}
+
+	return entries, nil
+}
+
+// LeaderboardRank returns the standing of the user. It returns redis.Nil
+// when the user has no score.
+func (l *Leaderboard) LeaderboardRank(ctx context.Context, key string, userID uuid.UUID) (types.LeaderboardEntry, error) {
+	rank, err := l.client.ZRevRankWithScore(ctx, key, userID.String()).Result()
+	if err != nil {
+		return types.LeaderboardEntry{}, err
+	}
+
+	return types.LeaderboardEntry{
+		Rank:   int(rank.Rank) + 1,
+		UserID: userID,
+		Score:  rank.Score,
+	}, nil
+}
+
+func (l *Leaderboard) LeaderboardExpire(ctx context.Context, key string, ttl time.Duration) error {
+	return l.client.Expire(ctx, key, ttl).Err()
+}
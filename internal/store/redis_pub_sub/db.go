@@ -32,6 +32,8 @@ func (ps *PubSub) Subscribe(ctx context.Context, channel string) *redis.PubSub {
 }
 
 const (
-	NotificationsChannel = "notifications"
-	RegistrationChannel  = "registration"
+	NotificationsChannel      = "notifications"
+	StaffNotificationsChannel = "notifications:staff"
+	RegistrationChannel       = "registration"
+	GameEventsChannel         = "game_events"
 )
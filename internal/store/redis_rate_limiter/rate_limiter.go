@@ -0,0 +1,33 @@
+package redis_rate_limiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func New(client *redis.Client) *RateLimiter {
+	return &RateLimiter{client: client}
+}
+
+type RateLimiter struct {
+	client *redis.Client
+}
+
+// RateLimitHit counts an attempt under key and returns the attempts made in
+// the current window. The window starts with the first attempt.
+func (l *RateLimiter) RateLimitHit(ctx context.Context, key string, window time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+
+	_, err := l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/Jozzo6/casino_loyalty_reward_system/internal/types"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
 type Tx interface {
@@ -24,32 +26,173 @@ type UserManager interface {
 	UserGetBy(ctx context.Context, filter types.UserFilter) (types.User, error)
 	GetUsers(ctx context.Context) ([]types.User, error)
 	UserUpdate(ctx context.Context, user types.User) (types.User, error)
-	UserBalanceUpdate(ctx context.Context, id uuid.UUID, newBalance float64) (types.User, error)
+	UserBalanceUpdate(ctx context.Context, entry types.LedgerEntry) (types.User, error)
+	UserBalanceWithdraw(ctx context.Context, entry types.LedgerEntry) (types.User, error)
 	UserDelete(ctx context.Context, id uuid.UUID) error
+	GetEligibilityProfile(ctx context.Context, userID uuid.UUID) (types.EligibilityProfile, error)
+}
+
+type LedgerManager interface {
+	UserBalanceReconcile(ctx context.Context, userID uuid.UUID) (types.BalanceReconciliation, error)
+	UserBalanceRebuild(ctx context.Context, userID uuid.UUID) (types.User, error)
+	LedgerEntriesGet(ctx context.Context, filter types.LedgerFilter) ([]types.LedgerEntry, error)
+	GetUnmatchedDeposit(ctx context.Context, userID uuid.UUID, since time.Time, minimum types.Money) (types.LedgerEntry, error)
 }
 
 type PromotionManager interface {
 	PromotionCreate(ctx context.Context, promotion types.Promotion) (types.Promotion, error)
 	PromotionGetByID(ctx context.Context, uuid uuid.UUID) (types.Promotion, error)
 	PromotionGetByType(ctx context.Context, promotionType types.PromotionType) (types.Promotion, error)
-	GetPromotions(ctx context.Context) ([]types.Promotion, error)
+	GetPromotions(ctx context.Context, filter types.PromotionFilter) ([]types.Promotion, error)
 	PromotionUpdate(ctx context.Context, promotion types.Promotion) (types.Promotion, error)
-	PromotionDelete(ctx context.Context, id uuid.UUID) error
+	PromotionArchive(ctx context.Context, id uuid.UUID) error
+	PromotionRestore(ctx context.Context, id uuid.UUID) (types.Promotion, error)
+	PromotionSpend(ctx context.Context, id uuid.UUID, amount decimal.Decimal) (types.Promotion, error)
+	PromotionsApplySchedule(ctx context.Context, now time.Time) ([]types.PromotionStateChange, error)
+	PromotionStateChangeCreate(ctx context.Context, change types.PromotionStateChange) error
+	GetPromotionStateChanges(ctx context.Context, promotionID uuid.UUID) ([]types.PromotionStateChange, error)
 }
 
 type UserPromotionManager interface {
 	AddPromotion(ctx context.Context, userPromotion types.UserPromotion) (types.UserPromotion, error)
-	ClaimPromotion(ctx context.Context, userPromotionID uuid.UUID) error
-	GetUserPromotions(ctx context.Context, userID uuid.UUID) ([]types.UserPromotion, error)
+	ClaimPromotion(ctx context.Context, userPromotion types.UserPromotion) error
+	GetUserPromotions(ctx context.Context, userID uuid.UUID, filter types.UserPromotionFilter) ([]types.UserPromotion, error)
 	GetUserPromotionByID(ctx context.Context, userPromotionID uuid.UUID) (types.UserPromotion, error)
 	DeleteUserPromotion(ctx context.Context, userPromotionID uuid.UUID) error
+	UserPromotionsWager(ctx context.Context, userID uuid.UUID, betID uuid.UUID, amount types.Money) ([]types.UserPromotion, error)
+	UserPromotionsUnwager(ctx context.Context, userID uuid.UUID, betID uuid.UUID) (int, error)
+	UserPromotionConvert(ctx context.Context, userPromotionID uuid.UUID) error
+	UserPromotionForfeit(ctx context.Context, userPromotionID uuid.UUID) error
+	UserPromotionsExpire(ctx context.Context, now time.Time) (int, error)
+	UserPromotionsRemind(ctx context.Context, now time.Time, leadTime time.Duration) ([]types.PromotionExpiryReminder, error)
+	UserPromotionSettle(ctx context.Context, userPromotion types.UserPromotion) error
+	UserPromotionClaimCount(ctx context.Context, userID uuid.UUID, promotionID uuid.UUID) (int, error)
+	UserPromotionRevoke(ctx context.Context, userPromotionID uuid.UUID, status types.UserPromotionStatus) (types.UserPromotion, error)
+	UserPromotionRevocationCreate(ctx context.Context, revocation types.UserPromotionRevocation) error
+	GetUserPromotionRevocations(ctx context.Context, userID uuid.UUID) ([]types.UserPromotionRevocation, error)
+	GetExpiredUserPromotionBonuses(ctx context.Context, before time.Time, limit int) ([]types.UserPromotion, error)
+}
+
+type CashbackManager interface {
+	GetNetLosses(ctx context.Context, currency types.Currency, from time.Time, to time.Time) ([]types.CashbackCalculation, error)
+	CashbackCalculationCreate(ctx context.Context, calculation types.CashbackCalculation) (bool, error)
+	GetCashbackCalculations(ctx context.Context, userID uuid.UUID) ([]types.CashbackCalculation, error)
+}
+
+type FreeSpinsManager interface {
+	FreeSpinsCreate(ctx context.Context, freeSpins types.FreeSpinsEntitlement) error
+	FreeSpinsGetByID(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error)
+	GetActiveFreeSpins(ctx context.Context, userID uuid.UUID, gameID string) ([]types.FreeSpinsEntitlement, error)
+	GetExpiredFreeSpins(ctx context.Context, before time.Time, limit int) ([]types.FreeSpinsEntitlement, error)
+	FreeSpinRoundCreate(ctx context.Context, freeSpinsID uuid.UUID, spin types.FreeSpin) (bool, error)
+	FreeSpinsConsume(ctx context.Context, id uuid.UUID, win types.Money) (types.FreeSpinsEntitlement, error)
+	FreeSpinsSettle(ctx context.Context, id uuid.UUID) (types.FreeSpinsEntitlement, error)
+	FreeSpinsVoid(ctx context.Context, userPromotionID uuid.UUID) error
+}
+
+type PromotionCodeManager interface {
+	PromotionCodesCreate(ctx context.Context, codes []types.PromotionCode) error
+	GetPromotionCodes(ctx context.Context, promotionID uuid.UUID) ([]types.PromotionCode, error)
+	PromotionCodeGetByCode(ctx context.Context, code string) (types.PromotionCode, error)
+	PromotionCodeRedeem(ctx context.Context, id uuid.UUID) error
+	PromotionCodeRedemptionCreate(ctx context.Context, promotionCodeID uuid.UUID, userID uuid.UUID, userPromotionID uuid.UUID) (bool, error)
+}
+
+type WelcomePackageManager interface {
+	WelcomePackageCreate(ctx context.Context, welcomePackage types.WelcomePackage) (types.WelcomePackage, error)
+	WelcomePackageGetCurrent(ctx context.Context) (types.WelcomePackage, error)
+	GetWelcomePackages(ctx context.Context) ([]types.WelcomePackage, error)
+	UserWelcomePackageCreate(ctx context.Context, userID uuid.UUID, packageID uuid.UUID) (bool, error)
+	UserWelcomePackageGet(ctx context.Context, userID uuid.UUID) (types.UserWelcomePackage, error)
+	GetUnlockedWelcomeSteps(ctx context.Context, limit int) ([]types.UnlockedWelcomeStep, error)
+	UserWelcomeStepCreate(ctx context.Context, userID uuid.UUID, step int, userPromotionID uuid.NullUUID, unlocked time.Time) (bool, error)
+}
+
+type IdempotencyManager interface {
+	IdempotencyKeyCreate(ctx context.Context, key types.IdempotencyKey) (bool, error)
+	IdempotencyKeyGet(ctx context.Context, userID uuid.UUID, key string) (types.IdempotencyKey, error)
+	IdempotencyKeyComplete(ctx context.Context, key types.IdempotencyKey) error
+	IdempotencyKeyDelete(ctx context.Context, userID uuid.UUID, key string) error
+}
+
+type GameManager interface {
+	GameEventCreate(ctx context.Context, event types.GameEvent) (bool, error)
+	GameEventGet(ctx context.Context, gameID string, roundID string, eventType types.GameEventType) (types.GameEvent, error)
+	GameEventGetByEventID(ctx context.Context, gameID string, eventID string) (types.GameEvent, error)
+}
+
+type LoyaltyManager interface {
+	PointsRateUpsert(ctx context.Context, rate types.PointsRate) (types.PointsRate, error)
+	PointsRateGet(ctx context.Context, category string) (types.PointsRate, error)
+	GetPointsRates(ctx context.Context) ([]types.PointsRate, error)
+	PointsRateDelete(ctx context.Context, category string) error
+	PointsEntryCreate(ctx context.Context, entry types.PointsEntry) (bool, error)
+	PointsEntryGet(ctx context.Context, source types.PointsSource, referenceID uuid.UUID) (types.PointsEntry, error)
+	PointsLotsConsume(ctx context.Context, userID uuid.UUID, points decimal.Decimal) error
+	PointsLotsWarn(ctx context.Context, now time.Time, before time.Time) ([]types.PointsExpiryNotice, error)
+	PointsLotsExpire(ctx context.Context, now time.Time, limit int) ([]types.PointsExpiryNotice, error)
+	GetPointsLiability(ctx context.Context, from time.Time, to time.Time) ([]types.PointsLiability, error)
+	TierCreate(ctx context.Context, tier types.Tier) (types.Tier, error)
+	GetTiers(ctx context.Context) ([]types.Tier, error)
+	TierUpdate(ctx context.Context, tier types.Tier) (types.Tier, error)
+	TierDelete(ctx context.Context, id uuid.UUID) error
+	UserTiersQualify(ctx context.Context, userID uuid.NullUUID, since time.Time) ([]types.TierChange, error)
+	UserTiersWarn(ctx context.Context, userID uuid.NullUUID, since time.Time, demotionDate time.Time) ([]types.TierWarning, error)
+	UserTiersDemote(ctx context.Context, userID uuid.NullUUID, since time.Time, now time.Time) ([]types.TierChange, error)
+	GetTierHistory(ctx context.Context, userID uuid.UUID) ([]types.TierHistoryEntry, error)
+}
+
+type CatalogManager interface {
+	CatalogItemCreate(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error)
+	CatalogItemGetByID(ctx context.Context, id uuid.UUID) (types.CatalogItem, error)
+	GetCatalogItems(ctx context.Context, activeOnly bool) ([]types.CatalogItem, error)
+	CatalogItemUpdate(ctx context.Context, item types.CatalogItem) (types.CatalogItem, error)
+	CatalogItemDelete(ctx context.Context, id uuid.UUID) error
+	CatalogItemReserve(ctx context.Context, id uuid.UUID) error
+	RedemptionCreate(ctx context.Context, redemption types.Redemption) (types.Redemption, error)
+	GetRedemptions(ctx context.Context, filter types.RedemptionFilter) ([]types.Redemption, error)
+	RedemptionFulfil(ctx context.Context, id uuid.UUID) (types.Redemption, error)
+}
+
+type TournamentManager interface {
+	TournamentCreate(ctx context.Context, tournament types.Tournament) (types.Tournament, error)
+	TournamentGetByID(ctx context.Context, id uuid.UUID) (types.Tournament, error)
+	GetTournaments(ctx context.Context) ([]types.Tournament, error)
+	GetRunningTournaments(ctx context.Context, at time.Time) ([]types.Tournament, error)
+	GetEndedTournaments(ctx context.Context, at time.Time) ([]types.Tournament, error)
+	TournamentUpdate(ctx context.Context, tournament types.Tournament) (types.Tournament, error)
+	TournamentDelete(ctx context.Context, id uuid.UUID) error
+	TournamentFinish(ctx context.Context, id uuid.UUID, finished time.Time) error
+	TournamentResultCreate(ctx context.Context, result types.TournamentResult) error
+	TournamentResultPrize(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID, userPromotionID uuid.UUID) error
+	TournamentResultPrizeFail(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID, reason string) error
+	TournamentResultLock(ctx context.Context, tournamentID uuid.UUID, userID uuid.UUID) (types.TournamentResult, error)
+	GetTournamentResults(ctx context.Context, tournamentID uuid.UUID) ([]types.TournamentResult, error)
+	GetUnpaidTournamentResults(ctx context.Context) ([]types.TournamentResult, error)
+}
+
+type ReferralManager interface {
+	GetQualifiedReferrals(ctx context.Context, condition types.ReferralCondition, threshold decimal.Decimal, limit int) ([]types.Referral, error)
+	ReferralReward(ctx context.Context, id uuid.UUID, rewarded time.Time) error
+	GetReferralReport(ctx context.Context) ([]types.ReferralReport, error)
 }
 
 type Persistent interface {
 	Tx
 	UserManager
+	LedgerManager
 	PromotionManager
 	UserPromotionManager
+	CashbackManager
+	FreeSpinsManager
+	PromotionCodeManager
+	WelcomePackageManager
+	IdempotencyManager
+	GameManager
+	LoyaltyManager
+	CatalogManager
+	TournamentManager
+	ReferralManager
 }
 
 type PubSub interface {
@@ -57,6 +200,20 @@ type PubSub interface {
 	Subscribe(ctx context.Context, channel string) *redis.PubSub
 }
 
+// Leaderboard keeps the scores of players in sorted sets, highest first.
+type Leaderboard interface {
+	LeaderboardIncrement(ctx context.Context, key string, userID uuid.UUID, score float64) error
+	LeaderboardMax(ctx context.Context, key string, userID uuid.UUID, score float64) error
+	LeaderboardTop(ctx context.Context, key string, limit int) ([]types.LeaderboardEntry, error)
+	LeaderboardRank(ctx context.Context, key string, userID uuid.UUID) (types.LeaderboardEntry, error)
+	LeaderboardExpire(ctx context.Context, key string, ttl time.Duration) error
+}
+
+// RateLimiter counts attempts in fixed windows.
+type RateLimiter interface {
+	RateLimitHit(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
 func IsErrNotFound(err error) bool {
 	return errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) || errors.Is(err, redis.Nil)
 }
@@ -70,3 +227,23 @@ func IsErrConflict(err error) bool {
 	}
 	return false
 }
+
+func IsErrCheckViolation(err error) bool {
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return pgErr.Code == "23514"
+		}
+	}
+	return false
+}
+
+func IsErrForeignKeyViolation(err error) bool {
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return pgErr.Code == "23503"
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CatalogItemType string
+
+const (
+	CatalogItemBonus     CatalogItemType = "bonus"
+	CatalogItemPromotion CatalogItemType = "promotion"
+	CatalogItemPhysical  CatalogItemType = "physical"
+)
+
+// DefaultCatalogValidityDays is how long a promotion granted by a catalog
+// item can be claimed, or its bonus wagered, when the item does not set it.
+const DefaultCatalogValidityDays = 7
+
+// CatalogItem is a reward players buy with loyalty points. A bonus item
+// credits BonusAmount as a bonus of PromotionID, wagered under its
+// multiplier within ValidityDays, a promotion item assigns PromotionID for
+// ValidityDays and a physical item is handed out by staff. Stock is
+// unlimited when nil.
+type CatalogItem struct {
+	ID           uuid.UUID       `json:"id"`
+	Title        string          `json:"title" validate:"required"`
+	Description  string          `json:"description"`
+	Type         CatalogItemType `json:"type" validate:"required,oneof=bonus promotion physical"`
+	PointsPrice  decimal.Decimal `json:"points_price" swaggertype:"string"`
+	Stock        *int            `json:"stock"`
+	BonusAmount  Money           `json:"bonus_amount"`
+	PromotionID  uuid.NullUUID   `json:"promotion_id" swaggertype:"string"`
+	ValidityDays int             `json:"validity_days"`
+	IsActive     bool            `json:"is_active"`
+	Created      time.Time       `json:"created"`
+	Updated      time.Time       `json:"updated"`
+}
+
+type RedemptionStatus string
+
+const (
+	RedemptionPending   RedemptionStatus = "pending"
+	RedemptionFulfilled RedemptionStatus = "fulfilled"
+)
+
+// Redemption is a catalog item bought by a player. Bonus and promotion items
+// are fulfilled when redeemed, physical items when staff hand them out.
+type Redemption struct {
+	ID              uuid.UUID        `json:"id"`
+	UserID          uuid.UUID        `json:"user_id"`
+	CatalogItemID   uuid.UUID        `json:"catalog_item_id"`
+	Points          decimal.Decimal  `json:"points" swaggertype:"string"`
+	Status          RedemptionStatus `json:"status"`
+	UserPromotionID uuid.NullUUID    `json:"user_promotion_id" swaggertype:"string"`
+	Created         time.Time        `json:"created"`
+	Fulfilled       *time.Time       `json:"fulfilled"`
+}
+
+type RedemptionFilter struct {
+	UserID uuid.NullUUID
+	Status *RedemptionStatus
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type EligibilityRule string
+
+const (
+	RuleMinAccountAge      EligibilityRule = "min_account_age_days"
+	RuleTiers              EligibilityRule = "tier_ids"
+	RuleCountries          EligibilityRule = "countries"
+	RuleExcludedCountries  EligibilityRule = "excluded_countries"
+	RuleMinTotalDeposits   EligibilityRule = "min_total_deposits"
+	RuleRequiredPromotions EligibilityRule = "required_promotion_ids"
+	RuleExcludedPromotions EligibilityRule = "excluded_promotion_ids"
+)
+
+// EligibilityRules restrict which players can get a promotion. A player has
+// to pass every rule that is set, unset rules do not restrict.
+type EligibilityRules struct {
+	MinAccountAgeDays    int             `json:"min_account_age_days,omitempty" validate:"min=0" example:"30"`
+	TierIDs              []uuid.UUID     `json:"tier_ids,omitempty"`
+	Countries            []string        `json:"countries,omitempty" validate:"dive,iso3166_1_alpha2" example:"DE,AT"`
+	ExcludedCountries    []string        `json:"excluded_countries,omitempty" validate:"dive,iso3166_1_alpha2" example:"US"`
+	MinTotalDeposits     decimal.Decimal `json:"min_total_deposits" swaggertype:"string" example:"100"`
+	RequiredPromotionIDs []uuid.UUID     `json:"required_promotion_ids,omitempty"`
+	ExcludedPromotionIDs []uuid.UUID     `json:"excluded_promotion_ids,omitempty"`
+}
+
+// EligibilityProfile is what eligibility rules are checked against.
+// TotalDeposits is in the currency of the player and PromotionIDs are the
+// promotions the player received.
+type EligibilityProfile struct {
+	UserID        uuid.UUID
+	Created       time.Time
+	TierID        uuid.NullUUID
+	Country       string
+	TotalDeposits Money
+	PromotionIDs  []uuid.UUID
+}
+
+// EligibilityError names the eligibility rule a player failed. It matches
+// ErrNotEligible.
+type EligibilityError struct {
+	Rule   EligibilityRule
+	Reason string
+}
+
+func (e *EligibilityError) Error() string {
+	return fmt.Sprintf("%s, rule %s failed: %s", ErrNotEligible, e.Rule, e.Reason)
+}
+
+func (e *EligibilityError) Is(target error) bool {
+	return target == ErrNotEligible
+}
+
+// Check returns an EligibilityError for the first rule the player fails at
+// now. Deposits only count towards the minimum when they are in currency.
+func (r EligibilityRules) Check(profile EligibilityProfile, currency Currency, now time.Time) error {
+	if r.MinAccountAgeDays > 0 && now.Before(profile.Created.AddDate(0, 0, r.MinAccountAgeDays)) {
+		return &EligibilityError{
+			Rule:   RuleMinAccountAge,
+			Reason: fmt.Sprintf("account has to be at least %d days old", r.MinAccountAgeDays),
+		}
+	}
+
+	if len(r.TierIDs) > 0 && (!profile.TierID.Valid || !slices.Contains(r.TierIDs, profile.TierID.UUID)) {
+		return &EligibilityError{
+			Rule:   RuleTiers,
+			Reason: "player is not in one of the required tiers",
+		}
+	}
+
+	if len(r.Countries) > 0 && !slices.Contains(r.Countries, profile.Country) {
+		return &EligibilityError{
+			Rule:   RuleCountries,
+			Reason: "promotion is not offered in the country of the player",
+		}
+	}
+
+	if slices.Contains(r.ExcludedCountries, profile.Country) {
+		return &EligibilityError{
+			Rule:   RuleExcludedCountries,
+			Reason: "promotion is not offered in the country of the player",
+		}
+	}
+
+	if r.MinTotalDeposits.IsPositive() &&
+		(profile.TotalDeposits.Currency != currency || profile.TotalDeposits.Amount.LessThan(r.MinTotalDeposits)) {
+		return &EligibilityError{
+			Rule:   RuleMinTotalDeposits,
+			Reason: fmt.Sprintf("player has to have deposited at least %s %s", r.MinTotalDeposits, currency),
+		}
+	}
+
+	for _, id := range r.RequiredPromotionIDs {
+		if !slices.Contains(profile.PromotionIDs, id) {
+			return &EligibilityError{
+				Rule:   RuleRequiredPromotions,
+				Reason: fmt.Sprintf("player has to have received promotion %s", id),
+			}
+		}
+	}
+
+	for _, id := range r.ExcludedPromotionIDs {
+		if slices.Contains(profile.PromotionIDs, id) {
+			return &EligibilityError{
+				Rule:   RuleExcludedPromotions,
+				Reason: fmt.Sprintf("player already received promotion %s", id),
+			}
+		}
+	}
+
+	return nil
+}
@@ -15,4 +15,61 @@ var (
 	ErrPromotionNotStarted     = errors.New("Promotion did not start yet")
 	ErrRequestorIDNotMatching  = errors.New("Requestor ID is not matching path ID")
 	ErrPromotionClaimed        = errors.New("Promotion claimed")
+	ErrPromotionRevoked        = errors.New("Promotion was revoked")
+	ErrPromotionArchived       = errors.New("Promotion is archived")
+	ErrPromotionNotArchived    = errors.New("Promotion is not archived")
+	ErrPromotionNotRevocable   = errors.New("Only assigned or claimed promotions can be revoked")
+	ErrUserPromotionChanged    = errors.New("User promotion changed while it was revoked, try again")
+	ErrAdjustmentNotAllowed    = errors.New("Balance adjustments require staff role")
+	ErrReferenceNotAllowed     = errors.New("Ledger references require staff role")
+	ErrCurrencyMismatch        = errors.New("Currency does not match")
+	ErrInvalidAmount           = errors.New("Amount must be positive")
+	ErrInvalidCursor           = errors.New("Invalid cursor")
+	ErrInvalidWagering         = errors.New("Wagering multiplier cannot be negative")
+	ErrInvalidCashback         = errors.New("Cashback promotions need a percentage between 0 and 100, a positive maximum amount and a daily or weekly period")
+	ErrInvalidMatchBonus       = errors.New("Match bonus promotions need a positive percentage, a positive maximum bonus and a non-negative minimum deposit")
+	ErrNoQualifyingDeposit     = errors.New("No unmatched deposit since the promotion started meets its minimum deposit")
+	ErrPromotionNotAssignable  = errors.New("Promotion is granted automatically and cannot be assigned")
+	ErrInvalidFreeSpins        = errors.New("Free spins promotions need a game ID, a positive number of spins, a positive stake and a positive validity")
+	ErrFreeSpinsUnavailable    = errors.New("Free spins are used up, expired or settled")
+	ErrInvalidPromotionCodes   = errors.New("Single use codes need a count and no code, shared codes need a code, and codes cannot expire in the past")
+	ErrPromotionCodeExists     = errors.New("A promotion code with this code already exists")
+	ErrInvalidPromotionCode    = errors.New("Invalid or expired promotion code")
+	ErrPromotionCodeUsedUp     = errors.New("Promotion code reached its redemption limit")
+	ErrPromotionCodeRedeemed   = errors.New("Promotion code was already redeemed")
+	ErrTooManyAttempts         = errors.New("Too many attempts, try again later")
+	ErrInvalidEligibility      = errors.New("Eligibility rules cannot require and exclude the same country or promotion, or require a negative deposit total")
+	ErrNotEligible             = errors.New("Player is not eligible for this promotion")
+	ErrInvalidAvailability     = errors.New("Promotion has to become available before it stops being available")
+	ErrInvalidPromotionFilter  = errors.New("Availability has to be live, upcoming or ended")
+	ErrInvalidStatusFilter     = errors.New("Status has to be assigned, claimed, expired, revoked or forfeited")
+	ErrInvalidBudget           = errors.New("Promotion budget has to be positive and cannot be below what was already spent or claimed")
+	ErrBudgetExhausted         = errors.New("Promotion budget is exhausted")
+	ErrClaimLimitReached       = errors.New("Promotion reached its maximum number of claims")
+	ErrUserClaimLimitReached   = errors.New("Player reached the claim limit of this promotion")
+	ErrInvalidGameEvent        = errors.New("Game event needs an event ID, a game ID, a round ID and a bet, win or rollback type")
+	ErrGameRoundSettled        = errors.New("Game round already has a win and cannot be rolled back")
+	ErrGameRoundDuplicate      = errors.New("Game round already has a bet or a rollback")
+	ErrInvalidAPIKey           = errors.New("Invalid API key")
+	ErrInvalidPointsRate       = errors.New("Points rate cannot be negative")
+	ErrInvalidTierThreshold    = errors.New("Tier points threshold cannot be negative")
+	ErrTierExists              = errors.New("A tier with this name or points threshold already exists")
+	ErrInvalidCatalogItem      = errors.New("Catalog item needs a positive points price, a non-negative stock and the reward of its type")
+	ErrCatalogItemUnavailable  = errors.New("Catalog item is not available")
+	ErrCatalogItemOutOfStock   = errors.New("Catalog item is out of stock")
+	ErrCatalogItemRedeemed     = errors.New("Catalog item was already redeemed and can only be deactivated")
+	ErrInsufficientPoints      = errors.New("Insufficient loyalty points")
+	ErrInvalidReferralCode     = errors.New("Invalid referral code")
+	ErrSelfReferral            = errors.New("Players cannot refer themselves")
+	ErrDuplicateReferral       = errors.New("Referral codes are for new players only")
+	ErrInvalidTournamentPrizes = errors.New("Tournament prizes must cover distinct ranks and assign an existing promotion")
+	ErrTournamentStarted       = errors.New("Tournament already started, its scoring and games cannot change")
+	ErrTournamentFinished      = errors.New("Tournament is finished")
+	ErrInvalidWelcomePackage   = errors.New("Welcome package steps need an assignable promotion, a non-negative validity, and a deposit number only when they unlock with a deposit")
+	ErrWelcomePackageConflict  = errors.New("Another version of the welcome package was created at the same time, try again")
+	ErrWelcomePackageStarted   = errors.New("Player already started a welcome package")
+	ErrWelcomeStepUnlocked     = errors.New("Welcome package step was already unlocked")
+	ErrIdempotencyKeyInvalid   = errors.New("Idempotency key must be between 1 and 255 characters")
+	ErrIdempotencyKeyInUse     = errors.New("A request with this idempotency key is still being processed")
+	ErrIdempotencyKeyReused    = errors.New("Idempotency key was already used for a different request")
 )
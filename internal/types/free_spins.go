@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FreeSpinsEntitlement is what a player gets by claiming a free spins
+// promotion. Game servers consume it one spin at a time and the winnings add
+// up until the spins are used up or expire, when they are settled to the
+// player.
+type FreeSpinsEntitlement struct {
+	ID              uuid.UUID  `json:"id"`
+	UserPromotionID uuid.UUID  `json:"user_promotion_id"`
+	UserID          uuid.UUID  `json:"user_id"`
+	GameID          string     `json:"game_id"`
+	Spins           int        `json:"spins"`
+	Remaining       int        `json:"remaining"`
+	Stake           Money      `json:"stake"`
+	Winnings        Money      `json:"winnings"`
+	Expires         time.Time  `json:"expires"`
+	Settled         *time.Time `json:"settled"`
+	Created         time.Time  `json:"created"`
+	Updated         time.Time  `json:"updated"`
+}
+
+// FreeSpin is a free spin played by a game server. A round is counted once,
+// repeating it does not use another spin.
+type FreeSpin struct {
+	RoundID string `json:"round_id" validate:"required"`
+	Win     Money  `json:"win"`
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type GameEventType string
+
+const (
+	GameEventBet      GameEventType = "bet"
+	GameEventWin      GameEventType = "win"
+	GameEventRollback GameEventType = "rollback"
+)
+
+// GameEvent is a bet, win or rollback reported by a game server. EventID is
+// the game server's ID of the event, unique within the game. A round has at
+// most one bet and one rollback but can have several wins. CashAmount and
+// BonusAmount split Amount between the balances it was taken from or paid
+// to. GameCategory selects the loyalty points rate of bets.
+type GameEvent struct {
+	ID           uuid.UUID       `json:"id"`
+	EventID      string          `json:"event_id" validate:"required,max=255"`
+	UserID       uuid.UUID       `json:"user_id" validate:"required"`
+	GameID       string          `json:"game_id" validate:"required"`
+	GameCategory string          `json:"game_category"`
+	RoundID      string          `json:"round_id" validate:"required"`
+	Type         GameEventType   `json:"type" validate:"required,oneof=bet win rollback"`
+	Amount       Money           `json:"amount"`
+	CashAmount   decimal.Decimal `json:"cash_amount" swaggertype:"string"`
+	BonusAmount  decimal.Decimal `json:"bonus_amount" swaggertype:"string"`
+	Created      time.Time       `json:"created"`
+}
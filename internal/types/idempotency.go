@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyKey is a request reserved by a client supplied key. A zero
+// StatusCode means the original request is still being processed.
+type IdempotencyKey struct {
+	UserID      uuid.UUID
+	Key         string
+	RequestHash string
+	StatusCode  int
+	Response    []byte
+	Created     time.Time
+}
+
+func (k IdempotencyKey) IsCompleted() bool {
+	return k.StatusCode != 0
+}
@@ -0,0 +1,198 @@
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LedgerAccount identifies one side of a ledger entry. Player accounts are
+// scoped by the entry's UserID, house accounts are shared.
+type LedgerAccount string
+
+const (
+	LedgerAccountPlayerCash  LedgerAccount = "player_cash"
+	LedgerAccountPlayerBonus LedgerAccount = "player_bonus"
+	LedgerAccountCashier     LedgerAccount = "cashier"
+	LedgerAccountPromotions  LedgerAccount = "promotions"
+	LedgerAccountAdjustments LedgerAccount = "adjustments"
+	LedgerAccountGames       LedgerAccount = "games"
+	LedgerAccountLoyalty     LedgerAccount = "loyalty"
+)
+
+type LedgerSource string
+
+const (
+	LedgerSourceManual         LedgerSource = "manual"
+	LedgerSourcePromotionClaim LedgerSource = "promotion_claim"
+	LedgerSourceAdjustment     LedgerSource = "adjustment"
+	LedgerSourceBonusConvert   LedgerSource = "bonus_conversion"
+	LedgerSourceBonusForfeit   LedgerSource = "bonus_forfeit"
+	LedgerSourceGameBet        LedgerSource = "game_bet"
+	LedgerSourceGameWin        LedgerSource = "game_win"
+	LedgerSourceGameRollback   LedgerSource = "game_rollback"
+	LedgerSourceRedemption     LedgerSource = "points_redemption"
+	LedgerSourceReferral       LedgerSource = "referral_reward"
+	LedgerSourceFreeSpins      LedgerSource = "free_spins_win"
+	LedgerSourceClawback       LedgerSource = "promotion_clawback"
+)
+
+// ledgerCounterAccounts maps a source to the house account that balances
+// the player side of the entry.
+var ledgerCounterAccounts = map[LedgerSource]LedgerAccount{
+	LedgerSourceManual:         LedgerAccountCashier,
+	LedgerSourcePromotionClaim: LedgerAccountPromotions,
+	LedgerSourceAdjustment:     LedgerAccountAdjustments,
+	LedgerSourceBonusConvert:   LedgerAccountPlayerBonus,
+	LedgerSourceBonusForfeit:   LedgerAccountPromotions,
+	LedgerSourceGameBet:        LedgerAccountGames,
+	LedgerSourceGameWin:        LedgerAccountGames,
+	LedgerSourceGameRollback:   LedgerAccountGames,
+	LedgerSourceRedemption:     LedgerAccountLoyalty,
+	LedgerSourceReferral:       LedgerAccountPromotions,
+	LedgerSourceFreeSpins:      LedgerAccountPromotions,
+	LedgerSourceClawback:       LedgerAccountPromotions,
+}
+
+// UserPromotionLedgerSources returns the sources whose entries reference
+// the user promotion that caused them.
+func UserPromotionLedgerSources() []string {
+	return []string{
+		string(LedgerSourcePromotionClaim),
+		string(LedgerSourceRedemption),
+		string(LedgerSourceBonusConvert),
+		string(LedgerSourceBonusForfeit),
+		string(LedgerSourceFreeSpins),
+		string(LedgerSourceClawback),
+	}
+}
+
+func (s LedgerSource) IsValid() bool {
+	_, ok := ledgerCounterAccounts[s]
+	return ok
+}
+
+// LedgerEntry is an immutable double-entry record moving Amount from
+// DebitAccount to CreditAccount.
+type LedgerEntry struct {
+	ID            uuid.UUID     `json:"id"`
+	UserID        uuid.UUID     `json:"user_id"`
+	DebitAccount  LedgerAccount `json:"debit_account"`
+	CreditAccount LedgerAccount `json:"credit_account"`
+	Amount        Money         `json:"amount"`
+	Source        LedgerSource  `json:"source"`
+	ReferenceID   uuid.NullUUID `json:"reference_id" swaggertype:"string"`
+	Created       time.Time     `json:"created"`
+	// UserPromotion is set when the entry was caused by a user promotion.
+	UserPromotion *UserPromotion `json:"user_promotion,omitempty"`
+}
+
+// NewPlayerCashEntry builds an entry changing the player's cash account by
+// value, balanced against the house account for the given source.
+func NewPlayerCashEntry(userID uuid.UUID, source LedgerSource, referenceID uuid.NullUUID, value Money) LedgerEntry {
+	return newPlayerEntry(LedgerAccountPlayerCash, userID, source, referenceID, value)
+}
+
+// NewPlayerBonusEntry builds an entry changing the player's bonus account by
+// value, balanced against the house account for the given source.
+func NewPlayerBonusEntry(userID uuid.UUID, source LedgerSource, referenceID uuid.NullUUID, value Money) LedgerEntry {
+	return newPlayerEntry(LedgerAccountPlayerBonus, userID, source, referenceID, value)
+}
+
+// NewBonusConversionEntry builds an entry moving amount from the player's
+// bonus account to their cash account.
+func NewBonusConversionEntry(userID uuid.UUID, referenceID uuid.NullUUID, amount Money) LedgerEntry {
+	return newPlayerEntry(LedgerAccountPlayerCash, userID, LedgerSourceBonusConvert, referenceID, amount)
+}
+
+func newPlayerEntry(account LedgerAccount, userID uuid.UUID, source LedgerSource, referenceID uuid.NullUUID, value Money) LedgerEntry {
+	entry := LedgerEntry{
+		ID:            uuid.New(),
+		UserID:        userID,
+		DebitAccount:  ledgerCounterAccounts[source],
+		CreditAccount: account,
+		Amount:        value,
+		Source:        source,
+		ReferenceID:   referenceID,
+		Created:       time.Now(),
+	}
+
+	if value.IsNegative() {
+		entry.DebitAccount, entry.CreditAccount = entry.CreditAccount, entry.DebitAccount
+		entry.Amount = value.Neg()
+	}
+
+	return entry
+}
+
+// Delta returns the signed change the entry applies to account.
+func (e LedgerEntry) Delta(account LedgerAccount) Money {
+	switch account {
+	case e.CreditAccount:
+		return e.Amount
+	case e.DebitAccount:
+		return e.Amount.Neg()
+	}
+	return NewMoney(decimal.Zero, e.Amount.Currency)
+}
+
+type BalanceReconciliation struct {
+	UserID             uuid.UUID `json:"user_id"`
+	Balance            Money     `json:"balance"`
+	LedgerBalance      Money     `json:"ledger_balance"`
+	Difference         Money     `json:"difference"`
+	BonusBalance       Money     `json:"bonus_balance"`
+	LedgerBonusBalance Money     `json:"ledger_bonus_balance"`
+	BonusDifference    Money     `json:"bonus_difference"`
+}
+
+type LedgerFilter struct {
+	UserID  uuid.UUID
+	From    *time.Time
+	To      *time.Time
+	Sources []LedgerSource
+	After   *LedgerCursor
+	Limit   int
+}
+
+// LedgerCursor is the position of the last entry of a page. Entries are
+// ordered newest first, so the next page starts strictly before it.
+type LedgerCursor struct {
+	Created time.Time
+	ID      uuid.UUID
+}
+
+func (c LedgerCursor) String() string {
+	raw := c.Created.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseLedgerCursor(value string) (LedgerCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return LedgerCursor{}, ErrInvalidCursor
+	}
+
+	created, id, found := strings.Cut(string(raw), "|")
+	if !found {
+		return LedgerCursor{}, ErrInvalidCursor
+	}
+
+	cursor := LedgerCursor{}
+	if cursor.Created, err = time.Parse(time.RFC3339Nano, created); err != nil {
+		return LedgerCursor{}, ErrInvalidCursor
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return LedgerCursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+type LedgerPage struct {
+	Entries    []LedgerEntry `json:"entries"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
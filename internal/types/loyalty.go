@@ -0,0 +1,157 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultGameCategory is the points rate used for games whose category has
+// no rate of its own.
+const DefaultGameCategory = "default"
+
+type PointsSource string
+
+const (
+	PointsSourceWager      PointsSource = "wager"
+	PointsSourceRedemption PointsSource = "redemption"
+	PointsSourceExpiry     PointsSource = "expiry"
+	PointsSourceRollback   PointsSource = "rollback"
+)
+
+// PointsRate is the number of loyalty points earned per unit of currency
+// wagered on games of Category.
+type PointsRate struct {
+	Category string          `json:"category" validate:"required,max=64"`
+	Rate     decimal.Decimal `json:"rate" swaggertype:"string"`
+	Created  time.Time       `json:"created"`
+	Updated  time.Time       `json:"updated"`
+}
+
+// PointsFor returns the points earned for wagering amount, rounded down to
+// two decimal places.
+func (r PointsRate) PointsFor(amount Money) decimal.Decimal {
+	return amount.Amount.Mul(r.Rate).RoundDown(2)
+}
+
+// PointsEntry is an immutable change of a player's loyalty points. A source
+// and reference pair is booked at most once. Earned points expire at Expires
+// unless they are redeemed before. Wager and rollback entries reference the
+// bet's game event.
+type PointsEntry struct {
+	ID          uuid.UUID       `json:"id"`
+	UserID      uuid.UUID       `json:"user_id"`
+	Points      decimal.Decimal `json:"points" swaggertype:"string"`
+	Source      PointsSource    `json:"source"`
+	ReferenceID uuid.NullUUID   `json:"reference_id" swaggertype:"string"`
+	Expires     *time.Time      `json:"expires,omitempty"`
+	Created     time.Time       `json:"created"`
+}
+
+// PointsExpiry configures how long earned points are valid and how long
+// before they expire the player is warned.
+type PointsExpiry struct {
+	Validity time.Duration
+	Warning  time.Duration
+}
+
+// PointsExpiryNotice is published to a player whose points expire at
+// Expires, or expired when Expired is set.
+type PointsExpiryNotice struct {
+	UserID  uuid.UUID       `json:"user_id"`
+	Points  decimal.Decimal `json:"points" swaggertype:"string"`
+	Expires time.Time       `json:"expires"`
+	Expired bool            `json:"expired"`
+}
+
+// PointsLiability sums the unredeemed points expiring on Date.
+type PointsLiability struct {
+	Date   time.Time       `json:"date"`
+	Users  int             `json:"users"`
+	Points decimal.Decimal `json:"points" swaggertype:"string"`
+}
+
+// Tier is a VIP level reached by players who earned at least MinPoints
+// loyalty points.
+type Tier struct {
+	ID        uuid.UUID       `json:"id"`
+	Name      string          `json:"name" validate:"required,max=64"`
+	MinPoints decimal.Decimal `json:"min_points" swaggertype:"string"`
+	Benefits  []string        `json:"benefits"`
+	Created   time.Time       `json:"created"`
+	Updated   time.Time       `json:"updated"`
+}
+
+type TierChangeReason string
+
+const (
+	TierChangeQualified TierChangeReason = "qualified"
+	TierChangeDemoted   TierChangeReason = "demoted"
+)
+
+// TierChange is published to the player when their tier changes. Tier is
+// nil when the player no longer qualifies for any tier.
+type TierChange struct {
+	UserID           uuid.UUID        `json:"user_id"`
+	PreviousTierID   uuid.NullUUID    `json:"previous_tier_id" swaggertype:"string"`
+	Tier             *Tier            `json:"tier"`
+	Reason           TierChangeReason `json:"reason"`
+	QualifyingPoints decimal.Decimal  `json:"qualifying_points" swaggertype:"string"`
+}
+
+// TierWarning is published to a player who no longer earns enough points
+// for their tier. They are moved to QualifiedTier at DemotionDate unless
+// they requalify before.
+type TierWarning struct {
+	UserID           uuid.UUID       `json:"user_id"`
+	Tier             *Tier           `json:"tier"`
+	QualifiedTier    *Tier           `json:"qualified_tier"`
+	QualifyingPoints decimal.Decimal `json:"qualifying_points" swaggertype:"string"`
+	DemotionDate     time.Time       `json:"demotion_date"`
+}
+
+// TierHistoryEntry records a tier change of a player. Tier names are kept
+// so the entry stays readable after the tier is renamed or deleted.
+type TierHistoryEntry struct {
+	ID               uuid.UUID        `json:"id"`
+	UserID           uuid.UUID        `json:"user_id"`
+	PreviousTierID   uuid.NullUUID    `json:"previous_tier_id" swaggertype:"string"`
+	PreviousTierName *string          `json:"previous_tier_name"`
+	TierID           uuid.NullUUID    `json:"tier_id" swaggertype:"string"`
+	TierName         *string          `json:"tier_name"`
+	Reason           TierChangeReason `json:"reason"`
+	QualifyingPoints decimal.Decimal  `json:"qualifying_points" swaggertype:"string"`
+	Created          time.Time        `json:"created"`
+}
+
+type TierQualificationPeriod string
+
+const (
+	TierQualificationLifetime TierQualificationPeriod = "lifetime"
+	TierQualificationRolling  TierQualificationPeriod = "rolling"
+	TierQualificationQuarter  TierQualificationPeriod = "quarter"
+)
+
+// TierQualification configures which points count towards a tier and how
+// long a player keeps a tier they no longer qualify for.
+type TierQualification struct {
+	Period      TierQualificationPeriod
+	Days        int
+	GracePeriod time.Duration
+}
+
+// Since returns the start of the qualification window at now. A rolling
+// window covers the last Days days. A quarter window covers the previous
+// and the current calendar quarter, so a tier earned in one quarter is kept
+// through the next one.
+func (q TierQualification) Since(now time.Time) time.Time {
+	switch q.Period {
+	case TierQualificationRolling:
+		return now.AddDate(0, 0, -q.Days)
+	case TierQualificationQuarter:
+		quarter := (int(now.Month()) - 1) / 3
+		return time.Date(now.Year(), time.Month(quarter*3+1), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -3, 0)
+	}
+	return time.Time{}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const (
+	EUR             Currency = "EUR"
+	DefaultCurrency          = EUR
+)
+
+// Money is an exact decimal amount in a single currency. Amounts are encoded
+// as JSON strings so they never pass through float64.
+type Money struct {
+	Amount   decimal.Decimal `json:"amount" swaggertype:"string" example:"10.50"`
+	Currency Currency        `json:"currency" swaggertype:"string" example:"EUR"`
+}
+
+func NewMoney(amount decimal.Decimal, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func ParseMoney(amount string, currency Currency) (Money, error) {
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(d, currency), nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return NewMoney(m.Amount.Add(other.Amount), m.Currency), nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Neg())
+}
+
+func (m Money) Neg() Money {
+	return NewMoney(m.Amount.Neg(), m.Currency)
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+func (m Money) IsPositive() bool {
+	return m.Amount.IsPositive()
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount.IsNegative()
+}
+
+func (m Money) String() string {
+	return m.Amount.StringFixed(2) + " " + string(m.Currency)
+}
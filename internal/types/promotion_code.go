@@ -0,0 +1,51 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PromotionCodeKind string
+
+const (
+	PromotionCodeSingleUse PromotionCodeKind = "single_use"
+	PromotionCodeShared    PromotionCodeKind = "shared"
+)
+
+// DefaultPromotionCodeValidityDays is how long a promotion assigned by a
+// code can be claimed when the code does not set it.
+const DefaultPromotionCodeValidityDays = 7
+
+// PromotionCode assigns its promotion to the players who redeem it. A single
+// use code is redeemed once, a shared code once per player up to
+// MaxRedemptions, or without limit when nil. The assigned promotion can be
+// claimed for ValidityDays.
+type PromotionCode struct {
+	ID             uuid.UUID         `json:"id"`
+	PromotionID    uuid.UUID         `json:"promotion_id"`
+	Code           string            `json:"code"`
+	Kind           PromotionCodeKind `json:"kind"`
+	MaxRedemptions *int              `json:"max_redemptions"`
+	Redemptions    int               `json:"redemptions"`
+	ValidityDays   int               `json:"validity_days"`
+	Expires        *time.Time        `json:"expires"`
+	Created        time.Time         `json:"created"`
+}
+
+// PromotionCodeBatch describes the codes staff create for a promotion:
+// Count generated single use codes, or one shared Code.
+type PromotionCodeBatch struct {
+	Kind           PromotionCodeKind `json:"kind" validate:"required,oneof=single_use shared"`
+	Count          int               `json:"count" validate:"omitempty,min=1,max=10000" example:"100"`
+	Code           string            `json:"code" validate:"omitempty,alphanum,min=4,max=32" example:"SPRING25"`
+	MaxRedemptions *int              `json:"max_redemptions" validate:"omitempty,min=1" example:"500"`
+	ValidityDays   int               `json:"validity_days" validate:"min=0" example:"7"`
+	Expires        *time.Time        `json:"expires"`
+}
+
+// RateLimit allows Attempts per Window.
+type RateLimit struct {
+	Attempts int
+	Window   time.Duration
+}
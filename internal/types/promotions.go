@@ -4,22 +4,201 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Promotion struct {
-	ID          uuid.UUID     `json:"id"`
-	Title       string        `json:"title"`
-	Description string        `json:"description"`
-	Amount      float64       `json:"amount"`
-	IsActive    bool          `json:"is_active"`
-	Type        PromotionType `json:"type"`
-	Created     time.Time     `json:"created"`
-	Updated     time.Time     `json:"updated"`
+	ID                 uuid.UUID         `json:"id"`
+	Title              string            `json:"title"`
+	Description        string            `json:"description"`
+	Amount             Money             `json:"amount"`
+	IsActive           bool              `json:"is_active"`
+	Type               PromotionType     `json:"type" validate:"omitempty,oneof=regular welcome_bonus cashback match_bonus free_spins referral"`
+	WageringMultiplier decimal.Decimal   `json:"wagering_multiplier" swaggertype:"string" example:"30"`
+	Cashback           *CashbackRule     `json:"cashback,omitempty"`
+	MatchBonus         *MatchBonusRule   `json:"match_bonus,omitempty"`
+	FreeSpins          *FreeSpinsRule    `json:"free_spins,omitempty"`
+	Eligibility        *EligibilityRules `json:"eligibility,omitempty"`
+	AvailableFrom      *time.Time        `json:"available_from,omitempty"`
+	AvailableUntil     *time.Time        `json:"available_until,omitempty"`
+	ManualStateAt      *time.Time        `json:"manual_state_at,omitempty"`
+	Budget             *PromotionBudget  `json:"budget,omitempty"`
+	Archived           *time.Time        `json:"archived,omitempty"`
+	Created            time.Time         `json:"created"`
+	Updated            time.Time         `json:"updated"`
 }
 
 type PromotionType string
 
 const (
-	Regular      PromotionType = "regular"
-	WelcomeBonus PromotionType = "welcome_bonus"
+	Regular       PromotionType = "regular"
+	WelcomeBonus  PromotionType = "welcome_bonus"
+	Cashback      PromotionType = "cashback"
+	MatchBonus    PromotionType = "match_bonus"
+	FreeSpins     PromotionType = "free_spins"
+	ReferralBonus PromotionType = "referral"
 )
+
+type PromotionAvailability string
+
+const (
+	PromotionLive     PromotionAvailability = "live"
+	PromotionUpcoming PromotionAvailability = "upcoming"
+	PromotionEnded    PromotionAvailability = "ended"
+)
+
+// PromotionFilter narrows down the promotions returned by the store. Unset
+// fields do not filter. Availability is evaluated at At: live promotions are
+// active and inside their availability window, upcoming ones become
+// available later and ended ones are no longer available. Archived
+// promotions are only returned, latest first, when Archived is set.
+type PromotionFilter struct {
+	ByType       *PromotionType
+	IsActive     *bool
+	Availability *PromotionAvailability
+	Archived     bool
+	At           time.Time
+}
+
+// WageringRequirement returns the amount that has to be wagered before a
+// bonus of the promotion converts to cash.
+func (p Promotion) WageringRequirement(bonus Money) Money {
+	return NewMoney(bonus.Amount.Mul(p.WageringMultiplier), bonus.Currency)
+}
+
+// IsScheduled reports whether the promotion has an availability window, so
+// the scheduler switches it on and off.
+func (p Promotion) IsScheduled() bool {
+	return p.AvailableFrom != nil || p.AvailableUntil != nil
+}
+
+// IsAvailableAt reports whether t falls inside the availability window of
+// the promotion. An open end does not restrict.
+func (p Promotion) IsAvailableAt(t time.Time) bool {
+	return (p.AvailableFrom == nil || !t.Before(*p.AvailableFrom)) &&
+		(p.AvailableUntil == nil || t.Before(*p.AvailableUntil))
+}
+
+// IsAssignable reports whether the promotion can be assigned to players.
+// Cashback is only granted by its calculation and referral bonuses by the
+// referral program, with the amount the player gets.
+func (p Promotion) IsAssignable() bool {
+	return p.Type != Cashback && p.Type != ReferralBonus
+}
+
+type CashbackPeriod string
+
+const (
+	CashbackDaily  CashbackPeriod = "daily"
+	CashbackWeekly CashbackPeriod = "weekly"
+)
+
+// Last returns the last period that completed before now. Days start at
+// midnight UTC and weeks on Monday.
+func (p CashbackPeriod) Last(now time.Time) (time.Time, time.Time) {
+	to := now.UTC().Truncate(24 * time.Hour)
+
+	if p == CashbackWeekly {
+		to = to.AddDate(0, 0, -(int(to.Weekday())+6)%7)
+		return to.AddDate(0, 0, -7), to
+	}
+
+	return to.AddDate(0, 0, -1), to
+}
+
+// CashbackRule configures a cashback promotion: players get Percentage of
+// their net cash losses over each Period, up to MaxAmount in the currency of
+// the promotion.
+type CashbackRule struct {
+	Percentage decimal.Decimal `json:"percentage" swaggertype:"string" example:"10"`
+	MaxAmount  decimal.Decimal `json:"max_amount" swaggertype:"string" example:"100"`
+	Period     CashbackPeriod  `json:"period" validate:"required,oneof=daily weekly"`
+}
+
+// Amount returns the cashback on netLoss.
+func (r CashbackRule) Amount(netLoss decimal.Decimal) decimal.Decimal {
+	return decimal.Min(netLoss.Mul(r.Percentage).Div(decimal.NewFromInt(100)), r.MaxAmount).Round(2)
+}
+
+// CashbackCalculation records the inputs and the result of a player's
+// cashback for one period, so the granted amount can be audited. The record
+// is kept, without its user promotion, when the user promotion is deleted.
+type CashbackCalculation struct {
+	ID              uuid.UUID       `json:"id"`
+	PromotionID     uuid.UUID       `json:"promotion_id"`
+	UserID          uuid.UUID       `json:"user_id"`
+	UserPromotionID uuid.NullUUID   `json:"user_promotion_id" swaggertype:"string"`
+	PeriodStart     time.Time       `json:"period_start"`
+	PeriodEnd       time.Time       `json:"period_end"`
+	Wagered         Money           `json:"wagered"`
+	Returned        Money           `json:"returned"`
+	NetLoss         Money           `json:"net_loss"`
+	Percentage      decimal.Decimal `json:"percentage" swaggertype:"string"`
+	MaxAmount       decimal.Decimal `json:"max_amount" swaggertype:"string"`
+	Amount          Money           `json:"amount"`
+	Created         time.Time       `json:"created"`
+}
+
+// MatchBonusRule configures a deposit match promotion: players get
+// Percentage of the deposit they claim it with, up to MaxAmount. Deposits
+// below MinDeposit do not qualify.
+type MatchBonusRule struct {
+	Percentage decimal.Decimal `json:"percentage" swaggertype:"string" example:"100"`
+	MaxAmount  decimal.Decimal `json:"max_amount" swaggertype:"string" example:"200"`
+	MinDeposit decimal.Decimal `json:"min_deposit" swaggertype:"string" example:"20"`
+}
+
+// Amount returns the bonus matching deposit.
+func (r MatchBonusRule) Amount(deposit decimal.Decimal) decimal.Decimal {
+	return decimal.Min(deposit.Mul(r.Percentage).Div(decimal.NewFromInt(100)), r.MaxAmount).Round(2)
+}
+
+// PromotionBudget caps what a promotion pays out. Unset limits do not cap.
+// Spent and Claims are counted as the promotion is claimed. Free spins spend
+// the value of their stakes.
+type PromotionBudget struct {
+	Amount           *decimal.Decimal `json:"amount,omitempty" swaggertype:"string" example:"10000"`
+	MaxClaims        *int             `json:"max_claims,omitempty" validate:"omitempty,min=1" example:"500"`
+	MaxClaimsPerUser *int             `json:"max_claims_per_user,omitempty" validate:"omitempty,min=1" example:"1"`
+	Spent            decimal.Decimal  `json:"spent" swaggertype:"string"`
+	Claims           int              `json:"claims"`
+}
+
+// PromotionBudgetAlert is published to staff when the spend of a promotion
+// reaches Threshold of its budget.
+type PromotionBudgetAlert struct {
+	PromotionID uuid.UUID       `json:"promotion_id"`
+	Title       string          `json:"title"`
+	Budget      Money           `json:"budget"`
+	Spent       Money           `json:"spent"`
+	Threshold   decimal.Decimal `json:"threshold" swaggertype:"string"`
+}
+
+type PromotionStateChangeReason string
+
+const (
+	PromotionScheduledStart PromotionStateChangeReason = "scheduled_start"
+	PromotionScheduledEnd   PromotionStateChangeReason = "scheduled_end"
+	PromotionManualStart    PromotionStateChangeReason = "manual_start"
+	PromotionManualEnd      PromotionStateChangeReason = "manual_end"
+)
+
+// PromotionStateChange records a promotion being switched on or off, by the
+// scheduler at the edges of its availability window or by hand.
+type PromotionStateChange struct {
+	ID          uuid.UUID                  `json:"id"`
+	PromotionID uuid.UUID                  `json:"promotion_id"`
+	IsActive    bool                       `json:"is_active"`
+	Reason      PromotionStateChangeReason `json:"reason"`
+	Created     time.Time                  `json:"created"`
+}
+
+// FreeSpinsRule configures a free spins promotion: players get Spins rounds
+// of GameID at Stake each, to be played within ValidityDays of claiming. The
+// winnings are wagered with the wagering multiplier of the promotion.
+type FreeSpinsRule struct {
+	GameID       string          `json:"game_id" example:"starburst"`
+	Spins        int             `json:"spins" example:"20"`
+	Stake        decimal.Decimal `json:"stake" swaggertype:"string" example:"0.2"`
+	ValidityDays int             `json:"validity_days" example:"7"`
+}
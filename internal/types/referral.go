@@ -0,0 +1,66 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReferralStatus string
+
+const (
+	ReferralPending  ReferralStatus = "pending"
+	ReferralRewarded ReferralStatus = "rewarded"
+)
+
+// Referral links a player to the player whose referral code they registered
+// with. Both are rewarded once the referee qualifies.
+type Referral struct {
+	ID         uuid.UUID      `json:"id"`
+	ReferrerID uuid.UUID      `json:"referrer_id"`
+	RefereeID  uuid.UUID      `json:"referee_id"`
+	Status     ReferralStatus `json:"status"`
+	Rewarded   *time.Time     `json:"rewarded"`
+	Created    time.Time      `json:"created"`
+}
+
+type ReferralCondition string
+
+const (
+	ReferralConditionDeposit ReferralCondition = "first_deposit"
+	ReferralConditionWagered ReferralCondition = "wagered"
+)
+
+// ReferralProgram configures when a referee qualifies and the bonus both
+// players get then. With the first_deposit condition the referee's first
+// deposit must be at least Threshold, with the wagered condition their net
+// bets must add up to Threshold. The bonuses are granted as user promotions
+// of the active referral promotion, which can be claimed for Validity.
+type ReferralProgram struct {
+	Condition      ReferralCondition
+	Threshold      Money
+	ReferrerReward Money
+	RefereeReward  Money
+	Validity       time.Duration
+}
+
+// ReferralReport sums up the referrals of a referrer.
+type ReferralReport struct {
+	ReferrerID   uuid.UUID `json:"referrer_id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	Referrals    int       `json:"referrals"`
+	Rewarded     int       `json:"rewarded"`
+	Pending      int       `json:"pending"`
+	LastReferral time.Time `json:"last_referral"`
+}
+
+var mailboxTag = regexp.MustCompile(`\+[^@]*@`)
+
+// Mailbox returns the address mail to email is delivered to, without a
+// "+tag" suffix, so aliases of one mailbox compare equal.
+func Mailbox(email string) string {
+	return strings.ToLower(mailboxTag.ReplaceAllString(email, "@"))
+}
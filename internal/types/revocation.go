@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RevocationReason string
+
+const (
+	RevocationBonusAbuse       RevocationReason = "bonus_abuse"
+	RevocationFraud            RevocationReason = "fraud"
+	RevocationDuplicateAccount RevocationReason = "duplicate_account"
+	RevocationNotEligible      RevocationReason = "not_eligible"
+	RevocationOperatorError    RevocationReason = "operator_error"
+	RevocationPlayerRequest    RevocationReason = "player_request"
+)
+
+// ClawbackPolicy decides how much of a revoked claim is taken back when the
+// player no longer has it: all of it, leaving a negative balance, or what is
+// left, stopping at zero.
+type ClawbackPolicy string
+
+const (
+	ClawbackAllowNegative ClawbackPolicy = "allow_negative"
+	ClawbackCapAtZero     ClawbackPolicy = "cap_at_zero"
+)
+
+// UserPromotionRevocation records staff revoking a user promotion for
+// compliance. Credited is what the claim paid out, ClawedBack what was taken
+// back from Account under Policy. Both are zero for unclaimed promotions.
+type UserPromotionRevocation struct {
+	ID              uuid.UUID           `json:"id"`
+	UserPromotionID uuid.UUID           `json:"user_promotion_id"`
+	UserID          uuid.UUID           `json:"user_id"`
+	PromotionID     uuid.UUID           `json:"promotion_id"`
+	RevokedBy       uuid.UUID           `json:"revoked_by"`
+	Reason          RevocationReason    `json:"reason"`
+	Note            string              `json:"note"`
+	PreviousStatus  UserPromotionStatus `json:"previous_status"`
+	Credited        Money               `json:"credited"`
+	ClawedBack      Money               `json:"clawed_back"`
+	Account         LedgerAccount       `json:"account,omitempty"`
+	Policy          ClawbackPolicy      `json:"policy"`
+	Created         time.Time           `json:"created"`
+}
+
+// PromotionRevokedNotice is published to a player whose promotion was
+// revoked, with what was taken back.
+type PromotionRevokedNotice struct {
+	UserID          uuid.UUID        `json:"user_id"`
+	UserPromotionID uuid.UUID        `json:"user_promotion_id"`
+	PromotionID     uuid.UUID        `json:"promotion_id"`
+	Reason          RevocationReason `json:"reason"`
+	ClawedBack      Money            `json:"clawed_back"`
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TournamentScoring string
+
+const (
+	TournamentScoringWagered    TournamentScoring = "total_wagered"
+	TournamentScoringMultiplier TournamentScoring = "biggest_multiplier"
+	TournamentScoringPoints     TournamentScoring = "points_earned"
+)
+
+// DefaultPrizeValidityDays is how long a promotion won in a tournament can
+// be claimed when the prize does not set it.
+const DefaultPrizeValidityDays = 7
+
+// Tournament ranks players by Scoring on games of GameCategory, or on all
+// games when it is empty, played between StartDate and EndDate. Finished is
+// set once the standings are final, prizes are granted from them until
+// every winner has theirs.
+type Tournament struct {
+	ID           uuid.UUID         `json:"id"`
+	Name         string            `json:"name" validate:"required"`
+	Description  string            `json:"description"`
+	GameCategory string            `json:"game_category" validate:"max=64"`
+	Scoring      TournamentScoring `json:"scoring" validate:"required,oneof=total_wagered biggest_multiplier points_earned"`
+	StartDate    time.Time         `json:"start_date" validate:"required"`
+	EndDate      time.Time         `json:"end_date" validate:"required"`
+	Prizes       []TournamentPrize `json:"prizes" validate:"dive"`
+	Finished     *time.Time        `json:"finished"`
+	Created      time.Time         `json:"created"`
+	Updated      time.Time         `json:"updated"`
+}
+
+// TournamentPrize assigns PromotionID to the players ranked FromRank to
+// ToRank, for ValidityDays.
+type TournamentPrize struct {
+	FromRank     int       `json:"from_rank" validate:"min=1"`
+	ToRank       int       `json:"to_rank" validate:"gtefield=FromRank"`
+	PromotionID  uuid.UUID `json:"promotion_id" validate:"required"`
+	ValidityDays int       `json:"validity_days"`
+}
+
+// IsRunning reports whether events at t count towards the tournament.
+func (t Tournament) IsRunning(at time.Time) bool {
+	return !at.Before(t.StartDate) && at.Before(t.EndDate)
+}
+
+// Counts reports whether games of category count towards the tournament.
+func (t Tournament) Counts(category string) bool {
+	return t.GameCategory == "" || t.GameCategory == category
+}
+
+// Prize returns the prize of rank, if any.
+func (t Tournament) Prize(rank int) (TournamentPrize, bool) {
+	for _, prize := range t.Prizes {
+		if rank >= prize.FromRank && rank <= prize.ToRank {
+			return prize, true
+		}
+	}
+	return TournamentPrize{}, false
+}
+
+// PrizeRanks returns the lowest rank that wins a prize.
+func (t Tournament) PrizeRanks() int {
+	ranks := 0
+	for _, prize := range t.Prizes {
+		ranks = max(ranks, prize.ToRank)
+	}
+	return ranks
+}
+
+// LeaderboardEntry is the standing of a player in a tournament.
+type LeaderboardEntry struct {
+	Rank   int       `json:"rank"`
+	UserID uuid.UUID `json:"user_id"`
+	Score  float64   `json:"score"`
+}
+
+// Leaderboard lists the leading players of a tournament and, when ranked,
+// the standing of the requesting player.
+type Leaderboard struct {
+	Tournament Tournament         `json:"tournament"`
+	Entries    []LeaderboardEntry `json:"entries"`
+	Player     *LeaderboardEntry  `json:"player,omitempty"`
+}
+
+// TournamentResult is the final standing of a player in a finished
+// tournament and the user promotion granted as prize, or why the prize can
+// never be granted.
+type TournamentResult struct {
+	LeaderboardEntry
+	TournamentID    uuid.UUID     `json:"tournament_id"`
+	UserPromotionID uuid.NullUUID `json:"user_promotion_id" swaggertype:"string"`
+	PrizeError      *string       `json:"prize_error,omitempty"`
+	Created         time.Time     `json:"created"`
+}
@@ -4,23 +4,34 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type UserFilter struct {
-	ByID    uuid.NullUUID
-	ByEmail *string
+	ByID           uuid.NullUUID
+	ByEmail        *string
+	ByMailbox      *string
+	ByReferralCode *string
+	// ForUpdate locks the user until the transaction ends.
+	ForUpdate bool
 }
 
 type User struct {
-	ID         uuid.UUID       `json:"id"`
-	Name       string          `json:"name"`
-	Email      string          `json:"email"`
-	Role       UserType        `json:"role,omitempty" `
-	Balance    float64         `json:"balance"`
-	Created    time.Time       `json:"created"`
-	Updated    time.Time       `json:"updated"`
-	Promotions []UserPromotion `json:"promotions,omitempty"`
-	Password   string
+	ID            uuid.UUID       `json:"id"`
+	Name          string          `json:"name"`
+	Email         string          `json:"email"`
+	Role          UserType        `json:"role,omitempty" `
+	Balance       Money           `json:"balance"`
+	BonusBalance  Money           `json:"bonus_balance"`
+	LoyaltyPoints decimal.Decimal `json:"loyalty_points" swaggertype:"string"`
+	Tier          *Tier           `json:"tier,omitempty"`
+	ReferralCode  string          `json:"referral_code"`
+	Country       string          `json:"country,omitempty"`
+	ReferrerID    uuid.NullUUID   `json:"-"`
+	Created       time.Time       `json:"created"`
+	Updated       time.Time       `json:"updated"`
+	Promotions    []UserPromotion `json:"promotions,omitempty"`
+	Password      string
 }
 
 type UserType int
@@ -38,14 +49,53 @@ const (
 )
 
 type UserPromotion struct {
-	ID          uuid.UUID  `json:"id"`
-	Created     time.Time  `json:"created"`
-	Updated     time.Time  `json:"updated"`
-	StartDate   time.Time  `json:"start_date"`
-	EndDate     time.Time  `json:"end_date"`
-	Claimed     *time.Time `json:"claimed"`
-	UserID      uuid.UUID  `json:"user_id"`
-	PromotionID uuid.UUID  `json:"promotion_id"`
-	User        *User      `json:"user"`
-	Promotion   *Promotion `json:"promotion"`
+	ID               uuid.UUID           `json:"id"`
+	Created          time.Time           `json:"created"`
+	Updated          time.Time           `json:"updated"`
+	StartDate        time.Time           `json:"start_date"`
+	EndDate          time.Time           `json:"end_date"`
+	Status           UserPromotionStatus `json:"status"`
+	Claimed          *time.Time          `json:"claimed"`
+	BonusAmount      Money               `json:"bonus_amount"`
+	WageringRequired Money               `json:"wagering_required"`
+	Wagered          Money               `json:"wagered"`
+	Converted        *time.Time          `json:"converted"`
+	Forfeited        *time.Time          `json:"forfeited"`
+	DepositID        uuid.NullUUID       `json:"deposit_id" swaggertype:"string"`
+	UserID           uuid.UUID           `json:"user_id"`
+	PromotionID      uuid.UUID           `json:"promotion_id"`
+	User             *User               `json:"user"`
+	Promotion        *Promotion          `json:"promotion"`
+}
+
+// UserPromotionStatus is where a user promotion is in its lifecycle. An
+// assigned promotion is claimed, expires unclaimed at its end date or is
+// revoked. A claimed bonus is forfeited when its wagering is not met in time.
+type UserPromotionStatus string
+
+const (
+	UserPromotionAssigned  UserPromotionStatus = "assigned"
+	UserPromotionClaimed   UserPromotionStatus = "claimed"
+	UserPromotionExpired   UserPromotionStatus = "expired"
+	UserPromotionRevoked   UserPromotionStatus = "revoked"
+	UserPromotionForfeited UserPromotionStatus = "forfeited"
+)
+
+type UserPromotionFilter struct {
+	Status *UserPromotionStatus
+}
+
+// PromotionExpiryReminder is published to a player whose assigned promotion
+// ends at EndDate without having been claimed.
+type PromotionExpiryReminder struct {
+	UserID          uuid.UUID `json:"user_id"`
+	UserPromotionID uuid.UUID `json:"user_promotion_id"`
+	PromotionID     uuid.UUID `json:"promotion_id"`
+	Title           string    `json:"title"`
+	EndDate         time.Time `json:"end_date"`
+}
+
+// IsWageringMet reports whether the claimed bonus can be converted to cash.
+func (up UserPromotion) IsWageringMet() bool {
+	return up.Wagered.Amount.GreaterThanOrEqual(up.WageringRequired.Amount)
 }
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WelcomeUnlockCondition string
+
+const (
+	WelcomeUnlockRegistration WelcomeUnlockCondition = "registration"
+	WelcomeUnlockDeposit      WelcomeUnlockCondition = "deposit"
+)
+
+// DefaultWelcomeStepValidityHours is how long the promotion of a welcome
+// package step can be claimed when the step does not set it.
+const DefaultWelcomeStepValidityHours = 24
+
+// WelcomePackage is a version of the promotions new players get step by
+// step. Versions do not change once created, players keep the version that
+// was current when they registered.
+type WelcomePackage struct {
+	ID        uuid.UUID     `json:"id"`
+	Version   int           `json:"version"`
+	Steps     []WelcomeStep `json:"steps"`
+	CreatedBy uuid.UUID     `json:"created_by"`
+	Created   time.Time     `json:"created"`
+}
+
+// WelcomeStep assigns PromotionID for ValidityHours once the step unlocks:
+// at registration, or with the player's Deposits-th deposit after it.
+type WelcomeStep struct {
+	Step          int                    `json:"step"`
+	PromotionID   uuid.UUID              `json:"promotion_id" validate:"required"`
+	Unlock        WelcomeUnlockCondition `json:"unlock" validate:"required,oneof=registration deposit"`
+	Deposits      int                    `json:"deposits,omitempty" validate:"min=0" example:"1"`
+	ValidityHours int                    `json:"validity_hours" validate:"min=0" example:"24"`
+}
+
+// UserWelcomePackage is the version of the welcome package a player
+// registered under, with how far they got.
+type UserWelcomePackage struct {
+	UserID    uuid.UUID         `json:"user_id"`
+	PackageID uuid.UUID         `json:"package_id"`
+	Version   int               `json:"version"`
+	Steps     []UserWelcomeStep `json:"steps"`
+	Created   time.Time         `json:"created"`
+}
+
+// UserWelcomeStep is a step of a player's welcome package. Unlocked is set
+// once the step unlocked and UserPromotionID once its promotion was
+// assigned. Steps whose promotion was no longer active, or the player was not
+// eligible for, unlock without one.
+type UserWelcomeStep struct {
+	WelcomeStep
+	UserPromotionID uuid.NullUUID `json:"user_promotion_id" swaggertype:"string"`
+	Unlocked        *time.Time    `json:"unlocked"`
+}
+
+// UnlockedWelcomeStep is a step of a player's welcome package whose
+// condition was met at Unlocked.
+type UnlockedWelcomeStep struct {
+	WelcomeStep
+	UserID   uuid.UUID
+	Unlocked time.Time
+}